@@ -0,0 +1,119 @@
+//******************************************************************************************************
+//  filter.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/sttp/goapi/sttp/data"
+)
+
+// formatIDs defines the filter output format that only lists matching signal IDs.
+const formatIDs = "ids"
+
+func runFilter(ctx context.Context, cmd *command, args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet(cmd, stderr)
+	options := bindConnectionFlags(flags)
+
+	metadataFile := flags.String("file", "", "read cached metadata XML file instead of connecting to a publisher")
+	primaryTable := flags.String("table", "MeasurementDetail", "table used for expressions that are not a FILTER statement, e.g., signal IDs or point tags")
+	outputFormat := flags.String("format", formatText, "output format: text, csv or ids")
+
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+
+	if !validFormat(*outputFormat, formatText, formatCSV, formatIDs) {
+		fmt.Fprintf(stderr, "Invalid output format \"%s\"\n", *outputFormat)
+		return exitUsage
+	}
+
+	dataSet, code, ok := sourceMetadata(ctx, flags, options, *metadataFile, 1, stderr)
+
+	if !ok {
+		return code
+	}
+
+	expression := flags.Arg(flags.NArg() - 1)
+	rows, err := data.SelectDataRows(dataSet, expression, *primaryTable, nil, true)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to evaluate filter expression: %s\n", err.Error())
+		return exitFailure
+	}
+
+	if err = writeFilteredRows(stdout, rows, *outputFormat); err != nil {
+		fmt.Fprintf(stderr, "Failed to write filter results: %s\n", err.Error())
+		return exitFailure
+	}
+
+	return exitSuccess
+}
+
+// writeFilteredRows writes the rows matched by a filter expression grouped by table, in order of first
+// match, since a filter expression with multiple statements can select rows from different tables.
+func writeFilteredRows(writer io.Writer, rows []*data.DataRow, outputFormat string) error {
+	var tables []*data.DataTable
+	tableRows := make(map[*data.DataTable][]*data.DataRow)
+
+	for _, row := range rows {
+		table := row.Parent()
+
+		if _, ok := tableRows[table]; !ok {
+			tables = append(tables, table)
+		}
+
+		tableRows[table] = append(tableRows[table], row)
+	}
+
+	if outputFormat == formatIDs {
+		for _, table := range tables {
+			signalIDIndex := table.ColumnIndex("SignalID")
+
+			if signalIDIndex < 0 {
+				continue
+			}
+
+			for _, row := range tableRows[table] {
+				fmt.Fprintln(writer, row.ValueAsString(signalIDIndex))
+			}
+		}
+
+		return nil
+	}
+
+	for i, table := range tables {
+		if i > 0 {
+			fmt.Fprintln(writer)
+		}
+
+		if err := writeRows(writer, table, tableRows[table], outputFormat, len(tables) > 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+//******************************************************************************************************
+//  flags.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sttp/goapi/sttp"
+)
+
+// connectionOptions defines the command-line options shared by subcommands that connect to a publisher.
+// Every sttp.Config and sttp.Settings field is exposed as a flag named after the field in lower-case,
+// hyphen separated form, e.g., Config.MaxRetries is exposed as -max-retries.
+type connectionOptions struct {
+	config   *sttp.Config
+	settings *sttp.Settings
+
+	failoverAddresses string
+
+	tls           bool
+	tlsCA         string
+	tlsCert       string
+	tlsKey        string
+	tlsServerName string
+	tlsSkipVerify bool
+
+	timeout time.Duration
+	verbose bool
+}
+
+// bindConnectionFlags registers the connection options with the specified flag set.
+func bindConnectionFlags(flags *flag.FlagSet) *connectionOptions {
+	options := &connectionOptions{
+		config:   sttp.NewConfig(),
+		settings: sttp.NewSettings(),
+	}
+
+	config := options.config
+	settings := options.settings
+
+	// Config fields
+	flags.Var((*int32Value)(&config.MaxRetries), "max-retries", "maximum number of connection retries, -1 to retry infinitely")
+	flags.Var((*int32Value)(&config.RetryInterval), "retry-interval", "base connection retry interval, in milliseconds")
+	flags.Var((*int32Value)(&config.MaxRetryInterval), "max-retry-interval", "maximum connection retry interval, in milliseconds")
+	flags.BoolVar(&config.AutoReconnect, "auto-reconnect", config.AutoReconnect, "automatically reattempt lost connections")
+	flags.StringVar(&options.failoverAddresses, "failover-addresses", "", "comma separated list of backup publisher addresses, in \"hostname:port\" format")
+	flags.Var((*int32Value)(&config.FailbackInterval), "failback-interval", "interval, in milliseconds, to check if a higher priority address is reachable, 0 to disable")
+	flags.BoolVar(&config.AutoRequestMetadata, "auto-request-metadata", config.AutoRequestMetadata, "automatically request metadata upon connection")
+	flags.BoolVar(&config.AutoSubscribe, "auto-subscribe", config.AutoSubscribe, "automatically subscribe upon connection")
+	flags.BoolVar(&config.CompressPayloadData, "compress-payload-data", config.CompressPayloadData, "compress payload data")
+	flags.BoolVar(&config.CompressMetadata, "compress-metadata", config.CompressMetadata, "compress metadata transfer")
+	flags.BoolVar(&config.CompressSignalIndexCache, "compress-signal-index-cache", config.CompressSignalIndexCache, "compress signal index cache")
+	flags.StringVar(&config.MetadataFilters, "metadata-filters", config.MetadataFilters, "semi-colon separated filter expressions applied to requested metadata")
+	flags.Var((*uint8Value)(&config.Version), "version", "target STTP protocol version")
+	flags.BoolVar(&config.RfcGuidEncoding, "rfc-guid-encoding", config.RfcGuidEncoding, "use RFC encoding for Guid wire serialization")
+
+	// Config.TLSConfig fields
+	flags.BoolVar(&options.tls, "tls", false, "secure the command channel with TLS")
+	flags.StringVar(&options.tlsCA, "tls-ca", "", "PEM file of trusted certificate authorities, implies -tls")
+	flags.StringVar(&options.tlsCert, "tls-cert", "", "PEM certificate file for mutual TLS, or server certificate when listening, implies -tls")
+	flags.StringVar(&options.tlsKey, "tls-key", "", "PEM private key file for -tls-cert")
+	flags.StringVar(&options.tlsServerName, "tls-server-name", "", "host name used to verify the publisher certificate, implies -tls")
+	flags.BoolVar(&options.tlsSkipVerify, "tls-skip-verify", false, "skip verification of the publisher certificate, implies -tls")
+
+	// Settings fields
+	flags.BoolVar(&settings.Throttled, "throttled", settings.Throttled, "request a throttled, i.e., down-sampled, subscription")
+	flags.Float64Var(&settings.PublishInterval, "publish-interval", settings.PublishInterval, "throttled subscription publish interval, in seconds")
+	flags.Var((*uint16Value)(&settings.UdpPort), "udp-port", "local UDP port for the data channel, 0 to use the command channel")
+	flags.BoolVar(&settings.IncludeTime, "include-time", settings.IncludeTime, "include timestamps in received measurements")
+	flags.BoolVar(&settings.EnableTimeReasonabilityCheck, "enable-time-reasonability-check", settings.EnableTimeReasonabilityCheck, "have publisher reject measurements outside of lag and lead time")
+	flags.Float64Var(&settings.LagTime, "lag-time", settings.LagTime, "allowed past time deviation tolerance, in seconds")
+	flags.Float64Var(&settings.LeadTime, "lead-time", settings.LeadTime, "allowed future time deviation tolerance, in seconds")
+	flags.BoolVar(&settings.UseLocalClockAsRealTime, "use-local-clock-as-real-time", settings.UseLocalClockAsRealTime, "have publisher use its local clock as real time")
+	flags.BoolVar(&settings.UseMillisecondResolution, "use-millisecond-resolution", settings.UseMillisecondResolution, "request millisecond timestamp resolution")
+	flags.BoolVar(&settings.RequestNaNValueFilter, "request-nan-value-filter", settings.RequestNaNValueFilter, "have publisher filter NaN values")
+	flags.StringVar(&settings.StartTime, "start-time", settings.StartTime, "start time for a temporal, i.e., historical, subscription")
+	flags.StringVar(&settings.StopTime, "stop-time", settings.StopTime, "stop time for a temporal, i.e., historical, subscription")
+	flags.StringVar(&settings.ConstraintParameters, "constraint-parameters", settings.ConstraintParameters, "parameters for a temporal subscription constraint")
+	flags.Var((*int32Value)(&settings.ProcessingInterval), "processing-interval", "temporal subscription playback interval, in milliseconds, -1 for default, 0 for fast as possible")
+	flags.StringVar(&settings.ExtraConnectionStringParameters, "extra-connection-string-parameters", settings.ExtraConnectionStringParameters, "additional subscription connection string parameters")
+
+	flags.DurationVar(&options.timeout, "timeout", 30*time.Second, "maximum time to wait for connection and command responses")
+	flags.BoolVar(&options.verbose, "v", false, "show status messages")
+
+	return options
+}
+
+// resolve completes the Config from the options that are not directly bound to Config fields.
+func (o *connectionOptions) resolve() error {
+	o.config.FailoverAddresses = nil
+
+	for _, address := range strings.Split(o.failoverAddresses, ",") {
+		if address = strings.TrimSpace(address); len(address) > 0 {
+			o.config.FailoverAddresses = append(o.config.FailoverAddresses, address)
+		}
+	}
+
+	tlsConfig, err := o.tlsConfig()
+
+	if err != nil {
+		return err
+	}
+
+	o.config.TLSConfig = tlsConfig
+	return nil
+}
+
+// tlsConfig creates the TLS configuration defined by the TLS options, nil when TLS is not enabled.
+func (o *connectionOptions) tlsConfig() (*tls.Config, error) {
+	if !o.tls && len(o.tlsCA) == 0 && len(o.tlsCert) == 0 && len(o.tlsServerName) == 0 && !o.tlsSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		ServerName:         o.tlsServerName,
+		InsecureSkipVerify: o.tlsSkipVerify,
+	}
+
+	if len(o.tlsCA) > 0 {
+		pem, err := os.ReadFile(o.tlsCA)
+
+		if err != nil {
+			return nil, errors.New("failed to read TLS certificate authorities: " + err.Error())
+		}
+
+		pool := x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no certificates found in \"" + o.tlsCA + "\"")
+		}
+
+		// Trusted authorities verify the publisher when dialing and the publisher certificate when listening
+		tlsConfig.RootCAs = pool
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	if len(o.tlsCert) > 0 {
+		keyFile := o.tlsKey
+
+		if len(keyFile) == 0 {
+			keyFile = o.tlsCert
+		}
+
+		certificate, err := tls.LoadX509KeyPair(o.tlsCert, keyFile)
+
+		if err != nil {
+			return nil, errors.New("failed to load TLS certificate: " + err.Error())
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// int32Value implements flag.Value for an int32.
+type int32Value int32
+
+func (v *int32Value) Set(s string) error {
+	value, err := strconv.ParseInt(s, 0, 32)
+
+	if err != nil {
+		return err
+	}
+
+	*v = int32Value(value)
+	return nil
+}
+
+func (v *int32Value) String() string {
+	return strconv.FormatInt(int64(*v), 10)
+}
+
+// uint16Value implements flag.Value for a uint16.
+type uint16Value uint16
+
+func (v *uint16Value) Set(s string) error {
+	value, err := strconv.ParseUint(s, 0, 16)
+
+	if err != nil {
+		return err
+	}
+
+	*v = uint16Value(value)
+	return nil
+}
+
+func (v *uint16Value) String() string {
+	return strconv.FormatUint(uint64(*v), 10)
+}
+
+// uint8Value implements flag.Value for a uint8.
+type uint8Value uint8
+
+func (v *uint8Value) Set(s string) error {
+	value, err := strconv.ParseUint(s, 0, 8)
+
+	if err != nil {
+		return err
+	}
+
+	*v = uint8Value(value)
+	return nil
+}
+
+func (v *uint8Value) String() string {
+	return strconv.FormatUint(uint64(*v), 10)
+}
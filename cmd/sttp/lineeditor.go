@@ -0,0 +1,293 @@
+//******************************************************************************************************
+//  lineeditor.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// lineEditor reads lines of input with history and tab completion. In raw mode, input is handled a character
+// at a time to support line editing; otherwise, plain lines are read, e.g., from a pipe.
+type lineEditor struct {
+	input  *bufio.Reader
+	output io.Writer
+	raw    bool
+
+	prompt  string
+	history []string
+
+	// complete gets the completion candidates for the word ending at cursor and the start of the word.
+	complete func(line string, cursor int) (int, []string)
+}
+
+func newLineEditor(input io.Reader, output io.Writer, raw bool) *lineEditor {
+	return &lineEditor{
+		input:  bufio.NewReader(input),
+		output: output,
+		raw:    raw,
+	}
+}
+
+// readLine reads the next line of input, returning io.EOF when input ends.
+func (le *lineEditor) readLine() (string, error) {
+	if !le.raw {
+		line, err := le.input.ReadString('\n')
+
+		if err != nil && (err != io.EOF || len(line) == 0) {
+			return "", err
+		}
+
+		return strings.TrimRight(line, "\r\n"), nil
+	}
+
+	var buffer []rune
+	cursor := 0
+	historyIndex := len(le.history)
+
+	refresh := func() {
+		fmt.Fprintf(le.output, "\r%s%s\x1b[K", le.prompt, string(buffer))
+
+		if back := len(buffer) - cursor; back > 0 {
+			fmt.Fprintf(le.output, "\x1b[%dD", back)
+		}
+	}
+
+	replace := func(value string) {
+		buffer = []rune(value)
+		cursor = len(buffer)
+	}
+
+	refresh()
+
+	for {
+		r, _, err := le.input.ReadRune()
+
+		if err != nil {
+			return "", err
+		}
+
+		switch r {
+		case '\r', '\n':
+			fmt.Fprint(le.output, "\r\n")
+			line := string(buffer)
+
+			if len(strings.TrimSpace(line)) > 0 && (len(le.history) == 0 || le.history[len(le.history)-1] != line) {
+				le.history = append(le.history, line)
+			}
+
+			return line, nil
+		case 3: // Ctrl+C discards the line
+			fmt.Fprint(le.output, "^C\r\n")
+			buffer, cursor, historyIndex = nil, 0, len(le.history)
+		case 4: // Ctrl+D ends input on an empty line
+			if len(buffer) == 0 {
+				fmt.Fprint(le.output, "\r\n")
+				return "", io.EOF
+			}
+
+			if cursor < len(buffer) {
+				buffer = append(buffer[:cursor], buffer[cursor+1:]...)
+			}
+		case 127, 8: // Backspace
+			if cursor > 0 {
+				buffer = append(buffer[:cursor-1], buffer[cursor:]...)
+				cursor--
+			}
+		case 1: // Ctrl+A
+			cursor = 0
+		case 5: // Ctrl+E
+			cursor = len(buffer)
+		case 11: // Ctrl+K
+			buffer = buffer[:cursor]
+		case 21: // Ctrl+U
+			buffer = append([]rune{}, buffer[cursor:]...)
+			cursor = 0
+		case '\t':
+			buffer, cursor = le.completeLine(buffer, cursor)
+		case 27:
+			switch le.readEscape() {
+			case 'A':
+				if historyIndex > 0 {
+					historyIndex--
+					replace(le.history[historyIndex])
+				}
+			case 'B':
+				if historyIndex < len(le.history)-1 {
+					historyIndex++
+					replace(le.history[historyIndex])
+				} else {
+					historyIndex = len(le.history)
+					replace("")
+				}
+			case 'C':
+				if cursor < len(buffer) {
+					cursor++
+				}
+			case 'D':
+				if cursor > 0 {
+					cursor--
+				}
+			case 'H':
+				cursor = 0
+			case 'F':
+				cursor = len(buffer)
+			case '~':
+				if cursor < len(buffer) {
+					buffer = append(buffer[:cursor], buffer[cursor+1:]...)
+				}
+			}
+		default:
+			if unicode.IsPrint(r) {
+				buffer = append(buffer[:cursor], append([]rune{r}, buffer[cursor:]...)...)
+				cursor++
+			}
+		}
+
+		refresh()
+	}
+}
+
+// readEscape reads an ANSI escape sequence following ESC and returns its final character, normalized so that
+// home and end are 'H' and 'F' and delete is '~'. Unrecognized sequences return zero.
+func (le *lineEditor) readEscape() rune {
+	r, _, err := le.input.ReadRune()
+
+	if err != nil || (r != '[' && r != 'O') {
+		return 0
+	}
+
+	var parameter []rune
+
+	for {
+		if r, _, err = le.input.ReadRune(); err != nil {
+			return 0
+		}
+
+		if r >= '0' && r <= '9' || r == ';' {
+			parameter = append(parameter, r)
+			continue
+		}
+
+		break
+	}
+
+	if r != '~' {
+		return r
+	}
+
+	switch string(parameter) {
+	case "1", "7":
+		return 'H'
+	case "4", "8":
+		return 'F'
+	case "3":
+		return '~'
+	default:
+		return 0
+	}
+}
+
+// completeLine completes the word at cursor. A single candidate replaces the word; for multiple candidates,
+// the word is extended to their longest common prefix or, when it cannot be extended, the candidates are listed.
+func (le *lineEditor) completeLine(buffer []rune, cursor int) ([]rune, int) {
+	if le.complete == nil {
+		return buffer, cursor
+	}
+
+	line := string(buffer[:cursor])
+	start, candidates := le.complete(line, len(line))
+
+	if len(candidates) == 0 {
+		return buffer, cursor
+	}
+
+	word := line[start:]
+	completion := commonPrefix(candidates)
+
+	if len(candidates) > 1 && len(completion) <= len(word) {
+		fmt.Fprint(le.output, "\r\n")
+		writeColumns(le.output, candidates, 80)
+		return buffer, cursor
+	}
+
+	completed := []rune(line[:start] + completion)
+	return append(completed, buffer[cursor:]...), len(completed)
+}
+
+// commonPrefix gets the longest case-insensitive common prefix of the candidates, using the casing of the first.
+func commonPrefix(candidates []string) string {
+	prefix := candidates[0]
+
+	for _, candidate := range candidates[1:] {
+		length := 0
+
+		for length < len(prefix) && length < len(candidate) && unicode.ToUpper(rune(prefix[length])) == unicode.ToUpper(rune(candidate[length])) {
+			length++
+		}
+
+		prefix = prefix[:length]
+	}
+
+	return prefix
+}
+
+// writeColumns writes the sorted values in columns that fit within width.
+func writeColumns(writer io.Writer, values []string, width int) {
+	values = append([]string{}, values...)
+	sort.Strings(values)
+
+	columnWidth := 0
+
+	for _, value := range values {
+		if len(value) > columnWidth {
+			columnWidth = len(value)
+		}
+	}
+
+	columnWidth += 2
+	columns := width / columnWidth
+
+	if columns < 1 {
+		columns = 1
+	}
+
+	rows := (len(values) + columns - 1) / columns
+
+	for row := 0; row < rows; row++ {
+		var line strings.Builder
+
+		for column := 0; column < columns; column++ {
+			if index := column*rows + row; index < len(values) {
+				line.WriteString(fmt.Sprintf("%-*s", columnWidth, values[index]))
+			}
+		}
+
+		fmt.Fprint(writer, strings.TrimRight(line.String(), " ")+"\r\n")
+	}
+}
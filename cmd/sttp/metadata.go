@@ -0,0 +1,299 @@
+//******************************************************************************************************
+//  metadata.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sttp/goapi/sttp/data"
+)
+
+// Metadata output formats.
+const (
+	formatText = "text"
+	formatCSV  = "csv"
+	formatXML  = "xml"
+)
+
+func runMetadata(ctx context.Context, cmd *command, args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet(cmd, stderr)
+	options := bindConnectionFlags(flags)
+
+	metadataFile := flags.String("file", "", "read cached metadata XML file instead of connecting to a publisher")
+	outputFormat := flags.String("format", formatText, "output format: text, csv or xml")
+	tableNames := flags.String("tables", "", "comma separated list of tables to show, defaults to all tables")
+	outputFile := flags.String("o", "", "write output to file instead of stdout")
+	list := flags.Bool("list", false, "only list table names with row counts")
+
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+
+	if !validFormat(*outputFormat, formatText, formatCSV, formatXML) {
+		fmt.Fprintf(stderr, "Invalid output format \"%s\"\n", *outputFormat)
+		return exitUsage
+	}
+
+	dataSet, code, ok := sourceMetadata(ctx, flags, options, *metadataFile, 0, stderr)
+
+	if !ok {
+		return code
+	}
+
+	tables, err := selectTables(dataSet, *tableNames)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "%s\n", err.Error())
+		return exitFailure
+	}
+
+	writer, closeWriter, err := createOutput(*outputFile, stdout)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to create output file \"%s\": %s\n", *outputFile, err.Error())
+		return exitFailure
+	}
+
+	if *list {
+		err = writeTableList(writer, tables)
+	} else {
+		err = writeMetadata(writer, dataSet, tables, *outputFormat)
+	}
+
+	if closeErr := closeWriter(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		fmt.Fprintf(stderr, "Failed to write metadata: %s\n", err.Error())
+		return exitFailure
+	}
+
+	return exitSuccess
+}
+
+// sourceMetadata loads metadata from the specified file or, when no file is specified, requests it from the
+// publisher at the address defined by the first positional argument. The positional parameter defines the
+// number of expected arguments that follow the address.
+func sourceMetadata(ctx context.Context, flags *flag.FlagSet, options *connectionOptions, metadataFile string, positional int, stderr io.Writer) (*data.DataSet, int, bool) {
+	if len(metadataFile) > 0 {
+		if flags.NArg() != positional {
+			flags.Usage()
+			return nil, exitUsage, false
+		}
+
+		dataSet, err := loadMetadataFile(metadataFile)
+
+		if err != nil {
+			fmt.Fprintf(stderr, "Failed to load metadata file \"%s\": %s\n", metadataFile, err.Error())
+			return nil, exitFailure, false
+		}
+
+		return dataSet, exitSuccess, true
+	}
+
+	if flags.NArg() != positional+1 {
+		flags.Usage()
+		return nil, exitUsage, false
+	}
+
+	if err := options.resolve(); err != nil {
+		fmt.Fprintf(stderr, "%s\n", err.Error())
+		return nil, exitUsage, false
+	}
+
+	dataSet, err := requestMetadata(ctx, flags.Arg(0), options, stderr)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "%s\n", err.Error())
+		return nil, exitFailure, false
+	}
+
+	return dataSet, exitSuccess, true
+}
+
+func validFormat(format string, formats ...string) bool {
+	for _, valid := range formats {
+		if format == valid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectTables gets the named tables, or all tables when names is empty, in name order.
+func selectTables(dataSet *data.DataSet, names string) ([]*data.DataTable, error) {
+	var tables []*data.DataTable
+
+	if len(strings.TrimSpace(names)) == 0 {
+		tables = dataSet.Tables()
+		sortTables(tables)
+		return tables, nil
+	}
+
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+
+		if len(name) == 0 {
+			continue
+		}
+
+		table := dataSet.Table(name)
+
+		if table == nil {
+			return nil, errors.New("metadata does not contain a \"" + name + "\" table")
+		}
+
+		tables = append(tables, table)
+	}
+
+	return tables, nil
+}
+
+func sortTables(tables []*data.DataTable) {
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].Name() < tables[j].Name()
+	})
+}
+
+// createOutput opens the named output file, or uses stdout when fileName is empty.
+func createOutput(fileName string, stdout io.Writer) (io.Writer, func() error, error) {
+	if len(fileName) == 0 {
+		return stdout, func() error { return nil }, nil
+	}
+
+	file, err := os.Create(fileName)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return file, file.Close, nil
+}
+
+func writeTableList(writer io.Writer, tables []*data.DataTable) error {
+	output := tabwriter.NewWriter(writer, 0, 4, 2, ' ', 0)
+
+	for _, table := range tables {
+		fmt.Fprintf(output, "%s\t%d rows\t%d columns\n", table.Name(), table.RowCount(), table.ColumnCount())
+	}
+
+	return output.Flush()
+}
+
+// writeMetadata writes the specified tables in the requested format. XML output is a DataSet, including
+// its schema, that can be read back with the -file option.
+func writeMetadata(writer io.Writer, dataSet *data.DataSet, tables []*data.DataTable, outputFormat string) error {
+	if outputFormat == formatXML {
+		selected := data.NewDataSet()
+		selected.Name = dataSet.Name
+
+		for _, table := range tables {
+			selected.AddTable(table)
+		}
+
+		return selected.WriteXml(writer)
+	}
+
+	for i, table := range tables {
+		if i > 0 {
+			fmt.Fprintln(writer)
+		}
+
+		if err := writeRows(writer, table, table.Rows(), outputFormat, len(tables) > 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// textReplacer keeps each text row on a single line with tab separated cells.
+var textReplacer = strings.NewReplacer("\t", " ", "\r", " ", "\n", " ", "\x00", "\t")
+
+// writeRows writes the rows of a table as aligned text or CSV. When titled, the table name precedes
+// the rows, as a "# name" comment line for CSV.
+func writeRows(writer io.Writer, table *data.DataTable, rows []*data.DataRow, outputFormat string, titled bool) error {
+	columns := table.ColumnCount()
+	values := make([]string, columns)
+
+	header := func() []string {
+		for i := 0; i < columns; i++ {
+			values[i] = table.Column(i).Name()
+		}
+
+		return values
+	}
+
+	record := func(row *data.DataRow) []string {
+		for i := 0; i < columns; i++ {
+			values[i] = row.ValueAsString(i)
+		}
+
+		return values
+	}
+
+	if outputFormat == formatCSV {
+		if titled {
+			fmt.Fprintf(writer, "# %s\n", table.Name())
+		}
+
+		output := csv.NewWriter(writer)
+		output.Write(header())
+
+		for _, row := range rows {
+			if row != nil {
+				output.Write(record(row))
+			}
+		}
+
+		output.Flush()
+		return output.Error()
+	}
+
+	if titled {
+		fmt.Fprintf(writer, "%s (%d rows)\n", table.Name(), len(rows))
+	}
+
+	output := tabwriter.NewWriter(writer, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(output, strings.Join(header(), "\t"))
+
+	for _, row := range rows {
+		if row != nil {
+			fmt.Fprintln(output, textReplacer.Replace(strings.Join(record(row), "\x00")))
+		}
+	}
+
+	return output.Flush()
+}
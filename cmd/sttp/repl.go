@@ -0,0 +1,524 @@
+//******************************************************************************************************
+//  repl.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// replKeywords defines the filter expression keywords offered for completion.
+var replKeywords = []string{"FILTER", "TOP", "WHERE", "ORDER", "BY", "ASC", "DESC", "AND", "OR", "NOT", "IN", "IS", "NULL", "LIKE", "BINARY", "TRUE", "FALSE"}
+
+// replCommands defines the REPL commands with their descriptions.
+var replCommands = [][2]string{
+	{".help", "show this help"},
+	{".tables", "list tables with row and column counts"},
+	{".columns [TABLE]", "list columns of TABLE, defaults to the primary table"},
+	{".table [TABLE]", "show or set the primary table used for signal IDs, measurement keys and point tags"},
+	{".rows [N]", "show or set the maximum number of matching rows to show, -1 for all"},
+	{".ids [on|off]", "show or set display of resolved signal IDs"},
+	{".tree [on|off]", "show or set display of expression tree structure"},
+	{".quit", "exit"},
+}
+
+// repl evaluates filter expressions interactively against a metadata DataSet.
+type repl struct {
+	dataSet      *data.DataSet
+	primaryTable string
+	output       io.Writer
+
+	maxRows  int
+	showIDs  bool
+	showTree bool
+
+	functionNames []string
+}
+
+func newRepl(dataSet *data.DataSet, primaryTable string, output io.Writer) *repl {
+	r := &repl{
+		dataSet:      dataSet,
+		primaryTable: primaryTable,
+		output:       output,
+		maxRows:      20,
+		showIDs:      true,
+		showTree:     true,
+	}
+
+	for function := data.ExpressionFunctionTypeEnum(0); ; function++ {
+		name := function.String()
+
+		if strings.HasPrefix(name, "0x") {
+			break
+		}
+
+		r.functionNames = append(r.functionNames, name)
+	}
+
+	return r
+}
+
+func runRepl(ctx context.Context, cmd *command, args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet(cmd, stderr)
+	options := bindConnectionFlags(flags)
+
+	metadataFile := flags.String("file", "", "read cached metadata XML file instead of connecting to a publisher")
+	primaryTable := flags.String("table", "MeasurementDetail", "primary table used for signal IDs, measurement keys and point tags")
+	maxRows := flags.Int("rows", 20, "maximum number of matching rows to show, -1 for all")
+
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+
+	dataSet, code, ok := sourceMetadata(ctx, flags, options, *metadataFile, 0, stderr)
+
+	if !ok {
+		return code
+	}
+
+	r := newRepl(dataSet, *primaryTable, stdout)
+	r.maxRows = *maxRows
+
+	fd := os.Stdin.Fd()
+	raw := isTerminal(fd)
+
+	if raw {
+		restore, err := makeRaw(fd)
+
+		if err != nil {
+			raw = false
+		} else {
+			defer restore()
+		}
+	}
+
+	editor := newLineEditor(os.Stdin, stdout, raw)
+	editor.complete = r.complete
+
+	if raw {
+		editor.prompt = "filter> "
+		fmt.Fprintf(stdout, "Loaded %d metadata tables, primary table is %s. Enter a filter expression or .help for commands.\n", dataSet.TableCount(), r.primaryTable)
+	}
+
+	r.run(ctx, editor)
+	return exitSuccess
+}
+
+// run reads and executes lines from the editor until input ends, a quit command is entered or the context is done.
+func (r *repl) run(ctx context.Context, editor *lineEditor) {
+	lines := make(chan string)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		defer close(lines)
+
+		for {
+			line, err := editor.readLine()
+
+			if err != nil {
+				return
+			}
+
+			select {
+			case lines <- line:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case line, ok := <-lines:
+			if !ok || !r.execute(line) {
+				return
+			}
+		}
+	}
+}
+
+// execute runs a REPL command or evaluates a filter expression, returning false when the REPL should exit.
+func (r *repl) execute(line string) bool {
+	line = strings.TrimSpace(line)
+
+	if len(line) == 0 {
+		return true
+	}
+
+	if !strings.HasPrefix(line, ".") {
+		r.evaluate(line)
+		return true
+	}
+
+	fields := strings.Fields(line)
+	argument := ""
+
+	if len(fields) > 1 {
+		argument = fields[1]
+	}
+
+	switch strings.ToLower(fields[0]) {
+	case ".help":
+		output := tabwriter.NewWriter(r.output, 0, 4, 2, ' ', 0)
+
+		for _, command := range replCommands {
+			fmt.Fprintf(output, "%s\t%s\n", command[0], command[1])
+		}
+
+		output.Flush()
+		fmt.Fprintln(r.output, "Anything else is evaluated as a filter expression, e.g.:")
+		fmt.Fprintln(r.output, "    FILTER ActiveMeasurements WHERE SignalType = 'FREQ'")
+		fmt.Fprintln(r.output, "Press TAB to complete table names, column names, function names and keywords.")
+	case ".tables":
+		tables := r.dataSet.Tables()
+		sortTables(tables)
+		writeTableList(r.output, tables)
+	case ".columns":
+		r.writeColumns(argument)
+	case ".table":
+		if len(argument) > 0 {
+			table := r.dataSet.Table(argument)
+
+			if table == nil {
+				fmt.Fprintf(r.output, "Table \"%s\" not found\n", argument)
+				break
+			}
+
+			r.primaryTable = table.Name()
+		}
+
+		fmt.Fprintf(r.output, "Primary table is %s\n", r.primaryTable)
+	case ".rows":
+		if len(argument) > 0 {
+			maxRows, err := strconv.Atoi(argument)
+
+			if err != nil {
+				fmt.Fprintf(r.output, "Invalid row count \"%s\"\n", argument)
+				break
+			}
+
+			r.maxRows = maxRows
+		}
+
+		fmt.Fprintf(r.output, "Showing at most %d rows\n", r.maxRows)
+	case ".ids":
+		setToggle(r.output, "Signal ID display", &r.showIDs, argument)
+	case ".tree":
+		setToggle(r.output, "Expression tree display", &r.showTree, argument)
+	case ".quit", ".exit":
+		return false
+	default:
+		fmt.Fprintf(r.output, "Unknown command \"%s\", enter .help for commands\n", fields[0])
+	}
+
+	return true
+}
+
+func setToggle(writer io.Writer, name string, toggle *bool, argument string) {
+	switch strings.ToLower(argument) {
+	case "on":
+		*toggle = true
+	case "off":
+		*toggle = false
+	case "":
+	default:
+		fmt.Fprintf(writer, "Invalid setting \"%s\", expected on or off\n", argument)
+		return
+	}
+
+	state := "off"
+
+	if *toggle {
+		state = "on"
+	}
+
+	fmt.Fprintf(writer, "%s is %s\n", name, state)
+}
+
+func (r *repl) writeColumns(tableName string) {
+	if len(tableName) == 0 {
+		tableName = r.primaryTable
+	}
+
+	table := r.dataSet.Table(tableName)
+
+	if table == nil {
+		fmt.Fprintf(r.output, "Table \"%s\" not found\n", tableName)
+		return
+	}
+
+	output := tabwriter.NewWriter(r.output, 0, 4, 2, ' ', 0)
+
+	for i := 0; i < table.ColumnCount(); i++ {
+		column := table.Column(i)
+		fmt.Fprintf(output, "%s\t%s", column.Name(), column.Type().String())
+
+		if column.Computed() {
+			fmt.Fprintf(output, "\t= %s", column.Expression())
+		}
+
+		fmt.Fprintln(output)
+	}
+
+	output.Flush()
+}
+
+// syntaxError defines a filter expression syntax error at a position in the expression.
+type syntaxError struct {
+	line    int
+	column  int
+	message string
+}
+
+// evaluate parses and evaluates a filter expression, showing any syntax errors with caret positions,
+// otherwise the expression tree structure, matching rows and resolved signal IDs.
+func (r *repl) evaluate(expression string) {
+	parser, err := data.NewFilterExpressionParserForDataSet(r.dataSet, expression, r.primaryTable, nil, true)
+
+	if err != nil {
+		fmt.Fprintf(r.output, "Error: %s\n", err.Error())
+		return
+	}
+
+	var syntaxErrors []syntaxError
+
+	parser.SetSyntaxErrorCallback(func(line, column int, message string) {
+		syntaxErrors = append(syntaxErrors, syntaxError{line, column, message})
+	})
+
+	err = parser.Evaluate(true, true)
+
+	if len(syntaxErrors) > 0 {
+		writeSyntaxErrors(r.output, expression, syntaxErrors)
+		return
+	}
+
+	if err != nil {
+		fmt.Fprintf(r.output, "Error: %s\n", err.Error())
+		return
+	}
+
+	if r.showTree {
+		expressionTrees, _ := parser.ExpressionTrees()
+
+		for i, expressionTree := range expressionTrees {
+			if len(expressionTrees) > 1 {
+				fmt.Fprintf(r.output, "Statement %d:\n", i+1)
+			}
+
+			fmt.Fprint(r.output, expressionTree.String())
+		}
+	}
+
+	rows := parser.FilteredRows()
+	shown := rows
+
+	if r.maxRows > -1 && len(shown) > r.maxRows {
+		shown = shown[:r.maxRows]
+	}
+
+	if len(shown) > 0 {
+		writeFilteredRows(r.output, shown, formatText)
+	}
+
+	if len(shown) < len(rows) {
+		fmt.Fprintf(r.output, "... %d more rows\n", len(rows)-len(shown))
+	}
+
+	signalIDs := resolvedSignalIDs(rows)
+
+	if r.showIDs && len(signalIDs) > 0 {
+		fmt.Fprintln(r.output, "Signal IDs:")
+
+		for i, signalID := range signalIDs {
+			if r.maxRows > -1 && i >= r.maxRows {
+				fmt.Fprintf(r.output, "    ... %d more signal IDs\n", len(signalIDs)-i)
+				break
+			}
+
+			fmt.Fprintf(r.output, "    %s\n", signalID.String())
+		}
+	}
+
+	fmt.Fprintf(r.output, "%d matching rows, %d signal IDs\n", len(rows), len(signalIDs))
+}
+
+// resolvedSignalIDs gets the unique signal IDs of the rows, in order, for rows of tables with a SignalID column.
+func resolvedSignalIDs(rows []*data.DataRow) []guid.Guid {
+	var signalIDs []guid.Guid
+	resolved := make(map[guid.Guid]bool)
+
+	for _, row := range rows {
+		signalIDIndex := row.Parent().ColumnIndex(data.DefaultTableIDFields.SignalIDFieldName)
+
+		if signalIDIndex < 0 {
+			continue
+		}
+
+		signalID, null, err := row.GuidValue(signalIDIndex)
+
+		if null || err != nil || signalID.IsZero() || resolved[signalID] {
+			continue
+		}
+
+		resolved[signalID] = true
+		signalIDs = append(signalIDs, signalID)
+	}
+
+	return signalIDs
+}
+
+// writeSyntaxErrors writes each syntax error below the expression line it applies to, with a caret marking its position.
+func writeSyntaxErrors(writer io.Writer, expression string, syntaxErrors []syntaxError) {
+	lines := strings.Split(expression, "\n")
+
+	for _, syntaxError := range syntaxErrors {
+		if syntaxError.line < 1 || syntaxError.line > len(lines) {
+			fmt.Fprintf(writer, "Syntax error: %s\n", syntaxError.message)
+			continue
+		}
+
+		line := []rune(lines[syntaxError.line-1])
+		column := syntaxError.column
+
+		if column > len(line) {
+			column = len(line)
+		}
+
+		// Retain tabs so the caret aligns with the expression as displayed
+		indent := make([]rune, column)
+
+		for i := range indent {
+			if line[i] == '\t' {
+				indent[i] = '\t'
+			} else {
+				indent[i] = ' '
+			}
+		}
+
+		fmt.Fprintln(writer, string(line))
+		fmt.Fprintf(writer, "%s^\n", string(indent))
+		fmt.Fprintf(writer, "Syntax error at line %d, column %d: %s\n", syntaxError.line, syntaxError.column+1, syntaxError.message)
+	}
+}
+
+// complete gets the completion candidates for the word ending at cursor and the start of the word. Table names are
+// offered following FILTER or FILTER TOP n; otherwise, column names of the statement table, or the primary table,
+// function names and keywords are offered. Commands are offered for a line starting with ".".
+func (r *repl) complete(line string, cursor int) (int, []string) {
+	line = line[:cursor]
+	start := cursor
+
+	for start > 0 && isIdentifierRune(rune(line[start-1])) {
+		start--
+	}
+
+	word := line[start:]
+
+	if start == 1 && line[0] == '.' {
+		var commands []string
+
+		for _, command := range replCommands {
+			commands = append(commands, strings.Fields(command[0])[0][1:])
+		}
+
+		return start, matchPrefix(word, commands)
+	}
+
+	// Only consider the current statement, statements are separated by semi-colons
+	statement := line[:start]
+
+	if index := strings.LastIndex(statement, ";"); index > -1 {
+		statement = statement[index+1:]
+	}
+
+	tokens := strings.Fields(statement)
+	count := len(tokens)
+
+	if count > 0 && strings.EqualFold(tokens[count-1], "FILTER") ||
+		count > 2 && strings.EqualFold(tokens[count-3], "FILTER") && strings.EqualFold(tokens[count-2], "TOP") {
+		return start, matchPrefix(word, r.dataSet.TableNames())
+	}
+
+	if len(word) == 0 {
+		return start, nil
+	}
+
+	tableName := r.primaryTable
+
+	if count > 1 && strings.EqualFold(tokens[0], "FILTER") {
+		tableName = tokens[1]
+
+		if count > 3 && strings.EqualFold(tokens[1], "TOP") {
+			tableName = tokens[3]
+		}
+	}
+
+	var candidates []string
+
+	if table := r.dataSet.Table(tableName); table != nil {
+		for i := 0; i < table.ColumnCount(); i++ {
+			candidates = append(candidates, table.Column(i).Name())
+		}
+	}
+
+	candidates = append(candidates, r.functionNames...)
+	candidates = append(candidates, replKeywords...)
+
+	return start, matchPrefix(word, candidates)
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// matchPrefix gets the sorted, unique candidates that start with prefix, ignoring case.
+func matchPrefix(prefix string, candidates []string) []string {
+	var matches []string
+	matched := make(map[string]bool)
+	prefix = strings.ToUpper(prefix)
+
+	for _, candidate := range candidates {
+		if strings.HasPrefix(strings.ToUpper(candidate), prefix) && !matched[candidate] {
+			matched[candidate] = true
+			matches = append(matches, candidate)
+		}
+	}
+
+	sort.Strings(matches)
+	return matches
+}
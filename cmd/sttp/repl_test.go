@@ -0,0 +1,159 @@
+//******************************************************************************************************
+//  repl_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestRepl(t *testing.T) (*repl, *strings.Builder) {
+	dataSet, err := loadMetadataFile("../../test/SampleMetadata.xml")
+
+	if err != nil {
+		t.Fatalf("Failed to load sample metadata: %s", err.Error())
+	}
+
+	var output strings.Builder
+	return newRepl(dataSet, "MeasurementDetail", &output), &output
+}
+
+func TestReplEvaluate(t *testing.T) {
+	r, output := newTestRepl(t)
+
+	r.execute("FILTER MeasurementDetail WHERE SignalAcronym = 'FREQ'")
+
+	for _, expected := range []string{"Table: MeasurementDetail", "Column SignalAcronym (String)", "TVA_SHELBY:ABBF", "{93673c68-d59d-4926-b7e9-e7678f9f66b4}", "1 matching rows, 1 signal IDs"} {
+		if !strings.Contains(output.String(), expected) {
+			t.Fatalf("TestReplEvaluate: expected \"%s\" in output:\n%s", expected, output.String())
+		}
+	}
+
+	output.Reset()
+	r.execute(".tree off")
+	r.execute(".rows 2")
+	output.Reset()
+	r.execute("FILTER MeasurementDetail WHERE SignalAcronym LIKE 'IPH%'")
+
+	if strings.Contains(output.String(), "Where:") || !strings.Contains(output.String(), "... 4 more rows") || !strings.Contains(output.String(), "6 matching rows, 6 signal IDs") {
+		t.Fatalf("TestReplEvaluate: unexpected limited output:\n%s", output.String())
+	}
+
+	output.Reset()
+	r.execute("FILTER MeasurementDetail WHERE SignalAcronym = 'FREQ' AND")
+
+	lines := strings.Split(output.String(), "\n")
+
+	if len(lines) < 3 || lines[1] != strings.Repeat(" ", 57)+"^" || !strings.HasPrefix(lines[2], "Syntax error at line 1, column 58:") {
+		t.Fatalf("TestReplEvaluate: unexpected syntax error output:\n%s", output.String())
+	}
+
+	output.Reset()
+	r.execute("FILTER MeasurementDetail WHERE Unknown = 1")
+
+	if !strings.HasPrefix(output.String(), "Error: ") {
+		t.Fatalf("TestReplEvaluate: expected evaluation error, received:\n%s", output.String())
+	}
+
+	output.Reset()
+	r.execute(".table phasordetail")
+
+	if r.primaryTable != "PhasorDetail" || !strings.Contains(output.String(), "Primary table is PhasorDetail") {
+		t.Fatalf("TestReplEvaluate: expected primary table change, received:\n%s", output.String())
+	}
+
+	if r.execute(".quit") {
+		t.Fatalf("TestReplEvaluate: expected .quit to end REPL")
+	}
+}
+
+func TestReplComplete(t *testing.T) {
+	r, _ := newTestRepl(t)
+
+	tests := []struct {
+		line       string
+		start      int
+		candidates []string
+	}{
+		{"FILTER Meas", 7, []string{"MeasurementDetail"}},
+		{"FILTER TOP 5 ph", 13, []string{"PhasorDetail"}},
+		{"FILTER PhasorDetail WHERE Lab", 26, []string{"Label"}},
+		{"FILTER PhasorDetail WHERE Le", 26, []string{"Len"}},
+		{"SignalAc", 0, []string{"SignalAcronym"}},
+		{"FILTER DeviceDetail WHERE True; FILTER MeasurementDetail WHERE Point", 63, []string{"PointTag"}},
+		{"FILTER MeasurementDetail WHERE IsN", 31, []string{"IsNull", "IsNumeric"}},
+		{".ta", 1, []string{"table", "tables"}},
+		{"FILTER MeasurementDetail WHERE ", 31, nil},
+	}
+
+	for _, test := range tests {
+		start, candidates := r.complete(test.line, len(test.line))
+
+		if start != test.start || !reflect.DeepEqual(candidates, test.candidates) {
+			t.Fatalf("TestReplComplete: for \"%s\" expected %d %v, received %d %v", test.line, test.start, test.candidates, start, candidates)
+		}
+	}
+}
+
+func TestLineEditor(t *testing.T) {
+	r, _ := newTestRepl(t)
+
+	// Complete, edit with backspace and cursor keys, recall history, then end input with Ctrl+D
+	input := "FILTER Meas\t WHERE x\x7fTru\x1b[De\x1b[Ce\r" +
+		"\x1b[A\r" +
+		"FILTER IsN\t\r" +
+		"\x04"
+
+	var output strings.Builder
+	editor := newLineEditor(strings.NewReader(input), &output, true)
+	editor.complete = r.complete
+
+	expected := []string{
+		"FILTER MeasurementDetail WHERE Treue",
+		"FILTER MeasurementDetail WHERE Treue",
+		"FILTER IsN",
+	}
+
+	for _, line := range expected {
+		received, err := editor.readLine()
+
+		if err != nil {
+			t.Fatalf("TestLineEditor: unexpected error: %s", err.Error())
+		}
+
+		if received != line {
+			t.Fatalf("TestLineEditor: expected \"%s\", received \"%s\"", line, received)
+		}
+	}
+
+	if _, err := editor.readLine(); err != io.EOF {
+		t.Fatalf("TestLineEditor: expected EOF for Ctrl+D")
+	}
+
+	if len(editor.history) != 2 {
+		t.Fatalf("TestLineEditor: expected 2 unique history entries, received %d", len(editor.history))
+	}
+}
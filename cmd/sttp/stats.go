@@ -0,0 +1,201 @@
+//******************************************************************************************************
+//  stats.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/format"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func runStats(ctx context.Context, cmd *command, args []string, stdout, stderr io.Writer) int {
+	flags := newFlagSet(cmd, stderr)
+	options := bindConnectionFlags(flags)
+
+	interval := flags.Duration("interval", 5*time.Second, "statistics reporting interval")
+	maxReports := flags.Int("n", 0, "exit after the specified number of reports, 0 for no limit")
+	reverse := flags.Bool("listen", false, "accept a reverse connection from a publisher on ADDRESS")
+
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+
+	if *interval <= 0 {
+		fmt.Fprintln(stderr, "Statistics interval must be greater than zero")
+		return exitUsage
+	}
+
+	address, expression, ok := streamArgs(flags)
+
+	if !ok {
+		return exitUsage
+	}
+
+	var window statsWindow
+
+	subscriber, err := startStream(ctx, address, expression, options, *reverse, stderr, func(_ *sttp.Subscriber, measurements []transport.Measurement) {
+		window.add(measurements, time.Now())
+	})
+
+	if err != nil {
+		fmt.Fprintf(stderr, "%s\n", err.Error())
+		return exitFailure
+	}
+
+	defer subscriber.Close()
+
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	lastReport := time.Now()
+	lastBytes := uint64(0)
+
+	for reports := 0; *maxReports <= 0 || reports < *maxReports; reports++ {
+		select {
+		case <-ctx.Done():
+			return exitSuccess
+		case now := <-ticker.C:
+			bytes := subscriber.TotalCommandChannelBytesReceived() + subscriber.TotalDataChannelBytesReceived()
+			statistics := subscriber.Statistics()
+			sample := window.reset()
+
+			writeStats(stdout, now, now.Sub(lastReport), &sample, bytes-lastBytes, &statistics)
+
+			lastReport = now
+			lastBytes = bytes
+		}
+	}
+
+	return exitSuccess
+}
+
+// statsSample defines the measurement statistics accumulated over one reporting interval.
+type statsSample struct {
+	measurements uint64
+	frames       uint64
+	latencyCount uint64
+	latencyTotal time.Duration
+	latencyMin   time.Duration
+	latencyMax   time.Duration
+}
+
+// statsWindow accumulates a statsSample from subscriber callbacks.
+type statsWindow struct {
+	mutex  sync.Mutex
+	sample statsSample
+}
+
+// add accumulates the specified measurements, received at the specified time. Latency is measured from the
+// measurement timestamp, so it includes any clock offset between the publisher and the local system.
+func (w *statsWindow) add(measurements []transport.Measurement, received time.Time) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	sample := &w.sample
+	sample.measurements += uint64(len(measurements))
+	lastTimestamp := ticks.Ticks(0)
+
+	for i := range measurements {
+		timestamp := measurements[i].Timestamp
+
+		if timestamp == 0 {
+			continue
+		}
+
+		// Measurements that share a timestamp are counted as a single frame
+		if timestamp != lastTimestamp {
+			sample.frames++
+			lastTimestamp = timestamp
+		}
+
+		latency := received.Sub(timestamp.ToTime())
+
+		if sample.latencyCount == 0 || latency < sample.latencyMin {
+			sample.latencyMin = latency
+		}
+
+		if sample.latencyCount == 0 || latency > sample.latencyMax {
+			sample.latencyMax = latency
+		}
+
+		sample.latencyCount++
+		sample.latencyTotal += latency
+	}
+}
+
+// reset gets the accumulated sample and starts a new one.
+func (w *statsWindow) reset() statsSample {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	sample := w.sample
+	w.sample = statsSample{}
+
+	return sample
+}
+
+// writeStats writes a single line statistics report for a reporting interval.
+func writeStats(writer io.Writer, now time.Time, elapsed time.Duration, sample *statsSample, bytes uint64, statistics *transport.SubscriberStatistics) {
+	seconds := elapsed.Seconds()
+
+	if seconds <= 0 {
+		seconds = math.SmallestNonzeroFloat64
+	}
+
+	latency := "n/a"
+
+	if sample.latencyCount > 0 {
+		latency = milliseconds(sample.latencyTotal/time.Duration(sample.latencyCount)) + "/" +
+			milliseconds(sample.latencyMin) + "/" +
+			milliseconds(sample.latencyMax) + " ms"
+	}
+
+	fmt.Fprintf(writer, "%s  measurements=%s/s  frames=%s/s  latency(avg/min/max)=%s  bytes=%s/s  total=%s  connected=%t  subscribed=%t  reconnects=%d  tssc-resets=%d  out-of-sequence=%d  cache-swaps=%d  events-dropped=%d\n",
+		now.UTC().Format("15:04:05.000"),
+		format.Float(float64(sample.measurements)/seconds, 1),
+		format.Float(float64(sample.frames)/seconds, 1),
+		latency,
+		format.Float(float64(bytes)/seconds, 0),
+		format.UInt64(statistics.MeasurementsReceived),
+		statistics.Connected,
+		statistics.Subscribed,
+		statistics.Reconnects,
+		statistics.TSSCResets,
+		statistics.TSSCOutOfSequence,
+		statistics.SignalIndexCacheSwaps,
+		statistics.EventsDropped)
+}
+
+func milliseconds(duration time.Duration) string {
+	return strconv.FormatFloat(float64(duration)/float64(time.Millisecond), 'f', 1, 64)
+}
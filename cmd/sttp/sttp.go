@@ -0,0 +1,229 @@
+//******************************************************************************************************
+//  sttp.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+// sttp is a command-line toolbox for STTP publishers: it dumps metadata, evaluates filter expressions,
+// interactively or not, streams measurement values and reports connection statistics, over forward or
+// reverse connections.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/data"
+)
+
+// command defines an sttp subcommand.
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(ctx context.Context, cmd *command, args []string, stdout, stderr io.Writer) int
+}
+
+// Exit codes returned by subcommands.
+const (
+	exitSuccess = 0
+	exitUsage   = 1
+	exitFailure = 2
+)
+
+var commands = []*command{
+	{name: "metadata", args: "[options] (ADDRESS | -file METADATAFILE)", summary: "dump metadata tables as text, CSV or XML", run: runMetadata},
+	{name: "filter", args: "[options] (ADDRESS | -file METADATAFILE) EXPRESSION", summary: "evaluate a filter expression against metadata", run: runFilter},
+	{name: "repl", args: "[options] (ADDRESS | -file METADATAFILE)", summary: "evaluate filter expressions interactively against metadata", run: runRepl},
+	{name: "tail", args: "[options] ADDRESS [EXPRESSION]", summary: "stream measurement values with point tags", run: runTail},
+	{name: "stats", args: "[options] ADDRESS [EXPRESSION]", summary: "show measurement rates, latency and connection statistics", run: runStats},
+	{name: "listen", args: "[options] ADDRESS [EXPRESSION]", summary: "accept a reverse connection from a publisher and stream measurement values", run: runListen},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	stop()
+	os.Exit(code)
+}
+
+// run executes the subcommand specified by args and returns the process exit code.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) int {
+	stdout = &syncWriter{writer: stdout}
+	stderr = &syncWriter{writer: stderr}
+
+	if len(args) == 0 {
+		usage(stderr)
+		return exitUsage
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == args[0] {
+			return cmd.run(ctx, cmd, args[1:], stdout, stderr)
+		}
+	}
+
+	if args[0] == "help" || args[0] == "-h" || args[0] == "-help" || args[0] == "--help" {
+		usage(stdout)
+		return exitSuccess
+	}
+
+	fmt.Fprintf(stderr, "Unknown command \"%s\"\n", args[0])
+	usage(stderr)
+	return exitUsage
+}
+
+func usage(writer io.Writer) {
+	fmt.Fprintln(writer, "Usage:")
+	fmt.Fprintln(writer, "    sttp COMMAND [options] ARGS")
+	fmt.Fprintln(writer, "Commands:")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(writer, "    %-10s %s\n", cmd.name, cmd.summary)
+	}
+
+	fmt.Fprintln(writer, "Run \"sttp COMMAND -h\" for command options.")
+}
+
+// newFlagSet creates the flag set for a subcommand.
+func newFlagSet(cmd *command, stderr io.Writer) *flag.FlagSet {
+	flags := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "Usage:")
+		fmt.Fprintf(stderr, "    sttp %s %s\n", cmd.name, cmd.args)
+		fmt.Fprintln(stderr, "Options:")
+		flags.PrintDefaults()
+	}
+
+	return flags
+}
+
+// parseFlags parses the subcommand arguments, returning false with the exit code when execution should stop.
+func parseFlags(flags *flag.FlagSet, args []string) (int, bool) {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitSuccess, false
+		}
+
+		return exitUsage, false
+	}
+
+	return exitSuccess, true
+}
+
+// newSubscriber creates a Subscriber that writes status messages to stderr when verbose,
+// and error messages to stderr.
+func newSubscriber(options *connectionOptions, stderr io.Writer) *sttp.Subscriber {
+	subscriber := sttp.NewSubscriber()
+
+	statusMessage := func(message string) {
+		if options.verbose {
+			fmt.Fprintln(stderr, message)
+		}
+	}
+
+	subscriber.SetStatusMessageLogger(statusMessage)
+	subscriber.SetErrorMessageLogger(func(message string) { fmt.Fprintln(stderr, message) })
+	subscriber.SetConnectionEstablishedReceiver(subscriber.DefaultConnectionEstablishedReceiver)
+
+	subscriber.SetConnectionTerminatedReceiver(func() {
+		statusMessage("Connection for " + subscriber.ConnectionID() + " terminated.")
+	})
+
+	return subscriber
+}
+
+// loadMetadataFile reads a metadata XML file, e.g., as saved by "sttp metadata -format xml".
+func loadMetadataFile(fileName string) (*data.DataSet, error) {
+	file, err := os.Open(fileName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	dataSet := data.NewDataSet()
+
+	if err = dataSet.ReadXml(file); err != nil {
+		return nil, err
+	}
+
+	return dataSet, nil
+}
+
+// requestMetadata connects to the publisher at address and requests its metadata.
+func requestMetadata(ctx context.Context, address string, options *connectionOptions, stderr io.Writer) (*data.DataSet, error) {
+	// Metadata is requested manually, so the connection never needs to subscribe
+	config := *options.config
+	config.AutoRequestMetadata = false
+	config.AutoSubscribe = false
+
+	subscriber := newSubscriber(options, stderr)
+	defer subscriber.Close()
+
+	received := make(chan *data.DataSet, 1)
+
+	subscriber.SetMetadataReceiver(func(dataSet *data.DataSet) {
+		select {
+		case received <- dataSet:
+		default:
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(ctx, options.timeout)
+	defer cancel()
+
+	if err := subscriber.DialContext(ctx, address, &config); err != nil {
+		return nil, errors.New("failed to connect to \"" + address + "\": " + err.Error())
+	}
+
+	if err := subscriber.RequestMetadataContext(ctx); err != nil {
+		return nil, errors.New("failed to request metadata: " + err.Error())
+	}
+
+	select {
+	case dataSet := <-received:
+		return dataSet, nil
+	case <-ctx.Done():
+		return nil, errors.New("failed to receive metadata: " + ctx.Err().Error())
+	}
+}
+
+// syncWriter serializes writes from subscriber callbacks and the main command flow.
+type syncWriter struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+func (w *syncWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.writer.Write(p)
+}
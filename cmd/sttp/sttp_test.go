@@ -0,0 +1,313 @@
+//******************************************************************************************************
+//  sttp_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/internal/transporttest"
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func runCommand(args ...string) (int, string, string) {
+	var stdout, stderr strings.Builder
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	code := run(ctx, args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+// flagName converts a field name to its hyphen separated flag name, e.g., MaxRetries to max-retries.
+func flagName(fieldName string) string {
+	var name strings.Builder
+
+	for i, r := range fieldName {
+		upper := r >= 'A' && r <= 'Z'
+
+		if upper && i > 0 {
+			previousUpper := fieldName[i-1] >= 'A' && fieldName[i-1] <= 'Z'
+			nextLower := i+1 < len(fieldName) && fieldName[i+1] >= 'a' && fieldName[i+1] <= 'z'
+
+			if !previousUpper || nextLower {
+				name.WriteRune('-')
+			}
+		}
+
+		name.WriteString(strings.ToLower(string(r)))
+	}
+
+	return name.String()
+}
+
+func TestConnectionFlags(t *testing.T) {
+	flags := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	options := bindConnectionFlags(flags)
+
+	for _, structType := range []reflect.Type{reflect.TypeOf(sttp.Config{}), reflect.TypeOf(sttp.Settings{})} {
+		for i := 0; i < structType.NumField(); i++ {
+			name := flagName(strings.ReplaceAll(structType.Field(i).Name, "NaN", "Nan"))
+
+			// TLSConfig is defined by the -tls-* flags
+			if name == "tls-config" {
+				name = "tls"
+			}
+
+			if flags.Lookup(name) == nil {
+				t.Fatalf("TestConnectionFlags: no flag defined for %s.%s, expected \"-%s\"", structType.Name(), structType.Field(i).Name, name)
+			}
+		}
+	}
+
+	err := flags.Parse([]string{
+		"-max-retries", "5",
+		"-failover-addresses", "backup1:7165, backup2:7165",
+		"-auto-reconnect=false",
+		"-version", "3",
+		"-udp-port", "9600",
+		"-processing-interval", "0",
+		"-publish-interval", "0.5",
+		"-start-time", "*-5m",
+	})
+
+	if err != nil {
+		t.Fatalf("TestConnectionFlags: failed to parse flags: %s", err.Error())
+	}
+
+	if err = options.resolve(); err != nil {
+		t.Fatalf("TestConnectionFlags: failed to resolve options: %s", err.Error())
+	}
+
+	config := options.config
+	settings := options.settings
+
+	if config.MaxRetries != 5 || config.AutoReconnect || config.Version != 3 || !config.AutoSubscribe {
+		t.Fatalf("TestConnectionFlags: unexpected config: %+v", *config)
+	}
+
+	if len(config.FailoverAddresses) != 2 || config.FailoverAddresses[1] != "backup2:7165" {
+		t.Fatalf("TestConnectionFlags: unexpected failover addresses: %v", config.FailoverAddresses)
+	}
+
+	if config.TLSConfig != nil {
+		t.Fatalf("TestConnectionFlags: expected no TLS configuration")
+	}
+
+	if settings.UdpPort != 9600 || settings.ProcessingInterval != 0 || settings.PublishInterval != 0.5 || settings.StartTime != "*-5m" || !settings.IncludeTime {
+		t.Fatalf("TestConnectionFlags: unexpected settings: %+v", *settings)
+	}
+
+	if err = flags.Parse([]string{"-version", "256"}); err == nil {
+		t.Fatalf("TestConnectionFlags: expected out of range version to fail")
+	}
+
+	flags.Parse([]string{"-tls-skip-verify", "-tls-server-name", "publisher"})
+
+	if err = options.resolve(); err != nil {
+		t.Fatalf("TestConnectionFlags: failed to resolve TLS options: %s", err.Error())
+	}
+
+	if config.TLSConfig == nil || !config.TLSConfig.InsecureSkipVerify || config.TLSConfig.ServerName != "publisher" {
+		t.Fatalf("TestConnectionFlags: unexpected TLS configuration")
+	}
+}
+
+func TestUsage(t *testing.T) {
+	if code, _, stderr := runCommand(); code != exitUsage || !strings.Contains(stderr, "metadata") {
+		t.Fatalf("TestUsage: expected usage for no command, received %d: %s", code, stderr)
+	}
+
+	if code, _, _ := runCommand("unknown"); code != exitUsage {
+		t.Fatalf("TestUsage: expected usage exit code for unknown command, received %d", code)
+	}
+
+	if code, _, _ := runCommand("tail"); code != exitUsage {
+		t.Fatalf("TestUsage: expected usage exit code for missing address, received %d", code)
+	}
+
+	if code, _, _ := runCommand("metadata", "-format", "json", "-file", "metadata.xml"); code != exitUsage {
+		t.Fatalf("TestUsage: expected usage exit code for invalid format, received %d", code)
+	}
+}
+
+func TestMetadataAndFilter(t *testing.T) {
+	publisher := transport.NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	address := transporttest.Address(publisher)
+	defer publisher.Stop()
+
+	code, stdout, stderr := runCommand("metadata", "-tables", "SchemaVersion", address)
+
+	if code != exitSuccess {
+		t.Fatalf("TestMetadataAndFilter: metadata failed with %d: %s", code, stderr)
+	}
+
+	if lines := strings.Split(strings.TrimSpace(stdout), "\n"); len(lines) != 2 || strings.TrimSpace(lines[0]) != "VersionNumber" {
+		t.Fatalf("TestMetadataAndFilter: unexpected SchemaVersion output: %q", stdout)
+	}
+
+	code, stdout, stderr = runCommand("metadata", "-list", address)
+
+	if code != exitSuccess || !strings.Contains(stdout, "MeasurementDetail") || !strings.Contains(stdout, "130 rows") {
+		t.Fatalf("TestMetadataAndFilter: unexpected table list (%d): %s%s", code, stdout, stderr)
+	}
+
+	// Cache metadata to a file, then filter it offline
+	metadataFile := filepath.Join(t.TempDir(), "metadata.xml")
+
+	if code, _, stderr = runCommand("metadata", "-format", "xml", "-o", metadataFile, address); code != exitSuccess {
+		t.Fatalf("TestMetadataAndFilter: metadata XML failed with %d: %s", code, stderr)
+	}
+
+	code, stdout, stderr = runCommand("filter", "-file", metadataFile, "-format", "ids", "FILTER MeasurementDetail WHERE SignalAcronym = 'FREQ'")
+
+	if code != exitSuccess {
+		t.Fatalf("TestMetadataAndFilter: filter failed with %d: %s", code, stderr)
+	}
+
+	ids := strings.Fields(stdout)
+
+	if len(ids) == 0 {
+		t.Fatalf("TestMetadataAndFilter: expected frequency signal IDs")
+	}
+
+	for _, id := range ids {
+		if _, err := guid.Parse(id); err != nil {
+			t.Fatalf("TestMetadataAndFilter: unexpected signal ID \"%s\": %s", id, err.Error())
+		}
+	}
+
+	// Live filter against the same metadata yields the same rows
+	code, stdout, stderr = runCommand("filter", "-format", "csv", address, "FILTER MeasurementDetail WHERE SignalAcronym = 'FREQ'")
+
+	if code != exitSuccess {
+		t.Fatalf("TestMetadataAndFilter: live filter failed with %d: %s", code, stderr)
+	}
+
+	if lines := strings.Split(strings.TrimSpace(stdout), "\n"); len(lines) != len(ids)+1 || !strings.Contains(lines[0], "SignalID") {
+		t.Fatalf("TestMetadataAndFilter: expected %d CSV rows with header, received %d", len(ids), len(lines)-1)
+	}
+
+	if code, _, _ = runCommand("filter", "-file", metadataFile, "FILTER MeasurementDetail WHERE"); code != exitFailure {
+		t.Fatalf("TestMetadataAndFilter: expected invalid filter expression to fail, received %d", code)
+	}
+
+	if code, _, _ = runCommand("metadata", "-tables", "Missing", "-file", metadataFile); code != exitFailure {
+		t.Fatalf("TestMetadataAndFilter: expected missing table to fail, received %d", code)
+	}
+}
+
+func TestTail(t *testing.T) {
+	publisher := transport.NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	address := transporttest.Address(publisher)
+	defer publisher.Stop()
+
+	measurements := publisher.Metadata().Table("MeasurementDetail")
+	pointTag := measurements.RowValueAsStringByName(0, "PointTag")
+	signalID, _, _ := measurements.Row(0).GuidValueByName("SignalID")
+
+	type result struct {
+		code           int
+		stdout, stderr string
+	}
+
+	done := make(chan result, 1)
+
+	go func() {
+		code, stdout, stderr := runCommand("tail", "-n", "3", address, signalID.String())
+		done <- result{code, stdout, stderr}
+	}()
+
+	for {
+		select {
+		case result := <-done:
+			if result.code != exitSuccess {
+				t.Fatalf("TestTail: tail failed with %d: %s", result.code, result.stderr)
+			}
+
+			lines := strings.Split(strings.TrimSpace(result.stdout), "\n")
+
+			if len(lines) != 3 {
+				t.Fatalf("TestTail: expected 3 measurements, received %d: %q", len(lines), result.stdout)
+			}
+
+			for _, line := range lines {
+				if !strings.Contains(line, pointTag) || !strings.Contains(line, "  60.5  ") {
+					t.Fatalf("TestTail: unexpected measurement line: %q", line)
+				}
+			}
+
+			return
+		case <-time.After(20 * time.Millisecond):
+			publisher.PublishMeasurements([]transport.Measurement{{SignalID: signalID, Value: 60.5, Timestamp: ticks.UtcNow()}})
+		}
+	}
+}
+
+func TestStatsWindow(t *testing.T) {
+	var window statsWindow
+
+	received := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	timestamp := ticks.FromTime(received.Add(-20 * time.Millisecond))
+
+	window.add([]transport.Measurement{{Timestamp: timestamp}, {Timestamp: timestamp}, {Timestamp: 0}}, received)
+	window.add([]transport.Measurement{{Timestamp: ticks.FromTime(received.Add(-10 * time.Millisecond))}}, received)
+
+	sample := window.reset()
+
+	if sample.measurements != 4 || sample.frames != 2 || sample.latencyCount != 3 {
+		t.Fatalf("TestStatsWindow: unexpected sample counts: %+v", sample)
+	}
+
+	if sample.latencyMin != 10*time.Millisecond || sample.latencyMax != 20*time.Millisecond {
+		t.Fatalf("TestStatsWindow: unexpected latency range: %s - %s", sample.latencyMin, sample.latencyMax)
+	}
+
+	if sample = window.reset(); sample.measurements != 0 {
+		t.Fatalf("TestStatsWindow: expected reset sample")
+	}
+
+	var output strings.Builder
+	sample = statsSample{measurements: 300, frames: 10, latencyCount: 2, latencyTotal: 30 * time.Millisecond, latencyMin: 10 * time.Millisecond, latencyMax: 20 * time.Millisecond}
+
+	writeStats(&output, received, 2*time.Second, &sample, 2048, &transport.SubscriberStatistics{Connected: true, MeasurementsReceived: 300})
+
+	for _, expected := range []string{"measurements=150.0/s", "frames=5.0/s", "latency(avg/min/max)=15.0/10.0/20.0 ms", "bytes=1,024/s", "connected=true"} {
+		if !strings.Contains(output.String(), expected) {
+			t.Fatalf("TestStatsWindow: expected \"%s\" in report: %s", expected, output.String())
+		}
+	}
+}
@@ -0,0 +1,190 @@
+//******************************************************************************************************
+//  tail.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// defaultExpression defines the subscription filter expression used when none is specified.
+const defaultExpression = "FILTER ActiveMeasurements WHERE True"
+
+func runTail(ctx context.Context, cmd *command, args []string, stdout, stderr io.Writer) int {
+	return runValues(ctx, cmd, args, stdout, stderr, false)
+}
+
+func runListen(ctx context.Context, cmd *command, args []string, stdout, stderr io.Writer) int {
+	return runValues(ctx, cmd, args, stdout, stderr, true)
+}
+
+// runValues streams measurement values, over a reverse connection when reverse is true.
+func runValues(ctx context.Context, cmd *command, args []string, stdout, stderr io.Writer, reverse bool) int {
+	flags := newFlagSet(cmd, stderr)
+	options := bindConnectionFlags(flags)
+
+	maxCount := flags.Int("n", 0, "exit after showing the specified number of measurements, 0 for no limit")
+
+	if code, ok := parseFlags(flags, args); !ok {
+		return code
+	}
+
+	address, expression, ok := streamArgs(flags)
+
+	if !ok {
+		return exitUsage
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var mutex sync.Mutex
+	count := 0
+
+	receiver := func(subscriber *sttp.Subscriber, measurements []transport.Measurement) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		for i := range measurements {
+			if *maxCount > 0 && count >= *maxCount {
+				cancel()
+				return
+			}
+
+			writeValue(stdout, subscriber, &measurements[i])
+			count++
+		}
+
+		if *maxCount > 0 && count >= *maxCount {
+			cancel()
+		}
+	}
+
+	subscriber, err := startStream(ctx, address, expression, options, reverse, stderr, receiver)
+
+	if err != nil {
+		fmt.Fprintf(stderr, "%s\n", err.Error())
+		return exitFailure
+	}
+
+	defer subscriber.Close()
+
+	<-ctx.Done()
+	return exitSuccess
+}
+
+// streamArgs gets the ADDRESS and optional EXPRESSION positional arguments of a streaming subcommand.
+func streamArgs(flags *flag.FlagSet) (string, string, bool) {
+	if flags.NArg() < 1 || flags.NArg() > 2 {
+		flags.Usage()
+		return "", "", false
+	}
+
+	expression := defaultExpression
+
+	if flags.NArg() == 2 {
+		expression = flags.Arg(1)
+	}
+
+	return flags.Arg(0), expression, true
+}
+
+// startStream connects to, or when reverse listens for, a publisher and subscribes to the measurements selected
+// by expression. The receiver is called with each set of received measurements until the returned Subscriber
+// is closed.
+func startStream(ctx context.Context, address, expression string, options *connectionOptions, reverse bool, stderr io.Writer, receiver func(subscriber *sttp.Subscriber, measurements []transport.Measurement)) (*sttp.Subscriber, error) {
+	if err := options.resolve(); err != nil {
+		return nil, err
+	}
+
+	config := options.config
+	settings := options.settings
+	subscriber := newSubscriber(options, stderr)
+
+	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+		receiver(subscriber, *measurements)
+		subscriber.PutMeasurementSlice(measurements)
+	})
+
+	// Without automatic subscription, subscribe as soon as each connection is established
+	subscriber.SetConnectionEstablishedReceiver(func() {
+		subscriber.DefaultConnectionEstablishedReceiver()
+
+		if !config.AutoSubscribe {
+			go subscriber.Subscribe(expression, settings)
+		}
+	})
+
+	subscriber.Subscribe(expression, settings)
+
+	if reverse {
+		if err := subscriber.Listen(address, config); err != nil {
+			subscriber.Close()
+			return nil, errors.New("failed to listen on \"" + address + "\": " + err.Error())
+		}
+
+		return subscriber, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, options.timeout)
+	defer cancel()
+
+	if err := subscriber.DialContext(ctx, address, config); err != nil {
+		subscriber.Close()
+		return nil, errors.New("failed to connect to \"" + address + "\": " + err.Error())
+	}
+
+	return subscriber, nil
+}
+
+// writeValue writes a measurement value with its point tag, or measurement key when no point tag is defined.
+func writeValue(writer io.Writer, subscriber *sttp.Subscriber, measurement *transport.Measurement) {
+	fmt.Fprintf(writer, "%s  %s  %s  %s\n",
+		measurement.Timestamp.String(),
+		pointTag(subscriber.Metadata(measurement), measurement),
+		strconv.FormatFloat(subscriber.AdjustedValue(measurement), 'g', -1, 64),
+		measurement.Flags.String())
+}
+
+func pointTag(metadata *transport.MeasurementMetadata, measurement *transport.Measurement) string {
+	if metadata != nil {
+		if len(metadata.Tag) > 0 {
+			return metadata.Tag
+		}
+
+		if len(metadata.Source) > 0 {
+			return metadata.Source + ":" + strconv.FormatUint(metadata.ID, 10)
+		}
+	}
+
+	return measurement.SignalID.String()
+}
@@ -0,0 +1,229 @@
+//******************************************************************************************************
+//  sttpdump.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+// sttpdump decodes a raw STTP capture, as written by a DataSubscriber CaptureWriter, into readable form.
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// options defines the output options of a capture dump.
+type options struct {
+	maxMeasurements int
+	showCache       bool
+	showMetadata    bool
+	showHex         bool
+}
+
+func main() {
+	var opts options
+	var swapGuidEndianness bool
+
+	flag.IntVar(&opts.maxMeasurements, "m", 10, "maximum number of measurements to show per data packet, -1 for all")
+	flag.BoolVar(&opts.showCache, "cache", true, "show signal index cache contents")
+	flag.BoolVar(&opts.showMetadata, "metadata", false, "show received metadata XML")
+	flag.BoolVar(&opts.showHex, "hex", false, "show hex dump of frame payloads")
+	flag.BoolVar(&swapGuidEndianness, "swap", false, "swap Guid endianness for non-RFC Guid encodings")
+
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "Usage:")
+		fmt.Fprintln(flag.CommandLine.Output(), "    sttpdump [options] CAPTUREFILE")
+		fmt.Fprintln(flag.CommandLine.Output(), "Options:")
+		flag.PrintDefaults()
+	}
+
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	reader, err := transport.OpenCapture(flag.Arg(0))
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open capture \"%s\": %s\n", flag.Arg(0), err.Error())
+		os.Exit(2)
+	}
+
+	defer reader.Close()
+
+	decoder := transport.NewCaptureDecoder()
+	decoder.SwapGuidEndianness = swapGuidEndianness
+
+	writer := bufio.NewWriter(os.Stdout)
+	defer writer.Flush()
+
+	if err := dump(writer, reader, decoder, &opts); err != nil {
+		writer.Flush()
+		fmt.Fprintf(os.Stderr, "Failed to read capture: %s\n", err.Error())
+		os.Exit(3)
+	}
+}
+
+// dump writes each frame read from the capture in readable form.
+func dump(writer io.Writer, reader *transport.CaptureReader, decoder *transport.CaptureDecoder, opts *options) error {
+	for index := 1; ; index++ {
+		frame, err := reader.Next()
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		writeFrame(writer, index, decoder.Decode(frame), opts)
+	}
+}
+
+func writeFrame(writer io.Writer, index int, decoded *transport.DecodedFrame, opts *options) {
+	frame := decoded.Frame
+	direction := "RECV"
+	channel := "CMD "
+
+	if frame.Direction == transport.CaptureDirection.Sent {
+		direction = "SENT"
+	}
+
+	if frame.Channel == transport.CaptureChannel.Data {
+		channel = "DATA"
+	}
+
+	fmt.Fprintf(writer, "#%-6d %-29s %s %s ", index, frame.Time.String(), direction, channel)
+
+	if frame.Direction == transport.CaptureDirection.Sent {
+		fmt.Fprintf(writer, "%s", decoded.Command)
+
+		if decoded.Command == transport.ServerCommand.DefineOperationalModes {
+			fmt.Fprintf(writer, " modes=0x%08X", uint32(decoded.OperationalModes))
+		}
+	} else {
+		fmt.Fprintf(writer, "%s", decoded.Response)
+
+		switch decoded.Response {
+		case transport.ServerResponse.Succeeded, transport.ServerResponse.Failed:
+			fmt.Fprintf(writer, " [%s]", decoded.Command)
+		case transport.ServerResponse.DataPacket:
+			fmt.Fprintf(writer, " flags=%s measurements=%d", decoded.DataPacketFlags, len(decoded.Measurements))
+		case transport.ServerResponse.UpdateSignalIndexCache:
+			if decoded.SignalIndexCache != nil {
+				fmt.Fprintf(writer, " signals=%d", decoded.SignalIndexCache.Count())
+			}
+		}
+	}
+
+	fmt.Fprintf(writer, " (%d bytes)", len(decoded.Payload))
+
+	if len(decoded.Message) > 0 {
+		fmt.Fprintf(writer, ": %s", decoded.Message)
+	}
+
+	fmt.Fprintln(writer)
+
+	if decoded.Err != nil {
+		fmt.Fprintf(writer, "    ERROR: %s\n", decoded.Err.Error())
+	}
+
+	writeMeasurements(writer, decoded.Measurements, opts.maxMeasurements)
+
+	if opts.showCache && decoded.SignalIndexCache != nil {
+		signalIndexCache := decoded.SignalIndexCache
+
+		for _, signalIndex := range signalIndexCache.SignalIndexes() {
+			signalID, source, id, _ := signalIndexCache.Record(signalIndex)
+			fmt.Fprintf(writer, "    %6d  %s  %s:%d\n", signalIndex, signalID.String(), source, id)
+		}
+	}
+
+	if opts.showMetadata && len(decoded.Metadata) > 0 {
+		fmt.Fprintf(writer, "%s\n", decoded.Metadata)
+	}
+
+	if opts.showHex && len(decoded.Payload) > 0 {
+		dumper := hex.Dumper(&indentWriter{writer: writer})
+		dumper.Write(decoded.Payload)
+		dumper.Close()
+	}
+}
+
+func writeMeasurements(writer io.Writer, measurements []transport.Measurement, maxMeasurements int) {
+	count := len(measurements)
+
+	if maxMeasurements >= 0 && count > maxMeasurements {
+		count = maxMeasurements
+	}
+
+	for i := 0; i < count; i++ {
+		measurement := &measurements[i]
+
+		fmt.Fprintf(writer, "    %s  %s  %s  %s\n",
+			measurement.SignalID.String(),
+			measurement.Timestamp.String(),
+			strconv.FormatFloat(measurement.Value, 'g', -1, 64),
+			measurement.Flags.String())
+	}
+
+	if remaining := len(measurements) - count; remaining > 0 {
+		fmt.Fprintf(writer, "    ... %d more measurements\n", remaining)
+	}
+}
+
+// indentWriter indents each line written to the underlying writer.
+type indentWriter struct {
+	writer  io.Writer
+	midLine bool
+}
+
+func (iw *indentWriter) Write(data []byte) (int, error) {
+	for _, value := range data {
+		if !iw.midLine {
+			if _, err := io.WriteString(iw.writer, "    "); err != nil {
+				return 0, err
+			}
+
+			iw.midLine = true
+		}
+
+		if _, err := iw.writer.Write([]byte{value}); err != nil {
+			return 0, err
+		}
+
+		if value == '\n' {
+			iw.midLine = false
+		}
+	}
+
+	return len(data), nil
+}
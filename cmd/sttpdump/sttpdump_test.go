@@ -0,0 +1,97 @@
+//******************************************************************************************************
+//  sttpdump_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func responseFrame(response transport.ServerResponseEnum, command transport.ServerCommandEnum, payload []byte) []byte {
+	data := []byte{byte(response), byte(command), 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(data[2:], uint32(len(payload)))
+	return append(data, payload...)
+}
+
+func TestDump(t *testing.T) {
+	connectionString := "includeTime=true;filterExpression={FILTER ActiveMeasurements WHERE SignalType = 'FREQ'}"
+	subscribe := []byte{byte(transport.ServerCommand.Subscribe), byte(transport.DataPacketFlags.Compact), 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(subscribe[2:], uint32(len(connectionString)))
+	subscribe = append(subscribe, connectionString...)
+
+	modes := []byte{byte(transport.ServerCommand.DefineOperationalModes), 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(modes[1:], uint32(transport.OperationalModes.CompressPayloadData)|3)
+
+	startTime := ticks.Ticks(638000000000000000)
+	frames := []*transport.CaptureFrame{
+		{Direction: transport.CaptureDirection.Sent, Data: modes},
+		{Direction: transport.CaptureDirection.Sent, Data: subscribe},
+		{Data: responseFrame(transport.ServerResponse.Succeeded, transport.ServerCommand.Subscribe, []byte("Client subscribed"))},
+		{Data: responseFrame(transport.ServerResponse.DataStartTime, transport.ServerCommand.Subscribe, binary.BigEndian.AppendUint64(nil, uint64(startTime)))},
+		{Data: responseFrame(transport.ServerResponse.DataPacket, transport.ServerCommand.Subscribe, []byte{byte(transport.DataPacketFlags.Compact)})},
+	}
+
+	var capture bytes.Buffer
+	writer, _ := transport.NewCaptureWriter(&capture)
+
+	for _, frame := range frames {
+		writer.WriteFrame(frame)
+	}
+
+	writer.Close()
+
+	reader, err := transport.NewCaptureReader(bytes.NewReader(capture.Bytes()))
+
+	if err != nil {
+		t.Fatalf("TestDump: failed to read capture: %s", err.Error())
+	}
+
+	var output strings.Builder
+
+	if err := dump(&output, reader, transport.NewCaptureDecoder(), &options{maxMeasurements: 10, showHex: true}); err != nil {
+		t.Fatalf("TestDump: dump failed: %s", err.Error())
+	}
+
+	lines := output.String()
+
+	for _, expected := range []string{
+		"SENT CMD  DefineOperationalModes modes=0x20000003",
+		"SENT CMD  Subscribe (" + strconv.Itoa(len(subscribe)-1) + " bytes): " + connectionString,
+		"RECV CMD  Succeeded [Subscribe] (17 bytes): Client subscribed",
+		"RECV CMD  DataStartTime (8 bytes): " + startTime.String(),
+		"RECV CMD  DataPacket flags=Compact measurements=0 (1 bytes)",
+		"    ERROR: malformed frame",
+		"    00000000  43 6c 69 65 6e 74",
+	} {
+		if !strings.Contains(lines, expected) {
+			t.Fatalf("TestDump: expected output to contain %q, received:\n%s", expected, lines)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+//******************************************************************************************************
+//  Publisher.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transporttest
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+// Publisher defines the DataPublisher operations used to start a test publisher. Defining
+// operations as an interface allows tests in the transport package to share these helpers.
+type Publisher interface {
+	DefineMetadataXml(metadata []byte) error
+	Start(port uint16, networkInterface string) error
+	Port() uint16
+}
+
+// SampleMetadata loads the sample metadata, test/SampleMetadata.xml, used by test publishers.
+func SampleMetadata(t testing.TB) []byte {
+	t.Helper()
+
+	// Sample metadata is located relative to this source file so it loads from any test directory
+	_, source, _, _ := runtime.Caller(0)
+	metadata, err := os.ReadFile(filepath.Join(filepath.Dir(source), "..", "..", "test", "SampleMetadata.xml"))
+
+	if err != nil {
+		t.Fatalf("Failed to load sample metadata: %s", err.Error())
+	}
+
+	return metadata
+}
+
+// StartPublisher defines the sample metadata for the publisher, then starts it listening on an
+// available loopback port. Any settings, e.g., TLSConfig, should be applied before the call.
+// The sample metadata is returned.
+func StartPublisher(t testing.TB, publisher Publisher) []byte {
+	t.Helper()
+
+	metadata := SampleMetadata(t)
+
+	if err := publisher.DefineMetadataXml(metadata); err != nil {
+		t.Fatalf("Failed to define publisher metadata: %s", err.Error())
+	}
+
+	if err := publisher.Start(0, "127.0.0.1"); err != nil {
+		t.Fatalf("Failed to start publisher: %s", err.Error())
+	}
+
+	return metadata
+}
+
+// Address gets the "host:port" address of a publisher started with StartPublisher.
+func Address(publisher Publisher) string {
+	return net.JoinHostPort("127.0.0.1", strconv.Itoa(int(publisher.Port())))
+}
@@ -0,0 +1,374 @@
+//******************************************************************************************************
+//  Concentrator.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Frame represents a set of measurements that have been aligned to a common timestamp by a Concentrator.
+type Frame struct {
+	// Timestamp is the frame timestamp, aligned to the configured frame rate.
+	Timestamp ticks.Ticks
+
+	// Measurements are the measurements sorted into the frame, keyed by signal ID.
+	Measurements map[guid.Guid]transport.Measurement
+
+	// Complete determines if the frame received the configured number of expected measurements
+	// before it was published; otherwise, the frame was published because lag time expired.
+	Complete bool
+}
+
+// Concentrator sorts measurements into time-aligned frames at a configured frame rate and publishes
+// each frame, in timestamp order, when it is complete or when its lag time expires. A Concentrator
+// is typically fed from a Subscriber new measurements receiver, e.g.:
+//
+//	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+//		concentrator.SortMeasurements(*measurements)
+//		subscriber.PutMeasurementSlice(measurements)
+//	})
+type Concentrator struct {
+	framesPerSecond      int
+	ticksPerFrame        float64
+	lagTime              ticks.Ticks
+	leadTime             ticks.Ticks
+	useLocalClock        bool
+	expectedMeasurements int
+
+	frames        map[ticks.Ticks]*Frame
+	lastPublished ticks.Ticks
+	latestTime    ticks.Ticks
+	mutex         sync.Mutex
+
+	// Publication is serialized so that frames are received in timestamp order
+	publishMutex sync.Mutex
+
+	sortedMeasurements    uint64
+	lateMeasurements      uint64
+	discardedMeasurements uint64
+	publishedFrames       uint64
+
+	frameReceiver func(frame *Frame)
+
+	assigningHandlerMutex sync.RWMutex
+
+	stop      chan struct{}
+	completed chan struct{}
+}
+
+// NewConcentrator creates a new Concentrator. Config parameter controls frame alignment related
+// settings, set value to nil for default values.
+func NewConcentrator(config *ConcentratorConfig) *Concentrator {
+	if config == nil {
+		config = &concentratorConfigDefaults
+	}
+
+	framesPerSecond := config.FramesPerSecond
+
+	if framesPerSecond < 1 {
+		framesPerSecond = concentratorConfigDefaults.FramesPerSecond
+	}
+
+	lagTime := config.LagTime
+
+	if lagTime <= 0 {
+		lagTime = concentratorConfigDefaults.LagTime
+	}
+
+	leadTime := config.LeadTime
+
+	if leadTime <= 0 {
+		leadTime = concentratorConfigDefaults.LeadTime
+	}
+
+	return &Concentrator{
+		framesPerSecond:      framesPerSecond,
+		ticksPerFrame:        float64(ticks.PerSecond) / float64(framesPerSecond),
+		lagTime:              ticks.Ticks(lagTime * float64(ticks.PerSecond)),
+		leadTime:             ticks.Ticks(leadTime * float64(ticks.PerSecond)),
+		useLocalClock:        config.UseLocalClockAsRealTime,
+		expectedMeasurements: config.ExpectedMeasurements,
+		frames:               make(map[ticks.Ticks]*Frame),
+	}
+}
+
+// SetFrameReceiver defines the callback that handles published frames. Frames are received in
+// timestamp order and the receiver owns the provided frame.
+// Assignment will take effect immediately, even while concentrator is started.
+func (c *Concentrator) SetFrameReceiver(callback func(frame *Frame)) {
+	c.assigningHandlerMutex.Lock()
+	defer c.assigningHandlerMutex.Unlock()
+
+	c.frameReceiver = callback
+}
+
+// Start begins monitoring frames for lag time expiration at the configured frame rate. Without
+// being started, expired frames are only published when new measurements are sorted.
+func (c *Concentrator) Start() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.stop != nil {
+		return
+	}
+
+	c.stop = make(chan struct{})
+	c.completed = make(chan struct{})
+
+	go c.runPublicationMonitor(c.stop, c.completed)
+}
+
+// Stop ends monitoring frames for lag time expiration. Frames that have not been published remain
+// pending until further measurements are sorted or the concentrator is started again.
+func (c *Concentrator) Stop() {
+	c.mutex.Lock()
+	stop, completed := c.stop, c.completed
+	c.stop = nil
+	c.mutex.Unlock()
+
+	if stop != nil {
+		close(stop)
+		<-completed
+	}
+}
+
+// RealTime gets the current real time of the Concentrator, which is either the local clock
+// or the timestamp of the latest sorted measurement, see ConcentratorConfig.UseLocalClockAsRealTime.
+func (c *Concentrator) RealTime() ticks.Ticks {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.realTime()
+}
+
+// realTime gets the current real time, mutex must be held.
+func (c *Concentrator) realTime() ticks.Ticks {
+	if c.useLocalClock {
+		return ticks.UtcNow()
+	}
+
+	return c.latestTime
+}
+
+// SortMeasurements sorts the provided measurements into their time-aligned frames, then publishes
+// any frames that have completed or expired. Measurements are copied, so the provided slice can be
+// reused once the method returns.
+func (c *Concentrator) SortMeasurements(measurements []transport.Measurement) {
+	c.publishMutex.Lock()
+	defer c.publishMutex.Unlock()
+
+	c.mutex.Lock()
+
+	leadLimit := ticks.UtcNow() + c.leadTime
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		timestamp := ticks.Ticks(measurement.Timestamp.TimestampValue())
+
+		// Measurements too far in the future are unreasonable and would skew real time
+		if timestamp > leadLimit {
+			c.discardedMeasurements++
+			continue
+		}
+
+		if timestamp > c.latestTime {
+			c.latestTime = timestamp
+		}
+
+		frameTimestamp := c.frameTimestamp(timestamp)
+
+		if frameTimestamp <= c.lastPublished {
+			c.lateMeasurements++
+			c.discardedMeasurements++
+			continue
+		}
+
+		frame, found := c.frames[frameTimestamp]
+
+		if !found {
+			frame = &Frame{
+				Timestamp:    frameTimestamp,
+				Measurements: make(map[guid.Guid]transport.Measurement),
+			}
+
+			c.frames[frameTimestamp] = frame
+		}
+
+		// Only the first measurement received for a signal is used
+		if _, found := frame.Measurements[measurement.SignalID]; found {
+			c.discardedMeasurements++
+			continue
+		}
+
+		frame.Measurements[measurement.SignalID] = *measurement
+		c.sortedMeasurements++
+
+		if c.expectedMeasurements > 0 && len(frame.Measurements) >= c.expectedMeasurements {
+			frame.Complete = true
+		}
+	}
+
+	frames := c.readyFrames()
+
+	c.mutex.Unlock()
+
+	c.publish(frames)
+}
+
+// frameTimestamp rounds a timestamp to the nearest frame boundary at the configured frame rate.
+func (c *Concentrator) frameTimestamp(timestamp ticks.Ticks) ticks.Ticks {
+	baseTime := timestamp - timestamp%ticks.PerSecond
+	frameIndex := math.Round(float64(timestamp-baseTime) / c.ticksPerFrame)
+
+	return baseTime + ticks.Ticks(math.Round(frameIndex*c.ticksPerFrame))
+}
+
+// readyFrames removes and returns pending frames, in timestamp order, that are ready to publish.
+// Frames publish in order, so a complete frame waits for any older incomplete frames. Mutex must be held.
+func (c *Concentrator) readyFrames() []*Frame {
+	if len(c.frames) == 0 {
+		return nil
+	}
+
+	timestamps := make([]ticks.Ticks, 0, len(c.frames))
+
+	for timestamp := range c.frames {
+		timestamps = append(timestamps, timestamp)
+	}
+
+	sort.Slice(timestamps, func(i, j int) bool {
+		return timestamps[i] < timestamps[j]
+	})
+
+	expiration := c.realTime() - c.lagTime
+	var frames []*Frame
+
+	for _, timestamp := range timestamps {
+		frame := c.frames[timestamp]
+
+		if !frame.Complete && timestamp > expiration {
+			break
+		}
+
+		delete(c.frames, timestamp)
+		c.lastPublished = timestamp
+		c.publishedFrames++
+		frames = append(frames, frame)
+	}
+
+	return frames
+}
+
+// publish delivers frames to the frame receiver, publishMutex must be held.
+func (c *Concentrator) publish(frames []*Frame) {
+	if len(frames) == 0 {
+		return
+	}
+
+	c.assigningHandlerMutex.RLock()
+	defer c.assigningHandlerMutex.RUnlock()
+
+	if c.frameReceiver == nil {
+		return
+	}
+
+	for _, frame := range frames {
+		c.frameReceiver(frame)
+	}
+}
+
+func (c *Concentrator) runPublicationMonitor(stop chan struct{}, completed chan struct{}) {
+	defer close(completed)
+
+	ticker := time.NewTicker(time.Duration(c.ticksPerFrame) * 100)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.publishMutex.Lock()
+			c.mutex.Lock()
+			frames := c.readyFrames()
+			c.mutex.Unlock()
+			c.publish(frames)
+			c.publishMutex.Unlock()
+		}
+	}
+}
+
+// FramesPerSecond gets the number of frames per second that measurements are aligned to.
+func (c *Concentrator) FramesPerSecond() int {
+	return c.framesPerSecond
+}
+
+// PendingFrames gets the number of frames that have not yet been published.
+func (c *Concentrator) PendingFrames() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return len(c.frames)
+}
+
+// PublishedFrames gets the total number of frames published.
+func (c *Concentrator) PublishedFrames() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.publishedFrames
+}
+
+// SortedMeasurements gets the total number of measurements sorted into frames.
+func (c *Concentrator) SortedMeasurements() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.sortedMeasurements
+}
+
+// LateMeasurements gets the total number of measurements that arrived after their frame was published.
+// Late measurements are included in the total of discarded measurements.
+func (c *Concentrator) LateMeasurements() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.lateMeasurements
+}
+
+// DiscardedMeasurements gets the total number of measurements that were not sorted into a frame,
+// i.e., late measurements, measurements exceeding the lead time and duplicate measurements.
+func (c *Concentrator) DiscardedMeasurements() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.discardedMeasurements
+}
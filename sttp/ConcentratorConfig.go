@@ -0,0 +1,63 @@
+//******************************************************************************************************
+//  ConcentratorConfig.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+// ConcentratorConfig defines the frame alignment related settings for a Concentrator.
+type ConcentratorConfig struct {
+	// FramesPerSecond defines the number of frames per second that measurements are aligned to.
+	FramesPerSecond int
+
+	// LagTime defines the allowed past time deviation tolerance in seconds (can be sub-second).
+	// A frame is published once real time exceeds its timestamp by this value, whether or not it is
+	// complete; measurements for frames that have already been published are discarded as late.
+	LagTime float64
+
+	// LeadTime defines the allowed future time deviation tolerance in seconds (can be sub-second).
+	// Measurements with a timestamp that exceeds the local clock by more than this value are discarded.
+	LeadTime float64
+
+	// UseLocalClockAsRealTime determines if the local clock is used as real time. If false,
+	// the timestamp of the latest measurement will be used as real time.
+	UseLocalClockAsRealTime bool
+
+	// ExpectedMeasurements defines the number of distinct measurements that make up a complete frame.
+	// Complete frames are published without waiting for lag time to expire. Set value to zero to
+	// only publish frames when lag time expires.
+	ExpectedMeasurements int
+}
+
+// concentratorConfigDefaults define the default values for Concentrator ConcentratorConfig.
+var concentratorConfigDefaults = ConcentratorConfig{
+	FramesPerSecond:         30,
+	LagTime:                 3.0,
+	LeadTime:                1.0,
+	UseLocalClockAsRealTime: false,
+	ExpectedMeasurements:    0,
+}
+
+// NewConcentratorConfig creates a new ConcentratorConfig instance initialized with default values.
+func NewConcentratorConfig() *ConcentratorConfig {
+	config := concentratorConfigDefaults
+	return &config
+}
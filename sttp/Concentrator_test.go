@@ -0,0 +1,181 @@
+//******************************************************************************************************
+//  Concentrator_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func newTestConcentrator(config *ConcentratorConfig) (*Concentrator, chan *Frame) {
+	concentrator := NewConcentrator(config)
+	frames := make(chan *Frame, 100)
+
+	concentrator.SetFrameReceiver(func(frame *Frame) {
+		frames <- frame
+	})
+
+	return concentrator, frames
+}
+
+func testBaseTime() ticks.Ticks {
+	// Second aligned time in the recent past so measurements do not exceed lead time
+	baseTime := ticks.UtcNow() - ticks.PerMinute
+	return baseTime - baseTime%ticks.PerSecond
+}
+
+func TestConcentratorFrameAlignment(t *testing.T) {
+	concentrator := NewConcentrator(nil)
+	baseTime := testBaseTime()
+
+	for _, test := range []struct {
+		timestamp ticks.Ticks
+		expected  ticks.Ticks
+	}{
+		{baseTime, baseTime},
+		{baseTime + 333333, baseTime + 333333},
+		{baseTime + 333334, baseTime + 333333},
+		{baseTime + 666666, baseTime + 666667},
+		{baseTime + 9999999, baseTime + ticks.PerSecond},
+	} {
+		if frameTimestamp := concentrator.frameTimestamp(test.timestamp); frameTimestamp != test.expected {
+			t.Fatalf("TestConcentratorFrameAlignment: expected frame timestamp %d for %d, received %d", test.expected-baseTime, test.timestamp-baseTime, frameTimestamp-baseTime)
+		}
+	}
+}
+
+func TestConcentratorCompleteFrames(t *testing.T) {
+	config := NewConcentratorConfig()
+	config.ExpectedMeasurements = 2
+
+	concentrator, frames := newTestConcentrator(config)
+	baseTime := testBaseTime()
+	signal1, signal2 := guid.New(), guid.New()
+
+	concentrator.SortMeasurements([]transport.Measurement{
+		{SignalID: signal1, Timestamp: baseTime, Value: 1},
+		{SignalID: signal1, Timestamp: baseTime + 333333, Value: 2},
+		{SignalID: signal1, Timestamp: baseTime, Value: 3},
+	})
+
+	if len(frames) != 0 {
+		t.Fatalf("TestConcentratorCompleteFrames: expected no frames before completion")
+	}
+
+	if discarded := concentrator.DiscardedMeasurements(); discarded != 1 {
+		t.Fatalf("TestConcentratorCompleteFrames: expected duplicate to be discarded, discarded %d", discarded)
+	}
+
+	// Completing second frame does not publish it ahead of the incomplete first frame
+	concentrator.SortMeasurements([]transport.Measurement{{SignalID: signal2, Timestamp: baseTime + 333333, Value: 4}})
+
+	if len(frames) != 0 {
+		t.Fatalf("TestConcentratorCompleteFrames: expected frames to be published in order")
+	}
+
+	concentrator.SortMeasurements([]transport.Measurement{{SignalID: signal2, Timestamp: baseTime + 1, Value: 5}})
+
+	if len(frames) != 2 {
+		t.Fatalf("TestConcentratorCompleteFrames: expected 2 frames, received %d", len(frames))
+	}
+
+	first, second := <-frames, <-frames
+
+	if first.Timestamp != baseTime || !first.Complete || first.Measurements[signal1].Value != 1 || first.Measurements[signal2].Value != 5 {
+		t.Fatalf("TestConcentratorCompleteFrames: unexpected first frame: %+v", first)
+	}
+
+	if second.Timestamp != baseTime+333333 || !second.Complete || len(second.Measurements) != 2 {
+		t.Fatalf("TestConcentratorCompleteFrames: unexpected second frame: %+v", second)
+	}
+
+	if concentrator.PublishedFrames() != 2 || concentrator.SortedMeasurements() != 4 || concentrator.PendingFrames() != 0 {
+		t.Fatalf("TestConcentratorCompleteFrames: unexpected statistics")
+	}
+}
+
+func TestConcentratorLagTime(t *testing.T) {
+	config := NewConcentratorConfig()
+	config.LagTime = 1.0
+
+	concentrator, frames := newTestConcentrator(config)
+	baseTime := testBaseTime()
+	signal1, signal2 := guid.New(), guid.New()
+
+	concentrator.SortMeasurements([]transport.Measurement{{SignalID: signal1, Timestamp: baseTime}})
+	concentrator.SortMeasurements([]transport.Measurement{{SignalID: signal1, Timestamp: baseTime + ticks.PerSecond/2}})
+
+	if len(frames) != 0 {
+		t.Fatalf("TestConcentratorLagTime: expected no frames before lag time expired")
+	}
+
+	// Latest timestamp advances real time past lag time of first frame only
+	concentrator.SortMeasurements([]transport.Measurement{{SignalID: signal1, Timestamp: baseTime + ticks.PerSecond}})
+
+	if len(frames) != 1 {
+		t.Fatalf("TestConcentratorLagTime: expected 1 frame, received %d", len(frames))
+	}
+
+	if frame := <-frames; frame.Timestamp != baseTime || frame.Complete {
+		t.Fatalf("TestConcentratorLagTime: unexpected frame: %+v", frame)
+	}
+
+	if realTime := concentrator.RealTime(); realTime != baseTime+ticks.PerSecond {
+		t.Fatalf("TestConcentratorLagTime: expected real time to be latest timestamp")
+	}
+
+	concentrator.SortMeasurements([]transport.Measurement{
+		{SignalID: signal2, Timestamp: baseTime},
+		{SignalID: signal2, Timestamp: ticks.UtcNow() + ticks.PerMinute},
+	})
+
+	if concentrator.LateMeasurements() != 1 || concentrator.DiscardedMeasurements() != 2 {
+		t.Fatalf("TestConcentratorLagTime: expected 1 late and 2 discarded measurements, received %d and %d", concentrator.LateMeasurements(), concentrator.DiscardedMeasurements())
+	}
+}
+
+func TestConcentratorLocalClock(t *testing.T) {
+	config := NewConcentratorConfig()
+	config.LagTime = 0.05
+	config.UseLocalClockAsRealTime = true
+
+	concentrator, frames := newTestConcentrator(config)
+	concentrator.Start()
+	defer concentrator.Stop()
+
+	concentrator.SortMeasurements([]transport.Measurement{{SignalID: guid.New(), Timestamp: ticks.UtcNow()}})
+
+	select {
+	case frame := <-frames:
+		if frame.Complete || len(frame.Measurements) != 1 {
+			t.Fatalf("TestConcentratorLocalClock: unexpected frame: %+v", frame)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestConcentratorLocalClock: timed out waiting for expired frame")
+	}
+}
@@ -23,6 +23,8 @@
 
 package sttp
 
+import "crypto/tls"
+
 // Config defines the STTP connection related configuration parameters.
 type Config struct {
 	// MaxRetries defines the maximum number of times to retry a connection.
@@ -44,6 +46,18 @@ type Config struct {
 	// Note: setting ignored for listening connections.
 	AutoReconnect bool
 
+	// FailoverAddresses defines an ordered list of backup publisher addresses, in "hostname:port"
+	// format, that are tried, in priority order, when the address provided to Dial cannot be
+	// reached. Retries, back-off and MaxRetries apply once all addresses have been tried.
+	// Note: setting ignored for listening connections.
+	FailoverAddresses []string
+
+	// FailbackInterval defines the interval, in milliseconds, at which a connection to a failover
+	// address checks if a higher priority address is reachable again so that the connection can
+	// fail back to it. Fail-back requires AutoReconnect. Set value to zero to disable fail-back.
+	// Note: setting ignored for listening connections.
+	FailbackInterval int32
+
 	// AutoRequestMetadata defines the flag that determines if metadata should be
 	// automatically requested upon successful connection. When true, metadata will
 	// be requested upon connection before subscription; otherwise, any metadata
@@ -77,6 +91,14 @@ type Config struct {
 	// RfcGuidEncoding determines if Guid wire serialization should use RFC encoding.
 	// This defaults to true.
 	RfcGuidEncoding bool
+
+	// TLSConfig defines the Transport Layer Security configuration used to secure the
+	// command channel. This defaults to nil, i.e., an unencrypted connection. When dialing,
+	// RootCAs pins the trusted certificate authorities, Certificates provides the client
+	// certificate for mutual TLS and ServerName overrides the host name used to verify
+	// the publisher certificate. When listening, Certificates must define the server
+	// certificate; set ClientCAs and ClientAuth to verify the publisher certificate.
+	TLSConfig *tls.Config
 }
 
 // configDefaults define the default values for an STTP connection Config.
@@ -85,6 +107,7 @@ var configDefaults = Config{
 	RetryInterval:            1000,
 	MaxRetryInterval:         30000,
 	AutoReconnect:            true,
+	FailbackInterval:         30000,
 	AutoRequestMetadata:      true,
 	AutoSubscribe:            true,
 	CompressPayloadData:      true,
@@ -25,46 +25,246 @@ package sttp
 
 import (
 	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
 
 	"github.com/sttp/goapi/sttp/transport"
 )
 
-// MeasurementReader defines an STTP measurement reader.
+// ErrReaderClosed is the error returned when reading from a MeasurementReader that has been closed.
+var ErrReaderClosed = errors.New("measurement reader is closed")
+
+// MeasurementReader defines an STTP measurement reader. Received measurements are copied into a
+// bounded ring buffer so that reception is decoupled from reading; when the buffer is full, the
+// configured OverflowPolicy determines how newly received measurements are handled.
 type MeasurementReader struct {
-	current chan *transport.Measurement
+	parent *Subscriber
+	policy OverflowPolicyEnum
+
+	buffer []transport.Measurement
+	head   int
+	count  int
+	closed bool
+	mutex  sync.Mutex
+
+	// Signals for reader and receiver waits, buffered so that signaling never blocks
+	available chan struct{}
+	space     chan struct{}
+	done      chan struct{}
+
+	overflowed    bool
+	dropped       uint64
+	highWaterMark int64
 }
 
-func newMeasurementReader(parent *Subscriber) *MeasurementReader {
+func newMeasurementReader(parent *Subscriber, config *ReaderConfig) *MeasurementReader {
+	if config == nil {
+		config = &readerConfigDefaults
+	}
+
+	capacity := config.Capacity
+
+	if capacity < 1 {
+		capacity = readerConfigDefaults.Capacity
+	}
+
 	reader := &MeasurementReader{
-		current: make(chan *transport.Measurement),
+		parent:    parent,
+		policy:    config.OverflowPolicy,
+		buffer:    make([]transport.Measurement, capacity),
+		available: make(chan struct{}, 1),
+		space:     make(chan struct{}, 1),
+		done:      make(chan struct{}),
 	}
 
 	parent.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
-		for _, m := range *measurements {
-			reader.current <- &m
-		}
+		reader.enqueue(*measurements)
 	})
 
 	return reader
 }
 
-// NextMeasurement blocks current thread until a new measurement arrives or provided context is completed.
-// Returns tuple of measurement and completed state. Completed state flag will be false if a measurement
-// was received; otherwise, state flag will be true along with a nil measurement when context is done.
-func (mr *MeasurementReader) NextMeasurement(ctx context.Context) (*transport.Measurement, bool) {
+// enqueue copies received measurements into the buffer, applying overflow policy as needed.
+func (mr *MeasurementReader) enqueue(measurements []transport.Measurement) {
+	mr.mutex.Lock()
+	defer mr.mutex.Unlock()
+
+	for len(measurements) > 0 && !mr.closed {
+		capacity := len(mr.buffer)
+
+		if mr.count == capacity {
+			switch mr.policy {
+			case OverflowPolicy.DropOldest:
+				// Overwrite oldest measurements by advancing head past them
+				overwrite := len(measurements)
+
+				if overwrite > capacity {
+					// Only the newest measurements that fit in the buffer are retained
+					atomic.AddUint64(&mr.dropped, uint64(overwrite-capacity))
+					measurements = measurements[overwrite-capacity:]
+					overwrite = capacity
+				}
+
+				atomic.AddUint64(&mr.dropped, uint64(overwrite))
+				mr.head = (mr.head + overwrite) % capacity
+				mr.count -= overwrite
+			case OverflowPolicy.Block:
+				mr.mutex.Unlock()
+
+				select {
+				case <-mr.space:
+				case <-mr.done:
+				}
+
+				mr.mutex.Lock()
+				continue
+			default:
+				atomic.AddUint64(&mr.dropped, uint64(len(measurements)))
+
+				if mr.policy == OverflowPolicy.Disconnect && !mr.overflowed {
+					mr.overflowed = true
+					mr.parent.ErrorMessage("Measurement reader buffer is full - disconnecting.")
+					mr.parent.Disconnect()
+				}
+
+				return
+			}
+		}
+
+		// Copy as many measurements as will fit, wrapping around end of ring buffer
+		length := min(capacity-mr.count, len(measurements))
+		tail := (mr.head + mr.count) % capacity
+		copied := copy(mr.buffer[tail:min(capacity, tail+length)], measurements[:length])
+		copy(mr.buffer, measurements[copied:length])
+
+		mr.count += length
+		measurements = measurements[length:]
+
+		if int64(mr.count) > atomic.LoadInt64(&mr.highWaterMark) {
+			atomic.StoreInt64(&mr.highWaterMark, int64(mr.count))
+		}
+
+		notify(mr.available)
+	}
+}
+
+// NextBatch blocks current thread until at least one measurement is available, then returns up to max
+// buffered measurements in order of reception. When max is less than one, all buffered measurements are
+// returned. The returned slice is newly allocated and is owned by the caller. An error is returned when
+// the provided context is completed or when the reader has been closed.
+func (mr *MeasurementReader) NextBatch(ctx context.Context, max int) ([]transport.Measurement, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	select {
-	case <-ctx.Done():
+	for {
+		mr.mutex.Lock()
+
+		if mr.count > 0 {
+			batch := mr.dequeue(max)
+			mr.mutex.Unlock()
+			return batch, nil
+		}
+
+		closed := mr.closed
+		mr.mutex.Unlock()
+
+		if closed {
+			return nil, ErrReaderClosed
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-mr.done:
+		case <-mr.available:
+		}
+	}
+}
+
+// dequeue removes up to max measurements from the buffer, mutex must be held.
+func (mr *MeasurementReader) dequeue(max int) []transport.Measurement {
+	length := mr.count
+
+	if max > 0 && max < length {
+		length = max
+	}
+
+	capacity := len(mr.buffer)
+	batch := make([]transport.Measurement, length)
+	copied := copy(batch, mr.buffer[mr.head:min(capacity, mr.head+length)])
+	copy(batch[copied:], mr.buffer[:length-copied])
+
+	mr.head = (mr.head + length) % capacity
+	mr.count -= length
+
+	// Wake other readers when measurements remain, and a blocked receiver now that there is space
+	if mr.count > 0 {
+		notify(mr.available)
+	}
+
+	notify(mr.space)
+
+	return batch
+}
+
+// NextMeasurement blocks current thread until a new measurement arrives or provided context is completed.
+// Returns tuple of measurement and completed state. Completed state flag will be false if a measurement
+// was received; otherwise, state flag will be true along with a nil measurement when context is done or
+// the reader has been closed. The returned measurement is a copy that is owned by the caller.
+func (mr *MeasurementReader) NextMeasurement(ctx context.Context) (*transport.Measurement, bool) {
+	batch, err := mr.NextBatch(ctx, 1)
+
+	if err != nil {
 		return nil, true
-	case measurement := <-mr.current:
-		return measurement, false
 	}
+
+	return &batch[0], false
+}
+
+// Len gets the number of measurements currently buffered by the reader.
+func (mr *MeasurementReader) Len() int {
+	mr.mutex.Lock()
+	defer mr.mutex.Unlock()
+
+	return mr.count
+}
+
+// Capacity gets the maximum number of measurements that can be buffered by the reader.
+func (mr *MeasurementReader) Capacity() int {
+	return len(mr.buffer)
+}
+
+// DroppedMeasurements gets the total number of received measurements that were discarded
+// because the buffer was full.
+func (mr *MeasurementReader) DroppedMeasurements() uint64 {
+	return atomic.LoadUint64(&mr.dropped)
 }
 
-// Close closes the measurement reader channel.
+// HighWaterMark gets the largest number of measurements that have been buffered by the reader
+// at one time.
+func (mr *MeasurementReader) HighWaterMark() int {
+	return int(atomic.LoadInt64(&mr.highWaterMark))
+}
+
+// Close closes the measurement reader. Any blocked reads or receptions are released and
+// measurements received after closing are ignored.
 func (mr *MeasurementReader) Close() {
-	close(mr.current)
+	mr.mutex.Lock()
+	defer mr.mutex.Unlock()
+
+	if mr.closed {
+		return
+	}
+
+	mr.closed = true
+	close(mr.done)
+}
+
+func notify(channel chan struct{}) {
+	select {
+	case channel <- struct{}{}:
+	default:
+	}
 }
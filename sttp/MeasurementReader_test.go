@@ -0,0 +1,209 @@
+//******************************************************************************************************
+//  MeasurementReader_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func newTestMeasurements(start, count int) []transport.Measurement {
+	measurements := make([]transport.Measurement, count)
+
+	for i := range measurements {
+		measurements[i] = transport.Measurement{Value: float64(start + i), Timestamp: ticks.Ticks(start + i)}
+	}
+
+	return measurements
+}
+
+func expectValues(t *testing.T, test string, measurements []transport.Measurement, start, count int) {
+	if len(measurements) != count {
+		t.Fatalf("%s: expected %d measurements, received: %d", test, count, len(measurements))
+	}
+
+	for i, measurement := range measurements {
+		if measurement.Value != float64(start+i) {
+			t.Fatalf("%s: expected measurement %d value %d, received: %f", test, i, start+i, measurement.Value)
+		}
+	}
+}
+
+func TestMeasurementReaderBatches(t *testing.T) {
+	subscriber, _ := newTestSubscriber()
+	defer subscriber.Close()
+
+	reader := subscriber.ReadMeasurementsWithConfig(&ReaderConfig{Capacity: 8})
+	defer reader.Close()
+
+	// Wrap ring buffer around its end
+	reader.enqueue(newTestMeasurements(0, 6))
+	batch, _ := reader.NextBatch(context.Background(), 4)
+	expectValues(t, "TestMeasurementReaderBatches", batch, 0, 4)
+
+	reader.enqueue(newTestMeasurements(6, 5))
+
+	if reader.Len() != 7 {
+		t.Fatalf("TestMeasurementReaderBatches: expected 7 buffered measurements, received: %d", reader.Len())
+	}
+
+	batch, _ = reader.NextBatch(context.Background(), 0)
+	expectValues(t, "TestMeasurementReaderBatches", batch, 4, 7)
+
+	if reader.HighWaterMark() != 7 {
+		t.Fatalf("TestMeasurementReaderBatches: expected high-water mark of 7, received: %d", reader.HighWaterMark())
+	}
+
+	// Returned batches are stable copies
+	reader.enqueue(newTestMeasurements(11, 1))
+	measurement, completed := reader.NextMeasurement(context.Background())
+
+	if completed || measurement.Value != 11 || batch[0].Value != 4 {
+		t.Fatalf("TestMeasurementReaderBatches: unexpected measurement after batch read")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := reader.NextBatch(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("TestMeasurementReaderBatches: expected deadline exceeded from empty reader, received: %v", err)
+	}
+}
+
+func TestMeasurementReaderDropPolicies(t *testing.T) {
+	subscriber, _ := newTestSubscriber()
+	defer subscriber.Close()
+
+	tests := []struct {
+		policy  OverflowPolicyEnum
+		start   int
+		dropped uint64
+	}{
+		{OverflowPolicy.DropOldest, 6, 6},
+		{OverflowPolicy.DropNewest, 0, 6},
+		{OverflowPolicy.Disconnect, 0, 6},
+	}
+
+	for _, test := range tests {
+		reader := subscriber.ReadMeasurementsWithConfig(&ReaderConfig{Capacity: 4, OverflowPolicy: test.policy})
+
+		reader.enqueue(newTestMeasurements(0, 3))
+		reader.enqueue(newTestMeasurements(3, 3))
+		reader.enqueue(newTestMeasurements(6, 4))
+
+		batch, _ := reader.NextBatch(context.Background(), 0)
+		expectValues(t, "TestMeasurementReaderDropPolicies: "+test.policy.String(), batch, test.start, 4)
+
+		if reader.DroppedMeasurements() != test.dropped {
+			t.Fatalf("TestMeasurementReaderDropPolicies: %s: expected %d dropped measurements, received: %d", test.policy.String(), test.dropped, reader.DroppedMeasurements())
+		}
+
+		if reader.HighWaterMark() != 4 {
+			t.Fatalf("TestMeasurementReaderDropPolicies: %s: expected high-water mark of 4, received: %d", test.policy.String(), reader.HighWaterMark())
+		}
+
+		reader.Close()
+	}
+}
+
+func TestMeasurementReaderBlockPolicy(t *testing.T) {
+	subscriber, _ := newTestSubscriber()
+	defer subscriber.Close()
+
+	reader := subscriber.ReadMeasurementsWithConfig(&ReaderConfig{Capacity: 4, OverflowPolicy: OverflowPolicy.Block})
+	defer reader.Close()
+
+	enqueued := make(chan struct{})
+
+	go func() {
+		reader.enqueue(newTestMeasurements(0, 10))
+		close(enqueued)
+	}()
+
+	var received []transport.Measurement
+
+	for len(received) < 10 {
+		batch, err := reader.NextBatch(context.Background(), 3)
+
+		if err != nil {
+			t.Fatalf("TestMeasurementReaderBlockPolicy: unexpected error: %s", err.Error())
+		}
+
+		received = append(received, batch...)
+	}
+
+	<-enqueued
+
+	expectValues(t, "TestMeasurementReaderBlockPolicy", received, 0, 10)
+
+	if reader.DroppedMeasurements() != 0 {
+		t.Fatalf("TestMeasurementReaderBlockPolicy: expected no dropped measurements, received: %d", reader.DroppedMeasurements())
+	}
+}
+
+func TestMeasurementReaderClose(t *testing.T) {
+	subscriber, _ := newTestSubscriber()
+	defer subscriber.Close()
+
+	reader := subscriber.ReadMeasurementsWithConfig(&ReaderConfig{Capacity: 2, OverflowPolicy: OverflowPolicy.Block})
+	reader.enqueue(newTestMeasurements(0, 2))
+
+	// Blocked receptions are released on close
+	enqueued := make(chan struct{})
+
+	go func() {
+		reader.enqueue(newTestMeasurements(2, 1))
+		close(enqueued)
+	}()
+
+	reader.Close()
+
+	select {
+	case <-enqueued:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestMeasurementReaderClose: blocked reception was not released by close")
+	}
+
+	// Buffered measurements remain readable after close
+	batch, err := reader.NextBatch(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("TestMeasurementReaderClose: unexpected error: %s", err.Error())
+	}
+
+	expectValues(t, "TestMeasurementReaderClose", batch, 0, 2)
+
+	if _, err = reader.NextBatch(context.Background(), 0); !errors.Is(err, ErrReaderClosed) {
+		t.Fatalf("TestMeasurementReaderClose: expected ErrReaderClosed, received: %v", err)
+	}
+
+	if _, completed := reader.NextMeasurement(nil); !completed {
+		t.Fatalf("TestMeasurementReaderClose: expected completed state from closed reader")
+	}
+}
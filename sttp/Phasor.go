@@ -0,0 +1,113 @@
+//******************************************************************************************************
+//  Phasor.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"math"
+	"math/cmplx"
+	"strconv"
+
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// AngleUnitsEnum defines the type of the AngleUnits enumeration.
+type AngleUnitsEnum int
+
+// AngleUnits is an enumeration of the possible units of received phasor angle measurements.
+var AngleUnits = struct {
+	// Degrees defines that angles are measured in degrees.
+	Degrees AngleUnitsEnum
+	// Radians defines that angles are measured in radians.
+	Radians AngleUnitsEnum
+}{
+	Degrees: 0,
+	Radians: 1,
+}
+
+// String gets the AngleUnits enumeration value as a string.
+func (aue AngleUnitsEnum) String() string {
+	switch aue {
+	case AngleUnits.Degrees:
+		return "Degrees"
+	case AngleUnits.Radians:
+		return "Radians"
+	default:
+		return "0x" + strconv.FormatInt(int64(aue), 16)
+	}
+}
+
+// PhasorKey identifies a phasor by its device and one-based phasor index within the device, as
+// parsed from the signal reference of its magnitude and angle measurements, e.g., SHELBY-PM1.
+type PhasorKey struct {
+	// Device is the acronym of the device that defines the phasor.
+	Device string
+
+	// Index is the one-based index of the phasor within its device.
+	Index int
+}
+
+// String gets the PhasorKey formatted as a signal reference style string, e.g., SHELBY-PH1.
+func (pk PhasorKey) String() string {
+	return pk.Device + "-PH" + strconv.Itoa(pk.Index)
+}
+
+// Phasor represents a phasor value joined from its magnitude and angle measurements.
+type Phasor struct {
+	// Key identifies the phasor.
+	Key PhasorKey
+
+	// Label is the phasor label from the PhasorDetail metadata, if defined.
+	Label string
+
+	// Type is the phasor type from the PhasorDetail metadata, V for voltage or I for current, if defined.
+	Type string
+
+	// Magnitude is the phasor magnitude with Adder and Multiplier adjustments applied.
+	Magnitude float64
+
+	// Angle is the phasor angle, in radians, with Adder and Multiplier adjustments applied.
+	Angle float64
+
+	// Timestamp is the timestamp of the phasor.
+	Timestamp ticks.Ticks
+
+	// Flags are the combined state flags of the magnitude and angle measurements.
+	Flags transport.StateFlagsEnum
+}
+
+// Complex gets the phasor value as a complex number in rectangular form.
+func (p *Phasor) Complex() complex128 {
+	return cmplx.Rect(p.Magnitude, p.Angle)
+}
+
+// SetComplex sets the phasor magnitude and angle from a complex number in rectangular form.
+func (p *Phasor) SetComplex(value complex128) {
+	p.Magnitude, p.Angle = cmplx.Polar(value)
+}
+
+// AngleDegrees gets the phasor angle in degrees.
+func (p *Phasor) AngleDegrees() float64 {
+	return p.Angle * 180.0 / math.Pi
+}
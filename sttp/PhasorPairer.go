@@ -0,0 +1,260 @@
+//******************************************************************************************************
+//  PhasorPairer.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/metadata"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// PhasorFrame represents the phasors paired from a Frame of measurements.
+type PhasorFrame struct {
+	// Timestamp is the frame timestamp.
+	Timestamp ticks.Ticks
+
+	// Phasors are the phasors with both a magnitude and an angle in the frame, sorted by key.
+	Phasors []Phasor
+
+	// MissingMagnitudes are the keys of the phasors with an angle but no magnitude in the frame.
+	MissingMagnitudes []PhasorKey
+
+	// MissingAngles are the keys of the phasors with a magnitude but no angle in the frame.
+	MissingAngles []PhasorKey
+}
+
+// PhasorPairer joins phasor magnitude and angle measurements into phasor values. Components are
+// identified by their signal reference, see transport.MeasurementMetadata.ParseSignalReference,
+// where the magnitude and angle of a phasor share a device and phasor index, e.g., SHELBY-PM1
+// and SHELBY-PA1.
+type PhasorPairer struct {
+	angleUnits AngleUnitsEnum
+	components map[guid.Guid]phasorComponent
+	details    map[PhasorKey]*metadata.Phasor
+	mutex      sync.RWMutex
+}
+
+type phasorComponent struct {
+	key        PhasorKey
+	kind       transport.SignalKindEnum
+	adder      float64
+	multiplier float64
+}
+
+type phasorHalves struct {
+	magnitude *transport.Measurement
+	angle     *transport.Measurement
+	adjusted  [2]float64
+}
+
+// NewPhasorPairer creates a new PhasorPairer for angle measurements in the specified units.
+func NewPhasorPairer(angleUnits AngleUnitsEnum) *PhasorPairer {
+	return &PhasorPairer{
+		angleUnits: angleUnits,
+		components: make(map[guid.Guid]phasorComponent),
+		details:    make(map[PhasorKey]*metadata.Phasor),
+	}
+}
+
+// DefineMeasurements defines the phasor components from the provided measurement metadata. Records
+// with a signal reference that is not a phasor magnitude or angle are ignored. Adder and Multiplier
+// of each record are applied to the component values when pairing.
+func (pp *PhasorPairer) DefineMeasurements(records []*transport.MeasurementMetadata) {
+	pp.mutex.Lock()
+	defer pp.mutex.Unlock()
+
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+
+		device, kind, index := record.ParseSignalReference()
+
+		if kind != transport.SignalKind.Magnitude && kind != transport.SignalKind.Angle || index < 1 {
+			continue
+		}
+
+		multiplier := record.Multiplier
+
+		// Zero multiplier indicates metadata without linear adjustments defined
+		if multiplier == 0.0 {
+			multiplier = 1.0
+		}
+
+		pp.components[record.SignalID] = phasorComponent{
+			key:        PhasorKey{Device: strings.ToUpper(device), Index: index},
+			kind:       kind,
+			adder:      record.Adder,
+			multiplier: multiplier,
+		}
+	}
+}
+
+// DefinePhasorDetail defines the phasor labels and types from the PhasorDetail metadata of the
+// provided snapshot. Phasors are matched by device acronym and source index.
+func (pp *PhasorPairer) DefinePhasorDetail(snapshot *metadata.Snapshot) {
+	if snapshot == nil {
+		return
+	}
+
+	pp.mutex.Lock()
+	defer pp.mutex.Unlock()
+
+	for _, phasor := range snapshot.Phasors() {
+		pp.details[PhasorKey{Device: strings.ToUpper(phasor.DeviceAcronym), Index: phasor.SourceIndex}] = phasor
+	}
+}
+
+// DefineSubscriberMetadata defines the phasor components and phasor details from the metadata
+// most recently received by the specified Subscriber, see Subscriber.MetadataSnapshot.
+func (pp *PhasorPairer) DefineSubscriberMetadata(subscriber *Subscriber) {
+	snapshot := subscriber.MetadataSnapshot()
+
+	if snapshot == nil {
+		return
+	}
+
+	measurements := snapshot.Measurements()
+	records := make([]*transport.MeasurementMetadata, len(measurements))
+
+	for i, measurement := range measurements {
+		records[i] = subscriber.LookupMetadata(measurement.SignalID)
+	}
+
+	pp.DefineMeasurements(records)
+	pp.DefinePhasorDetail(snapshot)
+}
+
+// PhasorCount gets the number of phasors with a defined magnitude or angle component.
+func (pp *PhasorPairer) PhasorCount() int {
+	pp.mutex.RLock()
+	defer pp.mutex.RUnlock()
+
+	keys := make(map[PhasorKey]struct{})
+
+	for _, component := range pp.components {
+		keys[component.key] = struct{}{}
+	}
+
+	return len(keys)
+}
+
+// PairFrame joins the phasor magnitude and angle measurements of a Frame into phasors.
+// Phasors for which only one half is in the frame are reported as missing the other half.
+func (pp *PhasorPairer) PairFrame(frame *Frame) *PhasorFrame {
+	pp.mutex.RLock()
+	defer pp.mutex.RUnlock()
+
+	phasorFrame := &PhasorFrame{Timestamp: frame.Timestamp}
+	halves := make(map[PhasorKey]*phasorHalves)
+
+	for signalID, measurement := range frame.Measurements {
+		component, found := pp.components[signalID]
+
+		if !found {
+			continue
+		}
+
+		half, found := halves[component.key]
+
+		if !found {
+			half = &phasorHalves{}
+			halves[component.key] = half
+		}
+
+		value := measurement.Value*component.multiplier + component.adder
+
+		if component.kind == transport.SignalKind.Magnitude {
+			half.magnitude = &measurement
+			half.adjusted[0] = value
+		} else {
+			half.angle = &measurement
+			half.adjusted[1] = value
+		}
+	}
+
+	for key, half := range halves {
+		switch {
+		case half.magnitude == nil:
+			phasorFrame.MissingMagnitudes = append(phasorFrame.MissingMagnitudes, key)
+		case half.angle == nil:
+			phasorFrame.MissingAngles = append(phasorFrame.MissingAngles, key)
+		default:
+			phasorFrame.Phasors = append(phasorFrame.Phasors, pp.phasor(key, half, frame.Timestamp))
+		}
+	}
+
+	sort.Slice(phasorFrame.Phasors, func(i, j int) bool {
+		return lessPhasorKey(phasorFrame.Phasors[i].Key, phasorFrame.Phasors[j].Key)
+	})
+
+	sortPhasorKeys(phasorFrame.MissingMagnitudes)
+	sortPhasorKeys(phasorFrame.MissingAngles)
+
+	return phasorFrame
+}
+
+// phasor creates a phasor from its halves, mutex must be held.
+func (pp *PhasorPairer) phasor(key PhasorKey, half *phasorHalves, timestamp ticks.Ticks) Phasor {
+	angle := half.adjusted[1]
+
+	if pp.angleUnits == AngleUnits.Degrees {
+		angle = angle * math.Pi / 180.0
+	}
+
+	phasor := Phasor{
+		Key:       key,
+		Magnitude: half.adjusted[0],
+		Angle:     angle,
+		Timestamp: timestamp,
+		Flags:     half.magnitude.Flags | half.angle.Flags,
+	}
+
+	if detail, found := pp.details[key]; found {
+		phasor.Label = detail.Label
+		phasor.Type = detail.Type
+	}
+
+	return phasor
+}
+
+func lessPhasorKey(left PhasorKey, right PhasorKey) bool {
+	if left.Device != right.Device {
+		return left.Device < right.Device
+	}
+
+	return left.Index < right.Index
+}
+
+func sortPhasorKeys(keys []PhasorKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		return lessPhasorKey(keys[i], keys[j])
+	})
+}
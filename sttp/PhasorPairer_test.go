@@ -0,0 +1,176 @@
+//******************************************************************************************************
+//  PhasorPairer_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"math"
+	"math/cmplx"
+	"os"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/metadata"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func newTestPhasorPairer(t *testing.T, angleUnits AngleUnitsEnum) (*PhasorPairer, map[string]guid.Guid) {
+	buffer, err := os.ReadFile("../test/SampleMetadata.xml")
+
+	if err != nil {
+		t.Fatalf("Failed to load sample metadata: %s", err.Error())
+	}
+
+	dataSet := data.NewDataSet()
+
+	if err = dataSet.ParseXml(buffer); err != nil {
+		t.Fatalf("Failed to parse sample metadata: %s", err.Error())
+	}
+
+	snapshot := metadata.NewSnapshot(dataSet)
+	signalIDs := make(map[string]guid.Guid)
+	var records []*transport.MeasurementMetadata
+
+	for _, measurement := range snapshot.Measurements() {
+		record := &transport.MeasurementMetadata{
+			SignalID:        measurement.SignalID,
+			SignalReference: measurement.SignalReference,
+			Multiplier:      1.0,
+		}
+
+		// Apply a linear adjustment to the first magnitude
+		if measurement.SignalReference == "SHELBY-PM1" {
+			record.Multiplier = 2.0
+			record.Adder = 10.0
+		}
+
+		records = append(records, record)
+		signalIDs[measurement.SignalReference] = measurement.SignalID
+	}
+
+	pairer := NewPhasorPairer(angleUnits)
+	pairer.DefineMeasurements(records)
+	pairer.DefinePhasorDetail(snapshot)
+
+	return pairer, signalIDs
+}
+
+func TestPhasorPairerFrame(t *testing.T) {
+	pairer, signalIDs := newTestPhasorPairer(t, AngleUnits.Degrees)
+
+	if count := pairer.PhasorCount(); count != 5 {
+		t.Fatalf("TestPhasorPairerFrame: expected 5 phasors, received %d", count)
+	}
+
+	timestamp := testBaseTime()
+
+	frame := &Frame{
+		Timestamp: timestamp,
+		Measurements: map[guid.Guid]transport.Measurement{
+			signalIDs["SHELBY-PM1"]: {SignalID: signalIDs["SHELBY-PM1"], Value: 100.0, Timestamp: timestamp},
+			signalIDs["SHELBY-PA1"]: {SignalID: signalIDs["SHELBY-PA1"], Value: 90.0, Timestamp: timestamp, Flags: transport.StateFlags.SuspectData},
+			signalIDs["SHELBY-PM2"]: {SignalID: signalIDs["SHELBY-PM2"], Value: 50.0, Timestamp: timestamp},
+			signalIDs["SHELBY-PA3"]: {SignalID: signalIDs["SHELBY-PA3"], Value: 45.0, Timestamp: timestamp},
+			signalIDs["SHELBY-FQ"]:  {SignalID: signalIDs["SHELBY-FQ"], Value: 60.0, Timestamp: timestamp},
+		},
+	}
+
+	phasorFrame := pairer.PairFrame(frame)
+
+	if phasorFrame.Timestamp != timestamp || len(phasorFrame.Phasors) != 1 {
+		t.Fatalf("TestPhasorPairerFrame: expected 1 phasor, received %d", len(phasorFrame.Phasors))
+	}
+
+	phasor := phasorFrame.Phasors[0]
+
+	if phasor.Key != (PhasorKey{Device: "SHELBY", Index: 1}) || phasor.Key.String() != "SHELBY-PH1" {
+		t.Fatalf("TestPhasorPairerFrame: unexpected phasor key: %s", phasor.Key)
+	}
+
+	if phasor.Label != "500 kV Bus 1" || phasor.Type != "V" {
+		t.Fatalf("TestPhasorPairerFrame: unexpected phasor detail: %s, %s", phasor.Label, phasor.Type)
+	}
+
+	if phasor.Magnitude != 210.0 || math.Abs(phasor.Angle-math.Pi/2) > 1e-12 || math.Abs(phasor.AngleDegrees()-90.0) > 1e-9 {
+		t.Fatalf("TestPhasorPairerFrame: unexpected phasor value: %f, %f", phasor.Magnitude, phasor.Angle)
+	}
+
+	if phasor.Flags != transport.StateFlags.SuspectData || phasor.Timestamp != timestamp {
+		t.Fatalf("TestPhasorPairerFrame: unexpected phasor flags or timestamp")
+	}
+
+	if len(phasorFrame.MissingAngles) != 1 || phasorFrame.MissingAngles[0].Index != 2 {
+		t.Fatalf("TestPhasorPairerFrame: expected missing angle for phasor 2, received %v", phasorFrame.MissingAngles)
+	}
+
+	if len(phasorFrame.MissingMagnitudes) != 1 || phasorFrame.MissingMagnitudes[0].Index != 3 {
+		t.Fatalf("TestPhasorPairerFrame: expected missing magnitude for phasor 3, received %v", phasorFrame.MissingMagnitudes)
+	}
+}
+
+func TestPhasorPairerRadians(t *testing.T) {
+	pairer, signalIDs := newTestPhasorPairer(t, AngleUnits.Radians)
+
+	phasorFrame := pairer.PairFrame(&Frame{
+		Measurements: map[guid.Guid]transport.Measurement{
+			signalIDs["SHELBY-PM4"]: {SignalID: signalIDs["SHELBY-PM4"], Value: 2.0},
+			signalIDs["SHELBY-PA4"]: {SignalID: signalIDs["SHELBY-PA4"], Value: math.Pi},
+		},
+	})
+
+	if len(phasorFrame.Phasors) != 1 || phasorFrame.Phasors[0].Type != "I" {
+		t.Fatalf("TestPhasorPairerRadians: expected 1 current phasor, received %v", phasorFrame.Phasors)
+	}
+
+	if value := phasorFrame.Phasors[0].Complex(); cmplx.Abs(value-complex(-2.0, 0.0)) > 1e-12 {
+		t.Fatalf("TestPhasorPairerRadians: unexpected complex value: %v", value)
+	}
+}
+
+func TestPhasorComplex(t *testing.T) {
+	var phasor Phasor
+	phasor.SetComplex(complex(0.0, 3.0))
+
+	if phasor.Magnitude != 3.0 || phasor.AngleDegrees() != 90.0 {
+		t.Fatalf("TestPhasorComplex: unexpected polar value: %f, %f", phasor.Magnitude, phasor.AngleDegrees())
+	}
+
+	if value := phasor.Complex(); cmplx.Abs(value-complex(0.0, 3.0)) > 1e-12 {
+		t.Fatalf("TestPhasorComplex: unexpected complex value: %v", value)
+	}
+}
+
+func TestPhasorPairerUnformattedSignalReference(t *testing.T) {
+	pairer := NewPhasorPairer(AngleUnits.Degrees)
+
+	pairer.DefineMeasurements([]*transport.MeasurementMetadata{
+		{SignalID: guid.New(), SignalReference: "SHELBY"},
+		{SignalID: guid.New(), SignalReference: "SHELBY-P"},
+		{SignalID: guid.New(), SignalReference: "SHELBY-PA"},
+	})
+
+	if count := pairer.PhasorCount(); count != 0 {
+		t.Fatalf("TestPhasorPairerUnformattedSignalReference: expected no phasors, received %d", count)
+	}
+}
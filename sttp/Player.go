@@ -0,0 +1,200 @@
+//******************************************************************************************************
+//  Player.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp/recording"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Player replays an STTP recording through the callbacks of a Subscriber, so that code written
+// against the Subscriber API can be used offline with recorded data. The Subscriber does not need
+// to be connected: recorded metadata is delivered to the metadata receiver, recorded signal index
+// caches to the subscription updated receiver and recorded measurements to the new measurements
+// receiver. The data start time receiver is called before the first measurements are delivered
+// and the historical read complete receiver is called at the end of the recording.
+type Player struct {
+	subscriber *Subscriber
+	reader     *recording.Reader
+	speed      float64
+	mutex      sync.Mutex
+}
+
+// NewPlayer creates a new Player that replays the recording read by reader through the subscriber.
+// Playback speed defaults to real-time.
+func NewPlayer(subscriber *Subscriber, reader *recording.Reader) *Player {
+	return &Player{
+		subscriber: subscriber,
+		reader:     reader,
+		speed:      1.0,
+	}
+}
+
+// Speed gets the playback speed of the Player.
+func (pl *Player) Speed() float64 {
+	pl.mutex.Lock()
+	defer pl.mutex.Unlock()
+
+	return pl.speed
+}
+
+// SetSpeed defines the playback speed of the Player as a multiple of real-time, e.g., 1.0 replays
+// records with their recorded timing and 2.0 replays them twice as fast. A speed of zero, or less,
+// replays records as fast as possible. Speed can be changed while playing.
+func (pl *Player) SetSpeed(speed float64) {
+	pl.mutex.Lock()
+	defer pl.mutex.Unlock()
+
+	pl.speed = speed
+}
+
+// Play replays the recording from the current reader position until the end of the recording or
+// until ctx is canceled, in which case the context error is returned. Use SeekTime on the reader
+// before calling Play to start playback from a specific time.
+func (pl *Player) Play(ctx context.Context) error {
+	sb := pl.subscriber
+	ds := sb.dataSubscriber()
+	started := false
+
+	// Playback timing is relative to the first record, recalculated when speed changes
+	var baseTime ticks.Ticks
+	var baseWallTime time.Time
+	var baseSpeed float64
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		record, err := pl.reader.Next()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if speed := pl.Speed(); speed > 0 {
+			if baseWallTime.IsZero() || speed != baseSpeed {
+				baseTime, baseWallTime, baseSpeed = record.Time, time.Now(), speed
+			}
+
+			delay := time.Duration(float64(record.Time-baseTime)*100/speed) - time.Since(baseWallTime)
+
+			if err := sleep(ctx, delay); err != nil {
+				return err
+			}
+		} else {
+			baseWallTime = time.Time{}
+		}
+
+		switch record.Type {
+		case recording.RecordType.Metadata:
+			sb.loadMetadata(bytes.NewReader(record.Metadata))
+		case recording.RecordType.SignalIndexCache:
+			pl.handleSignalIndexCache(record.SignalIndexCache)
+		case recording.RecordType.Measurements:
+			if !started && len(record.Measurements) > 0 {
+				started = true
+				sb.handleDataStartTime(record.Measurements[0].Timestamp)
+			}
+
+			pl.handleMeasurements(ds, record.Measurements)
+		}
+	}
+
+	sb.handleProcessingComplete("Playback of recording complete")
+	return nil
+}
+
+func (pl *Player) handleSignalIndexCache(signalIndexCache *transport.SignalIndexCache) {
+	ds := pl.subscriber.dataSubscriber()
+
+	// Register measurement metadata, as a received signal index cache would
+	for _, signalIndex := range signalIndexCache.SignalIndexes() {
+		signalID, source, id, _ := signalIndexCache.Record(signalIndex)
+		metadata := ds.LookupMetadata(signalID)
+
+		if len(metadata.Source) == 0 {
+			metadata.Source = source
+			metadata.ID = id
+		}
+	}
+
+	ds.BeginCallbackSync()
+
+	if ds.SubscriptionUpdatedCallback != nil {
+		ds.SubscriptionUpdatedCallback(signalIndexCache)
+	}
+
+	ds.EndCallbackSync()
+}
+
+func (pl *Player) handleMeasurements(ds *transport.DataSubscriber, measurements []transport.Measurement) {
+	ds.BeginCallbackSync()
+	defer ds.EndCallbackSync()
+
+	if ds.NewMeasurementsCallback == nil {
+		return
+	}
+
+	// Deliver measurements in a pooled slice, as received measurements would be
+	var buffer *[]transport.Measurement
+
+	if pooled := ds.MeasurementPool.Get(); pooled != nil {
+		buffer = pooled.(*[]transport.Measurement)
+	} else {
+		buffer = new([]transport.Measurement)
+	}
+
+	*buffer = append((*buffer)[:0], measurements...)
+	ds.NewMeasurementsCallback(buffer)
+}
+
+// sleep waits for the specified duration or until ctx is canceled.
+func sleep(ctx context.Context, duration time.Duration) error {
+	if duration <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,199 @@
+//******************************************************************************************************
+//  Player_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/recording"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func newTestPlayer(t *testing.T, data []byte) (*Player, *Subscriber) {
+	reader, err := recording.NewReader(bytes.NewReader(data))
+
+	if err != nil {
+		t.Fatalf("newTestPlayer: failed to read recording: %s", err.Error())
+	}
+
+	subscriber, _ := newTestSubscriber()
+	return NewPlayer(subscriber, reader), subscriber
+}
+
+func TestPlayerReplay(t *testing.T) {
+	recorded, signalIDs := recordTestData(t, 10)
+	player, subscriber := newTestPlayer(t, recorded)
+	defer subscriber.Close()
+
+	var metadataLoaded, completed bool
+	var startTime time.Time
+	var signalIndexCache *transport.SignalIndexCache
+	var measurements []transport.Measurement
+
+	subscriber.SetMetadataReceiver(func(*data.DataSet) {
+		metadataLoaded = true
+	})
+
+	subscriber.SetSubscriptionUpdatedReceiver(func(cache *transport.SignalIndexCache) {
+		signalIndexCache = cache
+	})
+
+	subscriber.SetDataStartTimeReceiver(func(time time.Time) {
+		startTime = time
+	})
+
+	subscriber.SetNewMeasurementsReceiver(func(received *[]transport.Measurement) {
+		measurements = append(measurements, *received...)
+		subscriber.PutMeasurementSlice(received)
+	})
+
+	subscriber.SetHistoricalReadCompleteReceiver(func() {
+		completed = true
+	})
+
+	player.SetSpeed(0)
+
+	if err := player.Play(context.Background()); err != nil {
+		t.Fatalf("TestPlayerReplay: playback failed: %s", err.Error())
+	}
+
+	if !metadataLoaded || subscriber.MetadataSnapshot() == nil || subscriber.MetadataSnapshot().MeasurementCount() == 0 {
+		t.Fatalf("TestPlayerReplay: expected recorded metadata to be loaded")
+	}
+
+	if signalIndexCache == nil || signalIndexCache.Count() != uint32(len(signalIDs)) {
+		t.Fatalf("TestPlayerReplay: expected recorded signal index cache to be delivered")
+	}
+
+	if metadata := subscriber.LookupMetadata(signalIDs[1]); metadata.Source != "PPA" || metadata.ID != 2 {
+		t.Fatalf("TestPlayerReplay: expected measurement metadata to be registered from signal index cache")
+	}
+
+	if len(measurements) != 20 {
+		t.Fatalf("TestPlayerReplay: expected 20 measurements, received %d", len(measurements))
+	}
+
+	if !startTime.Equal(measurements[0].DateTime()) {
+		t.Fatalf("TestPlayerReplay: unexpected data start time %s", startTime)
+	}
+
+	for i, measurement := range measurements {
+		if measurement.SignalID != signalIDs[i%2] || measurement.Value != float64(i/2) {
+			t.Fatalf("TestPlayerReplay: unexpected measurement %d: %s", i, measurement.String())
+		}
+	}
+
+	if !completed {
+		t.Fatalf("TestPlayerReplay: expected historical read complete notification at end of recording")
+	}
+}
+
+// writePacedRecording writes a recording of measurement batches received at the specified interval
+func writePacedRecording(t *testing.T, batches int, interval ticks.Ticks) []byte {
+	var buffer bytes.Buffer
+	writer, err := recording.NewWriter(&buffer)
+
+	if err != nil {
+		t.Fatalf("writePacedRecording: failed to create recording writer: %s", err.Error())
+	}
+
+	signalID := guid.New()
+	baseTime := testBaseTime()
+
+	for i := 0; i < batches; i++ {
+		time := baseTime + ticks.Ticks(i)*interval
+
+		if err := writer.WriteMeasurements(time, []transport.Measurement{{SignalID: signalID, Timestamp: time, Value: float64(i)}}); err != nil {
+			t.Fatalf("writePacedRecording: failed to write measurements: %s", err.Error())
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("writePacedRecording: failed to close recording writer: %s", err.Error())
+	}
+
+	return buffer.Bytes()
+}
+
+func TestPlayerSpeed(t *testing.T) {
+	// Five batches over 400ms of recorded time
+	player, subscriber := newTestPlayer(t, writePacedRecording(t, 5, 100*ticks.PerMillisecond))
+	defer subscriber.Close()
+
+	player.SetSpeed(4.0)
+	started := time.Now()
+
+	if err := player.Play(context.Background()); err != nil {
+		t.Fatalf("TestPlayerSpeed: playback failed: %s", err.Error())
+	}
+
+	if elapsed := time.Since(started); elapsed < 90*time.Millisecond || elapsed > time.Second {
+		t.Fatalf("TestPlayerSpeed: expected playback at 4x speed to take about 100ms, took %s", elapsed)
+	}
+}
+
+func TestPlayerCanceled(t *testing.T) {
+	player, subscriber := newTestPlayer(t, writePacedRecording(t, 2, ticks.PerMinute))
+	defer subscriber.Close()
+
+	received := make(chan struct{}, 2)
+
+	subscriber.SetNewMeasurementsReceiver(func(*[]transport.Measurement) {
+		received <- struct{}{}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	result := make(chan error, 1)
+
+	go func() {
+		result <- player.Play(ctx)
+	}()
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestPlayerCanceled: timed out waiting for first measurements")
+	}
+
+	cancel()
+
+	select {
+	case err := <-result:
+		if err != context.Canceled {
+			t.Fatalf("TestPlayerCanceled: expected context.Canceled, received %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestPlayerCanceled: timed out waiting for playback to stop")
+	}
+
+	if len(received) != 0 {
+		t.Fatalf("TestPlayerCanceled: expected second measurements to not be delivered")
+	}
+}
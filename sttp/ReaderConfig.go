@@ -0,0 +1,86 @@
+//******************************************************************************************************
+//  ReaderConfig.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import "strconv"
+
+// OverflowPolicyEnum defines the type of the OverflowPolicy enumeration.
+type OverflowPolicyEnum int
+
+// OverflowPolicy is an enumeration of the possible ways a MeasurementReader handles
+// received measurements when its buffer is full.
+var OverflowPolicy = struct {
+	// Block defines that reception waits for the reader to make room in the buffer. Note that
+	// this stalls reception for the whole connection until measurements are read.
+	Block OverflowPolicyEnum
+	// DropOldest defines that the oldest buffered measurements are discarded to make room.
+	DropOldest OverflowPolicyEnum
+	// DropNewest defines that newly received measurements are discarded.
+	DropNewest OverflowPolicyEnum
+	// Disconnect defines that newly received measurements are discarded and the Subscriber
+	// is disconnected from the publisher.
+	Disconnect OverflowPolicyEnum
+}{
+	Block:      0,
+	DropOldest: 1,
+	DropNewest: 2,
+	Disconnect: 3,
+}
+
+// String gets the OverflowPolicy enumeration value as a string.
+func (ope OverflowPolicyEnum) String() string {
+	switch ope {
+	case OverflowPolicy.Block:
+		return "Block"
+	case OverflowPolicy.DropOldest:
+		return "DropOldest"
+	case OverflowPolicy.DropNewest:
+		return "DropNewest"
+	case OverflowPolicy.Disconnect:
+		return "Disconnect"
+	default:
+		return "0x" + strconv.FormatInt(int64(ope), 16)
+	}
+}
+
+// ReaderConfig defines the buffering related settings for a MeasurementReader.
+type ReaderConfig struct {
+	// Capacity defines the maximum number of measurements buffered by the reader.
+	Capacity int
+
+	// OverflowPolicy defines how received measurements are handled when the buffer is full.
+	OverflowPolicy OverflowPolicyEnum
+}
+
+// readerConfigDefaults define the default values for MeasurementReader ReaderConfig.
+var readerConfigDefaults = ReaderConfig{
+	Capacity:       65536,
+	OverflowPolicy: OverflowPolicy.Block,
+}
+
+// NewReaderConfig creates a new ReaderConfig instance initialized with default values.
+func NewReaderConfig() *ReaderConfig {
+	config := readerConfigDefaults
+	return &config
+}
@@ -0,0 +1,169 @@
+//******************************************************************************************************
+//  Recorder.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"sync"
+
+	"github.com/sttp/goapi/sttp/recording"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Recorder records the metadata, signal index caches and measurements received by a Subscriber
+// to an STTP recording that can later be replayed using a Player.
+//
+// Recorder wraps the metadata, subscription updated and new measurements callbacks that are
+// assigned to the Subscriber when the Recorder is created, so receivers should be assigned
+// before creating a Recorder. Data is recorded before being passed to the original receivers.
+type Recorder struct {
+	subscriber *Subscriber
+	writer     *recording.Writer
+
+	// Original callbacks
+	metadataReceived    func([]byte)
+	subscriptionUpdated func(*transport.SignalIndexCache)
+	newMeasurements     func(*[]transport.Measurement)
+
+	err    error
+	closed bool
+	mutex  sync.Mutex
+}
+
+// NewRecorder creates a new Recorder that records data received by the subscriber to the writer.
+// If subscriber already has an active subscription, its signal index cache is recorded immediately.
+func NewRecorder(subscriber *Subscriber, writer *recording.Writer) *Recorder {
+	rc := &Recorder{
+		subscriber: subscriber,
+		writer:     writer,
+	}
+
+	ds := subscriber.dataSubscriber()
+	ds.BeginCallbackAssignment()
+
+	rc.metadataReceived = ds.MetadataReceivedCallback
+	rc.subscriptionUpdated = ds.SubscriptionUpdatedCallback
+	rc.newMeasurements = ds.NewMeasurementsCallback
+
+	ds.MetadataReceivedCallback = rc.handleMetadataReceived
+	ds.SubscriptionUpdatedCallback = rc.handleSubscriptionUpdated
+	ds.NewMeasurementsCallback = rc.handleNewMeasurements
+
+	ds.EndCallbackAssignment()
+
+	if signalIndexCache := subscriber.ActiveSignalIndexCache(); subscriber.IsSubscribed() && signalIndexCache != nil {
+		rc.record(writer.WriteSignalIndexCache(ticks.UtcNow(), signalIndexCache))
+	}
+
+	return rc
+}
+
+// Err gets the first error encountered while recording, if any. Recording stops after an error.
+func (rc *Recorder) Err() error {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	return rc.err
+}
+
+// Close restores the original Subscriber callbacks and closes the recording writer.
+func (rc *Recorder) Close() error {
+	rc.mutex.Lock()
+
+	if rc.closed {
+		rc.mutex.Unlock()
+		return nil
+	}
+
+	rc.closed = true
+	rc.mutex.Unlock()
+
+	ds := rc.subscriber.dataSubscriber()
+	ds.BeginCallbackAssignment()
+
+	ds.MetadataReceivedCallback = rc.metadataReceived
+	ds.SubscriptionUpdatedCallback = rc.subscriptionUpdated
+	ds.NewMeasurementsCallback = rc.newMeasurements
+
+	ds.EndCallbackAssignment()
+
+	return rc.writer.Close()
+}
+
+// recording determines if data should still be recorded.
+func (rc *Recorder) recording() bool {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	return !rc.closed && rc.err == nil
+}
+
+// record tracks the first recording error and reports it to the subscriber.
+func (rc *Recorder) record(err error) {
+	if err == nil {
+		return
+	}
+
+	rc.mutex.Lock()
+
+	if rc.closed || rc.err != nil {
+		rc.mutex.Unlock()
+		return
+	}
+
+	rc.err = err
+	rc.mutex.Unlock()
+
+	rc.subscriber.ErrorMessage("Failed to record received data, recording stopped: " + err.Error())
+}
+
+func (rc *Recorder) handleMetadataReceived(metadata []byte) {
+	if rc.recording() {
+		rc.record(rc.writer.WriteMetadata(ticks.UtcNow(), metadata))
+	}
+
+	if rc.metadataReceived != nil {
+		rc.metadataReceived(metadata)
+	}
+}
+
+func (rc *Recorder) handleSubscriptionUpdated(signalIndexCache *transport.SignalIndexCache) {
+	if rc.recording() {
+		rc.record(rc.writer.WriteSignalIndexCache(ticks.UtcNow(), signalIndexCache))
+	}
+
+	if rc.subscriptionUpdated != nil {
+		rc.subscriptionUpdated(signalIndexCache)
+	}
+}
+
+func (rc *Recorder) handleNewMeasurements(measurements *[]transport.Measurement) {
+	if rc.recording() {
+		rc.record(rc.writer.WriteMeasurements(ticks.UtcNow(), *measurements))
+	}
+
+	if rc.newMeasurements != nil {
+		rc.newMeasurements(measurements)
+	}
+}
@@ -0,0 +1,174 @@
+//******************************************************************************************************
+//  Recorder_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/recording"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// errorWriter is an io.Writer that always fails
+type errorWriter struct{}
+
+func (errorWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+// recordTestData drives recorder wrapped subscriber callbacks as a connected subscriber would,
+// returning the recording and the recorded signal IDs
+func recordTestData(t *testing.T, batches int) ([]byte, []guid.Guid) {
+	metadata, err := os.ReadFile("../test/SampleMetadata.xml")
+
+	if err != nil {
+		t.Fatalf("recordTestData: failed to load sample metadata: %s", err.Error())
+	}
+
+	subscriber, _ := newTestSubscriber()
+	defer subscriber.Close()
+
+	var received int
+	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+		received += len(*measurements)
+	})
+
+	var buffer bytes.Buffer
+	writer, err := recording.NewWriter(&buffer)
+
+	if err != nil {
+		t.Fatalf("recordTestData: failed to create recording writer: %s", err.Error())
+	}
+
+	recorder := NewRecorder(subscriber, writer)
+	ds := subscriber.dataSubscriber()
+	signalIDs := []guid.Guid{guid.New(), guid.New()}
+	signalIndexCache := transport.NewSignalIndexCache()
+
+	for i, signalID := range signalIDs {
+		signalIndexCache.AddRecord(int32(i), signalID, "PPA", uint64(i+1))
+	}
+
+	ds.MetadataReceivedCallback(metadata)
+	ds.SubscriptionUpdatedCallback(signalIndexCache)
+
+	baseTime := testBaseTime()
+
+	for i := 0; i < batches; i++ {
+		measurements := make([]transport.Measurement, len(signalIDs))
+
+		for j, signalID := range signalIDs {
+			measurements[j] = transport.Measurement{SignalID: signalID, Timestamp: baseTime + ticks.Ticks(i), Value: float64(i)}
+		}
+
+		ds.NewMeasurementsCallback(&measurements)
+	}
+
+	if received != batches*len(signalIDs) {
+		t.Fatalf("recordTestData: expected %d measurements passed to original receiver, received %d", batches*len(signalIDs), received)
+	}
+
+	if err := recorder.Close(); err != nil {
+		t.Fatalf("recordTestData: failed to close recorder: %s", err.Error())
+	}
+
+	if ds.MetadataReceivedCallback != nil || ds.SubscriptionUpdatedCallback != nil {
+		t.Fatalf("recordTestData: expected original callbacks to be restored on close")
+	}
+
+	return buffer.Bytes(), signalIDs
+}
+
+func TestRecorderRecordsCallbacks(t *testing.T) {
+	data, signalIDs := recordTestData(t, 5)
+	reader, err := recording.NewReader(bytes.NewReader(data))
+
+	if err != nil {
+		t.Fatalf("TestRecorderRecordsCallbacks: failed to read recording: %s", err.Error())
+	}
+
+	expected := []recording.RecordTypeEnum{recording.RecordType.Metadata, recording.RecordType.SignalIndexCache}
+
+	for i := 0; i < 5; i++ {
+		expected = append(expected, recording.RecordType.Measurements)
+	}
+
+	for i, recordType := range expected {
+		record, err := reader.Next()
+
+		if err != nil {
+			t.Fatalf("TestRecorderRecordsCallbacks: failed to read record %d: %s", i, err.Error())
+		}
+
+		if record.Type != recordType {
+			t.Fatalf("TestRecorderRecordsCallbacks: expected %s record, received %s", recordType, record.Type)
+		}
+
+		if record.Type == recording.RecordType.Measurements && (len(record.Measurements) != 2 || record.Measurements[1].SignalID != signalIDs[1]) {
+			t.Fatalf("TestRecorderRecordsCallbacks: unexpected measurements in record %d", i)
+		}
+	}
+}
+
+func TestRecorderWriteFailure(t *testing.T) {
+	subscriber, _ := newTestSubscriber()
+	defer subscriber.Close()
+
+	var errorMessages []string
+	subscriber.SetErrorMessageLogger(func(message string) {
+		errorMessages = append(errorMessages, message)
+	})
+
+	// Header write is buffered, so writer creation succeeds
+	writer, err := recording.NewWriter(errorWriter{})
+
+	if err != nil {
+		t.Fatalf("TestRecorderWriteFailure: failed to create recording writer: %s", err.Error())
+	}
+
+	recorder := NewRecorder(subscriber, writer)
+	ds := subscriber.dataSubscriber()
+
+	// Write enough measurements to force buffered writer to flush
+	measurements := make([]transport.Measurement, 1000)
+
+	for i := 0; i < 3; i++ {
+		ds.NewMeasurementsCallback(&measurements)
+	}
+
+	if recorder.Err() == nil {
+		t.Fatalf("TestRecorderWriteFailure: expected recording error")
+	}
+
+	if len(errorMessages) != 1 {
+		t.Fatalf("TestRecorderWriteFailure: expected recording error to be reported once, received %d", len(errorMessages))
+	}
+
+	recorder.Close()
+}
@@ -0,0 +1,648 @@
+//******************************************************************************************************
+//  RedundantSubscriber.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// maxRedundantSources defines the maximum number of publisher connections for a RedundantSubscriber.
+const maxRedundantSources = 64
+
+// defaultDeduplicationWindow defines the default de-duplication window for a RedundantSubscriber.
+const defaultDeduplicationWindow = time.Second
+
+// Quality flags used to rank duplicate measurements, any error condition outranks all warnings
+var (
+	errorQualityFlags = transport.StateFlags.BadData | transport.StateFlags.OverRangeError | transport.StateFlags.UnderRangeError |
+		transport.StateFlags.ReceivedAsBad | transport.StateFlags.CalculationError | transport.StateFlags.BadTime |
+		transport.StateFlags.DiscardedValue | transport.StateFlags.SystemError | transport.StateFlags.MeasurementError
+
+	warningQualityFlags = transport.StateFlags.SuspectData | transport.StateFlags.AlarmHigh | transport.StateFlags.AlarmLow |
+		transport.StateFlags.WarningHigh | transport.StateFlags.WarningLow | transport.StateFlags.FlatlineAlarm |
+		transport.StateFlags.ComparisonAlarm | transport.StateFlags.ROCAlarm | transport.StateFlags.CalculationWarning |
+		transport.StateFlags.SuspectTime | transport.StateFlags.LateTimeAlarm | transport.StateFlags.FutureTimeAlarm |
+		transport.StateFlags.UpSampled | transport.StateFlags.DownSampled | transport.StateFlags.SystemWarning
+)
+
+// RedundantSubscriber represents an STTP data subscriber that receives the same measurements from
+// multiple independent publishers and merges them into a single de-duplicated stream.
+//
+// Each publisher connection is managed by its own Subscriber using the same filter expression.
+// Measurements are considered duplicates when they have the same SignalID and Timestamp. A received
+// measurement is held until every connected publisher has provided it, or the de-duplication window
+// has elapsed, whichever occurs first; the copy with the best StateFlags quality is then published.
+// Duplicates that arrive after a measurement has been published, but within the window, are discarded.
+type RedundantSubscriber struct {
+	window  time.Duration
+	sources []*redundantSource
+
+	filterExpression string
+	settings         *Settings
+
+	// De-duplication state
+	pending map[redundantKey]*redundantEntry
+	queue   []*redundantEntry
+	mutex   sync.Mutex
+
+	// Publication state, ready measurements are guarded by mutex in the order they were selected
+	ready          []transport.Measurement
+	publishMutex   sync.Mutex
+	totalPublished uint64
+	totalDiscarded uint64
+
+	// Expiration routine, guarded by mutex
+	expirationStop      chan struct{}
+	expirationCompleted chan struct{}
+
+	// Callback references
+	statusMessageLogger  func(message string)
+	errorMessageLogger   func(message string)
+	measurementsReceiver func(measurements []transport.Measurement)
+
+	// Lock used to synchronize console writes
+	consoleLock sync.Mutex
+
+	assigningHandlerMutex sync.RWMutex
+}
+
+// SourceStatistics defines the health statistics of a RedundantSubscriber publisher connection.
+type SourceStatistics struct {
+	// Address is the publisher address, in "hostname:port" format.
+	Address string
+
+	// Connected determines if the source is currently connected to its publisher.
+	Connected bool
+
+	// Connections is the number of connections established to the publisher.
+	Connections uint64
+
+	// MeasurementsReceived is the total number of measurements received from the publisher.
+	MeasurementsReceived uint64
+
+	// MeasurementsPublished is the number of measurements from the publisher that were selected for publication.
+	MeasurementsPublished uint64
+
+	// MeasurementsDiscarded is the number of measurements from the publisher that were discarded as duplicates.
+	MeasurementsDiscarded uint64
+
+	// LastReceived is the time measurements were last received from the publisher, zero if none.
+	LastReceived time.Time
+}
+
+type redundantSource struct {
+	index      int
+	address    string
+	subscriber *Subscriber
+	statistics SourceStatistics
+}
+
+type redundantKey struct {
+	signalID  guid.Guid
+	timestamp ticks.Ticks
+}
+
+type redundantEntry struct {
+	key         redundantKey
+	measurement transport.Measurement
+	source      int
+	rank        int
+	sources     uint64
+	received    time.Time
+	published   bool
+}
+
+// NewRedundantSubscriber creates a new RedundantSubscriber that de-duplicates measurements received
+// within the specified window. Set window to zero for the default window of one second.
+func NewRedundantSubscriber(window time.Duration) *RedundantSubscriber {
+	if window <= 0 {
+		window = defaultDeduplicationWindow
+	}
+
+	rs := &RedundantSubscriber{
+		window:  window,
+		pending: make(map[redundantKey]*redundantEntry),
+	}
+
+	rs.statusMessageLogger = rs.DefaultStatusMessageLogger
+	rs.errorMessageLogger = rs.DefaultErrorMessageLogger
+
+	return rs
+}
+
+// Dial starts a connection cycle to each of the STTP publisher addresses, in "hostname:port" format,
+// and returns once at least one connection has been established; connections to the remaining
+// publishers continue in the background. An error is returned when all connections fail. Config
+// parameter controls connection related settings, set value to nil for default values. The config
+// is applied to each connection, except for FailoverAddresses which are ignored.
+func (rs *RedundantSubscriber) Dial(addresses []string, config *Config) error {
+	if len(addresses) == 0 {
+		return errors.New("no publisher addresses specified; cannot dial")
+	}
+
+	if len(addresses) > maxRedundantSources {
+		return fmt.Errorf("too many publisher addresses specified: maximum is %d", maxRedundantSources)
+	}
+
+	if config == nil {
+		config = NewConfig()
+	}
+
+	sources := make([]*redundantSource, len(addresses))
+
+	for i, address := range addresses {
+		sources[i] = rs.newSource(i, address)
+	}
+
+	stop := make(chan struct{})
+	completed := make(chan struct{})
+
+	rs.mutex.Lock()
+
+	if len(rs.sources) > 0 {
+		rs.mutex.Unlock()
+		return errors.New("redundant subscriber is already dialed; close first")
+	}
+
+	rs.sources = sources
+	rs.expirationStop = stop
+	rs.expirationCompleted = completed
+	rs.mutex.Unlock()
+
+	go rs.runExpiration(stop, completed)
+
+	results := make(chan error, len(sources))
+
+	for _, source := range sources {
+		sourceConfig := *config
+		sourceConfig.FailoverAddresses = nil
+
+		go func(source *redundantSource) {
+			err := source.subscriber.Dial(source.address, &sourceConfig)
+
+			if err != nil {
+				err = fmt.Errorf("connection to \"%s\" failed: %w", source.address, err)
+			}
+
+			results <- err
+		}(source)
+	}
+
+	errs := make([]error, 0, len(sources))
+
+	for range sources {
+		err := <-results
+
+		if err == nil {
+			// Background connection failures are still reported
+			go rs.reportDialErrors(results, len(sources)-len(errs)-1)
+			return nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	rs.Close()
+
+	return errors.Join(errs...)
+}
+
+func (rs *RedundantSubscriber) reportDialErrors(results chan error, remaining int) {
+	for i := 0; i < remaining; i++ {
+		if err := <-results; err != nil {
+			rs.ErrorMessage(err.Error())
+		}
+	}
+}
+
+func (rs *RedundantSubscriber) newSource(index int, address string) *redundantSource {
+	source := &redundantSource{
+		index:      index,
+		address:    address,
+		subscriber: NewSubscriber(),
+	}
+
+	source.statistics.Address = address
+	subscriber := source.subscriber
+	prefix := "[" + address + "] "
+
+	subscriber.SetStatusMessageLogger(func(message string) {
+		rs.StatusMessage(prefix + message)
+	})
+
+	subscriber.SetErrorMessageLogger(func(message string) {
+		rs.ErrorMessage(prefix + message)
+	})
+
+	subscriber.SetConnectionEstablishedReceiver(func() {
+		rs.mutex.Lock()
+		source.statistics.Connected = true
+		source.statistics.Connections++
+		rs.mutex.Unlock()
+
+		subscriber.DefaultConnectionEstablishedReceiver()
+	})
+
+	subscriber.SetConnectionTerminatedReceiver(func() {
+		rs.mutex.Lock()
+		source.statistics.Connected = false
+		rs.mutex.Unlock()
+
+		subscriber.DefaultConnectionTerminatedReceiver()
+	})
+
+	subscriber.SetNewMeasurementsReceiver(func(measurements *[]transport.Measurement) {
+		rs.handleMeasurements(source, *measurements)
+		subscriber.PutMeasurementSlice(measurements)
+	})
+
+	rs.mutex.Lock()
+	filterExpression, settings := rs.filterExpression, rs.settings
+	rs.mutex.Unlock()
+
+	subscriber.Subscribe(filterExpression, settings)
+
+	return source
+}
+
+// Subscribe sets up a request indicating that the RedundantSubscriber would like to start receiving
+// streaming data from each publisher using the same filter expression, see Subscriber.Subscribe.
+// If publishers are already connected, the updated subscription will be requested immediately;
+// otherwise, the subscription will be used when connections are established.
+func (rs *RedundantSubscriber) Subscribe(filterExpression string, settings *Settings) {
+	rs.mutex.Lock()
+	rs.filterExpression = filterExpression
+	rs.settings = settings
+	sources := rs.sources
+	rs.mutex.Unlock()
+
+	for _, source := range sources {
+		source.subscriber.Subscribe(filterExpression, settings)
+	}
+}
+
+// Disconnect disconnects from all STTP publishers.
+func (rs *RedundantSubscriber) Disconnect() {
+	for _, source := range rs.activeSources() {
+		source.subscriber.Disconnect()
+	}
+}
+
+// Close cleanly shuts down all publisher connections, after which the RedundantSubscriber can be dialed again.
+// Measurements pending de-duplication are discarded.
+func (rs *RedundantSubscriber) Close() {
+	rs.mutex.Lock()
+	sources := rs.sources
+	stop := rs.expirationStop
+	completed := rs.expirationCompleted
+	rs.sources = nil
+	rs.expirationStop = nil
+	rs.expirationCompleted = nil
+	rs.mutex.Unlock()
+
+	for _, source := range sources {
+		source.subscriber.Close()
+	}
+
+	if stop != nil {
+		close(stop)
+		<-completed
+	}
+
+	rs.mutex.Lock()
+	rs.pending = make(map[redundantKey]*redundantEntry)
+	rs.queue = nil
+	rs.mutex.Unlock()
+}
+
+// activeSources gets the sources that manage each publisher connection, in order of the dialed addresses.
+func (rs *RedundantSubscriber) activeSources() []*redundantSource {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	return rs.sources
+}
+
+// SourceStatistics gets a snapshot of the health statistics for each publisher connection,
+// in order of the dialed addresses.
+func (rs *RedundantSubscriber) SourceStatistics() []SourceStatistics {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	statistics := make([]SourceStatistics, len(rs.sources))
+
+	for i, source := range rs.sources {
+		statistics[i] = source.statistics
+	}
+
+	return statistics
+}
+
+// TotalMeasurementsPublished gets the total number of de-duplicated measurements published.
+func (rs *RedundantSubscriber) TotalMeasurementsPublished() uint64 {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	return rs.totalPublished
+}
+
+// TotalMeasurementsDiscarded gets the total number of received measurements discarded as duplicates.
+func (rs *RedundantSubscriber) TotalMeasurementsDiscarded() uint64 {
+	rs.mutex.Lock()
+	defer rs.mutex.Unlock()
+
+	return rs.totalDiscarded
+}
+
+func (rs *RedundantSubscriber) handleMeasurements(source *redundantSource, measurements []transport.Measurement) {
+	now := time.Now()
+	sourceBit := uint64(1) << source.index
+
+	rs.mutex.Lock()
+
+	expected := rs.connectedSources() | sourceBit
+
+	source.statistics.MeasurementsReceived += uint64(len(measurements))
+	source.statistics.LastReceived = now
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		key := redundantKey{signalID: measurement.SignalID, timestamp: measurement.Timestamp}
+		rank := qualityRank(measurement.Flags)
+		entry, found := rs.pending[key]
+
+		if !found {
+			entry = &redundantEntry{
+				key:         key,
+				measurement: *measurement,
+				source:      source.index,
+				rank:        rank,
+				sources:     sourceBit,
+				received:    now,
+			}
+
+			rs.pending[key] = entry
+			rs.queue = append(rs.queue, entry)
+		} else {
+			// Measurements already published, or repeated by the same source, are discarded
+			if entry.published || entry.sources&sourceBit != 0 {
+				source.statistics.MeasurementsDiscarded++
+				rs.totalDiscarded++
+				continue
+			}
+
+			entry.sources |= sourceBit
+
+			if rank < entry.rank {
+				entry.measurement = *measurement
+				entry.source = source.index
+				entry.rank = rank
+			}
+		}
+
+		// Publish once all connected sources have provided the measurement
+		if entry.sources&expected == expected {
+			rs.publishEntry(entry)
+		}
+	}
+
+	rs.expire(now)
+
+	rs.mutex.Unlock()
+
+	rs.publish()
+}
+
+// connectedSources gets a bit mask of the sources that are currently connected, mutex must be held.
+func (rs *RedundantSubscriber) connectedSources() uint64 {
+	var connected uint64
+
+	for _, source := range rs.sources {
+		if source.statistics.Connected {
+			connected |= 1 << source.index
+		}
+	}
+
+	return connected
+}
+
+// publishEntry marks entry as published, queues its measurement for publication and
+// updates statistics, mutex must be held.
+func (rs *RedundantSubscriber) publishEntry(entry *redundantEntry) {
+	entry.published = true
+	rs.ready = append(rs.ready, entry.measurement)
+
+	for _, source := range rs.sources {
+		sourceBit := uint64(1) << source.index
+
+		if entry.sources&sourceBit == 0 {
+			continue
+		}
+
+		if source.index == entry.source {
+			source.statistics.MeasurementsPublished++
+		} else {
+			source.statistics.MeasurementsDiscarded++
+			rs.totalDiscarded++
+		}
+	}
+
+	rs.totalPublished++
+}
+
+// expire removes entries older than the de-duplication window, publishing any that have not
+// already been published, mutex must be held.
+func (rs *RedundantSubscriber) expire(now time.Time) {
+	expired := 0
+
+	for _, entry := range rs.queue {
+		if now.Sub(entry.received) < rs.window {
+			break
+		}
+
+		if !entry.published {
+			rs.publishEntry(entry)
+		}
+
+		delete(rs.pending, entry.key)
+		expired++
+	}
+
+	if expired > 0 {
+		rs.queue = append(rs.queue[:0], rs.queue[expired:]...)
+	}
+}
+
+func (rs *RedundantSubscriber) runExpiration(stop chan struct{}, completed chan struct{}) {
+	defer close(completed)
+
+	interval := rs.window / 4
+
+	if interval < 10*time.Millisecond {
+		interval = 10 * time.Millisecond
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			rs.mutex.Lock()
+			rs.expire(now)
+			rs.mutex.Unlock()
+
+			rs.publish()
+		}
+	}
+}
+
+// publish delivers ready measurements to the receiver. Publication is serialized, and ready measurements
+// are taken in the order they were selected, so batches from different sources cannot be delivered out of
+// order. Mutex is not held while the receiver is called so that it can access RedundantSubscriber state.
+func (rs *RedundantSubscriber) publish() {
+	rs.publishMutex.Lock()
+	defer rs.publishMutex.Unlock()
+
+	for {
+		rs.mutex.Lock()
+		measurements := rs.ready
+		rs.ready = nil
+		rs.mutex.Unlock()
+
+		if len(measurements) == 0 {
+			return
+		}
+
+		rs.beginCallbackSync()
+
+		if rs.measurementsReceiver != nil {
+			rs.measurementsReceiver(measurements)
+		}
+
+		rs.endCallbackSync()
+	}
+}
+
+// qualityRank ranks StateFlags quality where lower values are better quality.
+func qualityRank(flags transport.StateFlagsEnum) int {
+	return bits.OnesCount32(uint32(flags&errorQualityFlags))*16 + bits.OnesCount32(uint32(flags&warningQualityFlags))
+}
+
+// beginCallbackAssignment informs RedundantSubscriber that a callback change has been initiated.
+func (rs *RedundantSubscriber) beginCallbackAssignment() {
+	rs.assigningHandlerMutex.Lock()
+}
+
+// beginCallbackSync begins a callback synchronization operation.
+func (rs *RedundantSubscriber) beginCallbackSync() {
+	rs.assigningHandlerMutex.RLock()
+}
+
+// endCallbackSync ends a callback synchronization operation.
+func (rs *RedundantSubscriber) endCallbackSync() {
+	rs.assigningHandlerMutex.RUnlock()
+}
+
+// endCallbackAssignment informs RedundantSubscriber that a callback change has been completed.
+func (rs *RedundantSubscriber) endCallbackAssignment() {
+	rs.assigningHandlerMutex.Unlock()
+}
+
+// StatusMessage executes the defined status message logger callback.
+func (rs *RedundantSubscriber) StatusMessage(message string) {
+	rs.beginCallbackSync()
+
+	if rs.statusMessageLogger != nil {
+		rs.statusMessageLogger(message)
+	}
+
+	rs.endCallbackSync()
+}
+
+// ErrorMessage executes the defined error message logger callback.
+func (rs *RedundantSubscriber) ErrorMessage(message string) {
+	rs.beginCallbackSync()
+
+	if rs.errorMessageLogger != nil {
+		rs.errorMessageLogger(message)
+	}
+
+	rs.endCallbackSync()
+}
+
+// DefaultStatusMessageLogger implements the default status message logger for the RedundantSubscriber.
+func (rs *RedundantSubscriber) DefaultStatusMessageLogger(message string) {
+	rs.consoleLock.Lock()
+	defer rs.consoleLock.Unlock()
+	fmt.Println(message)
+}
+
+// DefaultErrorMessageLogger implements the default error message logger for the RedundantSubscriber.
+func (rs *RedundantSubscriber) DefaultErrorMessageLogger(message string) {
+	rs.consoleLock.Lock()
+	defer rs.consoleLock.Unlock()
+	fmt.Fprintln(os.Stderr, message)
+}
+
+// SetStatusMessageLogger defines the callback that handles informational message logging for
+// all publisher connections. Messages are prefixed with the associated publisher address.
+// Assignment will take effect immediately, even while subscription is active.
+func (rs *RedundantSubscriber) SetStatusMessageLogger(callback func(message string)) {
+	rs.beginCallbackAssignment()
+	defer rs.endCallbackAssignment()
+
+	rs.statusMessageLogger = callback
+}
+
+// SetErrorMessageLogger defines the callback that handles error message logging for all
+// publisher connections. Messages are prefixed with the associated publisher address.
+// Assignment will take effect immediately, even while subscription is active.
+func (rs *RedundantSubscriber) SetErrorMessageLogger(callback func(message string)) {
+	rs.beginCallbackAssignment()
+	defer rs.endCallbackAssignment()
+
+	rs.errorMessageLogger = callback
+}
+
+// SetNewMeasurementsReceiver defines the callback that handles de-duplicated measurements merged
+// from all publisher connections. Receiver owns the provided slice.
+// Assignment will take effect immediately, even while subscription is active.
+func (rs *RedundantSubscriber) SetNewMeasurementsReceiver(callback func(measurements []transport.Measurement)) {
+	rs.beginCallbackAssignment()
+	defer rs.endCallbackAssignment()
+
+	rs.measurementsReceiver = callback
+}
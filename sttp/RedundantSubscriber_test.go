@@ -0,0 +1,314 @@
+//******************************************************************************************************
+//  RedundantSubscriber_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/internal/transporttest"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+type publishedMeasurements struct {
+	sync.Mutex
+	measurements []transport.Measurement
+}
+
+func (pm *publishedMeasurements) receive(measurements []transport.Measurement) {
+	pm.Lock()
+	defer pm.Unlock()
+	pm.measurements = append(pm.measurements, measurements...)
+}
+
+func (pm *publishedMeasurements) snapshot() []transport.Measurement {
+	pm.Lock()
+	defer pm.Unlock()
+	return append([]transport.Measurement(nil), pm.measurements...)
+}
+
+func newTestRedundantSubscriber(window time.Duration, count int) (*RedundantSubscriber, *publishedMeasurements) {
+	rs := NewRedundantSubscriber(window)
+	published := &publishedMeasurements{}
+	rs.SetNewMeasurementsReceiver(published.receive)
+
+	for i := 0; i < count; i++ {
+		source := &redundantSource{index: i, address: "source" + string(rune('A'+i))}
+		source.statistics.Address = source.address
+		source.statistics.Connected = true
+		rs.sources = append(rs.sources, source)
+	}
+
+	return rs, published
+}
+
+func TestRedundantSubscriberDeduplication(t *testing.T) {
+	rs, published := newTestRedundantSubscriber(time.Hour, 2)
+	signalID := guid.New()
+	timestamp := ticks.Now()
+
+	rs.handleMeasurements(rs.sources[0], []transport.Measurement{{SignalID: signalID, Timestamp: timestamp, Value: 1}})
+
+	if count := len(published.snapshot()); count != 0 {
+		t.Fatalf("TestRedundantSubscriberDeduplication: expected no measurements before all sources reported, received %d", count)
+	}
+
+	rs.handleMeasurements(rs.sources[1], []transport.Measurement{{SignalID: signalID, Timestamp: timestamp, Value: 2}})
+
+	measurements := published.snapshot()
+
+	if len(measurements) != 1 || measurements[0].Value != 1 {
+		t.Fatalf("TestRedundantSubscriberDeduplication: expected first received measurement to be published once, received %v", measurements)
+	}
+
+	// Late duplicate from an already reported source is discarded
+	rs.handleMeasurements(rs.sources[1], []transport.Measurement{{SignalID: signalID, Timestamp: timestamp, Value: 3}})
+
+	if count := len(published.snapshot()); count != 1 {
+		t.Fatalf("TestRedundantSubscriberDeduplication: expected duplicate to be discarded, published %d", count)
+	}
+
+	if rs.TotalMeasurementsPublished() != 1 || rs.TotalMeasurementsDiscarded() != 2 {
+		t.Fatalf("TestRedundantSubscriberDeduplication: unexpected totals: published %d, discarded %d", rs.TotalMeasurementsPublished(), rs.TotalMeasurementsDiscarded())
+	}
+
+	statistics := rs.SourceStatistics()
+
+	if statistics[0].MeasurementsReceived != 1 || statistics[0].MeasurementsPublished != 1 || statistics[0].MeasurementsDiscarded != 0 {
+		t.Fatalf("TestRedundantSubscriberDeduplication: unexpected statistics for first source: %+v", statistics[0])
+	}
+
+	if statistics[1].MeasurementsReceived != 2 || statistics[1].MeasurementsPublished != 0 || statistics[1].MeasurementsDiscarded != 2 {
+		t.Fatalf("TestRedundantSubscriberDeduplication: unexpected statistics for second source: %+v", statistics[1])
+	}
+
+	if statistics[1].LastReceived.IsZero() {
+		t.Fatalf("TestRedundantSubscriberDeduplication: expected last received time for second source")
+	}
+}
+
+func TestRedundantSubscriberQualityPreference(t *testing.T) {
+	rs, published := newTestRedundantSubscriber(time.Hour, 3)
+	signalID := guid.New()
+	timestamp := ticks.Now()
+
+	rs.handleMeasurements(rs.sources[0], []transport.Measurement{{SignalID: signalID, Timestamp: timestamp, Value: 1, Flags: transport.StateFlags.BadData}})
+	rs.handleMeasurements(rs.sources[1], []transport.Measurement{{SignalID: signalID, Timestamp: timestamp, Value: 2, Flags: transport.StateFlags.SuspectData}})
+	rs.handleMeasurements(rs.sources[2], []transport.Measurement{{SignalID: signalID, Timestamp: timestamp, Value: 3, Flags: transport.StateFlags.SuspectData | transport.StateFlags.AlarmHigh}})
+
+	measurements := published.snapshot()
+
+	if len(measurements) != 1 || measurements[0].Value != 2 {
+		t.Fatalf("TestRedundantSubscriberQualityPreference: expected best quality measurement to be published, received %v", measurements)
+	}
+
+	if statistics := rs.SourceStatistics(); statistics[1].MeasurementsPublished != 1 {
+		t.Fatalf("TestRedundantSubscriberQualityPreference: expected second source to be credited, received %+v", statistics[1])
+	}
+}
+
+func TestRedundantSubscriberWindowExpiration(t *testing.T) {
+	rs, published := newTestRedundantSubscriber(20*time.Millisecond, 2)
+	signalID := guid.New()
+	timestamp := ticks.Now()
+
+	rs.expirationStop = make(chan struct{})
+	rs.expirationCompleted = make(chan struct{})
+	go rs.runExpiration(rs.expirationStop, rs.expirationCompleted)
+
+	defer func() {
+		close(rs.expirationStop)
+		<-rs.expirationCompleted
+	}()
+
+	rs.handleMeasurements(rs.sources[0], []transport.Measurement{{SignalID: signalID, Timestamp: timestamp, Value: 1}})
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for len(published.snapshot()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("TestRedundantSubscriberWindowExpiration: measurement not published after window expired")
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// Once expired, entry is forgotten and a subsequent copy is treated as new
+	rs.mutex.Lock()
+	pending := len(rs.pending)
+	rs.mutex.Unlock()
+
+	if pending != 0 {
+		t.Fatalf("TestRedundantSubscriberWindowExpiration: expected expired entries to be removed, found %d", pending)
+	}
+}
+
+func TestRedundantSubscriberDisconnectedSource(t *testing.T) {
+	rs, published := newTestRedundantSubscriber(time.Hour, 2)
+	rs.sources[1].statistics.Connected = false
+
+	rs.handleMeasurements(rs.sources[0], []transport.Measurement{{SignalID: guid.New(), Timestamp: ticks.Now(), Value: 1}})
+
+	if count := len(published.snapshot()); count != 1 {
+		t.Fatalf("TestRedundantSubscriberDisconnectedSource: expected immediate publication when only one source is connected, published %d", count)
+	}
+}
+
+func TestRedundantSubscriberPublicationOrder(t *testing.T) {
+	rs, published := newTestRedundantSubscriber(time.Hour, 1)
+	release := make(chan struct{})
+	var once sync.Once
+
+	// Receiver blocks on first batch while later batches are selected from other Go routines
+	rs.SetNewMeasurementsReceiver(func(measurements []transport.Measurement) {
+		once.Do(func() { <-release })
+		published.receive(measurements)
+	})
+
+	const count = 10
+	timestamp := ticks.Now()
+	var wg sync.WaitGroup
+
+	for i := 1; i <= count; i++ {
+		wg.Add(1)
+
+		go func(value float64) {
+			defer wg.Done()
+			rs.handleMeasurements(rs.sources[0], []transport.Measurement{{SignalID: guid.New(), Timestamp: timestamp, Value: value}})
+		}(float64(i))
+
+		for rs.TotalMeasurementsPublished() < uint64(i) {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	close(release)
+	wg.Wait()
+
+	measurements := published.snapshot()
+
+	if len(measurements) != count {
+		t.Fatalf("TestRedundantSubscriberPublicationOrder: expected %d published measurements, received %d", count, len(measurements))
+	}
+
+	for i, measurement := range measurements {
+		if measurement.Value != float64(i+1) {
+			t.Fatalf("TestRedundantSubscriberPublicationOrder: expected measurement %d to have value %d, received %v", i, i+1, measurement.Value)
+		}
+	}
+}
+
+func TestRedundantSubscriberDial(t *testing.T) {
+	rs := NewRedundantSubscriber(0)
+	rs.SetStatusMessageLogger(func(string) {})
+	rs.SetErrorMessageLogger(func(string) {})
+
+	if err := rs.Dial(nil, nil); err == nil {
+		t.Fatalf("TestRedundantSubscriberDial: expected error for empty address list")
+	}
+
+	if err := rs.Dial(make([]string, maxRedundantSources+1), nil); err == nil {
+		t.Fatalf("TestRedundantSubscriberDial: expected error for too many addresses")
+	}
+
+	// Reserve a local port with no listener so that connection attempts are refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("TestRedundantSubscriberDial: failed to reserve port: %s", err.Error())
+	}
+
+	refused := listener.Addr().String()
+	listener.Close()
+
+	_, config := newTestSubscriber()
+
+	if err := rs.Dial([]string{refused}, config); err == nil {
+		t.Fatalf("TestRedundantSubscriberDial: expected error when all connections fail")
+	}
+
+	publisher := transport.NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Stop()
+
+	if err := rs.Dial([]string{refused, transporttest.Address(publisher)}, config); err != nil {
+		t.Fatalf("TestRedundantSubscriberDial: expected connection to available publisher: %s", err.Error())
+	}
+
+	defer rs.Close()
+
+	if err := rs.Dial([]string{transporttest.Address(publisher)}, config); err == nil {
+		t.Fatalf("TestRedundantSubscriberDial: expected error when already dialed")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for !rs.SourceStatistics()[1].Connected {
+		if time.Now().After(deadline) {
+			t.Fatalf("TestRedundantSubscriberDial: expected second source to report connected")
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if statistics := rs.SourceStatistics(); statistics[0].Connected || statistics[0].Address != refused {
+		t.Fatalf("TestRedundantSubscriberDial: unexpected statistics for refused source: %+v", statistics[0])
+	}
+}
+
+func TestRedundantSubscriberConcurrentClose(t *testing.T) {
+	publisher := transport.NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Stop()
+
+	rs := NewRedundantSubscriber(0)
+	rs.SetStatusMessageLogger(func(string) {})
+	rs.SetErrorMessageLogger(func(string) {})
+
+	_, config := newTestSubscriber()
+
+	for i := 0; i < 2; i++ {
+		if err := rs.Dial([]string{transporttest.Address(publisher)}, config); err != nil {
+			t.Fatalf("TestRedundantSubscriberConcurrentClose: failed to dial publisher: %s", err.Error())
+		}
+
+		// Expiration routine must be stopped exactly once
+		var closers sync.WaitGroup
+
+		for j := 0; j < 4; j++ {
+			closers.Add(1)
+
+			go func() {
+				defer closers.Done()
+				rs.Close()
+			}()
+		}
+
+		closers.Wait()
+	}
+}
@@ -24,9 +24,12 @@
 package sttp
 
 import (
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"math"
 	"net"
 	"os"
@@ -38,6 +41,7 @@ import (
 	"github.com/sttp/goapi/sttp/data"
 	"github.com/sttp/goapi/sttp/format"
 	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/metadata"
 	"github.com/sttp/goapi/sttp/ticks"
 	"github.com/sttp/goapi/sttp/transport"
 )
@@ -63,6 +67,11 @@ type Subscriber struct {
 	configurationChangedReceiver   func()
 	historicalReadCompleteReceiver func()
 	connectionEstablishedReceiver  func()
+	activeEndpointChangedReceiver  func(address string)
+
+	// Typed metadata from last metadata refresh
+	metadataSnapshot      *metadata.Snapshot
+	metadataSnapshotMutex sync.RWMutex
 
 	// Lock used to synchronize console writes
 	consoleLock sync.Mutex
@@ -159,6 +168,12 @@ func (sb *Subscriber) TotalMeasurementsReceived() uint64 {
 	return sb.dataSubscriber().TotalMeasurementsReceived()
 }
 
+// Statistics gets a snapshot of the operational statistics of the Subscriber, e.g., for export
+// to a monitoring system using the metrics package.
+func (sb *Subscriber) Statistics() transport.SubscriberStatistics {
+	return sb.dataSubscriber().Statistics()
+}
+
 // LookupMetadata gets the MeasurementMetadata for the specified signalID from the local
 // registry. If the metadata does not exist, a new record is created and returned.
 func (sb *Subscriber) LookupMetadata(signalID guid.Guid) *transport.MeasurementMetadata {
@@ -171,6 +186,16 @@ func (sb *Subscriber) Metadata(measurement *transport.Measurement) *transport.Me
 	return sb.dataSubscriber().Metadata(measurement)
 }
 
+// MetadataSnapshot gets the strongly typed metadata parsed from the last successful metadata refresh,
+// or nil if no metadata has been received. A new Snapshot is created for each refresh, so a reference
+// remains consistent while in use. Snapshot is available when the metadata receiver is called.
+func (sb *Subscriber) MetadataSnapshot() *metadata.Snapshot {
+	sb.metadataSnapshotMutex.RLock()
+	defer sb.metadataSnapshotMutex.RUnlock()
+
+	return sb.metadataSnapshot
+}
+
 // AdjustedValue gets the Value of a Measurement with any linear adjustments applied from the
 // measurement's Adder and Multiplier metadata, if found.
 func (sb *Subscriber) AdjustedValue(measurement *transport.Measurement) float64 {
@@ -185,38 +210,99 @@ func (sb *Subscriber) AdjustedValue(measurement *transport.Measurement) float64
 // subscription will occur after reception of metadata. When the config defines AutoRequestMetadata
 // as false and AutoSubscribe as true, subscription will occur at successful connection.
 func (sb *Subscriber) Dial(address string, config *Config) error {
+	hostname, port, err := sb.parseDialAddress(address)
+
+	if err != nil {
+		return err
+	}
+
+	if config != nil {
+		sb.config = config
+	}
+
+	return sb.connect(context.Background(), hostname, port)
+}
+
+// DialContext starts the client-based connection cycle to an STTP publisher, like Dial, but blocks
+// until the publisher accepts the connection or the context expires. For STTP versions above 2, the
+// connection is accepted when the publisher responds successfully to the operational modes that are
+// sent on connect; a rejection is returned as a *transport.ServerCommandError and the connection is
+// closed. When the context expires, any connection in progress is canceled and the context error
+// is returned.
+func (sb *Subscriber) DialContext(ctx context.Context, address string, config *Config) error {
+	hostname, port, err := sb.parseDialAddress(address)
+
+	if err != nil {
+		return err
+	}
+
+	if config != nil {
+		sb.config = config
+	}
+
+	var response <-chan error
+
+	// Publisher only responds to operational modes for STTP versions above 2
+	if sb.config.Version > 2 {
+		var release func()
+		response, release = sb.dataSubscriber().AwaitServerResponse(transport.ServerCommand.DefineOperationalModes)
+		defer release()
+	}
+
+	if err = sb.connect(ctx, hostname, port); err != nil {
+		return err
+	}
+
+	if response == nil {
+		return nil
+	}
+
+	select {
+	case err = <-response:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		sb.Disconnect()
+	}
+
+	return err
+}
+
+func (sb *Subscriber) parseDialAddress(address string) (string, uint16, error) {
 	if sb.IsConnected() {
-		return errors.New("subscriber is already connected; cannot dial at this time")
+		return "", 0, errors.New("subscriber is already connected; cannot dial at this time")
 	}
 
 	if sb.IsListening() {
-		return errors.New("subscriber is listening for connections; cannot dial at this time")
+		return "", 0, errors.New("subscriber is listening for connections; cannot dial at this time")
 	}
 
+	return parseAddress(address)
+}
+
+func parseAddress(address string) (string, uint16, error) {
 	hostname, portname, err := net.SplitHostPort(address)
 
 	if err != nil {
-		return err
+		return "", 0, err
 	}
 
 	port, err := strconv.Atoi(portname)
 
 	if err != nil {
-		return fmt.Errorf("invalid port number \"%s\": %s", portname, err.Error())
+		return "", 0, fmt.Errorf("invalid port number \"%s\": %s", portname, err.Error())
 	}
 
 	if port < 1 || port > math.MaxUint16 {
-		return fmt.Errorf("port number \"%s\" is out of range: must be 1 to %d", portname, math.MaxUint16)
+		return "", 0, fmt.Errorf("port number \"%s\" is out of range: must be 1 to %d", portname, math.MaxUint16)
 	}
 
-	if config != nil {
-		sb.config = config
-	}
-
-	return sb.connect(hostname, uint16(port))
+	return hostname, uint16(port), nil
 }
 
-func (sb *Subscriber) connect(hostname string, port uint16) error {
+func (sb *Subscriber) connect(ctx context.Context, hostname string, port uint16) error {
 	if sb.config == nil {
 		panic("Internal Config instance has not been initialized. Make sure to use NewSubscriber.")
 	}
@@ -227,11 +313,31 @@ func (sb *Subscriber) connect(hostname string, port uint16) error {
 	// Set connection properties
 	con.Hostname = hostname
 	con.Port = port
+	con.Endpoints = nil
+
+	if len(sb.config.FailoverAddresses) > 0 {
+		endpoints := []transport.Endpoint{{Hostname: hostname, Port: port}}
+
+		for _, address := range sb.config.FailoverAddresses {
+			failoverHostname, failoverPort, err := parseAddress(address)
+
+			if err != nil {
+				return fmt.Errorf("invalid failover address \"%s\": %w", address, err)
+			}
+
+			endpoints = append(endpoints, transport.Endpoint{Hostname: failoverHostname, Port: failoverPort})
+		}
+
+		con.Endpoints = endpoints
+	}
+
+	con.FailbackInterval = sb.config.FailbackInterval
 
 	con.MaxRetries = sb.config.MaxRetries
 	con.RetryInterval = sb.config.RetryInterval
 	con.MaxRetryInterval = sb.config.MaxRetryInterval
 	con.AutoReconnect = sb.config.AutoReconnect
+	con.TLSConfig = sb.config.TLSConfig
 
 	ds.CompressPayloadData = sb.config.CompressPayloadData
 	ds.CompressMetadata = sb.config.CompressMetadata
@@ -250,7 +356,8 @@ func (sb *Subscriber) connect(hostname string, port uint16) error {
 
 	// Register callbacks with intermediate handlers
 	con.ReconnectCallback = sb.handleReconnect
-	ds.MetadataReceivedCallback = sb.handleMetadataReceived
+	con.ActiveEndpointChangedCallback = sb.handleActiveEndpointChanged
+	ds.MetadataReaderCallback = sb.handleMetadataReceived
 	ds.DataStartTimeCallback = sb.handleDataStartTime
 	ds.ConfigurationChangedCallback = sb.handleConfigurationChanged
 	ds.ProcessingCompleteCallback = sb.handleProcessingComplete
@@ -262,13 +369,21 @@ func (sb *Subscriber) connect(hostname string, port uint16) error {
 	var err error
 
 	// Connect and subscribe to publisher
-	switch con.Connect(ds) {
+	switch con.ConnectContext(ctx, ds) {
 	case transport.ConnectStatus.Success:
 		sb.handleConnect()
 	case transport.ConnectStatus.Failed:
-		err = errors.New("all connection attempts failed")
+		var handshakeErr *transport.TLSHandshakeError
+
+		if errors.As(con.LastError(), &handshakeErr) {
+			err = fmt.Errorf("all connection attempts failed: %w", handshakeErr)
+		} else {
+			err = errors.New("all connection attempts failed")
+		}
 	case transport.ConnectStatus.Canceled:
-		err = errors.New("connection canceled")
+		if err = ctx.Err(); err == nil {
+			err = errors.New("connection canceled")
+		}
 	}
 
 	return err
@@ -290,27 +405,17 @@ func (sb *Subscriber) Listen(address string, config *Config) error {
 		return errors.New("subscriber is already connected; cannot listen at this time")
 	}
 
-	networkInterface, portname, err := net.SplitHostPort(address)
+	networkInterface, port, err := parseAddress(address)
 
 	if err != nil {
 		return err
 	}
 
-	port, err := strconv.Atoi(portname)
-
-	if err != nil {
-		return fmt.Errorf("invalid port number \"%s\": %s", portname, err.Error())
-	}
-
-	if port < 1 || port > math.MaxUint16 {
-		return fmt.Errorf("port number \"%s\" is out of range: must be 1 to %d", portname, math.MaxUint16)
-	}
-
 	if config != nil {
 		sb.config = config
 	}
 
-	return sb.listen(uint16(port), networkInterface)
+	return sb.listen(port, networkInterface)
 }
 
 func (sb *Subscriber) listen(port uint16, networkInterface string) error {
@@ -319,6 +424,7 @@ func (sb *Subscriber) listen(port uint16, networkInterface string) error {
 	}
 
 	ds := sb.dataSubscriber()
+	ds.Connector().TLSConfig = sb.config.TLSConfig
 
 	ds.CompressPayloadData = sb.config.CompressPayloadData
 	ds.CompressMetadata = sb.config.CompressMetadata
@@ -335,7 +441,7 @@ func (sb *Subscriber) listen(port uint16, networkInterface string) error {
 
 	// Register callbacks with intermediate handlers
 	ds.ConnectionEstablishedCallback = sb.handleConnect
-	ds.MetadataReceivedCallback = sb.handleMetadataReceived
+	ds.MetadataReaderCallback = sb.handleMetadataReceived
 	ds.DataStartTimeCallback = sb.handleDataStartTime
 	ds.ConfigurationChangedCallback = sb.handleConfigurationChanged
 	ds.ProcessingCompleteCallback = sb.handleProcessingComplete
@@ -355,20 +461,37 @@ func (sb *Subscriber) Disconnect() {
 // RequestMetadata sends a request to the data publisher indicating that the Subscriber would
 // like new metadata. Any defined MetadataFilters will be included in request.
 func (sb *Subscriber) RequestMetadata() {
-	ds := sb.dataSubscriber()
+	sb.dataSubscriber().SendServerCommandWithPayload(transport.ServerCommand.MetadataRefresh, sb.metadataRequestPayload())
+}
 
+// metadataRequestPayload gets the MetadataRefresh command payload for any defined MetadataFilters.
+func (sb *Subscriber) metadataRequestPayload() []byte {
 	if len(sb.config.MetadataFilters) == 0 {
-		ds.SendServerCommand(transport.ServerCommand.MetadataRefresh)
-		return
+		return nil
 	}
 
-	filters := ds.EncodeString(sb.config.MetadataFilters)
+	filters := sb.dataSubscriber().EncodeString(sb.config.MetadataFilters)
 	buffer := make([]byte, 4+len(filters))
 
 	binary.BigEndian.PutUint32(buffer, uint32(len(filters)))
 	copy(buffer[4:], filters)
 
-	ds.SendServerCommandWithPayload(transport.ServerCommand.MetadataRefresh, buffer)
+	return buffer
+}
+
+// RequestMetadataContext sends a request to the data publisher for new metadata, like RequestMetadata,
+// then blocks until the metadata received in response to this request has been parsed or the context
+// expires. When the request is rejected by the publisher, a *transport.ServerCommandError is returned.
+// An error is also returned when the received metadata could not be decompressed or parsed. Upon success,
+// MetadataSnapshot reflects the new metadata.
+func (sb *Subscriber) RequestMetadataContext(ctx context.Context) error {
+	ds := sb.dataSubscriber()
+
+	if !ds.IsConnected() {
+		return transport.ErrNotConnected
+	}
+
+	return ds.RequestMetadataContext(ctx, sb.metadataRequestPayload(), sb.readMetadata)
 }
 
 // Subscribe sets up a request indicating that the Subscriber would like to start receiving
@@ -393,7 +516,30 @@ func (sb *Subscriber) RequestMetadata() {
 // Settings parameter controls subscription related settings, set value to nil for default values.
 func (sb *Subscriber) Subscribe(filterExpression string, settings *Settings) {
 	ds := sb.dataSubscriber()
-	sub := ds.Subscription()
+	sb.applySubscription(filterExpression, settings)
+
+	if ds.IsConnected() {
+		ds.Subscribe()
+	}
+}
+
+// SubscribeContext requests a subscription from a connected data publisher, like Subscribe, then
+// blocks until the publisher responds to the subscription request or the context expires. When the
+// publisher rejects the subscription, e.g., due to an invalid filter expression, a
+// *transport.ServerCommandError carrying the publisher's failure message is returned.
+func (sb *Subscriber) SubscribeContext(ctx context.Context, filterExpression string, settings *Settings) error {
+	ds := sb.dataSubscriber()
+	sb.applySubscription(filterExpression, settings)
+
+	if !ds.IsConnected() {
+		return transport.ErrNotConnected
+	}
+
+	return ds.SubscribeContext(ctx)
+}
+
+func (sb *Subscriber) applySubscription(filterExpression string, settings *Settings) {
+	sub := sb.dataSubscriber().Subscription()
 
 	if settings == nil {
 		settings = &settingsDefaults
@@ -423,10 +569,6 @@ func (sb *Subscriber) Subscribe(filterExpression string, settings *Settings) {
 	sub.ConstraintParameters = settings.ConstraintParameters
 	sub.ProcessingInterval = settings.ProcessingInterval
 	sub.ExtraConnectionStringParameters = settings.ExtraConnectionStringParameters
-
-	if ds.IsConnected() {
-		ds.Subscribe()
-	}
 }
 
 // Unsubscribe sends a request to the data publisher indicating that the Subscriber would
@@ -435,9 +577,17 @@ func (sb *Subscriber) Unsubscribe() {
 	sb.dataSubscriber().Unsubscribe()
 }
 
-// ReadMeasurements sets up a new MeasurementReader to start reading measurements.
+// ReadMeasurements sets up a new MeasurementReader to start reading measurements
+// using default buffering settings.
 func (sb *Subscriber) ReadMeasurements() *MeasurementReader {
-	return newMeasurementReader(sb)
+	return newMeasurementReader(sb, nil)
+}
+
+// ReadMeasurementsWithConfig sets up a new MeasurementReader to start reading measurements.
+// Config parameter controls buffering related settings, set value to nil for default values.
+// Setting up a reader replaces any defined new measurements receiver.
+func (sb *Subscriber) ReadMeasurementsWithConfig(config *ReaderConfig) *MeasurementReader {
+	return newMeasurementReader(sb, config)
 }
 
 // beginCallbackAssignment informs Subscriber that a callback change has been initiated.
@@ -514,15 +664,48 @@ func (sb *Subscriber) handleReconnect(ds *transport.DataSubscriber) {
 	}
 }
 
-func (sb *Subscriber) handleMetadataReceived(metadata []byte) {
+func (sb *Subscriber) handleActiveEndpointChanged(endpoint transport.Endpoint) {
+	sb.beginCallbackSync()
+
+	if sb.activeEndpointChangedReceiver != nil {
+		sb.activeEndpointChangedReceiver(endpoint.String())
+	}
+
+	sb.endCallbackSync()
+}
+
+func (sb *Subscriber) handleMetadataReceived(reader io.Reader) {
+	_ = sb.readMetadata(reader)
+}
+
+// readMetadata loads received metadata, then subscribes when configured to do so after metadata is received.
+func (sb *Subscriber) readMetadata(reader io.Reader) error {
+	err := sb.loadMetadata(reader)
+
+	if sb.config.AutoRequestMetadata && sb.config.AutoSubscribe {
+		sb.dataSubscriber().Subscribe()
+	}
+
+	return err
+}
+
+// loadMetadata parses metadata XML, updates local metadata and notifies metadata receiver.
+func (sb *Subscriber) loadMetadata(reader io.Reader) error {
 	parseStarted := time.Now()
 	dataSet := data.NewDataSet()
-	err := dataSet.ParseXml(metadata)
+	err := dataSet.ReadXml(reader)
 
 	if err == nil {
 		sb.loadMeasurementMetadata(dataSet)
+
+		snapshot := metadata.NewSnapshot(dataSet)
+
+		sb.metadataSnapshotMutex.Lock()
+		sb.metadataSnapshot = snapshot
+		sb.metadataSnapshotMutex.Unlock()
 	} else {
 		sb.ErrorMessage("Failed to parse received XML metadata: " + err.Error())
+		err = fmt.Errorf("failed to parse received XML metadata: %w", err)
 	}
 
 	sb.showMetadataSummary(dataSet, parseStarted)
@@ -535,9 +718,7 @@ func (sb *Subscriber) handleMetadataReceived(metadata []byte) {
 
 	sb.endCallbackSync()
 
-	if sb.config.AutoRequestMetadata && sb.config.AutoSubscribe {
-		sb.dataSubscriber().Subscribe()
-	}
+	return err
 }
 
 func (sb *Subscriber) loadMeasurementMetadata(dataSet *data.DataSet) {
@@ -829,6 +1010,48 @@ func (sb *Subscriber) SetConnectionEstablishedReceiver(callback func()) {
 	sb.connectionEstablishedReceiver = callback
 }
 
+// SetEventChannel defines a channel that receives a structured transport.Event for each diagnostic
+// raised by the Subscriber connection, in addition to the status and error message loggers. Events
+// are sent without blocking, so the channel should be buffered; undeliverable events are dropped.
+// Assignment will take effect immediately, even while subscription is active.
+func (sb *Subscriber) SetEventChannel(events chan<- transport.Event) {
+	ds := sb.dataSubscriber()
+	ds.BeginCallbackAssignment()
+	defer ds.EndCallbackAssignment()
+
+	ds.EventChannel = events
+}
+
+// SetEventHandler defines a log/slog handler that receives each diagnostic raised by the Subscriber
+// connection as a log record, in addition to the status and error message loggers.
+// Assignment will take effect immediately, even while subscription is active.
+func (sb *Subscriber) SetEventHandler(handler slog.Handler) {
+	ds := sb.dataSubscriber()
+	ds.BeginCallbackAssignment()
+	defer ds.EndCallbackAssignment()
+
+	ds.EventHandler = handler
+}
+
+// SetCaptureWriter defines a CaptureWriter that receives every raw frame sent or received by the
+// Subscriber connection, e.g., for decoding with the sttpdump tool. Set to nil to stop capturing.
+// Assignment will take effect immediately, even while subscription is active.
+func (sb *Subscriber) SetCaptureWriter(writer *transport.CaptureWriter) {
+	sb.dataSubscriber().SetCaptureWriter(writer)
+}
+
+// SetActiveEndpointChangedReceiver defines the callback that handles notification that a connection
+// has been established to a different publisher address than the previous connection, e.g., after a
+// failover to, or fail-back from, one of the configured FailoverAddresses. The address is reported in
+// the same "hostname:port" format as ConnectionID.
+// Assignment will take effect immediately, even while subscription is active.
+func (sb *Subscriber) SetActiveEndpointChangedReceiver(callback func(address string)) {
+	sb.beginCallbackAssignment()
+	defer sb.endCallbackAssignment()
+
+	sb.activeEndpointChangedReceiver = callback
+}
+
 // SetConnectionTerminatedReceiver defines the callback that handles notification that a connection has been terminated.
 // Default implementation simply writes connection terminated feedback to ErrorMessage handler.
 // Assignment will take effect immediately, even while subscription is active.
@@ -0,0 +1,281 @@
+//******************************************************************************************************
+//  Subscriber_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package sttp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/internal/transporttest"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func newTestSubscriber() (*Subscriber, *Config) {
+	subscriber := NewSubscriber()
+	subscriber.SetStatusMessageLogger(func(string) {})
+	subscriber.SetErrorMessageLogger(func(string) {})
+	subscriber.SetConnectionEstablishedReceiver(func() {})
+	subscriber.SetConnectionTerminatedReceiver(func() {})
+
+	config := NewConfig()
+	config.AutoReconnect = false
+	config.AutoRequestMetadata = false
+	config.AutoSubscribe = false
+	config.MaxRetries = 1
+	config.RetryInterval = 10
+	config.MaxRetryInterval = 10
+
+	return subscriber, config
+}
+
+func TestContextOperations(t *testing.T) {
+	publisher := transport.NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Stop()
+
+	subscriber, config := newTestSubscriber()
+	defer subscriber.Close()
+
+	config.Version = 3
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := subscriber.DialContext(ctx, transporttest.Address(publisher), config); err != nil {
+		t.Fatalf("TestContextOperations: DialContext failed: %s", err.Error())
+	}
+
+	if !subscriber.IsValidated() {
+		t.Fatalf("TestContextOperations: expected connection to be validated after DialContext")
+	}
+
+	if err := subscriber.RequestMetadataContext(ctx); err != nil {
+		t.Fatalf("TestContextOperations: RequestMetadataContext failed: %s", err.Error())
+	}
+
+	if snapshot := subscriber.MetadataSnapshot(); snapshot == nil || snapshot.MeasurementCount() == 0 {
+		t.Fatalf("TestContextOperations: expected metadata snapshot after RequestMetadataContext")
+	}
+
+	if err := subscriber.SubscribeContext(ctx, "FILTER ActiveMeasurements WHERE SignalType = 'FREQ'", nil); err != nil {
+		t.Fatalf("TestContextOperations: SubscribeContext failed: %s", err.Error())
+	}
+
+	if !subscriber.IsSubscribed() {
+		t.Fatalf("TestContextOperations: expected subscriber to be subscribed after SubscribeContext")
+	}
+
+	err := subscriber.SubscribeContext(ctx, "FILTER ActiveMeasurements WHERE", nil)
+
+	var commandErr *transport.ServerCommandError
+
+	if !errors.As(err, &commandErr) {
+		t.Fatalf("TestContextOperations: expected ServerCommandError for invalid filter expression, received: %v", err)
+	}
+
+	if commandErr.Command != transport.ServerCommand.Subscribe {
+		t.Fatalf("TestContextOperations: unexpected failed command: %s", commandErr.Command.String())
+	}
+
+	if !strings.Contains(commandErr.Message, "filter expression") {
+		t.Fatalf("TestContextOperations: unexpected failure message: %s", commandErr.Message)
+	}
+}
+
+func TestRequestMetadataContextDecompressionFailure(t *testing.T) {
+	// Publisher that ignores requested compression sends metadata that subscriber cannot decompress
+	publisher := transport.NewDataPublisher()
+	publisher.CompressMetadata = false
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Stop()
+
+	subscriber, config := newTestSubscriber()
+	defer subscriber.Close()
+
+	config.CompressMetadata = true
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := subscriber.DialContext(ctx, transporttest.Address(publisher), config); err != nil {
+		t.Fatalf("TestRequestMetadataContextDecompressionFailure: DialContext failed: %s", err.Error())
+	}
+
+	result := make(chan error, 1)
+
+	// Context without a deadline must not block when metadata cannot be decompressed
+	go func() {
+		result <- subscriber.RequestMetadataContext(context.Background())
+	}()
+
+	select {
+	case err := <-result:
+		if !errors.Is(err, transport.ErrMetadataDecompression) {
+			t.Fatalf("TestRequestMetadataContextDecompressionFailure: expected ErrMetadataDecompression, received: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("TestRequestMetadataContextDecompressionFailure: RequestMetadataContext did not return")
+	}
+
+	if subscriber.MetadataSnapshot() != nil {
+		t.Fatal("TestRequestMetadataContextDecompressionFailure: expected no metadata snapshot")
+	}
+}
+
+func TestDialContextRejected(t *testing.T) {
+	publisher := transport.NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Stop()
+
+	subscriber, config := newTestSubscriber()
+	defer subscriber.Close()
+
+	// Version is not supported by the publisher
+	config.Version = 12
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := subscriber.DialContext(ctx, transporttest.Address(publisher), config)
+
+	var commandErr *transport.ServerCommandError
+
+	if !errors.As(err, &commandErr) {
+		t.Fatalf("TestDialContextRejected: expected ServerCommandError, received: %v", err)
+	}
+
+	if commandErr.Command != transport.ServerCommand.DefineOperationalModes {
+		t.Fatalf("TestDialContextRejected: unexpected failed command: %s", commandErr.Command.String())
+	}
+
+	if !strings.Contains(commandErr.Message, "not supported") {
+		t.Fatalf("TestDialContextRejected: unexpected failure message: %s", commandErr.Message)
+	}
+}
+
+func TestDialContextCanceled(t *testing.T) {
+	// Reserve a local port with no listener so that every connection attempt is refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("TestDialContextCanceled: failed to reserve port: %s", err.Error())
+	}
+
+	address := listener.Addr().String()
+	listener.Close()
+
+	subscriber, config := newTestSubscriber()
+	defer subscriber.Close()
+
+	config.MaxRetries = -1
+	config.RetryInterval = 50
+	config.MaxRetryInterval = 1000
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	err = subscriber.DialContext(ctx, address, config)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("TestDialContextCanceled: expected deadline exceeded, received: %v", err)
+	}
+
+	if elapsed := time.Since(started); elapsed > 2*time.Second {
+		t.Fatalf("TestDialContextCanceled: cancellation took too long: %s", elapsed)
+	}
+}
+
+func TestContextOperationsNotConnected(t *testing.T) {
+	subscriber, _ := newTestSubscriber()
+	defer subscriber.Close()
+
+	if err := subscriber.SubscribeContext(context.Background(), "FILTER ActiveMeasurements WHERE SignalType = 'FREQ'", nil); !errors.Is(err, transport.ErrNotConnected) {
+		t.Fatalf("TestContextOperationsNotConnected: expected ErrNotConnected from SubscribeContext, received: %v", err)
+	}
+
+	if err := subscriber.RequestMetadataContext(context.Background()); !errors.Is(err, transport.ErrNotConnected) {
+		t.Fatalf("TestContextOperationsNotConnected: expected ErrNotConnected from RequestMetadataContext, received: %v", err)
+	}
+}
+
+func TestDialFailoverAddresses(t *testing.T) {
+	publisher := transport.NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Stop()
+
+	// Reserve a local port with no listener so that primary connection attempts are refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("TestDialFailoverAddresses: failed to reserve port: %s", err.Error())
+	}
+
+	primary := listener.Addr().String()
+	listener.Close()
+
+	subscriber, config := newTestSubscriber()
+	defer subscriber.Close()
+
+	changed := make(chan string, 10)
+	subscriber.SetActiveEndpointChangedReceiver(func(address string) {
+		changed <- address
+	})
+
+	backup := transporttest.Address(publisher)
+	config.FailoverAddresses = []string{backup}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err = subscriber.DialContext(ctx, primary, config); err != nil {
+		t.Fatalf("TestDialFailoverAddresses: DialContext failed: %s", err.Error())
+	}
+
+	if subscriber.ConnectionID() != backup {
+		t.Fatalf("TestDialFailoverAddresses: expected connection ID %s, received: %s", backup, subscriber.ConnectionID())
+	}
+
+	select {
+	case address := <-changed:
+		if address != backup {
+			t.Fatalf("TestDialFailoverAddresses: unexpected active endpoint: %s", address)
+		}
+	case <-ctx.Done():
+		t.Fatalf("TestDialFailoverAddresses: timed out waiting for active endpoint notification")
+	}
+
+	invalidSubscriber, invalidConfig := newTestSubscriber()
+	defer invalidSubscriber.Close()
+
+	invalidConfig.FailoverAddresses = []string{"invalid"}
+
+	if err = invalidSubscriber.Dial(primary, invalidConfig); err == nil || !strings.Contains(err.Error(), "invalid failover address") {
+		t.Fatalf("TestDialFailoverAddresses: expected invalid failover address error, received: %v", err)
+	}
+}
@@ -0,0 +1,444 @@
+//******************************************************************************************************
+//  Adapter.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+	"github.com/tevino/abool/v2"
+)
+
+// maxFrameSize is the largest possible frame, i.e., the maximum FRAMESIZE value
+const maxFrameSize = 65535
+
+// Adapter is an input adapter that connects to an IEEE C37.118 PMU or PDC and maps received data
+// frames to STTP measurements. Upon connection, the Adapter requests a configuration frame, from
+// which it creates the Mapping of data frame values to measurements and synthesizes metadata, then
+// enables real-time data transmission. Data frames received before a configuration frame are ignored.
+//
+// Mapped measurements can be published to STTP subscribers, e.g.:
+//
+//	adapter.SetConfigurationReceiver(func(mapping *c37118.Mapping) {
+//		publisher.DefineMetadata(mapping.DataSet())
+//	})
+//
+//	adapter.SetMeasurementsReceiver(func(measurements []transport.Measurement) {
+//		publisher.PublishMeasurements(measurements)
+//	})
+type Adapter struct {
+	config AdapterConfig
+
+	connection net.Conn
+	closing    bool
+	completed  chan struct{}
+	mutex      sync.Mutex
+
+	mapping      *Mapping
+	mappingMutex sync.RWMutex
+
+	// Accessed only by the frame reader
+	assembler configurationAssembler
+
+	configurationPending abool.AtomicBool
+
+	measurementsReceiver  func(measurements []transport.Measurement)
+	configurationReceiver func(mapping *Mapping)
+	statusMessageLogger   func(message string)
+	errorMessageLogger    func(message string)
+
+	assigningHandlerMutex sync.RWMutex
+}
+
+// NewAdapter creates a new Adapter. Config parameter controls connection related settings, set value
+// to nil for default values.
+func NewAdapter(config *AdapterConfig) *Adapter {
+	if config == nil {
+		config = &adapterConfigDefaults
+	}
+
+	adapter := &Adapter{config: *config}
+
+	if adapter.config.Network == "" {
+		adapter.config.Network = adapterConfigDefaults.Network
+	}
+
+	return adapter
+}
+
+// SetMeasurementsReceiver defines the callback that handles the measurements mapped from each received
+// data frame; the receiver owns the provided slice. Assignment will take effect immediately, even
+// while connected.
+func (a *Adapter) SetMeasurementsReceiver(callback func(measurements []transport.Measurement)) {
+	a.assigningHandlerMutex.Lock()
+	defer a.assigningHandlerMutex.Unlock()
+
+	a.measurementsReceiver = callback
+}
+
+// SetConfigurationReceiver defines the callback that handles a new Mapping created from a received
+// configuration frame. Assignment will take effect immediately, even while connected.
+func (a *Adapter) SetConfigurationReceiver(callback func(mapping *Mapping)) {
+	a.assigningHandlerMutex.Lock()
+	defer a.assigningHandlerMutex.Unlock()
+
+	a.configurationReceiver = callback
+}
+
+// SetStatusMessageLogger defines the callback that handles informational message logging.
+// Assignment will take effect immediately, even while connected.
+func (a *Adapter) SetStatusMessageLogger(callback func(message string)) {
+	a.assigningHandlerMutex.Lock()
+	defer a.assigningHandlerMutex.Unlock()
+
+	a.statusMessageLogger = callback
+}
+
+// SetErrorMessageLogger defines the callback that handles error message logging.
+// Assignment will take effect immediately, even while connected.
+func (a *Adapter) SetErrorMessageLogger(callback func(message string)) {
+	a.assigningHandlerMutex.Lock()
+	defer a.assigningHandlerMutex.Unlock()
+
+	a.errorMessageLogger = callback
+}
+
+// Dial connects to the PMU or PDC at the specified address, in "hostname:port" format, and requests
+// its configuration frame.
+func (a *Adapter) Dial(address string) error {
+	return a.DialContext(context.Background(), address)
+}
+
+// DialContext connects to the PMU or PDC at the specified address, in "hostname:port" format, and
+// requests its configuration frame. The provided context bounds the connection attempt.
+func (a *Adapter) DialContext(ctx context.Context, address string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.connection != nil {
+		return errors.New("adapter is already connected")
+	}
+
+	if a.config.Network != "tcp" && a.config.Network != "udp" {
+		return errors.New("unsupported network \"" + a.config.Network + "\", expected \"tcp\" or \"udp\"")
+	}
+
+	dialer := net.Dialer{Timeout: time.Duration(a.config.ConnectTimeout) * time.Millisecond}
+	connection, err := dialer.DialContext(ctx, a.config.Network, address)
+
+	if err != nil {
+		return errors.New("failed to connect to \"" + address + "\": " + err.Error())
+	}
+
+	a.connection = connection
+	a.closing = false
+	a.completed = make(chan struct{})
+	a.assembler = configurationAssembler{}
+	a.configurationPending.UnSet()
+
+	go a.runFrameReader(connection, a.completed)
+
+	a.statusMessage("Connected to IEEE C37.118 device at " + address + " over " + a.config.Network + ".")
+
+	return a.requestConfiguration(connection)
+}
+
+// Close disconnects the Adapter, waiting for frame processing to complete.
+func (a *Adapter) Close() {
+	a.mutex.Lock()
+	connection, completed := a.connection, a.completed
+	a.closing = true
+	a.connection = nil
+	a.mutex.Unlock()
+
+	if connection == nil {
+		return
+	}
+
+	connection.Close()
+	<-completed
+}
+
+// IsConnected determines if the Adapter is connected to a PMU or PDC.
+func (a *Adapter) IsConnected() bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.connection != nil
+}
+
+// SendCommand sends the specified command to the connected PMU or PDC.
+func (a *Adapter) SendCommand(command CommandEnum) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.connection == nil {
+		return errors.New("adapter is not connected")
+	}
+
+	return a.sendCommand(a.connection, command)
+}
+
+func (a *Adapter) sendCommand(connection net.Conn, command CommandEnum) error {
+	version := byte(1)
+
+	if a.config.UseConfigurationFrame3 {
+		version = 2
+	}
+
+	if _, err := connection.Write(EncodeCommandFrame(a.config.IDCode, command, ticks.UtcNow(), version)); err != nil {
+		return errors.New("failed to send " + command.String() + " command: " + err.Error())
+	}
+
+	return nil
+}
+
+func (a *Adapter) requestConfiguration(connection net.Conn) error {
+	command := Command.SendConfig2
+
+	if a.config.UseConfigurationFrame3 {
+		command = Command.SendConfig3
+	}
+
+	a.configurationPending.Set()
+	return a.sendCommand(connection, command)
+}
+
+// Mapping gets the Mapping created from the most recently received configuration frame, or nil if
+// no configuration frame has been received.
+func (a *Adapter) Mapping() *Mapping {
+	a.mappingMutex.RLock()
+	defer a.mappingMutex.RUnlock()
+
+	return a.mapping
+}
+
+// Configuration gets the most recently received configuration frame, or nil if no configuration
+// frame has been received.
+func (a *Adapter) Configuration() *ConfigurationFrame {
+	if mapping := a.Mapping(); mapping != nil {
+		return mapping.Configuration()
+	}
+
+	return nil
+}
+
+// Metadata gets the metadata synthesized from the most recently received configuration frame, see
+// Mapping.DataSet, or nil if no configuration frame has been received.
+func (a *Adapter) Metadata() *data.DataSet {
+	if mapping := a.Mapping(); mapping != nil {
+		return mapping.DataSet()
+	}
+
+	return nil
+}
+
+func (a *Adapter) runFrameReader(connection net.Conn, completed chan struct{}) {
+	defer close(completed)
+
+	var err error
+
+	if a.config.Network == "udp" {
+		err = a.readDatagrams(connection)
+	} else {
+		err = a.readStream(connection)
+	}
+
+	a.mutex.Lock()
+	closing := a.closing
+
+	if a.connection == connection {
+		a.connection = nil
+	}
+
+	a.mutex.Unlock()
+	connection.Close()
+
+	if !closing {
+		a.errorMessage("Connection to IEEE C37.118 device terminated: " + err.Error())
+	}
+}
+
+// readStream reads frames from a TCP connection, re-synchronizing on the SYNC byte after invalid data.
+func (a *Adapter) readStream(connection net.Conn) error {
+	reader := bufio.NewReaderSize(connection, maxFrameSize)
+	buffer := make([]byte, maxFrameSize)
+
+	for {
+		header, err := reader.Peek(4)
+
+		if err != nil {
+			return err
+		}
+
+		frameSize := int(binary.BigEndian.Uint16(header[2:]))
+
+		if header[0] != syncByte || header[1]&0x80 != 0 || frameSize < commonHeaderSize+checksumSize {
+			reader.Discard(1)
+			continue
+		}
+
+		if _, err := io.ReadFull(reader, buffer[:frameSize]); err != nil {
+			return err
+		}
+
+		a.processFrame(connection, buffer[:frameSize])
+	}
+}
+
+// readDatagrams reads frames from a UDP socket, where each datagram holds one frame.
+func (a *Adapter) readDatagrams(connection net.Conn) error {
+	buffer := make([]byte, maxFrameSize)
+
+	for {
+		length, err := connection.Read(buffer)
+
+		if err != nil {
+			return err
+		}
+
+		a.processFrame(connection, buffer[:length])
+	}
+}
+
+func (a *Adapter) processFrame(connection net.Conn, frame []byte) {
+	header, payload, err := ParseFrame(frame)
+
+	if err != nil {
+		a.errorMessage("Discarded received frame: " + err.Error())
+		return
+	}
+
+	switch header.FrameType {
+	case FrameType.Data:
+		a.processDataFrame(connection, header, payload)
+	case FrameType.Config1, FrameType.Config2, FrameType.Config3:
+		config, err := a.assembler.add(header, payload)
+
+		if err != nil {
+			a.errorMessage("Failed to parse " + header.FrameType.String() + " frame: " + err.Error())
+			return
+		}
+
+		if config != nil {
+			a.applyConfiguration(connection, config)
+		}
+	}
+}
+
+func (a *Adapter) processDataFrame(connection net.Conn, header CommonFrameHeader, payload []byte) {
+	mapping := a.Mapping()
+
+	if mapping == nil {
+		return
+	}
+
+	frame, err := parseData(header, payload, mapping.Configuration())
+
+	if err != nil {
+		// Configuration changes are handled by requesting the new configuration, once
+		if errors.Is(err, ErrConfigurationMismatch) && !a.configurationPending.IsSet() {
+			a.errorMessage("Received data frame does not match configuration, requesting new configuration frame.")
+			a.sendLocked(connection, a.requestConfiguration)
+		}
+
+		return
+	}
+
+	a.assigningHandlerMutex.RLock()
+	defer a.assigningHandlerMutex.RUnlock()
+
+	if a.measurementsReceiver != nil {
+		a.measurementsReceiver(mapping.Measurements(frame))
+	}
+}
+
+func (a *Adapter) applyConfiguration(connection net.Conn, config *ConfigurationFrame) {
+	mapping := NewMapping(config)
+
+	a.mappingMutex.Lock()
+	a.mapping = mapping
+	a.mappingMutex.Unlock()
+
+	a.configurationPending.UnSet()
+
+	a.statusMessage("Received " + config.FrameType.String() + " frame defining " + strconv.Itoa(len(config.Cells)) +
+		" PMUs and " + strconv.Itoa(len(mapping.signals)) + " measurements.")
+
+	a.assigningHandlerMutex.RLock()
+
+	if a.configurationReceiver != nil {
+		a.configurationReceiver(mapping)
+	}
+
+	a.assigningHandlerMutex.RUnlock()
+
+	if a.config.AutoStartDataTransmission {
+		a.sendLocked(connection, func(connection net.Conn) error {
+			return a.sendCommand(connection, Command.EnableRealTimeData)
+		})
+	}
+}
+
+// sendLocked sends a command from the frame reader, unless the Adapter is closing.
+func (a *Adapter) sendLocked(connection net.Conn, send func(connection net.Conn) error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if a.connection != connection {
+		return
+	}
+
+	if err := send(connection); err != nil {
+		a.errorMessage(err.Error())
+	}
+}
+
+func (a *Adapter) statusMessage(message string) {
+	a.assigningHandlerMutex.RLock()
+	defer a.assigningHandlerMutex.RUnlock()
+
+	if a.statusMessageLogger != nil {
+		a.statusMessageLogger(message)
+	}
+}
+
+func (a *Adapter) errorMessage(message string) {
+	a.assigningHandlerMutex.RLock()
+	defer a.assigningHandlerMutex.RUnlock()
+
+	if a.errorMessageLogger != nil {
+		a.errorMessageLogger(message)
+	}
+}
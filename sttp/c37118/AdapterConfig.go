@@ -0,0 +1,62 @@
+//******************************************************************************************************
+//  AdapterConfig.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+// AdapterConfig defines the IEEE C37.118 connection related settings for an Adapter.
+type AdapterConfig struct {
+	// Network defines the transport used to connect to the PMU or PDC, i.e., "tcp" or "udp". With
+	// UDP, commands are sent to the dialed address and frames are received on the local socket.
+	Network string
+
+	// IDCode defines the ID code of the data stream, used to address sent command frames.
+	IDCode uint16
+
+	// UseConfigurationFrame3 determines if configuration frame 3 is requested instead of
+	// configuration frame 2. Configuration frame 3 defines phasor phases, PMU locations and
+	// global PMU IDs, but is only supported by IEEE C37.118.2-2011 devices.
+	UseConfigurationFrame3 bool
+
+	// AutoStartDataTransmission determines if real-time data transmission is enabled once a
+	// configuration frame has been received; otherwise, Adapter.SendCommand must be used to enable
+	// data transmission.
+	AutoStartDataTransmission bool
+
+	// ConnectTimeout defines the timeout, in milliseconds, for establishing a TCP connection.
+	ConnectTimeout int32
+}
+
+// adapterConfigDefaults define the default values for Adapter AdapterConfig.
+var adapterConfigDefaults = AdapterConfig{
+	Network:                   "tcp",
+	IDCode:                    1,
+	UseConfigurationFrame3:    false,
+	AutoStartDataTransmission: true,
+	ConnectTimeout:            5000,
+}
+
+// NewAdapterConfig creates a new AdapterConfig instance initialized with default values.
+func NewAdapterConfig() *AdapterConfig {
+	config := adapterConfigDefaults
+	return &config
+}
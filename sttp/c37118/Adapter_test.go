@@ -0,0 +1,202 @@
+//******************************************************************************************************
+//  Adapter_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// serveTestDevice simulates a PMU that responds to a configuration request with a configuration frame
+// and to an enable real-time data command with the specified number of data frames.
+func serveTestDevice(t *testing.T, read func([]byte) error, write func([]byte) error, frames int) {
+	t.Helper()
+
+	buffer := make([]byte, commandFrameSize)
+
+	for _, expected := range []CommandEnum{Command.SendConfig2, Command.EnableRealTimeData} {
+		if err := read(buffer); err != nil {
+			t.Errorf("TestAdapter: failed to read command: %s", err.Error())
+			return
+		}
+
+		header, payload, err := ParseFrame(buffer)
+
+		if err != nil || header.FrameType != FrameType.Command || header.IDCode != testStreamIDCode {
+			t.Errorf("TestAdapter: received invalid command frame: %v", err)
+			return
+		}
+
+		if command := CommandEnum(binary.BigEndian.Uint16(payload)); command != expected {
+			t.Errorf("TestAdapter: expected %s command, received: %s", expected, command)
+			return
+		}
+
+		if expected == Command.SendConfig2 {
+			write(buildConfigurationFrame2(testCells))
+			continue
+		}
+
+		for i := 0; i < frames; i++ {
+			write(buildDataFrame(0, uint32(i*33333)))
+		}
+	}
+}
+
+func testAdapter(t *testing.T, network, address string) {
+	config := NewAdapterConfig()
+	config.Network = network
+	config.IDCode = testStreamIDCode
+
+	adapter := NewAdapter(config)
+	mappings := make(chan *Mapping, 1)
+	measurements := make(chan []transport.Measurement, 3)
+
+	adapter.SetConfigurationReceiver(func(mapping *Mapping) {
+		mappings <- mapping
+	})
+
+	adapter.SetMeasurementsReceiver(func(received []transport.Measurement) {
+		measurements <- received
+	})
+
+	adapter.SetErrorMessageLogger(func(message string) {
+		t.Errorf("TestAdapter: unexpected error: %s", message)
+	})
+
+	if err := adapter.Dial(address); err != nil {
+		t.Fatalf("TestAdapter: failed to connect: %s", err.Error())
+	}
+
+	defer adapter.Close()
+
+	var mapping *Mapping
+
+	select {
+	case mapping = <-mappings:
+	case <-time.After(5 * time.Second):
+		t.Fatal("TestAdapter: timed out waiting for configuration")
+	}
+
+	if adapter.Mapping() != mapping || adapter.Configuration() == nil || adapter.Metadata().Table("MeasurementDetail").RowCount() != 18 {
+		t.Fatal("TestAdapter: adapter does not expose received configuration")
+	}
+
+	for i := 0; i < 3; i++ {
+		select {
+		case received := <-measurements:
+			if len(received) != 18 || received[1].SignalID != mapping.Signals()[1].SignalID || received[1].Value < 60.0 {
+				t.Fatalf("TestAdapter: unexpected measurements for frame %d: %d", i, len(received))
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("TestAdapter: timed out waiting for data frame %d", i)
+		}
+	}
+
+	if !adapter.IsConnected() {
+		t.Fatal("TestAdapter: expected adapter to be connected")
+	}
+
+	adapter.SetErrorMessageLogger(nil)
+	adapter.Close()
+
+	if adapter.IsConnected() {
+		t.Fatal("TestAdapter: expected adapter to be disconnected after close")
+	}
+}
+
+func TestAdapterTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("TestAdapterTCP: failed to listen: %s", err.Error())
+	}
+
+	defer listener.Close()
+
+	go func() {
+		connection, err := listener.Accept()
+
+		if err != nil {
+			return
+		}
+
+		defer connection.Close()
+
+		// Invalid data ahead of frames requires the adapter to re-synchronize
+		connection.Write([]byte{0x00, 0xAA, 0xFF})
+
+		serveTestDevice(t, func(buffer []byte) error {
+			_, err := io.ReadFull(connection, buffer)
+			return err
+		}, func(frame []byte) error {
+			_, err := connection.Write(frame)
+			return err
+		}, 3)
+
+		// Hold connection open until adapter closes it
+		io.Copy(io.Discard, connection)
+	}()
+
+	testAdapter(t, "tcp", listener.Addr().String())
+}
+
+func TestAdapterUDP(t *testing.T) {
+	connection, err := net.ListenPacket("udp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("TestAdapterUDP: failed to listen: %s", err.Error())
+	}
+
+	defer connection.Close()
+
+	go func() {
+		var source net.Addr
+
+		serveTestDevice(t, func(buffer []byte) error {
+			var err error
+			_, source, err = connection.ReadFrom(buffer)
+			return err
+		}, func(frame []byte) error {
+			_, err := connection.WriteTo(frame, source)
+			return err
+		}, 3)
+	}()
+
+	testAdapter(t, "udp", connection.LocalAddr().String())
+}
+
+func TestAdapterUnsupportedNetwork(t *testing.T) {
+	config := NewAdapterConfig()
+	config.Network = "serial"
+
+	if err := NewAdapter(config).Dial("127.0.0.1:4712"); err == nil {
+		t.Fatal("TestAdapterUnsupportedNetwork: expected error for unsupported network")
+	}
+}
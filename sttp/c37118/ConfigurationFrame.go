@@ -0,0 +1,500 @@
+//******************************************************************************************************
+//  ConfigurationFrame.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// ConfigurationFrame defines a parsed IEEE C37.118 configuration frame, i.e., CFG-1, CFG-2 or CFG-3,
+// which describes the content of the data frames of a data stream.
+type ConfigurationFrame struct {
+	CommonFrameHeader
+
+	// TimeBase is the resolution of the fraction of second of frame timestamps.
+	TimeBase uint32
+
+	// Cells are the configurations of the PMUs in the data stream, in data frame order.
+	Cells []*ConfigurationCell
+
+	// DataRate is the rate of data transmission; positive values are frames per second and
+	// negative values are seconds per frame.
+	DataRate int16
+}
+
+// FramesPerSecond gets the rate of data transmission, in frames per second.
+func (cf *ConfigurationFrame) FramesPerSecond() float64 {
+	if cf.DataRate < 0 {
+		return -1.0 / float64(cf.DataRate)
+	}
+
+	return float64(cf.DataRate)
+}
+
+// ConfigurationCell defines the configuration of a single PMU in a data stream.
+type ConfigurationCell struct {
+	// StationName is the name of the station the PMU is installed at.
+	StationName string
+
+	// IDCode is the data source identifier of the PMU.
+	IDCode uint16
+
+	// GlobalID is the globally unique identifier of the PMU; only defined by CFG-3.
+	GlobalID guid.Guid
+
+	// Format defines the encoding of the PMU values in data frames.
+	Format FormatFlagsEnum
+
+	// Phasors are the phasor channel definitions.
+	Phasors []PhasorDefinition
+
+	// Analogs are the analog channel definitions.
+	Analogs []AnalogDefinition
+
+	// Digitals are the digital status word definitions.
+	Digitals []DigitalDefinition
+
+	// NominalFrequency is the nominal line frequency, in Hz, i.e., 50 or 60.
+	NominalFrequency float64
+
+	// ConfigurationCount is the configuration change count of the PMU.
+	ConfigurationCount uint16
+
+	// Latitude is the PMU latitude, in degrees; only defined by CFG-3, zero when not specified.
+	Latitude float64
+
+	// Longitude is the PMU longitude, in degrees; only defined by CFG-3, zero when not specified.
+	Longitude float64
+
+	// Elevation is the PMU elevation, in meters; only defined by CFG-3, zero when not specified.
+	Elevation float64
+}
+
+// PhasorDefinition defines a phasor channel of a PMU.
+type PhasorDefinition struct {
+	// Name is the channel name.
+	Name string
+
+	// Type is the type of the phasor, i.e., voltage or current.
+	Type PhasorTypeEnum
+
+	// Phase is the phase of the phasor as used by the PhasorDetail metadata table, i.e., A, B, C,
+	// "+" (positive sequence), "-" (negative sequence) or "0" (zero sequence). Phase is only defined
+	// by CFG-3; for other configuration frames it is derived from common channel naming conventions,
+	// e.g., "VA" or "IB", and is empty when it cannot be determined.
+	Phase string
+
+	// Scale is the factor that converts integer encoded magnitudes, or rectangular components, to
+	// volts or amperes. Scale does not apply to floating-point encoded phasors.
+	Scale float64
+
+	// AngleOffset is the adjustment, in radians, added to integer encoded phasor angles; only
+	// defined by CFG-3.
+	AngleOffset float64
+}
+
+// AnalogDefinition defines an analog channel of a PMU.
+type AnalogDefinition struct {
+	// Name is the channel name.
+	Name string
+
+	// Type is the analog type, i.e., 0 single point-on-wave, 1 RMS or 2 peak; only defined by
+	// CFG-1 and CFG-2.
+	Type byte
+
+	// Scale is the factor that converts integer encoded values to engineering units. Scale does
+	// not apply to floating-point encoded analogs.
+	Scale float64
+
+	// Offset is the offset added to scaled integer encoded values; only defined by CFG-3.
+	Offset float64
+}
+
+// DigitalDefinition defines a 16-bit digital status word of a PMU.
+type DigitalDefinition struct {
+	// Names are the names of the digital status bits, least significant bit first.
+	Names [16]string
+
+	// NormalStatus is the normal state of the status bits.
+	NormalStatus uint16
+
+	// ValidInputs defines the status bits that are in use.
+	ValidInputs uint16
+}
+
+// ParseConfigurationFrame parses an IEEE C37.118 configuration frame. Fragments of a CFG-3 frame,
+// i.e., frames with a non-zero CONT_IDX, must be reassembled before they can be parsed; the Adapter
+// reassembles fragments automatically.
+func ParseConfigurationFrame(buffer []byte) (*ConfigurationFrame, error) {
+	header, payload, err := ParseFrame(buffer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch header.FrameType {
+	case FrameType.Config1, FrameType.Config2:
+		return parseConfiguration(header, payload)
+	case FrameType.Config3:
+		if len(payload) < 2 {
+			return nil, ErrInvalidFrame
+		}
+
+		if index := uint16(payload[0])<<8 | uint16(payload[1]); index != 0 {
+			return nil, fmt.Errorf("%w: CFG-3 fragment %d must be reassembled", ErrInvalidFrame, index)
+		}
+
+		return parseConfiguration(header, payload[2:])
+	default:
+		return nil, fmt.Errorf("%w: %s frame is not a configuration frame", ErrInvalidFrame, header.FrameType)
+	}
+}
+
+// maxAssembledConfigurationSize is the maximum size of a reassembled CFG-3 frame payload, this
+// limits buffered fragments when a final fragment is never received.
+const maxAssembledConfigurationSize = 16 * 1024 * 1024
+
+// configurationAssembler reassembles CFG-3 frames that have been fragmented, i.e., frames with a
+// CONT_IDX of 1 for the first fragment, increasing for each following fragment, and 0xFFFF for the
+// last fragment. Fragments that are out of sequence, are from another data stream or exceed the
+// maximum assembled size reset the assembler.
+type configurationAssembler struct {
+	header  CommonFrameHeader
+	payload []byte
+	next    uint16
+}
+
+// add adds the payload of a received configuration frame, returning the configuration frame once
+// complete; nil is returned for a fragment of an incomplete frame.
+func (ca *configurationAssembler) add(header CommonFrameHeader, payload []byte) (*ConfigurationFrame, error) {
+	if header.FrameType != FrameType.Config3 {
+		return parseConfiguration(header, payload)
+	}
+
+	if len(payload) < 2 {
+		return nil, ErrInvalidFrame
+	}
+
+	index := uint16(payload[0])<<8 | uint16(payload[1])
+	payload = payload[2:]
+
+	switch {
+	case index == 0:
+		ca.payload = nil
+		return parseConfiguration(header, payload)
+	case index == 1:
+		ca.header = header
+		ca.payload = append(make([]byte, 0, len(payload)), payload...)
+		ca.next = 2
+		return nil, nil
+	case ca.payload == nil || (index != ca.next && index != 0xFFFF) || header.IDCode != ca.header.IDCode:
+		ca.payload = nil
+		return nil, fmt.Errorf("%w: unexpected CFG-3 fragment %d", ErrInvalidFrame, index)
+	case len(ca.payload)+len(payload) > maxAssembledConfigurationSize:
+		ca.payload = nil
+		return nil, fmt.Errorf("%w: CFG-3 fragments exceed maximum size of %d bytes", ErrInvalidFrame, maxAssembledConfigurationSize)
+	}
+
+	ca.payload = append(ca.payload, payload...)
+	ca.next++
+
+	if index != 0xFFFF {
+		return nil, nil
+	}
+
+	fragments := ca.payload
+	ca.payload = nil
+
+	return parseConfiguration(ca.header, fragments)
+}
+
+// parseConfiguration parses a configuration frame payload; for CFG-3, the payload excludes CONT_IDX.
+func parseConfiguration(header CommonFrameHeader, payload []byte) (*ConfigurationFrame, error) {
+	parser := &frameParser{buffer: payload}
+	extended := header.FrameType == FrameType.Config3
+
+	frame := &ConfigurationFrame{
+		CommonFrameHeader: header,
+		TimeBase:          parser.uint32() & 0xFFFFFF,
+	}
+
+	count := int(parser.uint16())
+
+	// Each PMU configuration takes at least 30 bytes, bounding the count of a corrupt frame
+	if parser.failed || count*30 > parser.remaining() {
+		return nil, ErrInvalidFrame
+	}
+
+	frame.Cells = make([]*ConfigurationCell, count)
+
+	for i := range frame.Cells {
+		if extended {
+			frame.Cells[i] = parseConfigurationCell3(parser)
+		} else {
+			frame.Cells[i] = parseConfigurationCell(parser)
+		}
+
+		if parser.failed {
+			return nil, ErrInvalidFrame
+		}
+	}
+
+	frame.DataRate = parser.int16()
+
+	if parser.failed {
+		return nil, ErrInvalidFrame
+	}
+
+	return frame, nil
+}
+
+func parseConfigurationCell(parser *frameParser) *ConfigurationCell {
+	cell := &ConfigurationCell{
+		StationName: parser.name(16),
+		IDCode:      parser.uint16(),
+		Format:      FormatFlagsEnum(parser.uint16() & 0xF),
+	}
+
+	phasorCount, analogCount, digitalCount := parseChannelCounts(parser)
+
+	if parser.failed {
+		return cell
+	}
+
+	cell.Phasors = make([]PhasorDefinition, phasorCount)
+	cell.Analogs = make([]AnalogDefinition, analogCount)
+	cell.Digitals = make([]DigitalDefinition, digitalCount)
+
+	parseChannelNames(parser, cell, func() string { return parser.name(16) })
+
+	for i := range cell.Phasors {
+		phasor := &cell.Phasors[i]
+		unit := parser.uint32()
+
+		if unit>>24 == 1 {
+			phasor.Type = PhasorType.Current
+		}
+
+		phasor.Scale = float64(unit&0xFFFFFF) * 1e-5
+		phasor.Phase = phaseFromName(phasor.Name)
+	}
+
+	for i := range cell.Analogs {
+		analog := &cell.Analogs[i]
+		unit := parser.uint32()
+		analog.Type = byte(unit >> 24)
+
+		// Scale is a signed 24-bit integer
+		analog.Scale = float64(int32(unit<<8) >> 8)
+	}
+
+	parseDigitalUnits(parser, cell)
+	parseNominalFrequency(parser, cell)
+
+	return cell
+}
+
+func parseConfigurationCell3(parser *frameParser) *ConfigurationCell {
+	cell := &ConfigurationCell{
+		StationName: parser.lengthPrefixedName(),
+		IDCode:      parser.uint16(),
+	}
+
+	cell.GlobalID, _ = guid.FromBytes(parser.bytes(16), false)
+	cell.Format = FormatFlagsEnum(parser.uint16() & 0xF)
+
+	phasorCount, analogCount, digitalCount := parseChannelCounts(parser)
+
+	if parser.failed {
+		return cell
+	}
+
+	cell.Phasors = make([]PhasorDefinition, phasorCount)
+	cell.Analogs = make([]AnalogDefinition, analogCount)
+	cell.Digitals = make([]DigitalDefinition, digitalCount)
+
+	parseChannelNames(parser, cell, parser.lengthPrefixedName)
+
+	// PHSCALE is a 16-bit modification flags word, a phasor type byte, a user designation byte,
+	// then float scale and angle adjustment values
+	for i := range cell.Phasors {
+		phasor := &cell.Phasors[i]
+		parser.uint16()
+		phasorType := parser.uint8()
+		parser.uint8()
+
+		if phasorType&0x8 != 0 {
+			phasor.Type = PhasorType.Current
+		}
+
+		phasor.Phase = phaseFromComponent(phasorType & 0x7)
+
+		if phasor.Phase == "" {
+			phasor.Phase = phaseFromName(phasor.Name)
+		}
+
+		phasor.Scale = float64(parser.float32())
+		phasor.AngleOffset = float64(parser.float32())
+	}
+
+	for i := range cell.Analogs {
+		analog := &cell.Analogs[i]
+		analog.Scale = float64(parser.float32())
+		analog.Offset = float64(parser.float32())
+	}
+
+	parseDigitalUnits(parser, cell)
+
+	cell.Latitude = finiteValue(parser.float32())
+	cell.Longitude = finiteValue(parser.float32())
+	cell.Elevation = finiteValue(parser.float32())
+
+	// SVC_CLASS, WINDOW and GRP_DLY
+	parser.bytes(9)
+
+	parseNominalFrequency(parser, cell)
+
+	return cell
+}
+
+func parseChannelCounts(parser *frameParser) (phasorCount, analogCount, digitalCount int) {
+	phasorCount = int(parser.uint16())
+	analogCount = int(parser.uint16())
+	digitalCount = int(parser.uint16())
+
+	// Each channel takes at least 4 bytes for its unit, bounding the counts of a corrupt frame
+	if (phasorCount+analogCount+digitalCount)*4 > parser.remaining() {
+		parser.failed = true
+	}
+
+	return
+}
+
+func parseChannelNames(parser *frameParser, cell *ConfigurationCell, name func() string) {
+	for i := range cell.Phasors {
+		cell.Phasors[i].Name = name()
+	}
+
+	for i := range cell.Analogs {
+		cell.Analogs[i].Name = name()
+	}
+
+	for i := range cell.Digitals {
+		for bit := range cell.Digitals[i].Names {
+			cell.Digitals[i].Names[bit] = name()
+		}
+	}
+}
+
+func parseDigitalUnits(parser *frameParser, cell *ConfigurationCell) {
+	for i := range cell.Digitals {
+		cell.Digitals[i].NormalStatus = parser.uint16()
+		cell.Digitals[i].ValidInputs = parser.uint16()
+	}
+}
+
+func parseNominalFrequency(parser *frameParser, cell *ConfigurationCell) {
+	if parser.uint16()&0x1 != 0 {
+		cell.NominalFrequency = 50.0
+	} else {
+		cell.NominalFrequency = 60.0
+	}
+
+	cell.ConfigurationCount = parser.uint16()
+}
+
+// phaseFromComponent gets the phase for the phasor component bits of a CFG-3 PHSCALE phasor type.
+func phaseFromComponent(component byte) string {
+	switch component {
+	case 0:
+		return "0"
+	case 1:
+		return "+"
+	case 2:
+		return "-"
+	case 4:
+		return "A"
+	case 5:
+		return "B"
+	case 6:
+		return "C"
+	default:
+		return ""
+	}
+}
+
+// phaseFromName derives the phase of a phasor from common channel naming conventions, i.e., the
+// last word of the name being a phase, e.g., "BUS1 A", or a type and phase, e.g., "VA" or "I1".
+func phaseFromName(name string) string {
+	words := strings.FieldsFunc(strings.ToUpper(name), func(r rune) bool {
+		return r == ' ' || r == '_' || r == '-' || r == '.' || r == ':'
+	})
+
+	if len(words) == 0 {
+		return ""
+	}
+
+	word := words[len(words)-1]
+
+	// Sequence numbers, e.g., V1, are only recognized with a phasor type prefix
+	if len(word) == 2 && (word[0] == 'V' || word[0] == 'I') {
+		switch word[1] {
+		case '1':
+			return "+"
+		case '2':
+			return "-"
+		case '0':
+			return "0"
+		}
+
+		word = word[1:]
+	}
+
+	switch word {
+	case "A", "B", "C":
+		return word
+	case "+", "POS", "PS":
+		return "+"
+	case "NEG", "NS":
+		return "-"
+	case "ZERO", "ZS":
+		return "0"
+	default:
+		return ""
+	}
+}
+
+// finiteValue gets the specified value, or zero when it is not a finite number, e.g., an
+// unspecified CFG-3 PMU location.
+func finiteValue(value float32) float64 {
+	if math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+		return 0.0
+	}
+
+	return float64(value)
+}
@@ -0,0 +1,213 @@
+//******************************************************************************************************
+//  ConfigurationFrame_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+func TestParseConfigurationFrame2(t *testing.T) {
+	config, err := ParseConfigurationFrame(buildConfigurationFrame2(testCells))
+
+	if err != nil {
+		t.Fatalf("TestParseConfigurationFrame2: failed to parse configuration frame: %s", err.Error())
+	}
+
+	if config.FrameType != FrameType.Config2 || config.IDCode != testStreamIDCode || config.TimeBase != testTimeBase {
+		t.Fatalf("TestParseConfigurationFrame2: unexpected configuration frame header: %+v", config.CommonFrameHeader)
+	}
+
+	if config.FramesPerSecond() != 30.0 || len(config.Cells) != 2 {
+		t.Fatalf("TestParseConfigurationFrame2: expected 30 frames per second and 2 PMUs, received: %f and %d", config.FramesPerSecond(), len(config.Cells))
+	}
+
+	shelby, dell := config.Cells[0], config.Cells[1]
+
+	if shelby.StationName != "Shelby PMU" || shelby.IDCode != 7 || shelby.NominalFrequency != 60.0 || shelby.ConfigurationCount != 3 {
+		t.Fatalf("TestParseConfigurationFrame2: unexpected PMU configuration: %+v", shelby)
+	}
+
+	if len(shelby.Phasors) != 4 || len(shelby.Analogs) != 1 || len(shelby.Digitals) != 1 {
+		t.Fatalf("TestParseConfigurationFrame2: expected 4 phasors, 1 analog and 1 digital, received: %d, %d and %d", len(shelby.Phasors), len(shelby.Analogs), len(shelby.Digitals))
+	}
+
+	// CFG-2 does not define phases, so they are derived from the channel names
+	for i, phase := range []string{"A", "B", "C", "+"} {
+		phasor := shelby.Phasors[i]
+
+		if phasor.Name != testCells[0].phasors[i].name || phasor.Phase != phase || math.Abs(phasor.Scale-testCells[0].phasors[i].scale) > 1e-9 {
+			t.Fatalf("TestParseConfigurationFrame2: unexpected phasor definition %d: %+v", i, phasor)
+		}
+	}
+
+	if shelby.Phasors[0].Type != PhasorType.Voltage || shelby.Phasors[3].Type != PhasorType.Current {
+		t.Fatal("TestParseConfigurationFrame2: unexpected phasor types")
+	}
+
+	if shelby.Analogs[0].Name != "MW" || shelby.Analogs[0].Scale != 1.0 || shelby.Digitals[0].Names[15] != "BREAKER" || shelby.Digitals[0].ValidInputs != 0xFFFF {
+		t.Fatalf("TestParseConfigurationFrame2: unexpected analog or digital definitions: %+v, %+v", shelby.Analogs[0], shelby.Digitals[0])
+	}
+
+	if dell.StationName != "Dell" || dell.NominalFrequency != 50.0 || dell.Format != testCells[1].format || dell.Phasors[0].Phase != "+" {
+		t.Fatalf("TestParseConfigurationFrame2: unexpected PMU configuration: %+v", dell)
+	}
+
+	if _, err := ParseConfigurationFrame(buildDataFrame(0, 0)); !errors.Is(err, ErrInvalidFrame) {
+		t.Fatalf("TestParseConfigurationFrame2: expected invalid frame for data frame, received: %v", err)
+	}
+}
+
+func TestParseConfigurationFrame3(t *testing.T) {
+	payload := buildConfigurationFrame3Payload(testCells)
+	config, err := ParseConfigurationFrame(buildConfigurationFrame3(0, payload))
+
+	if err != nil {
+		t.Fatalf("TestParseConfigurationFrame3: failed to parse configuration frame: %s", err.Error())
+	}
+
+	testConfigurationFrame3(t, config)
+
+	// Fragments must be reassembled
+	if _, err := ParseConfigurationFrame(buildConfigurationFrame3(1, payload)); !errors.Is(err, ErrInvalidFrame) {
+		t.Fatalf("TestParseConfigurationFrame3: expected invalid frame for fragment, received: %v", err)
+	}
+
+	var assembler configurationAssembler
+	fragments := [][]byte{payload[:20], payload[20:100], payload[100:]}
+
+	for i, index := range []uint16{1, 2, 0xFFFF} {
+		header, fragment, _ := ParseFrame(buildConfigurationFrame3(index, fragments[i]))
+
+		if config, err = assembler.add(header, fragment); err != nil {
+			t.Fatalf("TestParseConfigurationFrame3: failed to add fragment %d: %s", index, err.Error())
+		}
+
+		if (config == nil) != (index != 0xFFFF) {
+			t.Fatalf("TestParseConfigurationFrame3: unexpected configuration frame after fragment %d", index)
+		}
+	}
+
+	testConfigurationFrame3(t, config)
+
+	// Missing fragments are discarded
+	header, fragment, _ := ParseFrame(buildConfigurationFrame3(1, fragments[0]))
+	assembler.add(header, fragment)
+	header, fragment, _ = ParseFrame(buildConfigurationFrame3(3, fragments[1]))
+
+	if _, err := assembler.add(header, fragment); !errors.Is(err, ErrInvalidFrame) {
+		t.Fatalf("TestParseConfigurationFrame3: expected invalid frame for out of sequence fragment, received: %v", err)
+	}
+
+	if assembler.payload != nil {
+		t.Fatal("TestParseConfigurationFrame3: expected out of sequence fragment to reset assembler")
+	}
+
+	// Fragments from another data stream are out of sequence
+	header, fragment, _ = ParseFrame(buildConfigurationFrame3(1, fragments[0]))
+	assembler.add(header, fragment)
+	header, fragment, _ = ParseFrame(buildConfigurationFrame3(2, fragments[1]))
+	header.IDCode++
+
+	if _, err := assembler.add(header, fragment); !errors.Is(err, ErrInvalidFrame) {
+		t.Fatalf("TestParseConfigurationFrame3: expected invalid frame for fragment of another stream, received: %v", err)
+	}
+
+	// Fragments without a final fragment cannot grow beyond the maximum assembled size
+	fragment = make([]byte, 2+60000)
+	var index uint16
+
+	for err = nil; err == nil; {
+		index++
+		fragment[0], fragment[1] = byte(index>>8), byte(index)
+
+		if _, err = assembler.add(header, fragment); err == nil && len(assembler.payload) > maxAssembledConfigurationSize {
+			t.Fatalf("TestParseConfigurationFrame3: assembled %d bytes, exceeding maximum", len(assembler.payload))
+		}
+	}
+
+	if !errors.Is(err, ErrInvalidFrame) || assembler.payload != nil || int(index-1)*60000 > maxAssembledConfigurationSize {
+		t.Fatalf("TestParseConfigurationFrame3: expected oversized fragments to reset assembler, received: %v", err)
+	}
+}
+
+func testConfigurationFrame3(t *testing.T, config *ConfigurationFrame) {
+	t.Helper()
+
+	if config.FrameType != FrameType.Config3 || config.Version != 2 || config.TimeBase != testTimeBase || len(config.Cells) != 2 {
+		t.Fatalf("TestParseConfigurationFrame3: unexpected configuration frame: %+v", config.CommonFrameHeader)
+	}
+
+	shelby := config.Cells[0]
+	globalID, _ := guid.FromBytes(testGlobalID(0), false)
+
+	if shelby.StationName != "Shelby PMU" || shelby.GlobalID != globalID || len(shelby.Phasors) != 4 {
+		t.Fatalf("TestParseConfigurationFrame3: unexpected PMU configuration: %+v", shelby)
+	}
+
+	// CFG-3 phases are defined by the phasor component
+	for i, phase := range []string{"A", "B", "C", "+"} {
+		if phasor := shelby.Phasors[i]; phasor.Phase != phase || phasor.Name != testCells[0].phasors[i].name {
+			t.Fatalf("TestParseConfigurationFrame3: unexpected phasor definition %d: %+v", i, phasor)
+		}
+	}
+
+	if shelby.Phasors[3].Type != PhasorType.Current || math.Abs(shelby.Phasors[3].Scale-0.1) > 1e-6 {
+		t.Fatalf("TestParseConfigurationFrame3: unexpected current phasor definition: %+v", shelby.Phasors[3])
+	}
+
+	if shelby.Analogs[0].Scale != 2.0 || shelby.Analogs[0].Offset != 0.5 {
+		t.Fatalf("TestParseConfigurationFrame3: unexpected analog definition: %+v", shelby.Analogs[0])
+	}
+
+	if math.Abs(shelby.Latitude-35.1) > 1e-5 || math.Abs(shelby.Longitude+89.9) > 1e-5 || shelby.Elevation != 0.0 {
+		t.Fatalf("TestParseConfigurationFrame3: unexpected PMU location: %f, %f, %f", shelby.Latitude, shelby.Longitude, shelby.Elevation)
+	}
+
+	if dell := config.Cells[1]; dell.StationName != "Dell" || dell.NominalFrequency != 50.0 || dell.ConfigurationCount != 3 {
+		t.Fatalf("TestParseConfigurationFrame3: unexpected PMU configuration: %+v", dell)
+	}
+}
+
+func TestPhaseFromName(t *testing.T) {
+	for name, phase := range map[string]string{
+		"VA":       "A",
+		"IB":       "B",
+		"BUS1 C":   "C",
+		"V1":       "+",
+		"I2":       "-",
+		"LINE POS": "+",
+		"V+":       "+",
+		"BUS 1":    "",
+		"DATA":     "",
+		"":         "",
+	} {
+		if result := phaseFromName(name); result != phase {
+			t.Fatalf("TestPhaseFromName: expected phase \"%s\" for \"%s\", received: \"%s\"", phase, name, result)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+//******************************************************************************************************
+//  Constants.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"errors"
+	"strconv"
+)
+
+// IEEE C37.118.2 frames start with a 14 byte common header, i.e., a 2 byte SYNC word, a 2 byte
+// FRAMESIZE, a 2 byte IDCODE, a 4 byte SOC and a 4 byte FRACSEC, and end with a 2 byte CRC-CCITT
+// check word. All values are big-endian.
+
+const (
+	syncByte         = 0xAA
+	commonHeaderSize = 14
+	checksumSize     = 2
+
+	// commandFrameSize is the size of a command frame without extended data
+	commandFrameSize = commonHeaderSize + 2 + checksumSize
+)
+
+// ErrInvalidFrame is the error returned when a frame is truncated or has an invalid header.
+var ErrInvalidFrame = errors.New("invalid IEEE C37.118 frame")
+
+// ErrChecksumMismatch is the error returned when the check word of a frame does not match its content.
+var ErrChecksumMismatch = errors.New("IEEE C37.118 frame checksum mismatch")
+
+// ErrConfigurationMismatch is the error returned when a data frame does not match the configuration
+// frame used to parse it.
+var ErrConfigurationMismatch = errors.New("IEEE C37.118 data frame does not match configuration")
+
+// FrameTypeEnum defines the type of the FrameType enumeration.
+type FrameTypeEnum byte
+
+// FrameType is an enumeration of the possible IEEE C37.118 frame types, as defined by bits 6-4 of
+// the second SYNC byte.
+var FrameType = struct {
+	// Data defines a data frame.
+	Data FrameTypeEnum
+	// Header defines a header frame, i.e., human-readable information about the data source.
+	Header FrameTypeEnum
+	// Config1 defines a configuration frame 1, i.e., the channels the PMU is capable of reporting.
+	Config1 FrameTypeEnum
+	// Config2 defines a configuration frame 2, i.e., the channels the PMU is currently reporting.
+	Config2 FrameTypeEnum
+	// Command defines a command frame.
+	Command FrameTypeEnum
+	// Config3 defines a configuration frame 3, i.e., an extended, optionally fragmented, configuration frame.
+	Config3 FrameTypeEnum
+}{
+	Data:    0,
+	Header:  1,
+	Config1: 2,
+	Config2: 3,
+	Command: 4,
+	Config3: 5,
+}
+
+// String gets the FrameType enumeration value as a string.
+func (fte FrameTypeEnum) String() string {
+	switch fte {
+	case FrameType.Data:
+		return "Data"
+	case FrameType.Header:
+		return "Header"
+	case FrameType.Config1:
+		return "Config1"
+	case FrameType.Config2:
+		return "Config2"
+	case FrameType.Command:
+		return "Command"
+	case FrameType.Config3:
+		return "Config3"
+	default:
+		return "0x" + strconv.FormatInt(int64(fte), 16)
+	}
+}
+
+// CommandEnum defines the type of the Command enumeration.
+type CommandEnum uint16
+
+// Command is an enumeration of the possible IEEE C37.118 commands sent to a PMU or PDC.
+var Command = struct {
+	// DisableRealTimeData defines a command to turn off transmission of data frames.
+	DisableRealTimeData CommandEnum
+	// EnableRealTimeData defines a command to turn on transmission of data frames.
+	EnableRealTimeData CommandEnum
+	// SendHeaderFrame defines a command to send the header frame.
+	SendHeaderFrame CommandEnum
+	// SendConfig1 defines a command to send configuration frame 1.
+	SendConfig1 CommandEnum
+	// SendConfig2 defines a command to send configuration frame 2.
+	SendConfig2 CommandEnum
+	// SendConfig3 defines a command to send configuration frame 3.
+	SendConfig3 CommandEnum
+}{
+	DisableRealTimeData: 1,
+	EnableRealTimeData:  2,
+	SendHeaderFrame:     3,
+	SendConfig1:         4,
+	SendConfig2:         5,
+	SendConfig3:         6,
+}
+
+// String gets the Command enumeration value as a string.
+func (ce CommandEnum) String() string {
+	switch ce {
+	case Command.DisableRealTimeData:
+		return "DisableRealTimeData"
+	case Command.EnableRealTimeData:
+		return "EnableRealTimeData"
+	case Command.SendHeaderFrame:
+		return "SendHeaderFrame"
+	case Command.SendConfig1:
+		return "SendConfig1"
+	case Command.SendConfig2:
+		return "SendConfig2"
+	case Command.SendConfig3:
+		return "SendConfig3"
+	default:
+		return "0x" + strconv.FormatInt(int64(ce), 16)
+	}
+}
+
+// FormatFlagsEnum defines the type of the FormatFlags enumeration.
+type FormatFlagsEnum uint16
+
+// FormatFlags is an enumeration of the possible flags of the FORMAT field of a PMU configuration,
+// which defines the encoding of the values in data frames.
+var FormatFlags = struct {
+	// PhasorsPolar defines a flag for phasors encoded as magnitude and angle; otherwise, phasors are rectangular.
+	PhasorsPolar FormatFlagsEnum
+	// PhasorsFloat defines a flag for phasors encoded as 32-bit floats; otherwise, phasors are 16-bit integers.
+	PhasorsFloat FormatFlagsEnum
+	// AnalogsFloat defines a flag for analogs encoded as 32-bit floats; otherwise, analogs are 16-bit integers.
+	AnalogsFloat FormatFlagsEnum
+	// FrequencyFloat defines a flag for FREQ and DFREQ encoded as 32-bit floats; otherwise, they are 16-bit integers.
+	FrequencyFloat FormatFlagsEnum
+}{
+	PhasorsPolar:   0x1,
+	PhasorsFloat:   0x2,
+	AnalogsFloat:   0x4,
+	FrequencyFloat: 0x8,
+}
+
+// PhasorTypeEnum defines the type of the PhasorType enumeration.
+type PhasorTypeEnum byte
+
+// PhasorType is an enumeration of the possible types of phasors.
+var PhasorType = struct {
+	// Voltage defines a voltage phasor.
+	Voltage PhasorTypeEnum
+	// Current defines a current phasor.
+	Current PhasorTypeEnum
+}{
+	Voltage: 0,
+	Current: 1,
+}
+
+// String gets the PhasorType enumeration value as a string.
+func (pte PhasorTypeEnum) String() string {
+	switch pte {
+	case PhasorType.Voltage:
+		return "Voltage"
+	case PhasorType.Current:
+		return "Current"
+	default:
+		return "0x" + strconv.FormatInt(int64(pte), 16)
+	}
+}
+
+// Acronym gets the PhasorType enumeration value as its single character acronym, i.e.,
+// V or I, as used by the PhasorDetail metadata table and transport.SignalKindEnum.SignalTypeAcronym.
+func (pte PhasorTypeEnum) Acronym() rune {
+	if pte == PhasorType.Current {
+		return 'I'
+	}
+
+	return 'V'
+}
+
+// STAT word bits of a PMU data cell
+const (
+	statusDataErrorMask    = 0xC000
+	statusSyncError        = 0x2000
+	statusUnlockedTimeMask = 0x0030
+)
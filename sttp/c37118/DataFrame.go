@@ -0,0 +1,192 @@
+//******************************************************************************************************
+//  DataFrame.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"math"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// DataFrame defines a parsed IEEE C37.118 data frame.
+type DataFrame struct {
+	CommonFrameHeader
+
+	// Timestamp is the frame timestamp, in ticks, based on the configuration frame TimeBase.
+	Timestamp ticks.Ticks
+
+	// Cells are the values of the PMUs in the data stream, in configuration frame order.
+	Cells []DataCell
+}
+
+// DataCell defines the values of a single PMU in a data frame, converted to engineering units.
+type DataCell struct {
+	// Status is the STAT word of the PMU, i.e., data, synchronization and time quality flags.
+	Status uint16
+
+	// Phasors are the phasor values.
+	Phasors []PhasorValue
+
+	// Frequency is the frequency, in Hz.
+	Frequency float64
+
+	// DfDt is the rate of change of frequency, in Hz per second.
+	DfDt float64
+
+	// Analogs are the analog values.
+	Analogs []float64
+
+	// Digitals are the digital status words.
+	Digitals []uint16
+}
+
+// PhasorValue defines a phasor value in polar form.
+type PhasorValue struct {
+	// Magnitude is the phasor magnitude, in volts or amperes.
+	Magnitude float64
+
+	// Angle is the phasor angle, in radians.
+	Angle float64
+}
+
+// ParseDataFrame parses an IEEE C37.118 data frame using the configuration frame of its data stream.
+// ErrConfigurationMismatch is returned when the frame does not match the configuration, e.g., when
+// the configuration of the data stream has changed.
+func ParseDataFrame(buffer []byte, config *ConfigurationFrame) (*DataFrame, error) {
+	header, payload, err := ParseFrame(buffer)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if header.FrameType != FrameType.Data {
+		return nil, ErrInvalidFrame
+	}
+
+	return parseData(header, payload, config)
+}
+
+func parseData(header CommonFrameHeader, payload []byte, config *ConfigurationFrame) (*DataFrame, error) {
+	if header.IDCode != config.IDCode || len(payload) != config.dataPayloadSize() {
+		return nil, ErrConfigurationMismatch
+	}
+
+	parser := &frameParser{buffer: payload}
+
+	frame := &DataFrame{
+		CommonFrameHeader: header,
+		Timestamp:         header.Timestamp(config.TimeBase),
+		Cells:             make([]DataCell, len(config.Cells)),
+	}
+
+	for i, cellConfig := range config.Cells {
+		parseDataCell(parser, cellConfig, &frame.Cells[i])
+	}
+
+	return frame, nil
+}
+
+// dataPayloadSize gets the size of the data frame payload described by the configuration frame.
+func (cf *ConfigurationFrame) dataPayloadSize() int {
+	size := 0
+
+	for _, cell := range cf.Cells {
+		phasorSize, analogSize, frequencySize := 4, 2, 2
+
+		if cell.Format&FormatFlags.PhasorsFloat != 0 {
+			phasorSize = 8
+		}
+
+		if cell.Format&FormatFlags.AnalogsFloat != 0 {
+			analogSize = 4
+		}
+
+		if cell.Format&FormatFlags.FrequencyFloat != 0 {
+			frequencySize = 4
+		}
+
+		size += 2 + len(cell.Phasors)*phasorSize + 2*frequencySize + len(cell.Analogs)*analogSize + len(cell.Digitals)*2
+	}
+
+	return size
+}
+
+func parseDataCell(parser *frameParser, config *ConfigurationCell, cell *DataCell) {
+	cell.Status = parser.uint16()
+	cell.Phasors = make([]PhasorValue, len(config.Phasors))
+
+	polar := config.Format&FormatFlags.PhasorsPolar != 0
+
+	for i, definition := range config.Phasors {
+		var first, second float64
+
+		if config.Format&FormatFlags.PhasorsFloat != 0 {
+			first, second = float64(parser.float32()), float64(parser.float32())
+		} else if polar {
+			// Integer magnitude is unsigned and angle is in units of 10^-4 radians
+			first = float64(parser.uint16()) * definition.Scale
+			second = float64(parser.int16())*1e-4 + definition.AngleOffset
+		} else {
+			first = float64(parser.int16()) * definition.Scale
+			second = float64(parser.int16()) * definition.Scale
+		}
+
+		if polar {
+			cell.Phasors[i] = PhasorValue{Magnitude: first, Angle: second}
+		} else {
+			angle := math.Atan2(second, first)
+
+			if config.Format&FormatFlags.PhasorsFloat == 0 {
+				angle += definition.AngleOffset
+			}
+
+			cell.Phasors[i] = PhasorValue{Magnitude: math.Hypot(first, second), Angle: angle}
+		}
+	}
+
+	if config.Format&FormatFlags.FrequencyFloat != 0 {
+		cell.Frequency = float64(parser.float32())
+		cell.DfDt = float64(parser.float32())
+	} else {
+		// Integer frequency is a deviation from nominal in mHz and dF/dt is in units of 0.01 Hz/s
+		cell.Frequency = config.NominalFrequency + float64(parser.int16())/1000.0
+		cell.DfDt = float64(parser.int16()) / 100.0
+	}
+
+	cell.Analogs = make([]float64, len(config.Analogs))
+
+	for i, definition := range config.Analogs {
+		if config.Format&FormatFlags.AnalogsFloat != 0 {
+			cell.Analogs[i] = float64(parser.float32())
+		} else {
+			cell.Analogs[i] = float64(parser.int16())*definition.Scale + definition.Offset
+		}
+	}
+
+	cell.Digitals = make([]uint16, len(config.Digitals))
+
+	for i := range cell.Digitals {
+		cell.Digitals[i] = parser.uint16()
+	}
+}
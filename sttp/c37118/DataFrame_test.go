@@ -0,0 +1,92 @@
+//******************************************************************************************************
+//  DataFrame_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+func TestParseDataFrame(t *testing.T) {
+	config, _ := ParseConfigurationFrame(buildConfigurationFrame2(testCells))
+	frame, err := ParseDataFrame(buildDataFrame(0x8000, 250000), config)
+
+	if err != nil {
+		t.Fatalf("TestParseDataFrame: failed to parse data frame: %s", err.Error())
+	}
+
+	if expected := ticks.UnixBaseOffset + testSOC*ticks.PerSecond + ticks.PerSecond/4; frame.Timestamp != expected {
+		t.Fatalf("TestParseDataFrame: expected timestamp %s, received: %s", expected, frame.Timestamp)
+	}
+
+	if len(frame.Cells) != 2 {
+		t.Fatalf("TestParseDataFrame: expected 2 PMUs, received: %d", len(frame.Cells))
+	}
+
+	for i, cell := range frame.Cells {
+		// Integer rectangular phasors are rounded to the phasor scale
+		tolerance := 0.02
+
+		if i == 1 {
+			tolerance = 1e-4
+		}
+
+		if cell.Status != 0x8000 || len(cell.Phasors) != len(testCells[i].phasors) {
+			t.Fatalf("TestParseDataFrame: unexpected PMU %d values: %+v", i, cell)
+		}
+
+		for j, phasor := range cell.Phasors {
+			magnitude := 100.0 * float64(j+1)
+			angle := math.Remainder(-math.Pi/6.0*float64(j+1), 2.0*math.Pi)
+
+			if math.Abs(phasor.Magnitude-magnitude) > tolerance || math.Abs(phasor.Angle-angle) > 1e-3 {
+				t.Fatalf("TestParseDataFrame: expected PMU %d phasor %d of %f∠%f, received: %f∠%f", i, j, magnitude, angle, phasor.Magnitude, phasor.Angle)
+			}
+		}
+	}
+
+	shelby, dell := frame.Cells[0], frame.Cells[1]
+
+	if math.Abs(shelby.Frequency-60.02) > 1e-9 || math.Abs(shelby.DfDt-0.05) > 1e-9 {
+		t.Fatalf("TestParseDataFrame: expected frequency 60.02 and dF/dt 0.05, received: %f and %f", shelby.Frequency, shelby.DfDt)
+	}
+
+	if math.Abs(dell.Frequency-50.02) > 1e-5 || math.Abs(dell.DfDt-0.05) > 1e-5 {
+		t.Fatalf("TestParseDataFrame: expected frequency 50.02 and dF/dt 0.05, received: %f and %f", dell.Frequency, dell.DfDt)
+	}
+
+	if len(shelby.Analogs) != 1 || shelby.Analogs[0] != 42.0 || len(shelby.Digitals) != 1 || shelby.Digitals[0] != 0x0005 {
+		t.Fatalf("TestParseDataFrame: unexpected analog or digital values: %v, %v", shelby.Analogs, shelby.Digitals)
+	}
+
+	// Data frame from a different configuration
+	config.Cells = config.Cells[:1]
+
+	if _, err := ParseDataFrame(buildDataFrame(0, 0), config); !errors.Is(err, ErrConfigurationMismatch) {
+		t.Fatalf("TestParseDataFrame: expected configuration mismatch, received: %v", err)
+	}
+}
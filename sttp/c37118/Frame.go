@@ -0,0 +1,232 @@
+//******************************************************************************************************
+//  Frame.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// CommonFrameHeader defines the header common to all IEEE C37.118 frames.
+type CommonFrameHeader struct {
+	// FrameType is the type of the frame.
+	FrameType FrameTypeEnum
+
+	// Version is the version of the standard the frame conforms to, i.e., 1 for IEEE C37.118-2005
+	// and 2 for IEEE C37.118.2-2011.
+	Version byte
+
+	// FrameSize is the total size of the frame, in bytes, including the check word.
+	FrameSize uint16
+
+	// IDCode is the data stream identifier of the frame.
+	IDCode uint16
+
+	// SOC is the second-of-century timestamp of the frame, i.e., Unix seconds.
+	SOC uint32
+
+	// FracSec is the fraction of second of the frame; the upper 8 bits are the time quality flags.
+	FracSec uint32
+}
+
+// ParseCommonFrameHeader parses the common header of the IEEE C37.118 frame at the start of buffer.
+// Only the header is validated, see ParseFrame for parsing a complete frame.
+func ParseCommonFrameHeader(buffer []byte) (CommonFrameHeader, error) {
+	if len(buffer) < commonHeaderSize || buffer[0] != syncByte || buffer[1]&0x80 != 0 {
+		return CommonFrameHeader{}, ErrInvalidFrame
+	}
+
+	header := CommonFrameHeader{
+		FrameType: FrameTypeEnum(buffer[1] >> 4 & 0x7),
+		Version:   buffer[1] & 0xF,
+		FrameSize: binary.BigEndian.Uint16(buffer[2:]),
+		IDCode:    binary.BigEndian.Uint16(buffer[4:]),
+		SOC:       binary.BigEndian.Uint32(buffer[6:]),
+		FracSec:   binary.BigEndian.Uint32(buffer[10:]),
+	}
+
+	if header.FrameSize < commonHeaderSize+checksumSize {
+		return CommonFrameHeader{}, ErrInvalidFrame
+	}
+
+	return header, nil
+}
+
+// TimeQuality gets the time quality flags of the frame, i.e., the upper 8 bits of FracSec.
+func (cfh *CommonFrameHeader) TimeQuality() byte {
+	return byte(cfh.FracSec >> 24)
+}
+
+// Timestamp gets the frame timestamp, in ticks, for the specified timeBase, i.e., the resolution
+// of the FracSec fraction of second as defined by the configuration frame TIME_BASE.
+func (cfh *CommonFrameHeader) Timestamp(timeBase uint32) ticks.Ticks {
+	timestamp := ticks.UnixBaseOffset + ticks.Ticks(cfh.SOC)*ticks.PerSecond
+
+	if timeBase > 0 {
+		fraction := uint64(cfh.FracSec & 0xFFFFFF)
+		timestamp += ticks.Ticks(math.Round(float64(fraction) * float64(ticks.PerSecond) / float64(timeBase)))
+	}
+
+	return timestamp
+}
+
+// ParseFrame validates the IEEE C37.118 frame at the start of buffer, including its check word,
+// and returns its common header and its payload, i.e., the frame content between the common
+// header and the check word.
+func ParseFrame(buffer []byte) (CommonFrameHeader, []byte, error) {
+	header, err := ParseCommonFrameHeader(buffer)
+
+	if err != nil {
+		return header, nil, err
+	}
+
+	frameSize := int(header.FrameSize)
+
+	if len(buffer) < frameSize {
+		return header, nil, ErrInvalidFrame
+	}
+
+	if Checksum(buffer[:frameSize-checksumSize]) != binary.BigEndian.Uint16(buffer[frameSize-checksumSize:]) {
+		return header, nil, ErrChecksumMismatch
+	}
+
+	return header, buffer[commonHeaderSize : frameSize-checksumSize], nil
+}
+
+// Checksum computes the CRC-CCITT check word of a frame, i.e., polynomial 0x1021 with an initial
+// value of 0xFFFF, over the specified buffer.
+func Checksum(buffer []byte) uint16 {
+	crc := uint16(0xFFFF)
+
+	for _, value := range buffer {
+		crc ^= uint16(value) << 8
+
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
+
+// EncodeCommandFrame encodes a command frame for the data stream with the specified idCode, using
+// the specified timestamp and version of the standard.
+func EncodeCommandFrame(idCode uint16, command CommandEnum, timestamp ticks.Ticks, version byte) []byte {
+	frame := make([]byte, commandFrameSize)
+	encodeCommonFrameHeader(frame, FrameType.Command, version, idCode, timestamp)
+	binary.BigEndian.PutUint16(frame[commonHeaderSize:], uint16(command))
+	binary.BigEndian.PutUint16(frame[commandFrameSize-checksumSize:], Checksum(frame[:commandFrameSize-checksumSize]))
+	return frame
+}
+
+// encodeCommonFrameHeader encodes a common header, sized to the length of frame, with a microsecond
+// resolution fraction of second.
+func encodeCommonFrameHeader(frame []byte, frameType FrameTypeEnum, version byte, idCode uint16, timestamp ticks.Ticks) {
+	value := timestamp.TimestampValue() - int64(ticks.UnixBaseOffset)
+	soc := value / int64(ticks.PerSecond)
+	fraction := value % int64(ticks.PerSecond) / int64(ticks.PerMicrosecond)
+
+	frame[0] = syncByte
+	frame[1] = byte(frameType)<<4 | version&0xF
+	binary.BigEndian.PutUint16(frame[2:], uint16(len(frame)))
+	binary.BigEndian.PutUint16(frame[4:], idCode)
+	binary.BigEndian.PutUint32(frame[6:], uint32(soc))
+	binary.BigEndian.PutUint32(frame[10:], uint32(fraction))
+}
+
+// frameParser reads big-endian values from a frame payload, recording an error, instead of
+// panicking, when reading past the end of the payload.
+type frameParser struct {
+	buffer []byte
+	offset int
+	failed bool
+}
+
+func (fp *frameParser) bytes(length int) []byte {
+	if fp.failed || length < 0 || fp.offset+length > len(fp.buffer) {
+		fp.failed = true
+		return make([]byte, max(length, 0))
+	}
+
+	value := fp.buffer[fp.offset : fp.offset+length]
+	fp.offset += length
+	return value
+}
+
+func (fp *frameParser) uint8() byte {
+	return fp.bytes(1)[0]
+}
+
+func (fp *frameParser) uint16() uint16 {
+	return binary.BigEndian.Uint16(fp.bytes(2))
+}
+
+func (fp *frameParser) int16() int16 {
+	return int16(fp.uint16())
+}
+
+func (fp *frameParser) uint32() uint32 {
+	return binary.BigEndian.Uint32(fp.bytes(4))
+}
+
+func (fp *frameParser) float32() float32 {
+	return math.Float32frombits(fp.uint32())
+}
+
+// name reads a fixed length, space padded, channel or station name.
+func (fp *frameParser) name(length int) string {
+	return trimName(fp.bytes(length))
+}
+
+// lengthPrefixedName reads a variable length name, prefixed by its 1 byte length, as used by
+// configuration frame 3.
+func (fp *frameParser) lengthPrefixedName() string {
+	return trimName(fp.bytes(int(fp.uint8())))
+}
+
+func (fp *frameParser) remaining() int {
+	return len(fp.buffer) - fp.offset
+}
+
+func trimName(value []byte) string {
+	end := len(value)
+
+	for end > 0 && (value[end-1] == ' ' || value[end-1] == 0) {
+		end--
+	}
+
+	start := 0
+
+	for start < end && value[start] == ' ' {
+		start++
+	}
+
+	return string(value[start:end])
+}
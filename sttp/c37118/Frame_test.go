@@ -0,0 +1,360 @@
+//******************************************************************************************************
+//  Frame_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+const (
+	testStreamIDCode = 235
+	testTimeBase     = 1000000
+	testSOC          = 1700000000
+)
+
+type testPhasor struct {
+	name      string
+	current   bool
+	scale     float64
+	component byte
+}
+
+type testCell struct {
+	name      string
+	idCode    uint16
+	format    FormatFlagsEnum
+	phasors   []testPhasor
+	analogs   []string
+	digitals  int
+	nominal50 bool
+}
+
+// testCells defines a PMU with integer rectangular values and a PMU with floating-point polar values
+var testCells = []testCell{
+	{
+		name:   "Shelby PMU",
+		idCode: 7,
+		phasors: []testPhasor{
+			{name: "BUS1 VA", scale: 0.01, component: 4},
+			{name: "BUS1 VB", scale: 0.01, component: 5},
+			{name: "BUS1 VC", scale: 0.01, component: 6},
+			{name: "LINE I1", current: true, scale: 0.1, component: 1},
+		},
+		analogs:  []string{"MW"},
+		digitals: 1,
+	},
+	{
+		name:      "Dell",
+		idCode:    8,
+		format:    FormatFlags.PhasorsPolar | FormatFlags.PhasorsFloat | FormatFlags.AnalogsFloat | FormatFlags.FrequencyFloat,
+		phasors:   []testPhasor{{name: "V+", scale: 1.0, component: 1}},
+		nominal50: true,
+	},
+}
+
+func appendName(buffer []byte, name string, length int) []byte {
+	padded := make([]byte, length)
+
+	for i := range padded {
+		padded[i] = ' '
+	}
+
+	copy(padded, name)
+	return append(buffer, padded...)
+}
+
+func appendFloat(buffer []byte, value float64) []byte {
+	return binary.BigEndian.AppendUint32(buffer, math.Float32bits(float32(value)))
+}
+
+// buildFrame builds a frame with a valid common header and check word around the specified payload.
+func buildFrame(frameType FrameTypeEnum, version byte, soc, fracSec uint32, payload []byte) []byte {
+	frame := make([]byte, commonHeaderSize, commonHeaderSize+len(payload)+checksumSize)
+	frame[0] = syncByte
+	frame[1] = byte(frameType)<<4 | version
+	binary.BigEndian.PutUint16(frame[2:], uint16(cap(frame)))
+	binary.BigEndian.PutUint16(frame[4:], testStreamIDCode)
+	binary.BigEndian.PutUint32(frame[6:], soc)
+	binary.BigEndian.PutUint32(frame[10:], fracSec)
+	frame = append(frame, payload...)
+	return binary.BigEndian.AppendUint16(frame, Checksum(frame))
+}
+
+func buildConfigurationFrame2(cells []testCell) []byte {
+	payload := binary.BigEndian.AppendUint32(nil, testTimeBase)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(len(cells)))
+
+	for _, cell := range cells {
+		payload = appendName(payload, cell.name, 16)
+		payload = binary.BigEndian.AppendUint16(payload, cell.idCode)
+		payload = binary.BigEndian.AppendUint16(payload, uint16(cell.format))
+		payload = binary.BigEndian.AppendUint16(payload, uint16(len(cell.phasors)))
+		payload = binary.BigEndian.AppendUint16(payload, uint16(len(cell.analogs)))
+		payload = binary.BigEndian.AppendUint16(payload, uint16(cell.digitals))
+
+		for _, phasor := range cell.phasors {
+			payload = appendName(payload, phasor.name, 16)
+		}
+
+		for _, analog := range cell.analogs {
+			payload = appendName(payload, analog, 16)
+		}
+
+		for i := 0; i < cell.digitals*16; i++ {
+			payload = appendName(payload, "BREAKER", 16)
+		}
+
+		for _, phasor := range cell.phasors {
+			unit := uint32(math.Round(phasor.scale / 1e-5))
+
+			if phasor.current {
+				unit |= 1 << 24
+			}
+
+			payload = binary.BigEndian.AppendUint32(payload, unit)
+		}
+
+		for range cell.analogs {
+			payload = binary.BigEndian.AppendUint32(payload, 1)
+		}
+
+		for i := 0; i < cell.digitals; i++ {
+			payload = binary.BigEndian.AppendUint32(payload, 0x0000FFFF)
+		}
+
+		payload = appendNominal(payload, cell)
+	}
+
+	payload = binary.BigEndian.AppendUint16(payload, 30)
+	return buildFrame(FrameType.Config2, 1, testSOC, 0, payload)
+}
+
+// buildConfigurationFrame3Payload builds a CFG-3 payload, excluding CONT_IDX.
+func buildConfigurationFrame3Payload(cells []testCell) []byte {
+	payload := binary.BigEndian.AppendUint32(nil, testTimeBase)
+	payload = binary.BigEndian.AppendUint16(payload, uint16(len(cells)))
+
+	for i, cell := range cells {
+		payload = append(payload, byte(len(cell.name)))
+		payload = append(payload, cell.name...)
+		payload = binary.BigEndian.AppendUint16(payload, cell.idCode)
+		payload = append(payload, testGlobalID(i)...)
+		payload = binary.BigEndian.AppendUint16(payload, uint16(cell.format))
+		payload = binary.BigEndian.AppendUint16(payload, uint16(len(cell.phasors)))
+		payload = binary.BigEndian.AppendUint16(payload, uint16(len(cell.analogs)))
+		payload = binary.BigEndian.AppendUint16(payload, uint16(cell.digitals))
+
+		for _, phasor := range cell.phasors {
+			payload = append(payload, byte(len(phasor.name)))
+			payload = append(payload, phasor.name...)
+		}
+
+		for _, analog := range cell.analogs {
+			payload = append(payload, byte(len(analog)))
+			payload = append(payload, analog...)
+		}
+
+		for j := 0; j < cell.digitals*16; j++ {
+			payload = append(payload, 0)
+		}
+
+		for _, phasor := range cell.phasors {
+			phasorType := phasor.component
+
+			if phasor.current {
+				phasorType |= 0x8
+			}
+
+			payload = append(payload, 0, 0, phasorType, 0)
+			payload = appendFloat(payload, phasor.scale)
+			payload = appendFloat(payload, 0.0)
+		}
+
+		for range cell.analogs {
+			payload = appendFloat(payload, 2.0)
+			payload = appendFloat(payload, 0.5)
+		}
+
+		for j := 0; j < cell.digitals; j++ {
+			payload = binary.BigEndian.AppendUint32(payload, 0x0000FFFF)
+		}
+
+		payload = appendFloat(payload, 35.1+float64(i))
+		payload = appendFloat(payload, -89.9)
+		payload = appendFloat(payload, math.Inf(1))
+		payload = append(payload, 'P')
+		payload = binary.BigEndian.AppendUint32(payload, 0)
+		payload = binary.BigEndian.AppendUint32(payload, 0)
+		payload = appendNominal(payload, cell)
+	}
+
+	return binary.BigEndian.AppendUint16(payload, 30)
+}
+
+func buildConfigurationFrame3(index uint16, payload []byte) []byte {
+	return buildFrame(FrameType.Config3, 2, testSOC, 0, append(binary.BigEndian.AppendUint16(nil, index), payload...))
+}
+
+func testGlobalID(index int) []byte {
+	globalID := make([]byte, 16)
+
+	for i := range globalID {
+		globalID[i] = byte(index*16 + i + 1)
+	}
+
+	return globalID
+}
+
+func appendNominal(payload []byte, cell testCell) []byte {
+	if cell.nominal50 {
+		payload = binary.BigEndian.AppendUint16(payload, 1)
+	} else {
+		payload = binary.BigEndian.AppendUint16(payload, 0)
+	}
+
+	return binary.BigEndian.AppendUint16(payload, 3)
+}
+
+// buildDataFrame builds a data frame for testCells where phasor magnitudes are 100 times the
+// 1-based phasor index, phasor angles are -30 degrees times the phasor index, frequency deviates
+// from nominal by 0.02 Hz, dF/dt is 0.05 Hz/s, analogs are 42 and digitals are 0x0005.
+func buildDataFrame(status uint16, fracSec uint32) []byte {
+	var payload []byte
+
+	for _, cell := range testCells {
+		payload = binary.BigEndian.AppendUint16(payload, status)
+
+		for i, phasor := range cell.phasors {
+			magnitude := 100.0 * float64(i+1)
+			angle := -math.Pi / 6.0 * float64(i+1)
+
+			if cell.format&FormatFlags.PhasorsFloat != 0 {
+				payload = appendFloat(payload, magnitude)
+				payload = appendFloat(payload, angle)
+			} else {
+				realPart := math.Round(magnitude * math.Cos(angle) / phasor.scale)
+				imaginaryPart := math.Round(magnitude * math.Sin(angle) / phasor.scale)
+				payload = binary.BigEndian.AppendUint16(payload, uint16(int16(realPart)))
+				payload = binary.BigEndian.AppendUint16(payload, uint16(int16(imaginaryPart)))
+			}
+		}
+
+		if cell.format&FormatFlags.FrequencyFloat != 0 {
+			payload = appendFloat(payload, 50.02)
+			payload = appendFloat(payload, 0.05)
+		} else {
+			payload = binary.BigEndian.AppendUint16(payload, 20)
+			payload = binary.BigEndian.AppendUint16(payload, 5)
+		}
+
+		for range cell.analogs {
+			if cell.format&FormatFlags.AnalogsFloat != 0 {
+				payload = appendFloat(payload, 42.0)
+			} else {
+				payload = binary.BigEndian.AppendUint16(payload, 42)
+			}
+		}
+
+		for i := 0; i < cell.digitals; i++ {
+			payload = binary.BigEndian.AppendUint16(payload, 0x0005)
+		}
+	}
+
+	return buildFrame(FrameType.Data, 1, testSOC, fracSec, payload)
+}
+
+func TestChecksum(t *testing.T) {
+	if checksum := Checksum([]byte("123456789")); checksum != 0x29B1 {
+		t.Fatalf("TestChecksum: expected check word 0x29B1, received: 0x%04X", checksum)
+	}
+}
+
+func TestParseFrame(t *testing.T) {
+	frame := buildDataFrame(0, 0x0F07A120)
+	header, payload, err := ParseFrame(frame)
+
+	if err != nil {
+		t.Fatalf("TestParseFrame: failed to parse frame: %s", err.Error())
+	}
+
+	if header.FrameType != FrameType.Data || header.Version != 1 || header.IDCode != testStreamIDCode || int(header.FrameSize) != len(frame) {
+		t.Fatalf("TestParseFrame: unexpected header: %+v", header)
+	}
+
+	if len(payload) != len(frame)-commonHeaderSize-checksumSize {
+		t.Fatalf("TestParseFrame: expected %d byte payload, received: %d", len(frame)-commonHeaderSize-checksumSize, len(payload))
+	}
+
+	if header.TimeQuality() != 0x0F {
+		t.Fatalf("TestParseFrame: expected time quality 0x0F, received: 0x%02X", header.TimeQuality())
+	}
+
+	// Fraction of 500000 with a one microsecond time base is half a second
+	expected := ticks.UnixBaseOffset + testSOC*ticks.PerSecond + ticks.PerSecond/2
+
+	if timestamp := header.Timestamp(testTimeBase); timestamp != expected {
+		t.Fatalf("TestParseFrame: expected timestamp %s, received: %s", expected, timestamp)
+	}
+
+	frame[commonHeaderSize] ^= 0xFF
+
+	if _, _, err := ParseFrame(frame); !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("TestParseFrame: expected checksum mismatch, received: %v", err)
+	}
+
+	if _, _, err := ParseFrame(frame[:len(frame)-1]); !errors.Is(err, ErrInvalidFrame) {
+		t.Fatalf("TestParseFrame: expected invalid frame for truncated frame, received: %v", err)
+	}
+
+	if _, _, err := ParseFrame([]byte{0x55, 0x01}); !errors.Is(err, ErrInvalidFrame) {
+		t.Fatalf("TestParseFrame: expected invalid frame for bad sync, received: %v", err)
+	}
+}
+
+func TestEncodeCommandFrame(t *testing.T) {
+	timestamp := ticks.UnixBaseOffset + testSOC*ticks.PerSecond + 250*ticks.PerMillisecond
+	frame := EncodeCommandFrame(testStreamIDCode, Command.EnableRealTimeData, timestamp, 2)
+	header, payload, err := ParseFrame(frame)
+
+	if err != nil {
+		t.Fatalf("TestEncodeCommandFrame: failed to parse command frame: %s", err.Error())
+	}
+
+	if len(frame) != commandFrameSize || header.FrameType != FrameType.Command || header.Version != 2 || header.IDCode != testStreamIDCode {
+		t.Fatalf("TestEncodeCommandFrame: unexpected command frame header: %+v", header)
+	}
+
+	if command := CommandEnum(binary.BigEndian.Uint16(payload)); command != Command.EnableRealTimeData {
+		t.Fatalf("TestEncodeCommandFrame: expected command %s, received: %s", Command.EnableRealTimeData, command)
+	}
+
+	if header.Timestamp(testTimeBase) != timestamp {
+		t.Fatalf("TestEncodeCommandFrame: expected timestamp %s, received: %s", timestamp, header.Timestamp(testTimeBase))
+	}
+}
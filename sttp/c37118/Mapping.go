@@ -0,0 +1,382 @@
+//******************************************************************************************************
+//  Mapping.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Namespace is the namespace used to derive the deterministic SignalIDs of mapped measurements
+// from the PMU ID code and signal reference, see guid.FromName.
+var Namespace, _ = guid.Parse("b4735dd0-a087-4a33-9a9b-e6016376aea2")
+
+// SignalDefinition defines an STTP measurement mapped from a value of an IEEE C37.118 data frame.
+type SignalDefinition struct {
+	// SignalID is the deterministic identifier of the measurement, derived from Namespace, the
+	// PMU ID code and SignalReference so it is stable across connections.
+	SignalID guid.Guid
+
+	// ID is the numeric part of the measurement key, i.e., Source:ID, unique within the data stream.
+	ID uint64
+
+	// DeviceAcronym is the acronym of the PMU the measurement belongs to.
+	DeviceAcronym string
+
+	// SignalReference is the reference to the signal, e.g., SHELBY-PA1 for the angle of the first phasor.
+	SignalReference string
+
+	// SignalKind is the kind of signal the measurement represents.
+	SignalKind transport.SignalKindEnum
+
+	// SignalType is the specific signal type acronym, e.g., VPHM, see transport.SignalKindEnum.SignalTypeAcronym.
+	SignalType string
+
+	// PointTag is the human-readable tag of the measurement, e.g., SHELBY:VPHM1.
+	PointTag string
+
+	// Description is a general description of the measurement.
+	Description string
+
+	// PhasorSourceIndex is the 1-based index of the phasor of an angle or magnitude measurement; zero otherwise.
+	PhasorSourceIndex int
+}
+
+// Mapping maps the values of IEEE C37.118 data frames to STTP measurements based on a configuration
+// frame. Each PMU in the data stream maps to the measurements SF (status flags), FQ (frequency),
+// DF (dF/dt), PA and PM (angle and magnitude) for each phasor, AV for each analog and DV for each
+// digital word, in that order. Phasor angles are mapped in degrees.
+type Mapping struct {
+	config    *ConfigurationFrame
+	source    string
+	acronyms  []string
+	signals   []*SignalDefinition
+	updatedOn time.Time
+}
+
+// NewMapping creates a new Mapping for the specified configuration frame. Device acronyms are derived
+// from PMU station names; measurement keys use the source C37118_<IDCode>, where IDCode is the ID
+// code of the data stream.
+func NewMapping(config *ConfigurationFrame) *Mapping {
+	m := &Mapping{
+		config:    config,
+		source:    "C37118_" + strconv.Itoa(int(config.IDCode)),
+		acronyms:  make([]string, len(config.Cells)),
+		updatedOn: time.Now().UTC(),
+	}
+
+	used := make(map[string]bool, len(config.Cells))
+
+	for i, cell := range config.Cells {
+		acronym := deviceAcronym(cell)
+
+		if used[acronym] {
+			acronym += "_" + strconv.Itoa(int(cell.IDCode))
+		}
+
+		used[acronym] = true
+		m.acronyms[i] = acronym
+		m.defineSignals(cell, acronym)
+	}
+
+	return m
+}
+
+// deviceAcronym derives a device acronym from the station name of a PMU, i.e., upper case letters,
+// digits and underscores so that the acronym can prefix a signal reference.
+func deviceAcronym(cell *ConfigurationCell) string {
+	acronym := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		default:
+			return '_'
+		}
+	}, strings.TrimSpace(cell.StationName))
+
+	if strings.Trim(acronym, "_") == "" {
+		return "PMU" + strconv.Itoa(int(cell.IDCode))
+	}
+
+	return acronym
+}
+
+func (m *Mapping) defineSignals(cell *ConfigurationCell, acronym string) {
+	define := func(kind transport.SignalKindEnum, index int, phasorType PhasorTypeEnum, description string) {
+		signalType := kind.SignalTypeAcronym(phasorType.Acronym())
+		signalReference := acronym + "-" + kind.Acronym()
+		pointTag := acronym + ":" + signalType
+		phasorSourceIndex := 0
+
+		if index > 0 {
+			signalReference += strconv.Itoa(index)
+			pointTag += strconv.Itoa(index)
+
+			if kind == transport.SignalKind.Angle || kind == transport.SignalKind.Magnitude {
+				phasorSourceIndex = index
+			}
+		}
+
+		m.signals = append(m.signals, &SignalDefinition{
+			SignalID:          guid.FromName(Namespace, strconv.Itoa(int(cell.IDCode))+"/"+signalReference),
+			ID:                uint64(len(m.signals) + 1),
+			DeviceAcronym:     acronym,
+			SignalReference:   signalReference,
+			SignalKind:        kind,
+			SignalType:        signalType,
+			PointTag:          pointTag,
+			Description:       description,
+			PhasorSourceIndex: phasorSourceIndex,
+		})
+	}
+
+	define(transport.SignalKind.Status, 0, PhasorType.Voltage, cell.StationName+" status flags")
+	define(transport.SignalKind.Frequency, 0, PhasorType.Voltage, cell.StationName+" frequency")
+	define(transport.SignalKind.DfDt, 0, PhasorType.Voltage, cell.StationName+" frequency delta (dF/dt)")
+
+	for i, phasor := range cell.Phasors {
+		description := cell.StationName + " " + phasor.Name + " " + strings.ToLower(phasor.Type.String()) + " phasor"
+		define(transport.SignalKind.Angle, i+1, phasor.Type, description+" angle")
+		define(transport.SignalKind.Magnitude, i+1, phasor.Type, description+" magnitude")
+	}
+
+	for i, analog := range cell.Analogs {
+		define(transport.SignalKind.Analog, i+1, PhasorType.Voltage, cell.StationName+" "+analog.Name+" analog value")
+	}
+
+	for i := range cell.Digitals {
+		define(transport.SignalKind.Digital, i+1, PhasorType.Voltage, cell.StationName+" digital word "+strconv.Itoa(i+1))
+	}
+}
+
+// Configuration gets the configuration frame of the Mapping.
+func (m *Mapping) Configuration() *ConfigurationFrame {
+	return m.config
+}
+
+// Source gets the source of the measurement keys of the Mapping, i.e., Source:ID.
+func (m *Mapping) Source() string {
+	return m.source
+}
+
+// Signals gets the definitions of the mapped measurements, in the order they are produced by Measurements.
+func (m *Mapping) Signals() []*SignalDefinition {
+	return append([]*SignalDefinition(nil), m.signals...)
+}
+
+// SignalIDs gets the SignalIDs of the mapped measurements, in the order they are produced by Measurements.
+func (m *Mapping) SignalIDs() []guid.Guid {
+	signalIDs := make([]guid.Guid, len(m.signals))
+
+	for i, signal := range m.signals {
+		signalIDs[i] = signal.SignalID
+	}
+
+	return signalIDs
+}
+
+// Measurements maps the values of a data frame, parsed with the configuration frame of the Mapping,
+// to STTP measurements. The state flags of each measurement are derived from the STAT word of its PMU.
+func (m *Mapping) Measurements(frame *DataFrame) []transport.Measurement {
+	measurements := make([]transport.Measurement, 0, len(m.signals))
+
+	add := func(value float64, flags transport.StateFlagsEnum) {
+		measurements = append(measurements, transport.Measurement{
+			SignalID:  m.signals[len(measurements)].SignalID,
+			Value:     value,
+			Timestamp: frame.Timestamp,
+			Flags:     flags,
+		})
+	}
+
+	for i := range frame.Cells {
+		cell := &frame.Cells[i]
+		flags := StatusFlags(cell.Status)
+
+		add(float64(cell.Status), flags)
+		add(cell.Frequency, flags)
+		add(cell.DfDt, flags)
+
+		for _, phasor := range cell.Phasors {
+			add(phasor.Angle*180.0/math.Pi, flags)
+			add(phasor.Magnitude, flags)
+		}
+
+		for _, analog := range cell.Analogs {
+			add(analog, flags)
+		}
+
+		for _, digital := range cell.Digitals {
+			add(float64(digital), flags)
+		}
+	}
+
+	return measurements
+}
+
+// StatusFlags gets the measurement state flags for the STAT word of a PMU, i.e., BadData for a data
+// error, BadTime for a synchronization error and SuspectTime for an unlocked time source.
+func StatusFlags(status uint16) transport.StateFlagsEnum {
+	flags := transport.StateFlags.Normal
+
+	if status&statusDataErrorMask != 0 {
+		flags |= transport.StateFlags.BadData
+	}
+
+	if status&statusSyncError != 0 {
+		flags |= transport.StateFlags.BadTime
+	}
+
+	if status&statusUnlockedTimeMask != 0 {
+		flags |= transport.StateFlags.SuspectTime
+	}
+
+	return flags
+}
+
+type columnDefinition struct {
+	name     string
+	dataType data.DataTypeEnum
+}
+
+var deviceDetailColumns = []columnDefinition{
+	{"UniqueID", data.DataType.Guid},
+	{"IsConcentrator", data.DataType.Boolean},
+	{"Acronym", data.DataType.String},
+	{"Name", data.DataType.String},
+	{"AccessID", data.DataType.Int32},
+	{"ProtocolName", data.DataType.String},
+	{"FramesPerSecond", data.DataType.Int32},
+	{"Longitude", data.DataType.Decimal},
+	{"Latitude", data.DataType.Decimal},
+	{"Enabled", data.DataType.Boolean},
+	{"UpdatedOn", data.DataType.DateTime},
+}
+
+var measurementDetailColumns = []columnDefinition{
+	{"DeviceAcronym", data.DataType.String},
+	{"ID", data.DataType.String},
+	{"SignalID", data.DataType.Guid},
+	{"PointTag", data.DataType.String},
+	{"SignalReference", data.DataType.String},
+	{"SignalAcronym", data.DataType.String},
+	{"PhasorSourceIndex", data.DataType.Int32},
+	{"Description", data.DataType.String},
+	{"Internal", data.DataType.Boolean},
+	{"Enabled", data.DataType.Boolean},
+	{"UpdatedOn", data.DataType.DateTime},
+}
+
+var phasorDetailColumns = []columnDefinition{
+	{"ID", data.DataType.Int32},
+	{"DeviceAcronym", data.DataType.String},
+	{"Label", data.DataType.String},
+	{"Type", data.DataType.String},
+	{"Phase", data.DataType.String},
+	{"SourceIndex", data.DataType.Int32},
+	{"UpdatedOn", data.DataType.DateTime},
+}
+
+// DataSet synthesizes STTP metadata for the mapped measurements, i.e., DeviceDetail, MeasurementDetail
+// and PhasorDetail tables following the STTP metadata schema, so the DataSet can be used with
+// metadata.NewSnapshot or to define the metadata of a transport.DataPublisher.
+func (m *Mapping) DataSet() *data.DataSet {
+	dataSet := data.NewDataSet()
+	devices := createTable(dataSet, "DeviceDetail", deviceDetailColumns)
+	measurements := createTable(dataSet, "MeasurementDetail", measurementDetailColumns)
+	phasors := createTable(dataSet, "PhasorDetail", phasorDetailColumns)
+
+	protocolName := "IEEE C37.118-2005"
+
+	if m.config.Version >= 2 {
+		protocolName = "IEEE C37.118.2-2011"
+	}
+
+	framesPerSecond := int32(math.Max(math.Round(m.config.FramesPerSecond()), 1))
+
+	for i, cell := range m.config.Cells {
+		acronym := m.acronyms[i]
+		uniqueID := cell.GlobalID
+
+		if uniqueID.IsZero() {
+			uniqueID = guid.FromName(Namespace, strconv.Itoa(int(cell.IDCode)))
+		}
+
+		var longitude, latitude interface{}
+
+		if cell.Longitude != 0.0 || cell.Latitude != 0.0 {
+			longitude = decimal.NewFromFloat(cell.Longitude)
+			latitude = decimal.NewFromFloat(cell.Latitude)
+		}
+
+		addRow(devices, uniqueID, false, acronym, cell.StationName, int32(cell.IDCode), protocolName,
+			framesPerSecond, longitude, latitude, true, m.updatedOn)
+
+		for j, phasor := range cell.Phasors {
+			addRow(phasors, int32(phasors.RowCount()+1), acronym, phasor.Name, string(phasor.Type.Acronym()),
+				phasor.Phase, int32(j+1), m.updatedOn)
+		}
+	}
+
+	for _, signal := range m.signals {
+		addRow(measurements, signal.DeviceAcronym, m.source+":"+strconv.FormatUint(signal.ID, 10), signal.SignalID,
+			signal.PointTag, signal.SignalReference, signal.SignalType, int32(signal.PhasorSourceIndex),
+			signal.Description, false, true, m.updatedOn)
+	}
+
+	return dataSet
+}
+
+func createTable(dataSet *data.DataSet, name string, columns []columnDefinition) *data.DataTable {
+	table := dataSet.CreateTable(name)
+
+	for _, column := range columns {
+		table.AddColumn(table.CreateColumn(column.name, column.dataType, ""))
+	}
+
+	dataSet.AddTable(table)
+	return table
+}
+
+// addRow adds a row with the specified values, in column order; nil values are null.
+func addRow(table *data.DataTable, values ...interface{}) {
+	row := table.CreateRow()
+
+	for i, value := range values {
+		if value != nil {
+			row.SetValue(i, value)
+		}
+	}
+
+	table.AddRow(row)
+}
@@ -0,0 +1,220 @@
+//******************************************************************************************************
+//  Mapping_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package c37118
+
+import (
+	"math"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/metadata"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func TestMappingSignals(t *testing.T) {
+	config, _ := ParseConfigurationFrame(buildConfigurationFrame2(testCells))
+	mapping := NewMapping(config)
+	signals := mapping.Signals()
+
+	// Shelby maps SF, FQ, DF, 4 phasors, 1 analog and 1 digital; Dell maps SF, FQ, DF and 1 phasor
+	if len(signals) != 18 {
+		t.Fatalf("TestMappingSignals: expected 18 signals, received: %d", len(signals))
+	}
+
+	expected := []struct {
+		index                     int
+		signalReference, pointTag string
+		kind                      transport.SignalKindEnum
+		phasorSourceIndex         int
+	}{
+		{0, "SHELBY_PMU-SF", "SHELBY_PMU:FLAG", transport.SignalKind.Status, 0},
+		{1, "SHELBY_PMU-FQ", "SHELBY_PMU:FREQ", transport.SignalKind.Frequency, 0},
+		{2, "SHELBY_PMU-DF", "SHELBY_PMU:DFDT", transport.SignalKind.DfDt, 0},
+		{3, "SHELBY_PMU-PA1", "SHELBY_PMU:VPHA1", transport.SignalKind.Angle, 1},
+		{4, "SHELBY_PMU-PM1", "SHELBY_PMU:VPHM1", transport.SignalKind.Magnitude, 1},
+		{10, "SHELBY_PMU-PM4", "SHELBY_PMU:IPHM4", transport.SignalKind.Magnitude, 4},
+		{11, "SHELBY_PMU-AV1", "SHELBY_PMU:ALOG1", transport.SignalKind.Analog, 0},
+		{12, "SHELBY_PMU-DV1", "SHELBY_PMU:DIGI1", transport.SignalKind.Digital, 0},
+		{17, "DELL-PM1", "DELL:VPHM1", transport.SignalKind.Magnitude, 1},
+	}
+
+	for _, test := range expected {
+		signal := signals[test.index]
+
+		if signal.SignalReference != test.signalReference || signal.PointTag != test.pointTag || signal.SignalKind != test.kind || signal.PhasorSourceIndex != test.phasorSourceIndex {
+			t.Fatalf("TestMappingSignals: unexpected signal %d: %+v", test.index, signal)
+		}
+
+		if signal.ID != uint64(test.index+1) {
+			t.Fatalf("TestMappingSignals: expected signal %d ID %d, received: %d", test.index, test.index+1, signal.ID)
+		}
+	}
+
+	// SignalIDs are deterministic and unique
+	signalIDs := NewMapping(config).SignalIDs()
+	unique := make(map[string]bool)
+
+	for i, signal := range signals {
+		if signal.SignalID != signalIDs[i] {
+			t.Fatalf("TestMappingSignals: SignalID for %s is not deterministic", signal.SignalReference)
+		}
+
+		unique[signal.SignalID.String()] = true
+	}
+
+	if len(unique) != len(signals) {
+		t.Fatalf("TestMappingSignals: expected %d unique SignalIDs, received: %d", len(signals), len(unique))
+	}
+
+	// Duplicate station names receive unique device acronyms
+	cells := []testCell{testCells[1], testCells[1]}
+	cells[1].idCode = 9
+	config, _ = ParseConfigurationFrame(buildConfigurationFrame2(cells))
+
+	if signals = NewMapping(config).Signals(); signals[5].SignalReference != "DELL_9-SF" {
+		t.Fatalf("TestMappingSignals: expected signal reference DELL_9-SF for duplicate station, received: %s", signals[5].SignalReference)
+	}
+}
+
+func TestMappingMeasurements(t *testing.T) {
+	config, _ := ParseConfigurationFrame(buildConfigurationFrame2(testCells))
+	mapping := NewMapping(config)
+	frame, _ := ParseDataFrame(buildDataFrame(0x8000|0x2000, 0), config)
+	measurements := mapping.Measurements(frame)
+	signalIDs := mapping.SignalIDs()
+
+	if len(measurements) != len(signalIDs) {
+		t.Fatalf("TestMappingMeasurements: expected %d measurements, received: %d", len(signalIDs), len(measurements))
+	}
+
+	expected := map[int]float64{
+		0:  0xA000,
+		1:  60.02,
+		2:  0.05,
+		3:  -30.0,
+		4:  100.0,
+		9:  -120.0,
+		11: 42.0,
+		12: 5.0,
+		15: 0.05,
+		16: -30.0,
+		17: 100.0,
+	}
+
+	for index, value := range expected {
+		measurement := measurements[index]
+
+		if math.Abs(measurement.Value-value) > 0.01 {
+			t.Fatalf("TestMappingMeasurements: expected measurement %d value %f, received: %f", index, value, measurement.Value)
+		}
+	}
+
+	for i, measurement := range measurements {
+		if measurement.SignalID != signalIDs[i] || measurement.Timestamp != frame.Timestamp {
+			t.Fatalf("TestMappingMeasurements: unexpected measurement %d: %s", i, measurement.String())
+		}
+
+		if measurement.Flags != transport.StateFlags.BadData|transport.StateFlags.BadTime {
+			t.Fatalf("TestMappingMeasurements: expected BadData and BadTime flags, received: %s", measurement.Flags)
+		}
+	}
+}
+
+func TestStatusFlags(t *testing.T) {
+	for status, flags := range map[uint16]transport.StateFlagsEnum{
+		0x0000: transport.StateFlags.Normal,
+		0x4000: transport.StateFlags.BadData,
+		0x2000: transport.StateFlags.BadTime,
+		0x0020: transport.StateFlags.SuspectTime,
+		0xC030: transport.StateFlags.BadData | transport.StateFlags.SuspectTime,
+		0x0800: transport.StateFlags.Normal,
+	} {
+		if result := StatusFlags(status); result != flags {
+			t.Fatalf("TestStatusFlags: expected flags %s for status 0x%04X, received: %s", flags, status, result)
+		}
+	}
+}
+
+func TestMappingDataSet(t *testing.T) {
+	config, _ := ParseConfigurationFrame(buildConfigurationFrame3(0, buildConfigurationFrame3Payload(testCells)))
+	mapping := NewMapping(config)
+	metadataXml, err := mapping.DataSet().MarshalXml()
+
+	if err != nil {
+		t.Fatalf("TestMappingDataSet: failed to serialize metadata: %s", err.Error())
+	}
+
+	// Metadata is loaded from XML to verify column types survive serialization
+	dataSet := data.FromXml(metadataXml)
+	snapshot := metadata.NewSnapshot(dataSet)
+
+	if snapshot.DeviceCount() != 2 || snapshot.MeasurementCount() != 18 || snapshot.PhasorCount() != 5 {
+		t.Fatalf("TestMappingDataSet: expected 2 devices, 18 measurements and 5 phasors, received: %d, %d and %d", snapshot.DeviceCount(), snapshot.MeasurementCount(), snapshot.PhasorCount())
+	}
+
+	device := snapshot.Device("SHELBY_PMU")
+
+	if device == nil || device.Name != "Shelby PMU" || device.AccessID != 7 || device.UniqueID != config.Cells[0].GlobalID {
+		t.Fatalf("TestMappingDataSet: unexpected device: %+v", device)
+	}
+
+	if device.ProtocolName != "IEEE C37.118.2-2011" || device.FramesPerSecond != 30 || math.Abs(device.Latitude-35.1) > 1e-5 || !device.Enabled {
+		t.Fatalf("TestMappingDataSet: unexpected device details: %+v", device)
+	}
+
+	if len(device.Phasors) != 4 {
+		t.Fatalf("TestMappingDataSet: expected 4 device phasors, received: %d", len(device.Phasors))
+	}
+
+	phasor := device.Phasors[3]
+
+	if phasor.Label != "LINE I1" || phasor.Type != "I" || phasor.Phase != "+" || phasor.SourceIndex != 4 {
+		t.Fatalf("TestMappingDataSet: unexpected phasor: %+v", phasor)
+	}
+
+	if phasor.Magnitude == nil || phasor.Magnitude.SignalReference != "SHELBY_PMU-PM4" || phasor.Angle == nil || phasor.Angle.SignalAcronym != "IPHA" {
+		t.Fatalf("TestMappingDataSet: phasor measurements are not linked: %+v", phasor)
+	}
+
+	measurement := snapshot.MeasurementByKey(mapping.Source() + ":2")
+
+	if measurement == nil || measurement.SignalReference != "SHELBY_PMU-FQ" || measurement.SignalID != mapping.Signals()[1].SignalID {
+		t.Fatalf("TestMappingDataSet: unexpected measurement for key %s:2: %+v", mapping.Source(), measurement)
+	}
+
+	// Synthesized metadata supports the filter expressions used with STTP metadata
+	if err := transport.NewDataPublisher().DefineMetadata(dataSet); err != nil {
+		t.Fatalf("TestMappingDataSet: failed to define publisher metadata: %s", err.Error())
+	}
+
+	signalIDs, err := data.SelectSignalIDSet(dataSet, "FILTER MeasurementDetail WHERE SignalAcronym = 'FREQ'", "MeasurementDetail", nil, true)
+
+	if err != nil {
+		t.Fatalf("TestMappingDataSet: failed to select signals: %s", err.Error())
+	}
+
+	if len(signalIDs) != 2 || !signalIDs.Contains(mapping.Signals()[1].SignalID) {
+		t.Fatalf("TestMappingDataSet: expected 2 frequency signals, received: %d", len(signalIDs))
+	}
+}
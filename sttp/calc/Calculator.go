@@ -0,0 +1,302 @@
+//******************************************************************************************************
+//  Calculator.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package calc
+
+import (
+	"math"
+	"math/cmplx"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/metadata"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Namespace is the namespace used to derive the deterministic SignalIDs of calculated outputs
+// from their signal references, see guid.FromName.
+var Namespace, _ = guid.Parse("b0ab69e3-d6f4-4b29-baea-93e14794533f")
+
+// Output defines a measurement calculated by a Calculator.
+type Output struct {
+	// SignalID is the deterministic identifier of the calculated measurement, derived from
+	// Namespace and SignalReference so it is stable across runs.
+	SignalID guid.Guid
+
+	// SignalReference is the reference to the calculated signal, e.g., SHELBY-VPH1-POS-MAG
+	// for the positive sequence magnitude of voltage phase set SHELBY-VPH1.
+	SignalReference string
+
+	// Description is a general description of the calculated measurement.
+	Description string
+}
+
+// Suffixes of sequence component outputs, in calculation order
+var sequenceOutputs = [...]struct{ suffix, description string }{
+	{"-ZERO-MAG", "zero sequence magnitude"},
+	{"-ZERO-ANG", "zero sequence angle"},
+	{"-POS-MAG", "positive sequence magnitude"},
+	{"-POS-ANG", "positive sequence angle"},
+	{"-NEG-MAG", "negative sequence magnitude"},
+	{"-NEG-ANG", "negative sequence angle"},
+}
+
+// Suffixes of power outputs, in calculation order
+var powerOutputs = [...]struct{ suffix, description string }{
+	{"-MW", "active power in megawatts"},
+	{"-MVAR", "reactive power in megavars"},
+	{"-MVA", "apparent power in megavolt-amperes"},
+	{"-PF", "power factor"},
+}
+
+type sequenceCalculation struct {
+	phaseSet *PhaseSet
+	outputs  [len(sequenceOutputs)]guid.Guid
+}
+
+type powerCalculation struct {
+	voltage *PhaseSet
+	current *PhaseSet
+	outputs [len(powerOutputs)]guid.Guid
+}
+
+// Calculator computes symmetrical components and power quantities for the phase sets defined in
+// metadata. Sequence components are calculated for each three-phase set. Power is calculated for
+// each current set paired with a voltage set, preferring the voltage phasor identified by the
+// PhasorDetail DestinationPhasorID, otherwise the first voltage set of the same device; three-phase
+// sets are paired with three-phase sets and positive sequence sets with positive sequence sets.
+// Voltages are expected to be line-to-neutral in volts and currents in amperes.
+type Calculator struct {
+	angleUnits    sttp.AngleUnitsEnum
+	adjustedValue func(measurement *transport.Measurement) float64
+	phaseSets     []*PhaseSet
+	sequences     []*sequenceCalculation
+	powers        []*powerCalculation
+	outputs       []Output
+}
+
+// NewCalculator creates a new Calculator for the phase sets defined in the specified metadata snapshot,
+// see FindPhaseSets. Angle units apply to received angle measurements and calculated angle outputs.
+func NewCalculator(snapshot *metadata.Snapshot, angleUnits sttp.AngleUnitsEnum) *Calculator {
+	calculator := &Calculator{
+		angleUnits: angleUnits,
+		phaseSets:  FindPhaseSets(snapshot),
+	}
+
+	for _, phaseSet := range calculator.phaseSets {
+		if !phaseSet.IsThreePhase() {
+			continue
+		}
+
+		sequence := &sequenceCalculation{phaseSet: phaseSet}
+
+		for i, output := range sequenceOutputs {
+			sequence.outputs[i] = calculator.defineOutput(phaseSet.Name()+output.suffix, phaseSet.Name()+" "+output.description)
+		}
+
+		calculator.sequences = append(calculator.sequences, sequence)
+	}
+
+	for _, current := range calculator.phaseSets {
+		if current.IsVoltage() {
+			continue
+		}
+
+		voltage := calculator.findVoltage(current)
+
+		if voltage == nil {
+			continue
+		}
+
+		power := &powerCalculation{voltage: voltage, current: current}
+
+		for i, output := range powerOutputs {
+			power.outputs[i] = calculator.defineOutput(current.Name()+output.suffix, current.Name()+" "+output.description)
+		}
+
+		calculator.powers = append(calculator.powers, power)
+	}
+
+	return calculator
+}
+
+func (c *Calculator) defineOutput(signalReference string, description string) guid.Guid {
+	signalID := guid.FromName(Namespace, signalReference)
+
+	c.outputs = append(c.outputs, Output{
+		SignalID:        signalID,
+		SignalReference: signalReference,
+		Description:     description,
+	})
+
+	return signalID
+}
+
+// findVoltage finds the voltage set to pair with a current set for power calculations.
+func (c *Calculator) findVoltage(current *PhaseSet) *PhaseSet {
+	destinationID := current.Phasors[0].DestinationPhasorID
+
+	if destinationID > 0 {
+		for _, voltage := range c.phaseSets {
+			if voltage.IsVoltage() && voltage.IsThreePhase() == current.IsThreePhase() && voltage.contains(destinationID) {
+				return voltage
+			}
+		}
+	}
+
+	for _, voltage := range c.phaseSets {
+		if voltage.IsVoltage() && voltage.IsThreePhase() == current.IsThreePhase() && voltage.Device == current.Device {
+			return voltage
+		}
+	}
+
+	return nil
+}
+
+// SetValueAdjuster defines the function used to get the value of received measurements, e.g.,
+// Subscriber.AdjustedValue to apply Adder and Multiplier metadata. Set to nil to use received values.
+func (c *Calculator) SetValueAdjuster(adjustedValue func(measurement *transport.Measurement) float64) {
+	c.adjustedValue = adjustedValue
+}
+
+// PhaseSets gets the phase sets identified from metadata.
+func (c *Calculator) PhaseSets() []*PhaseSet {
+	return c.phaseSets
+}
+
+// Outputs gets the definitions of the measurements the Calculator produces.
+func (c *Calculator) Outputs() []Output {
+	return c.outputs
+}
+
+// Calculate computes the sequence components and power quantities for a Frame of measurements,
+// see sttp.Concentrator, and returns them as derived measurements with the frame timestamp.
+// Quantities are only calculated when all of their inputs are in the frame; the flags of a
+// derived measurement are the combined flags of its inputs.
+func (c *Calculator) Calculate(frame *sttp.Frame) []transport.Measurement {
+	var results []transport.Measurement
+
+	output := func(signalID guid.Guid, value float64, flags transport.StateFlagsEnum) {
+		results = append(results, transport.Measurement{
+			SignalID:  signalID,
+			Value:     value,
+			Timestamp: frame.Timestamp,
+			Flags:     flags,
+		})
+	}
+
+	for _, sequence := range c.sequences {
+		phasors, flags, ok := c.phasorValues(frame, sequence.phaseSet)
+
+		if !ok {
+			continue
+		}
+
+		zero, positive, negative := SequenceComponents(phasors[0], phasors[1], phasors[2])
+
+		for i, component := range []complex128{zero, positive, negative} {
+			output(sequence.outputs[i*2], cmplx.Abs(component), flags)
+			output(sequence.outputs[i*2+1], c.toAngleUnits(cmplx.Phase(component)), flags)
+		}
+	}
+
+	for _, power := range c.powers {
+		voltages, voltageFlags, ok := c.phasorValues(frame, power.voltage)
+
+		if !ok {
+			continue
+		}
+
+		currents, currentFlags, ok := c.phasorValues(frame, power.current)
+
+		if !ok {
+			continue
+		}
+
+		var value complex128
+
+		if power.current.IsThreePhase() {
+			value = ThreePhasePower([3]complex128(voltages), [3]complex128(currents))
+		} else {
+			value = PositiveSequencePower(voltages[0], currents[0])
+		}
+
+		flags := voltageFlags | currentFlags
+
+		output(power.outputs[0], real(value)/1.0e6, flags)
+		output(power.outputs[1], imag(value)/1.0e6, flags)
+		output(power.outputs[2], cmplx.Abs(value)/1.0e6, flags)
+		output(power.outputs[3], PowerFactor(value), flags)
+	}
+
+	return results
+}
+
+// phasorValues gets the complex values of the phasors of a set from a frame.
+func (c *Calculator) phasorValues(frame *sttp.Frame, phaseSet *PhaseSet) ([]complex128, transport.StateFlagsEnum, bool) {
+	values := make([]complex128, len(phaseSet.Phasors))
+	var flags transport.StateFlagsEnum
+
+	for i := range phaseSet.Phasors {
+		magnitude, found := frame.Measurements[phaseSet.Magnitudes[i].SignalID]
+
+		if !found {
+			return nil, 0, false
+		}
+
+		angle, found := frame.Measurements[phaseSet.Angles[i].SignalID]
+
+		if !found {
+			return nil, 0, false
+		}
+
+		values[i] = cmplx.Rect(c.value(&magnitude), c.fromAngleUnits(c.value(&angle)))
+		flags |= magnitude.Flags | angle.Flags
+	}
+
+	return values, flags, true
+}
+
+func (c *Calculator) value(measurement *transport.Measurement) float64 {
+	if c.adjustedValue != nil {
+		return c.adjustedValue(measurement)
+	}
+
+	return measurement.Value
+}
+
+func (c *Calculator) fromAngleUnits(angle float64) float64 {
+	if c.angleUnits == sttp.AngleUnits.Degrees {
+		return angle * math.Pi / 180.0
+	}
+
+	return angle
+}
+
+func (c *Calculator) toAngleUnits(angle float64) float64 {
+	if c.angleUnits == sttp.AngleUnits.Degrees {
+		return angle * 180.0 / math.Pi
+	}
+
+	return angle
+}
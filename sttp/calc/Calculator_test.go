@@ -0,0 +1,249 @@
+//******************************************************************************************************
+//  Calculator_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package calc
+
+import (
+	"math"
+	"math/cmplx"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/sttp/goapi/sttp"
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/metadata"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+func addTestTable(dataSet *data.DataSet, name string, columns []string, rows [][]string) {
+	table := dataSet.CreateTable(name)
+
+	for _, column := range columns {
+		table.AddColumn(table.CreateColumn(column, data.DataType.String, ""))
+	}
+
+	for _, values := range rows {
+		row := table.CreateRow()
+
+		for i, value := range values {
+			row.SetValue(i, value)
+		}
+
+		table.AddRow(row)
+	}
+
+	dataSet.AddTable(table)
+}
+
+// newThreePhaseSnapshot creates metadata for a device with three-phase voltage and current phasors
+// where measurements are linked to phasors by signal reference only.
+func newThreePhaseSnapshot() (*metadata.Snapshot, map[string]guid.Guid) {
+	dataSet := data.NewDataSet()
+	addTestTable(dataSet, "DeviceDetail", []string{"Acronym"}, [][]string{{"SUB1"}})
+
+	phasors := [][]string{
+		{"1", "SUB1", "Bus A", "V", "A", "0", "1"},
+		{"2", "SUB1", "Bus B", "V", "B", "0", "2"},
+		{"3", "SUB1", "Bus C", "V", "C", "0", "3"},
+		{"4", "SUB1", "Line A", "I", "A", "1", "4"},
+		{"5", "SUB1", "Line B", "I", "B", "1", "5"},
+		{"6", "SUB1", "Line C", "I", "C", "1", "6"},
+		{"7", "SUB1", "Spare A", "I", "A", "0", "7"},
+	}
+
+	addTestTable(dataSet, "PhasorDetail", []string{"ID", "DeviceAcronym", "Label", "Type", "Phase", "DestinationPhasorID", "SourceIndex"}, phasors)
+
+	signalIDs := make(map[string]guid.Guid)
+	var measurements [][]string
+
+	for index := 1; index <= len(phasors); index++ {
+		for _, kind := range []string{"PM", "PA"} {
+			signalReference := "SUB1-" + kind + strconv.Itoa(index)
+			signalIDs[signalReference] = guid.New()
+			measurements = append(measurements, []string{signalIDs[signalReference].String(), "SUB1", signalReference})
+		}
+	}
+
+	addTestTable(dataSet, "MeasurementDetail", []string{"SignalID", "DeviceAcronym", "SignalReference"}, measurements)
+
+	return metadata.NewSnapshot(dataSet), signalIDs
+}
+
+func TestSequenceComponents(t *testing.T) {
+	phaseA := cmplx.Rect(100.0, 0.0)
+	phaseB := cmplx.Rect(100.0, -2.0*math.Pi/3.0)
+	phaseC := cmplx.Rect(100.0, 2.0*math.Pi/3.0)
+
+	zero, positive, negative := SequenceComponents(phaseA, phaseB, phaseC)
+
+	if cmplx.Abs(zero) > 1e-9 || cmplx.Abs(negative) > 1e-9 || cmplx.Abs(positive-phaseA) > 1e-9 {
+		t.Fatalf("TestSequenceComponents: unexpected balanced components: %v, %v, %v", zero, positive, negative)
+	}
+
+	// Identical phases are purely zero sequence
+	zero, positive, negative = SequenceComponents(phaseA, phaseA, phaseA)
+
+	if cmplx.Abs(zero-phaseA) > 1e-9 || cmplx.Abs(positive) > 1e-9 || cmplx.Abs(negative) > 1e-9 {
+		t.Fatalf("TestSequenceComponents: unexpected zero sequence components: %v, %v, %v", zero, positive, negative)
+	}
+
+	if pf := PowerFactor(complex(3.0, 4.0)); pf != 0.6 {
+		t.Fatalf("TestSequenceComponents: expected power factor of 0.6, received %f", pf)
+	}
+}
+
+func TestFindPhaseSets(t *testing.T) {
+	snapshot, _ := newThreePhaseSnapshot()
+	phaseSets := FindPhaseSets(snapshot)
+
+	// Incomplete spare current set is ignored
+	if len(phaseSets) != 2 {
+		t.Fatalf("TestFindPhaseSets: expected 2 phase sets, received %d", len(phaseSets))
+	}
+
+	voltage, current := phaseSets[0], phaseSets[1]
+
+	if !voltage.IsVoltage() || !voltage.IsThreePhase() || voltage.Name() != "SUB1-VPH1" || voltage.Phasors[2].Label != "Bus C" {
+		t.Fatalf("TestFindPhaseSets: unexpected voltage set: %s", voltage.Name())
+	}
+
+	if current.IsVoltage() || current.Name() != "SUB1-IPH4" || current.Angles[1].SignalReference != "SUB1-PA5" {
+		t.Fatalf("TestFindPhaseSets: unexpected current set: %s", current.Name())
+	}
+}
+
+func TestCalculatorThreePhase(t *testing.T) {
+	snapshot, signalIDs := newThreePhaseSnapshot()
+	calculator := NewCalculator(snapshot, sttp.AngleUnits.Degrees)
+
+	// Two sequence calculations of six outputs and one power calculation of four outputs
+	if outputs := calculator.Outputs(); len(outputs) != 16 {
+		t.Fatalf("TestCalculatorThreePhase: expected 16 outputs, received %d", len(outputs))
+	}
+
+	frame := &sttp.Frame{Timestamp: 1000, Measurements: make(map[guid.Guid]transport.Measurement)}
+
+	add := func(signalReference string, value float64, flags transport.StateFlagsEnum) {
+		signalID := signalIDs[signalReference]
+		frame.Measurements[signalID] = transport.Measurement{SignalID: signalID, Value: value, Flags: flags}
+	}
+
+	// Balanced 1000 V, 100 A system with current lagging voltage by 30 degrees
+	for i, angle := range []float64{0.0, -120.0, 120.0} {
+		add("SUB1-PM"+strconv.Itoa(i+1), 1000.0, 0)
+		add("SUB1-PA"+strconv.Itoa(i+1), angle, 0)
+		add("SUB1-PM"+strconv.Itoa(i+4), 100.0, 0)
+		add("SUB1-PA"+strconv.Itoa(i+4), angle-30.0, 0)
+	}
+
+	add("SUB1-PM6", 100.0, transport.StateFlags.SuspectData)
+
+	results := make(map[guid.Guid]transport.Measurement)
+
+	for _, measurement := range calculator.Calculate(frame) {
+		results[measurement.SignalID] = measurement
+	}
+
+	expect := func(signalReference string, expected float64) transport.Measurement {
+		measurement, found := results[guid.FromName(Namespace, signalReference)]
+
+		if !found || math.Abs(measurement.Value-expected) > 1e-6 || measurement.Timestamp != frame.Timestamp {
+			t.Fatalf("TestCalculatorThreePhase: expected %s of %f, received %f (found: %t)", signalReference, expected, measurement.Value, found)
+		}
+
+		return measurement
+	}
+
+	expect("SUB1-VPH1-POS-MAG", 1000.0)
+	expect("SUB1-VPH1-POS-ANG", 0.0)
+	expect("SUB1-VPH1-NEG-MAG", 0.0)
+	expect("SUB1-VPH1-ZERO-MAG", 0.0)
+	expect("SUB1-IPH4-POS-ANG", -30.0)
+
+	// S = 3 * 1000 * 100 = 0.3 MVA at power factor cos(30)
+	expect("SUB1-IPH4-MW", 0.3*math.Cos(math.Pi/6.0))
+	expect("SUB1-IPH4-MVAR", 0.3*math.Sin(math.Pi/6.0))
+	expect("SUB1-IPH4-MVA", 0.3)
+
+	if measurement := expect("SUB1-IPH4-PF", math.Cos(math.Pi/6.0)); measurement.Flags != transport.StateFlags.SuspectData {
+		t.Fatalf("TestCalculatorThreePhase: expected input flags to propagate to power outputs")
+	}
+
+	if measurement := results[guid.FromName(Namespace, "SUB1-VPH1-POS-MAG")]; measurement.Flags != 0 {
+		t.Fatalf("TestCalculatorThreePhase: expected unrelated input flags not to propagate")
+	}
+
+	// Missing inputs suppress dependent outputs only
+	delete(frame.Measurements, signalIDs["SUB1-PA2"])
+
+	if results := calculator.Calculate(frame); len(results) != 6 {
+		t.Fatalf("TestCalculatorThreePhase: expected only current sequence outputs, received %d", len(results))
+	}
+}
+
+func TestCalculatorPositiveSequence(t *testing.T) {
+	buffer, err := os.ReadFile("../../test/SampleMetadata.xml")
+
+	if err != nil {
+		t.Fatalf("Failed to load sample metadata: %s", err.Error())
+	}
+
+	dataSet := data.NewDataSet()
+
+	if err = dataSet.ParseXml(buffer); err != nil {
+		t.Fatalf("Failed to parse sample metadata: %s", err.Error())
+	}
+
+	snapshot := metadata.NewSnapshot(dataSet)
+	calculator := NewCalculator(snapshot, sttp.AngleUnits.Degrees)
+
+	if count := len(calculator.PhaseSets()); count != 5 {
+		t.Fatalf("TestCalculatorPositiveSequence: expected 5 phase sets, received %d", count)
+	}
+
+	// Three current phasors paired with first voltage phasor of the device
+	if count := len(calculator.Outputs()); count != 12 {
+		t.Fatalf("TestCalculatorPositiveSequence: expected 12 outputs, received %d", count)
+	}
+
+	frame := &sttp.Frame{Measurements: make(map[guid.Guid]transport.Measurement)}
+
+	for _, measurement := range snapshot.Measurements() {
+		switch measurement.SignalReference {
+		case "SHELBY-PM1":
+			frame.Measurements[measurement.SignalID] = transport.Measurement{Value: 300000.0}
+		case "SHELBY-PA1", "SHELBY-PA3":
+			frame.Measurements[measurement.SignalID] = transport.Measurement{Value: 10.0}
+		case "SHELBY-PM3":
+			frame.Measurements[measurement.SignalID] = transport.Measurement{Value: 1000.0}
+		}
+	}
+
+	results := calculator.Calculate(frame)
+
+	if len(results) != 4 || results[0].SignalID != guid.FromName(Namespace, "SHELBY-IPH3-MW") || math.Abs(results[0].Value-900.0) > 1e-6 {
+		t.Fatalf("TestCalculatorPositiveSequence: unexpected results: %v", results)
+	}
+}
@@ -0,0 +1,175 @@
+//******************************************************************************************************
+//  PhaseSet.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package calc
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/sttp/goapi/sttp/metadata"
+)
+
+// PhaseSet represents a set of related phasors of a device, either the phase A, B and C phasors of a
+// three-phase voltage or current, or a single positive sequence phasor.
+type PhaseSet struct {
+	// Device is the acronym of the device that defines the phasors.
+	Device string
+
+	// Type is the phasor type, V for voltage or I for current.
+	Type string
+
+	// Phasors are the phase A, B and C phasors, in that order, of a three-phase set, or the single
+	// phasor of a positive sequence set.
+	Phasors []*metadata.Phasor
+
+	// Magnitudes are the magnitude measurements of the phasors, in phasor order.
+	Magnitudes []*metadata.Measurement
+
+	// Angles are the angle measurements of the phasors, in phasor order.
+	Angles []*metadata.Measurement
+}
+
+// IsThreePhase determines if the set contains phase A, B and C phasors; otherwise, the set
+// contains a single positive sequence phasor.
+func (ps *PhaseSet) IsThreePhase() bool {
+	return len(ps.Phasors) == 3
+}
+
+// IsVoltage determines if the set contains voltage phasors.
+func (ps *PhaseSet) IsVoltage() bool {
+	return ps.Type == "V"
+}
+
+// Name gets a signal reference style name of the set based on its device, type and the
+// source index of its first phasor, e.g., SHELBY-VPH1.
+func (ps *PhaseSet) Name() string {
+	return ps.Device + "-" + ps.Type + "PH" + strconv.Itoa(ps.Phasors[0].SourceIndex)
+}
+
+// contains determines if the set contains the phasor with the specified ID.
+func (ps *PhaseSet) contains(phasorID int) bool {
+	for _, phasor := range ps.Phasors {
+		if phasor.ID == phasorID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FindPhaseSets identifies the phase sets of each device in the specified metadata snapshot using the
+// PhasorDetail Type and Phase. Phase A, B and C phasors of the same type are grouped in source index
+// order, e.g., phasors 1, 2 and 3 with phases A, B and C; incomplete three-phase sets are ignored.
+// Phasors with a + phase form positive sequence sets. Magnitude and angle measurements are located by
+// PhasorSourceIndex, falling back on a SignalReference match, e.g., SHELBY-PM1; phasors without both
+// measurements are ignored.
+func FindPhaseSets(snapshot *metadata.Snapshot) []*PhaseSet {
+	var phaseSets []*PhaseSet
+
+	if snapshot == nil {
+		return phaseSets
+	}
+
+	for _, device := range snapshot.Devices() {
+		for _, phasorType := range []string{"V", "I"} {
+			var pending [3]*metadata.Phasor
+
+			for _, phasor := range device.Phasors {
+				if !strings.EqualFold(phasor.Type, phasorType) || !hasComponents(device, phasor) {
+					continue
+				}
+
+				phase := strings.ToUpper(strings.TrimSpace(phasor.Phase))
+
+				if phase == "+" {
+					phaseSets = append(phaseSets, newPhaseSet(device, phasorType, phasor))
+					continue
+				}
+
+				index := strings.Index("ABC", phase)
+
+				if index < 0 || len(phase) != 1 {
+					continue
+				}
+
+				// Repeated phase starts a new set, discarding the incomplete set
+				if pending[index] != nil {
+					pending = [3]*metadata.Phasor{}
+				}
+
+				pending[index] = phasor
+
+				if pending[0] != nil && pending[1] != nil && pending[2] != nil {
+					phaseSets = append(phaseSets, newPhaseSet(device, phasorType, pending[:]...))
+					pending = [3]*metadata.Phasor{}
+				}
+			}
+		}
+	}
+
+	return phaseSets
+}
+
+func newPhaseSet(device *metadata.Device, phasorType string, phasors ...*metadata.Phasor) *PhaseSet {
+	phaseSet := &PhaseSet{
+		Device:  device.Acronym,
+		Type:    phasorType,
+		Phasors: append([]*metadata.Phasor(nil), phasors...),
+	}
+
+	for _, phasor := range phasors {
+		magnitude, angle := components(device, phasor)
+		phaseSet.Magnitudes = append(phaseSet.Magnitudes, magnitude)
+		phaseSet.Angles = append(phaseSet.Angles, angle)
+	}
+
+	return phaseSet
+}
+
+func hasComponents(device *metadata.Device, phasor *metadata.Phasor) bool {
+	magnitude, angle := components(device, phasor)
+	return magnitude != nil && angle != nil
+}
+
+// components gets the magnitude and angle measurements of a phasor.
+func components(device *metadata.Device, phasor *metadata.Phasor) (magnitude *metadata.Measurement, angle *metadata.Measurement) {
+	magnitude, angle = phasor.Magnitude, phasor.Angle
+
+	if magnitude != nil && angle != nil {
+		return
+	}
+
+	index := strconv.Itoa(phasor.SourceIndex)
+
+	for _, measurement := range device.Measurements {
+		switch {
+		case magnitude == nil && strings.EqualFold(measurement.SignalReference, device.Acronym+"-PM"+index):
+			magnitude = measurement
+		case angle == nil && strings.EqualFold(measurement.SignalReference, device.Acronym+"-PA"+index):
+			angle = measurement
+		}
+	}
+
+	return
+}
@@ -0,0 +1,76 @@
+//******************************************************************************************************
+//  Sequence.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package calc
+
+import (
+	"math"
+	"math/cmplx"
+)
+
+// a is the 120 degree rotation operator used for symmetrical components.
+var a = cmplx.Rect(1.0, 2.0*math.Pi/3.0)
+
+// SequenceComponents calculates the zero, positive and negative sequence components of
+// the specified phase A, B and C phasors.
+func SequenceComponents(phaseA, phaseB, phaseC complex128) (zero, positive, negative complex128) {
+	aSquared := a * a
+
+	zero = (phaseA + phaseB + phaseC) / 3.0
+	positive = (phaseA + a*phaseB + aSquared*phaseC) / 3.0
+	negative = (phaseA + aSquared*phaseB + a*phaseC) / 3.0
+
+	return
+}
+
+// ThreePhasePower calculates the total complex power, in volt-amperes, from the specified phase A, B
+// and C line-to-neutral voltage phasors, in volts, and current phasors, in amperes. Real part of the
+// result is active power and imaginary part is reactive power.
+func ThreePhasePower(voltages [3]complex128, currents [3]complex128) complex128 {
+	var power complex128
+
+	for i := range voltages {
+		power += voltages[i] * cmplx.Conj(currents[i])
+	}
+
+	return power
+}
+
+// PositiveSequencePower calculates the total three-phase complex power, in volt-amperes, from the
+// specified positive sequence line-to-neutral voltage phasor, in volts, and current phasor, in amperes,
+// assuming a balanced system.
+func PositiveSequencePower(voltage complex128, current complex128) complex128 {
+	return 3.0 * voltage * cmplx.Conj(current)
+}
+
+// PowerFactor calculates the power factor of the specified complex power, i.e., the ratio of active
+// power to apparent power. Result is zero when apparent power is zero.
+func PowerFactor(power complex128) float64 {
+	apparent := cmplx.Abs(power)
+
+	if apparent == 0.0 {
+		return 0.0
+	}
+
+	return real(power) / apparent
+}
@@ -0,0 +1,142 @@
+//******************************************************************************************************
+//  Channel.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package comtrade
+
+import (
+	"strings"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Channel defines a measurement exported as a COMTRADE channel. Digital measurements, i.e., 16-bit
+// digital status words, are exported as 16 status channels, least significant bit first; all other
+// measurements, e.g., phasor magnitudes and angles, frequency and analogs, are exported as analog
+// channels.
+type Channel struct {
+	// SignalID is the identifier of the exported measurement.
+	SignalID guid.Guid
+
+	// Name is the channel identifier, i.e., ch_id. Status channels of a digital measurement are
+	// named Name:B0 through Name:B15.
+	Name string
+
+	// Kind is the kind of signal the measurement represents.
+	Kind transport.SignalKindEnum
+
+	// Phase is the channel phase identification, i.e., ph, e.g., A; empty when not applicable.
+	Phase string
+
+	// CircuitComponent is the circuit component being monitored, i.e., ccbm, e.g., a device acronym.
+	CircuitComponent string
+
+	// Units are the channel units, i.e., uu, e.g., V, A, deg or Hz.
+	Units string
+
+	// Multiplier is the multiplicative value modifier applied to measurement values.
+	Multiplier float64
+
+	// Adder is the additive value modifier applied to measurement values.
+	Adder float64
+}
+
+// NewChannel creates a new Channel from the metadata of a measurement. The channel kind is derived
+// from the metadata signal type, e.g., VPHM, or, when not defined, from the signal reference. The
+// channel is named with the metadata point tag, or, when not defined, with the signal reference.
+func NewChannel(metadata *transport.MeasurementMetadata) *Channel {
+	source, kind, _ := metadata.ParseSignalReference()
+
+	if signalKind, ok := signalTypeKinds[strings.ToUpper(metadata.SignalType)]; ok {
+		kind = signalKind
+	}
+
+	name := metadata.Tag
+
+	if name == "" {
+		name = metadata.SignalReference
+	}
+
+	if name == "" {
+		name = metadata.SignalID.String()
+	}
+
+	multiplier := metadata.Multiplier
+
+	if multiplier == 0.0 {
+		multiplier = 1.0
+	}
+
+	return &Channel{
+		SignalID:         metadata.SignalID,
+		Name:             name,
+		Kind:             kind,
+		CircuitComponent: source,
+		Units:            channelUnits(kind, metadata.SignalType),
+		Multiplier:       multiplier,
+		Adder:            metadata.Adder,
+	}
+}
+
+// isStatus determines if the channel is exported as status channels.
+func (c *Channel) isStatus() bool {
+	return c.Kind == transport.SignalKind.Digital
+}
+
+// signalTypeKinds maps signal type acronyms to their SignalKind, see transport.SignalKindEnum.SignalTypeAcronym.
+var signalTypeKinds = map[string]transport.SignalKindEnum{
+	"VPHA": transport.SignalKind.Angle,
+	"IPHA": transport.SignalKind.Angle,
+	"VPHM": transport.SignalKind.Magnitude,
+	"IPHM": transport.SignalKind.Magnitude,
+	"FREQ": transport.SignalKind.Frequency,
+	"DFDT": transport.SignalKind.DfDt,
+	"FLAG": transport.SignalKind.Status,
+	"DIGI": transport.SignalKind.Digital,
+	"ALOG": transport.SignalKind.Analog,
+	"CALC": transport.SignalKind.Calculation,
+	"STAT": transport.SignalKind.Statistic,
+	"ALRM": transport.SignalKind.Alarm,
+	"QUAL": transport.SignalKind.Quality,
+}
+
+func channelUnits(kind transport.SignalKindEnum, signalType string) string {
+	switch kind {
+	case transport.SignalKind.Angle:
+		return "deg"
+	case transport.SignalKind.Magnitude:
+		if strings.HasPrefix(strings.ToUpper(signalType), "I") {
+			return "A"
+		}
+
+		if signalType != "" {
+			return "V"
+		}
+	case transport.SignalKind.Frequency:
+		return "Hz"
+	case transport.SignalKind.DfDt:
+		return "Hz/s"
+	}
+
+	return ""
+}
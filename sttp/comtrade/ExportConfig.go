@@ -0,0 +1,106 @@
+//******************************************************************************************************
+//  ExportConfig.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package comtrade
+
+import (
+	"strconv"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// FileFormatEnum defines the type of the FileFormat enumeration.
+type FileFormatEnum byte
+
+// FileFormat is an enumeration of the possible COMTRADE data file formats.
+var FileFormat = struct {
+	// ASCII defines a comma-separated text data file.
+	ASCII FileFormatEnum
+	// Binary defines a binary data file with 16-bit analog values.
+	Binary FileFormatEnum
+}{
+	ASCII:  0,
+	Binary: 1,
+}
+
+// String gets the FileFormat enumeration value as a string, as used by the configuration file.
+func (ffe FileFormatEnum) String() string {
+	switch ffe {
+	case FileFormat.ASCII:
+		return "ASCII"
+	case FileFormat.Binary:
+		return "BINARY"
+	default:
+		return "0x" + strconv.FormatInt(int64(ffe), 16)
+	}
+}
+
+// ExportConfig defines the COMTRADE export related settings.
+type ExportConfig struct {
+	// StationName defines the station name of the configuration file.
+	StationName string
+
+	// DeviceID defines the recording device identification of the configuration file.
+	DeviceID string
+
+	// Format defines the data file format.
+	Format FileFormatEnum
+
+	// NominalFrequency defines the nominal line frequency, in Hz.
+	NominalFrequency float64
+
+	// SampleRate defines the sample rate, in samples per second. Set value to zero to derive the
+	// sample rate from measurement timestamps; when timestamps are not evenly spaced, the export
+	// has no fixed sample rate and data file timestamps are used instead.
+	SampleRate float64
+
+	// StartTime defines the inclusive start of the export window. Set value to zero to start with
+	// the earliest measurement.
+	StartTime ticks.Ticks
+
+	// EndTime defines the exclusive end of the export window. Set value to zero to end with the
+	// latest measurement.
+	EndTime ticks.Ticks
+
+	// TriggerTime defines the trigger point of the export. Set value to zero to use the time of
+	// the first sample.
+	TriggerTime ticks.Ticks
+}
+
+// exportConfigDefaults define the default values for Export ExportConfig.
+var exportConfigDefaults = ExportConfig{
+	StationName:      "STTP",
+	DeviceID:         "goapi",
+	Format:           FileFormat.ASCII,
+	NominalFrequency: 60.0,
+	SampleRate:       0.0,
+	StartTime:        0,
+	EndTime:          0,
+	TriggerTime:      0,
+}
+
+// NewExportConfig creates a new ExportConfig instance initialized with default values.
+func NewExportConfig() *ExportConfig {
+	config := exportConfigDefaults
+	return &config
+}
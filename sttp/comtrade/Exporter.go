@@ -0,0 +1,453 @@
+//******************************************************************************************************
+//  Exporter.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package comtrade
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Exports follow IEEE C37.111-2013. Measurements with the same timestamp form a sample. Analog values
+// are scaled to 16-bit integers, i.e., value = a * x + b, with a and b derived from the range of each
+// channel so the full integer range is used. Data file timestamps are relative to the first sample
+// time of the configuration file, in ticks, i.e., a time multiplier of 0.1 microseconds, or, when the
+// export is too long for 32-bit tick offsets, in the smallest whole number of microseconds for which
+// offsets fit in 32 bits.
+
+const (
+	revisionYear   = "2013"
+	maxAnalogValue = 32767
+
+	// Values used for samples that have no measurement for a channel
+	missingASCIIValue  = "99999"
+	missingBinaryValue = -32768
+
+	cfgTimeFormat = "02/01/2006,15:04:05.000000"
+)
+
+// ErrNoChannels is the error returned when no channels are provided for export.
+var ErrNoChannels = errors.New("no channels to export")
+
+// ErrNoSamples is the error returned when no measurements for the exported channels are in the export window.
+var ErrNoSamples = errors.New("no measurements to export in export window")
+
+type sample struct {
+	timestamp ticks.Ticks
+	analogs   []float64
+	digitals  []uint16
+}
+
+type export struct {
+	config       *ExportConfig
+	analogs      []*Channel
+	digitals     []*Channel
+	samples      []*sample
+	scales       []analogScale
+	sampleRate   float64
+	baseTime     ticks.Ticks
+	ticksPerUnit ticks.Ticks
+	leapSecond   int
+	badTime      bool
+}
+
+type analogScale struct {
+	a float64
+	b float64
+}
+
+// Export writes the specified measurements of the specified channels as a COMTRADE configuration file
+// to cfgWriter and a COMTRADE data file to datWriter. Config parameter controls export related
+// settings, set value to nil for default values. Measurements of other signals, or outside the
+// export window, are ignored. Measurement leap second flags are preserved by the configuration file
+// leap second indicator.
+func Export(cfgWriter, datWriter io.Writer, channels []*Channel, measurements []transport.Measurement, config *ExportConfig) error {
+	if config == nil {
+		config = &exportConfigDefaults
+	}
+
+	export, err := newExport(channels, measurements, config)
+
+	if err != nil {
+		return err
+	}
+
+	if err := export.writeConfiguration(cfgWriter); err != nil {
+		return errors.New("failed to write configuration file: " + err.Error())
+	}
+
+	if err := export.writeData(datWriter); err != nil {
+		return errors.New("failed to write data file: " + err.Error())
+	}
+
+	return nil
+}
+
+// ExportFiles exports the specified measurements of the specified channels to the COMTRADE files
+// basePath.cfg and basePath.dat, see Export.
+func ExportFiles(basePath string, channels []*Channel, measurements []transport.Measurement, config *ExportConfig) error {
+	cfgFile, err := os.Create(basePath + ".cfg")
+
+	if err != nil {
+		return err
+	}
+
+	defer cfgFile.Close()
+
+	datFile, err := os.Create(basePath + ".dat")
+
+	if err != nil {
+		return err
+	}
+
+	defer datFile.Close()
+
+	if err := Export(cfgFile, datFile, channels, measurements, config); err != nil {
+		return err
+	}
+
+	if err := cfgFile.Close(); err != nil {
+		return err
+	}
+
+	return datFile.Close()
+}
+
+func newExport(channels []*Channel, measurements []transport.Measurement, config *ExportConfig) (*export, error) {
+	if len(channels) == 0 {
+		return nil, ErrNoChannels
+	}
+
+	e := &export{config: config}
+	analogIndexes := make(map[guid.Guid]int)
+	digitalIndexes := make(map[guid.Guid]int)
+
+	for _, channel := range channels {
+		if channel.isStatus() {
+			digitalIndexes[channel.SignalID] = len(e.digitals)
+			e.digitals = append(e.digitals, channel)
+		} else {
+			analogIndexes[channel.SignalID] = len(e.analogs)
+			e.analogs = append(e.analogs, channel)
+		}
+	}
+
+	samples := make(map[ticks.Ticks]*sample)
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		analogIndex, analog := analogIndexes[measurement.SignalID]
+		digitalIndex, digital := digitalIndexes[measurement.SignalID]
+		timestamp := ticks.Ticks(measurement.TimestampValue())
+
+		if !analog && !digital || timestamp < config.StartTime || config.EndTime > 0 && timestamp >= config.EndTime {
+			continue
+		}
+
+		current := samples[timestamp]
+
+		if current == nil {
+			current = &sample{
+				timestamp: timestamp,
+				analogs:   make([]float64, len(e.analogs)),
+				digitals:  make([]uint16, len(e.digitals)),
+			}
+
+			for j := range current.analogs {
+				current.analogs[j] = math.NaN()
+			}
+
+			samples[timestamp] = current
+		}
+
+		if analog {
+			channel := e.analogs[analogIndex]
+			current.analogs[analogIndex] = measurement.Value*channel.Multiplier + channel.Adder
+		} else {
+			current.digitals[digitalIndex] = uint16(measurement.Value)
+		}
+
+		if measurement.Timestamp.IsLeapSecond() {
+			if measurement.Timestamp.IsNegativeLeapSecond() {
+				e.leapSecond = 2
+			} else {
+				e.leapSecond = 1
+			}
+		}
+
+		if measurement.Flags&(transport.StateFlags.BadTime|transport.StateFlags.SuspectTime) != 0 {
+			e.badTime = true
+		}
+	}
+
+	if len(samples) == 0 {
+		return nil, ErrNoSamples
+	}
+
+	e.samples = make([]*sample, 0, len(samples))
+
+	for _, current := range samples {
+		e.samples = append(e.samples, current)
+	}
+
+	sort.Slice(e.samples, func(i, j int) bool {
+		return e.samples[i].timestamp < e.samples[j].timestamp
+	})
+
+	e.scales = make([]analogScale, len(e.analogs))
+
+	for i := range e.analogs {
+		e.scales[i] = e.analogScale(i)
+	}
+
+	e.sampleRate = config.SampleRate
+
+	if e.sampleRate <= 0.0 {
+		e.sampleRate = e.inferSampleRate()
+	}
+
+	first, last := e.samples[0].timestamp, e.samples[len(e.samples)-1].timestamp
+	e.baseTime = first - first%ticks.PerMicrosecond
+	e.ticksPerUnit = 1
+
+	if span := last - e.baseTime; span > math.MaxUint32 {
+		e.ticksPerUnit = (span/(math.MaxUint32*ticks.PerMicrosecond) + 1) * ticks.PerMicrosecond
+	}
+
+	return e, nil
+}
+
+// analogScale derives the conversion factors for an analog channel from the range of its values.
+func (e *export) analogScale(index int) analogScale {
+	minimum, maximum := math.Inf(1), math.Inf(-1)
+
+	for _, current := range e.samples {
+		if value := current.analogs[index]; !math.IsNaN(value) && !math.IsInf(value, 0) {
+			minimum = math.Min(minimum, value)
+			maximum = math.Max(maximum, value)
+		}
+	}
+
+	if minimum > maximum {
+		return analogScale{a: 1.0}
+	}
+
+	scale := analogScale{
+		a: (maximum - minimum) / (2.0 * maxAnalogValue),
+		b: (maximum + minimum) / 2.0,
+	}
+
+	if scale.a == 0.0 {
+		scale.a = 1.0
+	}
+
+	return scale
+}
+
+// inferSampleRate derives the sample rate from sample timestamps, or zero when timestamps are not evenly spaced.
+func (e *export) inferSampleRate() float64 {
+	count := len(e.samples)
+
+	if count < 2 {
+		return 0.0
+	}
+
+	// Allow for the rounding of timestamps to whole ticks, or to common device time resolutions
+	interval := e.samples[1].timestamp - e.samples[0].timestamp
+	tolerance := interval/100 + 1
+
+	for i := 2; i < count; i++ {
+		delta := e.samples[i].timestamp - e.samples[i-1].timestamp
+
+		if delta+tolerance < interval || delta > interval+tolerance {
+			return 0.0
+		}
+	}
+
+	rate := float64(count-1) * float64(ticks.PerSecond) / float64(e.samples[count-1].timestamp-e.samples[0].timestamp)
+
+	if rounded := math.Round(rate); math.Abs(rate-rounded) < rate*1e-4 {
+		return rounded
+	}
+
+	return rate
+}
+
+// analogValue gets the scaled integer value of an analog channel, or false when the sample has no value.
+func (e *export) analogValue(current *sample, index int) (int16, bool) {
+	value := current.analogs[index]
+
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, false
+	}
+
+	scale := e.scales[index]
+	scaled := math.Round((value - scale.b) / scale.a)
+	return int16(math.Max(-maxAnalogValue, math.Min(maxAnalogValue, scaled))), true
+}
+
+func (e *export) writeConfiguration(writer io.Writer) error {
+	buffer := bufio.NewWriter(writer)
+
+	writeLine := func(fields ...string) {
+		buffer.WriteString(strings.Join(fields, ","))
+		buffer.WriteString("\r\n")
+	}
+
+	analogCount, digitalCount := len(e.analogs), len(e.digitals)*16
+
+	writeLine(field(e.config.StationName), field(e.config.DeviceID), revisionYear)
+	writeLine(strconv.Itoa(analogCount+digitalCount), strconv.Itoa(analogCount)+"A", strconv.Itoa(digitalCount)+"D")
+
+	for i, channel := range e.analogs {
+		writeLine(strconv.Itoa(i+1), field(channel.Name), field(channel.Phase), field(channel.CircuitComponent), field(channel.Units),
+			formatReal(e.scales[i].a), formatReal(e.scales[i].b), "0", strconv.Itoa(-maxAnalogValue), strconv.Itoa(maxAnalogValue), "1", "1", "P")
+	}
+
+	for i, channel := range e.digitals {
+		for bit := 0; bit < 16; bit++ {
+			writeLine(strconv.Itoa(i*16+bit+1), field(channel.Name+":B"+strconv.Itoa(bit)), field(channel.Phase), field(channel.CircuitComponent), "0")
+		}
+	}
+
+	writeLine(formatReal(e.config.NominalFrequency))
+
+	if e.sampleRate > 0.0 {
+		writeLine("1")
+		writeLine(formatReal(e.sampleRate), strconv.Itoa(len(e.samples)))
+	} else {
+		writeLine("0")
+		writeLine("0", strconv.Itoa(len(e.samples)))
+	}
+
+	triggerTime := e.config.TriggerTime
+
+	if triggerTime == 0 {
+		triggerTime = e.samples[0].timestamp
+	}
+
+	writeLine(e.baseTime.ToTime().Format(cfgTimeFormat))
+	writeLine(ticks.Ticks(triggerTime.TimestampValue()).ToTime().Format(cfgTimeFormat))
+	writeLine(e.config.Format.String())
+	writeLine(formatReal(float64(e.ticksPerUnit) / float64(ticks.PerMicrosecond)))
+
+	// Times are UTC and time quality is either locked or faulted
+	timeQuality := "0"
+
+	if e.badTime {
+		timeQuality = "F"
+	}
+
+	writeLine("0", "0")
+	writeLine(timeQuality, strconv.Itoa(e.leapSecond))
+
+	return buffer.Flush()
+}
+
+func (e *export) writeData(writer io.Writer) error {
+	buffer := bufio.NewWriter(writer)
+
+	if e.config.Format == FileFormat.Binary {
+		e.writeBinaryData(buffer)
+	} else {
+		e.writeASCIIData(buffer)
+	}
+
+	return buffer.Flush()
+}
+
+func (e *export) writeASCIIData(buffer *bufio.Writer) {
+	for i, current := range e.samples {
+		buffer.WriteString(strconv.Itoa(i + 1))
+		buffer.WriteByte(',')
+		buffer.WriteString(strconv.FormatInt(int64((current.timestamp-e.baseTime)/e.ticksPerUnit), 10))
+
+		for j := range e.analogs {
+			buffer.WriteByte(',')
+
+			if value, ok := e.analogValue(current, j); ok {
+				buffer.WriteString(strconv.Itoa(int(value)))
+			} else {
+				buffer.WriteString(missingASCIIValue)
+			}
+		}
+
+		for _, word := range current.digitals {
+			for bit := 0; bit < 16; bit++ {
+				buffer.WriteByte(',')
+				buffer.WriteByte('0' + byte(word>>bit&1))
+			}
+		}
+
+		buffer.WriteString("\r\n")
+	}
+}
+
+func (e *export) writeBinaryData(buffer *bufio.Writer) {
+	record := make([]byte, 8+2*len(e.analogs)+2*len(e.digitals))
+
+	for i, current := range e.samples {
+		binary.LittleEndian.PutUint32(record, uint32(i+1))
+		binary.LittleEndian.PutUint32(record[4:], uint32((current.timestamp-e.baseTime)/e.ticksPerUnit))
+		offset := 8
+
+		for j := range e.analogs {
+			value, ok := e.analogValue(current, j)
+
+			if !ok {
+				value = missingBinaryValue
+			}
+
+			binary.LittleEndian.PutUint16(record[offset:], uint16(value))
+			offset += 2
+		}
+
+		// Each digital channel is exactly one 16-bit word of status channels
+		for _, word := range current.digitals {
+			binary.LittleEndian.PutUint16(record[offset:], word)
+			offset += 2
+		}
+
+		buffer.Write(record)
+	}
+}
+
+// field removes the characters that would break the comma-separated configuration file layout.
+func field(value string) string {
+	return strings.NewReplacer(",", " ", "\r", " ", "\n", " ").Replace(value)
+}
+
+func formatReal(value float64) string {
+	return strconv.FormatFloat(value, 'G', -1, 64)
+}
@@ -0,0 +1,348 @@
+//******************************************************************************************************
+//  Exporter_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package comtrade
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+const testSampleCount = 10
+
+// testBaseTime is a sample time with sub-microsecond ticks
+var testBaseTime = ticks.UnixBaseOffset + 1700000000*ticks.PerSecond + 1234567
+
+func testChannels() []*Channel {
+	return []*Channel{
+		NewChannel(&transport.MeasurementMetadata{SignalID: guid.New(), Tag: "SHELBY:FREQ", SignalType: "FREQ", SignalReference: "SHELBY-FQ", Multiplier: 1.0}),
+		NewChannel(&transport.MeasurementMetadata{SignalID: guid.New(), Tag: "SHELBY:VPHM1", SignalType: "VPHM", SignalReference: "SHELBY-PM1", Multiplier: 1000.0}),
+		NewChannel(&transport.MeasurementMetadata{SignalID: guid.New(), SignalReference: "SHELBY-PA2", SignalType: "IPHA", Multiplier: 1.0}),
+		NewChannel(&transport.MeasurementMetadata{SignalID: guid.New(), SignalReference: "SHELBY-DV1"}),
+	}
+}
+
+// testMeasurements creates 30 samples per second where frequency ramps from 59.95 Hz, magnitudes
+// are 500 kV, angles ramp from -180 degrees and the digital word is the sample index. The magnitude
+// of the fourth sample is missing.
+func testMeasurements(channels []*Channel) []transport.Measurement {
+	var measurements []transport.Measurement
+
+	for i := 0; i < testSampleCount; i++ {
+		timestamp := testBaseTime + ticks.Ticks(i)*ticks.PerSecond/30
+
+		for j, value := range []float64{59.95 + float64(i)*0.01, 500.0, -180.0 + float64(i)*36.0, float64(i)} {
+			if i == 3 && j == 1 {
+				continue
+			}
+
+			measurements = append(measurements, transport.Measurement{SignalID: channels[j].SignalID, Value: value, Timestamp: timestamp})
+		}
+	}
+
+	return measurements
+}
+
+func exportTest(t *testing.T, channels []*Channel, measurements []transport.Measurement, config *ExportConfig) ([]string, []byte) {
+	t.Helper()
+
+	var cfg, dat bytes.Buffer
+
+	if err := Export(&cfg, &dat, channels, measurements, config); err != nil {
+		t.Fatalf("%s: failed to export: %s", t.Name(), err.Error())
+	}
+
+	lines := strings.Split(cfg.String(), "\r\n")
+	return lines[:len(lines)-1], dat.Bytes()
+}
+
+func TestNewChannel(t *testing.T) {
+	channels := testChannels()
+
+	expected := []struct {
+		name, component, units string
+		kind                   transport.SignalKindEnum
+	}{
+		{"SHELBY:FREQ", "SHELBY", "Hz", transport.SignalKind.Frequency},
+		{"SHELBY:VPHM1", "SHELBY", "V", transport.SignalKind.Magnitude},
+		{"SHELBY-PA2", "SHELBY", "deg", transport.SignalKind.Angle},
+		{"SHELBY-DV1", "SHELBY", "", transport.SignalKind.Digital},
+	}
+
+	for i, test := range expected {
+		channel := channels[i]
+
+		if channel.Name != test.name || channel.CircuitComponent != test.component || channel.Units != test.units || channel.Kind != test.kind {
+			t.Fatalf("TestNewChannel: unexpected channel %d: %+v", i, channel)
+		}
+	}
+
+	if channel := NewChannel(&transport.MeasurementMetadata{SignalType: "IPHM"}); channel.Units != "A" || channel.Multiplier != 1.0 {
+		t.Fatalf("TestNewChannel: unexpected current magnitude channel: %+v", channel)
+	}
+}
+
+func TestExportASCII(t *testing.T) {
+	channels := testChannels()
+	lines, dat := exportTest(t, channels, testMeasurements(channels), nil)
+
+	expected := map[int]string{
+		0:  "STTP,goapi,2013",
+		1:  "19,3A,16D",
+		17: "13,SHELBY-DV1:B12,,SHELBY,0",
+		21: "60",
+		22: "1",
+		23: "30,10",
+		24: "14/11/2023,22:13:20.123456",
+		25: "14/11/2023,22:13:20.123456",
+		26: "ASCII",
+		27: "0.1",
+		28: "0,0",
+		29: "0,0",
+	}
+
+	if len(lines) != 30 {
+		t.Fatalf("TestExportASCII: expected 30 configuration lines, received: %d", len(lines))
+	}
+
+	for index, line := range expected {
+		if lines[index] != line {
+			t.Fatalf("TestExportASCII: expected configuration line %d \"%s\", received: \"%s\"", index+1, line, lines[index])
+		}
+	}
+
+	if !strings.HasPrefix(lines[2], "1,SHELBY:FREQ,,SHELBY,Hz,") || !strings.HasSuffix(lines[2], ",0,-32767,32767,1,1,P") {
+		t.Fatalf("TestExportASCII: unexpected analog channel line: %s", lines[2])
+	}
+
+	records := strings.Split(strings.TrimSuffix(string(dat), "\r\n"), "\r\n")
+
+	if len(records) != testSampleCount {
+		t.Fatalf("TestExportASCII: expected %d data records, received: %d", testSampleCount, len(records))
+	}
+
+	scales := make([][2]float64, 3)
+
+	for i := range scales {
+		fields := strings.Split(lines[2+i], ",")
+		scales[i][0], _ = strconv.ParseFloat(fields[5], 64)
+		scales[i][1], _ = strconv.ParseFloat(fields[6], 64)
+	}
+
+	for i, record := range records {
+		fields := strings.Split(record, ",")
+
+		if len(fields) != 2+3+16 || fields[0] != strconv.Itoa(i+1) {
+			t.Fatalf("TestExportASCII: unexpected data record %d: %s", i+1, record)
+		}
+
+		// Timestamps are ticks relative to the microsecond aligned first sample time
+		if offset, _ := strconv.ParseInt(fields[1], 10, 64); ticks.Ticks(offset) != 7+ticks.Ticks(i)*ticks.PerSecond/30 {
+			t.Fatalf("TestExportASCII: unexpected timestamp for data record %d: %s", i+1, fields[1])
+		}
+
+		for j, value := range []float64{59.95 + float64(i)*0.01, 500000.0, -180.0 + float64(i)*36.0} {
+			if i == 3 && j == 1 {
+				if fields[3] != "99999" {
+					t.Fatalf("TestExportASCII: expected missing value for data record 4, received: %s", fields[3])
+				}
+
+				continue
+			}
+
+			x, _ := strconv.ParseFloat(fields[2+j], 64)
+
+			if decoded := scales[j][0]*x + scales[j][1]; math.Abs(decoded-value) > scales[j][0] {
+				t.Fatalf("TestExportASCII: expected data record %d channel %d value %f, received: %f", i+1, j+1, value, decoded)
+			}
+		}
+
+		for bit := 0; bit < 16; bit++ {
+			if expected := strconv.Itoa(i >> bit & 1); fields[5+bit] != expected {
+				t.Fatalf("TestExportASCII: expected data record %d status channel %d value %s, received: %s", i+1, bit+1, expected, fields[5+bit])
+			}
+		}
+	}
+}
+
+func TestExportBinary(t *testing.T) {
+	channels := testChannels()
+	config := NewExportConfig()
+	config.Format = FileFormat.Binary
+	lines, dat := exportTest(t, channels, testMeasurements(channels), config)
+
+	if lines[26] != "BINARY" {
+		t.Fatalf("TestExportBinary: expected BINARY file type, received: %s", lines[26])
+	}
+
+	// Sample number, timestamp, 3 analog values and 1 status word
+	const recordSize = 4 + 4 + 3*2 + 2
+
+	if len(dat) != testSampleCount*recordSize {
+		t.Fatalf("TestExportBinary: expected %d bytes of data, received: %d", testSampleCount*recordSize, len(dat))
+	}
+
+	for i := 0; i < testSampleCount; i++ {
+		record := dat[i*recordSize:]
+
+		if binary.LittleEndian.Uint32(record) != uint32(i+1) || binary.LittleEndian.Uint32(record[4:]) != uint32(7+ticks.Ticks(i)*ticks.PerSecond/30) {
+			t.Fatalf("TestExportBinary: unexpected sample number or timestamp for data record %d", i+1)
+		}
+
+		if binary.LittleEndian.Uint16(record[14:]) != uint16(i) {
+			t.Fatalf("TestExportBinary: expected status word %d for data record %d, received: %d", i, i+1, binary.LittleEndian.Uint16(record[14:]))
+		}
+	}
+
+	if value := binary.LittleEndian.Uint16(dat[3*recordSize+10:]); value != 0x8000 {
+		t.Fatalf("TestExportBinary: expected missing value 0x8000 for data record 4, received: 0x%04X", value)
+	}
+
+	// Angles ramp over the full range, so the first and last samples use the integer range limits
+	if first, last := int16(binary.LittleEndian.Uint16(dat[12:])), int16(binary.LittleEndian.Uint16(dat[9*recordSize+12:])); first != -32767 || last != 32767 {
+		t.Fatalf("TestExportBinary: expected angle range -32767 to 32767, received: %d to %d", first, last)
+	}
+}
+
+func TestExportTimeMultiplier(t *testing.T) {
+	channels := testChannels()
+	config := NewExportConfig()
+	config.Format = FileFormat.Binary
+
+	for _, test := range []struct {
+		span       ticks.Ticks
+		multiplier string
+	}{
+		{ticks.PerMinute, "0.1"},
+		{ticks.PerHour, "1"},
+		{2 * ticks.PerHour, "2"},
+		{12 * ticks.PerHour, "11"},
+	} {
+		measurements := []transport.Measurement{
+			{SignalID: channels[0].SignalID, Value: 60.0, Timestamp: testBaseTime},
+			{SignalID: channels[0].SignalID, Value: 60.0, Timestamp: testBaseTime + test.span},
+		}
+
+		lines, dat := exportTest(t, channels, measurements, config)
+
+		if lines[27] != test.multiplier {
+			t.Fatalf("TestExportTimeMultiplier: expected time multiplier %s for %d tick export, received: %s", test.multiplier, test.span, lines[27])
+		}
+
+		// Last timestamp offset must not wrap, i.e., it must be within one unit of the span
+		multiplier, _ := strconv.ParseFloat(lines[27], 64)
+		unit := ticks.Ticks(math.Round(multiplier * float64(ticks.PerMicrosecond)))
+		offset := ticks.Ticks(binary.LittleEndian.Uint32(dat[len(dat)/2+4:])) * unit
+		expected := test.span + testBaseTime%ticks.PerMicrosecond
+
+		if offset > expected || expected-offset >= unit {
+			t.Fatalf("TestExportTimeMultiplier: expected last timestamp offset of %d ticks, received: %d", expected, offset)
+		}
+	}
+}
+
+func TestExportTimeQuality(t *testing.T) {
+	channels := testChannels()
+	measurements := testMeasurements(channels)
+
+	measurements[5].Timestamp.SetLeapSecond()
+	lines, _ := exportTest(t, channels, measurements, nil)
+
+	if lines[29] != "0,1" {
+		t.Fatalf("TestExportTimeQuality: expected leap second added indicator, received: %s", lines[29])
+	}
+
+	measurements[5].Timestamp.SetLeapSecondDirection(true)
+	measurements[6].Flags = transport.StateFlags.BadTime
+	lines, _ = exportTest(t, channels, measurements, nil)
+
+	if lines[29] != "F,2" {
+		t.Fatalf("TestExportTimeQuality: expected faulted time quality and leap second subtracted indicator, received: %s", lines[29])
+	}
+}
+
+func TestExportWindow(t *testing.T) {
+	channels := testChannels()
+	measurements := testMeasurements(channels)
+
+	// Irregular timestamps have no fixed sample rate
+	for i := range measurements {
+		if measurements[i].Timestamp > testBaseTime+ticks.PerSecond/4 {
+			measurements[i].Timestamp += ticks.PerMillisecond
+		}
+	}
+
+	config := NewExportConfig()
+	config.StartTime = testBaseTime + ticks.PerSecond/30
+	config.EndTime = testBaseTime + ticks.PerSecond/2
+	config.TriggerTime = testBaseTime + ticks.PerSecond/10
+	lines, dat := exportTest(t, channels, measurements, config)
+
+	if lines[22] != "0" || lines[23] != "0,9" {
+		t.Fatalf("TestExportWindow: expected no fixed sample rate and 9 samples, received: %s and %s", lines[22], lines[23])
+	}
+
+	if lines[24] != "14/11/2023,22:13:20.156790" || lines[25] != "14/11/2023,22:13:20.223456" {
+		t.Fatalf("TestExportWindow: unexpected start or trigger time: %s and %s", lines[24], lines[25])
+	}
+
+	if records := strings.Count(string(dat), "\r\n"); records != 9 {
+		t.Fatalf("TestExportWindow: expected 9 data records, received: %d", records)
+	}
+
+	config.StartTime = config.EndTime
+
+	if err := Export(&bytes.Buffer{}, &bytes.Buffer{}, channels, measurements, config); !errors.Is(err, ErrNoSamples) {
+		t.Fatalf("TestExportWindow: expected no samples error for empty window, received: %v", err)
+	}
+
+	if err := Export(&bytes.Buffer{}, &bytes.Buffer{}, nil, measurements, nil); !errors.Is(err, ErrNoChannels) {
+		t.Fatalf("TestExportWindow: expected no channels error, received: %v", err)
+	}
+}
+
+func TestExportFiles(t *testing.T) {
+	channels := testChannels()
+	basePath := filepath.Join(t.TempDir(), "disturbance")
+
+	if err := ExportFiles(basePath, channels, testMeasurements(channels), nil); err != nil {
+		t.Fatalf("TestExportFiles: failed to export files: %s", err.Error())
+	}
+
+	for _, extension := range []string{".cfg", ".dat"} {
+		if info, err := os.Stat(basePath + extension); err != nil || info.Size() == 0 {
+			t.Fatalf("TestExportFiles: expected non-empty %s file", extension)
+		}
+	}
+}
@@ -32,6 +32,10 @@ type CallbackErrorListener struct {
 
 	// ParsingExceptionCallback defines a callback for reporting ANTLR parsing exceptions.
 	ParsingExceptionCallback func(message string)
+
+	// SyntaxErrorCallback defines a callback for reporting ANTLR syntax errors with their position,
+	// where line is 1-based and column is the 0-based character offset within the line.
+	SyntaxErrorCallback func(line, column int, message string)
 }
 
 // NewCallbackErrorListener creates a new NewCallbackErrorListener.
@@ -43,9 +47,11 @@ func NewCallbackErrorListener() *CallbackErrorListener {
 
 // SyntaxError is called when ANTLR parser encounters a syntax error.
 func (cel *CallbackErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-	if cel.ParsingExceptionCallback == nil {
-		return
+	if cel.SyntaxErrorCallback != nil {
+		cel.SyntaxErrorCallback(line, column, msg)
 	}
 
-	cel.ParsingExceptionCallback(msg)
+	if cel.ParsingExceptionCallback != nil {
+		cel.ParsingExceptionCallback(msg)
+	}
 }
@@ -68,6 +68,11 @@ func (dc *DataColumn) Expression() string {
 	return dc.expression
 }
 
+// Computed gets a flag that determines if the DataColumn value is derived from its expression.
+func (dc *DataColumn) Computed() bool {
+	return dc.computed
+}
+
 // Index gets the index of the DataColumn within its parent DataTable columns collection.
 func (dc *DataColumn) Index() int {
 	return dc.index
@@ -515,6 +515,12 @@ func (dr *DataRow) SetValue(columnIndex int, value interface{}) error {
 	}
 
 	dr.values[columnIndex] = value
+
+	// Any index of the column in the parent table is rebuilt on next use to reflect the new value
+	if index := dr.parent.indexes[columnIndex]; index != nil {
+		index.invalidate()
+	}
+
 	return nil
 }
 
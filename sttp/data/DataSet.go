@@ -24,10 +24,18 @@
 package data
 
 import (
+	"bufio"
+	"bytes"
+	stdxml "encoding/xml"
 	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/shopspring/decimal"
 	"github.com/sttp/goapi/sttp/guid"
@@ -46,6 +54,11 @@ const (
 	DateTimeFormat = "2006-01-02T15:04:05.99-07:00"
 )
 
+// xmlDateTimeFormat defines the format used when writing date/time values. Full nanosecond
+// precision is written so that values round-trip, parsing with DateTimeFormat accepts any
+// number of fractional second digits.
+const xmlDateTimeFormat = "2006-01-02T15:04:05.999999999-07:00"
+
 // DataSet represents an in-memory cache of records that is structured similarly to information
 // defined in a database. The data set object consists of a collection of data table objects.
 // See https://sttp.github.io/documentation/data-sets/ for more information.
@@ -172,10 +185,106 @@ func (ds *DataSet) ParseXmlDocument(doc *xml.XmlDocument) error {
 		return errors.New("failed to parse DataSet XML: Cannot find schema node")
 	}
 
+	if err := validateSchema(schema, root.Name); err != nil {
+		return err
+	}
+
+	// Populate DataSet schema
+	ds.loadSchema(schema)
+
+	// Populate DataSet records
+	ds.loadRecords(&root)
+
+	return nil
+}
+
+// ReadXml loads the DataSet from the XML read from the specified reader. Unlike ParseXml, the XML
+// is decoded as a stream: only the schema node is loaded as an XmlDocument tree, records are then
+// decoded token-by-token directly into the rows of their DataTable. As a result, the schema node
+// must precede the records it defines, any elements preceding the schema node are ignored.
+//
+//gocyclo:ignore
+func (ds *DataSet) ReadXml(reader io.Reader) error {
+	decoder := stdxml.NewDecoder(reader)
+	var root stdxml.StartElement
+
+	// Find root node
+	for {
+		token, err := decoder.Token()
+
+		if err == io.EOF {
+			return errors.New("failed to parse DataSet XML: Cannot find root node")
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if element, ok := token.(stdxml.StartElement); ok {
+			root = element
+			break
+		}
+	}
+
+	schemaLoaded := false
+
+	for {
+		token, err := decoder.Token()
+
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case stdxml.StartElement:
+			if !schemaLoaded {
+				if element.Name.Local != "schema" {
+					if err := decoder.Skip(); err != nil {
+						return err
+					}
+
+					continue
+				}
+
+				// Populate DataSet schema
+				if err := ds.readSchema(decoder, element, root.Name.Local); err != nil {
+					return err
+				}
+
+				schemaLoaded = true
+				continue
+			}
+
+			// Each root node child that matches a table name represents a record
+			table := ds.Table(element.Name.Local)
+
+			if table == nil || table.Name() != element.Name.Local {
+				if err := decoder.Skip(); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			if err := readRecord(decoder, table); err != nil {
+				return err
+			}
+		case stdxml.EndElement:
+			// End of root node
+			if !schemaLoaded {
+				return errors.New("failed to parse DataSet XML: Cannot find schema node")
+			}
+
+			return nil
+		}
+	}
+}
+
+func validateSchema(schema *xml.XmlNode, rootName string) error {
 	id, found := schema.Attributes["id"]
 
-	if !found || id != root.Name {
-		return errors.New("failed to parse DataSet XML: Cannot find schema node matching \"" + root.Name + "\"")
+	if !found || id != rootName {
+		return errors.New("failed to parse DataSet XML: Cannot find schema node matching \"" + rootName + "\"")
 	}
 
 	// Validate schema namespace
@@ -183,15 +292,93 @@ func (ds *DataSet) ParseXmlDocument(doc *xml.XmlDocument) error {
 		return errors.New("failed to parse DataSet XML: cannot find schema namespace \"" + XmlSchemaNamespace + "\"")
 	}
 
-	// Populate DataSet schema
-	ds.loadSchema(schema)
+	return nil
+}
 
-	// Populate DataSet records
-	ds.loadRecords(&root)
+func (ds *DataSet) readSchema(decoder *stdxml.Decoder, start stdxml.StartElement, rootName string) error {
+	var doc xml.XmlDocument
+
+	if err := doc.LoadXmlElement(decoder, start); err != nil {
+		return err
+	}
+
+	schema := &doc.Root
+
+	if err := validateSchema(schema, rootName); err != nil {
+		return err
+	}
+
+	ds.loadSchema(schema)
 
 	return nil
 }
 
+// readRecord decodes the field elements of a record element into a new row of the table.
+func readRecord(decoder *stdxml.Decoder, table *DataTable) error {
+	dataRow := table.CreateRow()
+
+	for {
+		token, err := decoder.Token()
+
+		if err != nil {
+			return err
+		}
+
+		switch element := token.(type) {
+		case stdxml.StartElement:
+			// Each child node of a record represents a field value
+			column := table.ColumnByName(element.Name.Local)
+
+			if column == nil {
+				if err := decoder.Skip(); err != nil {
+					return err
+				}
+
+				continue
+			}
+
+			value, err := readElementText(decoder)
+
+			if err != nil {
+				return err
+			}
+
+			setXmlValue(dataRow, column, value)
+		case stdxml.EndElement:
+			table.AddRow(dataRow)
+			return nil
+		}
+	}
+}
+
+// readElementText reads the text content of the current element, including that of any child
+// elements, up to and including the element's end token.
+func readElementText(decoder *stdxml.Decoder) (string, error) {
+	var text strings.Builder
+	depth := 0
+
+	for {
+		token, err := decoder.Token()
+
+		if err != nil {
+			return "", err
+		}
+
+		switch element := token.(type) {
+		case stdxml.CharData:
+			text.Write(element)
+		case stdxml.StartElement:
+			depth++
+		case stdxml.EndElement:
+			if depth == 0 {
+				return text.String(), nil
+			}
+
+			depth--
+		}
+	}
+}
+
 //gocyclo:ignore
 func (ds *DataSet) loadSchema(schema *xml.XmlNode) {
 	schemaPrefix := schema.Prefix()
@@ -267,7 +454,6 @@ func (ds *DataSet) loadSchema(schema *xml.XmlNode) {
 	}
 }
 
-//gocyclo:ignore
 func (ds *DataSet) loadRecords(root *xml.XmlNode) {
 	// Each root node child that matches a table name represents a record
 	for _, table := range ds.Tables() {
@@ -286,54 +472,7 @@ func (ds *DataSet) loadRecords(root *xml.XmlNode) {
 					continue
 				}
 
-				columnIndex := column.Index()
-				value := field.Value()
-
-				switch column.Type() {
-				case DataType.String:
-					dataRow.SetValue(columnIndex, value)
-				case DataType.Boolean:
-					dataRow.SetValue(columnIndex, value == "true")
-				case DataType.DateTime:
-					dt, _ := time.Parse(DateTimeFormat, value)
-					dataRow.SetValue(columnIndex, dt)
-				case DataType.Single:
-					f32, _ := strconv.ParseFloat(value, 32)
-					dataRow.SetValue(columnIndex, float32(f32))
-				case DataType.Double:
-					f64, _ := strconv.ParseFloat(value, 64)
-					dataRow.SetValue(columnIndex, f64)
-				case DataType.Decimal:
-					d, _ := decimal.NewFromString(value)
-					dataRow.SetValue(columnIndex, d)
-				case DataType.Guid:
-					g, _ := guid.Parse(value)
-					dataRow.SetValue(columnIndex, g)
-				case DataType.Int8:
-					i8, _ := strconv.ParseInt(value, 0, 8)
-					dataRow.SetValue(columnIndex, int8(i8))
-				case DataType.Int16:
-					i16, _ := strconv.ParseInt(value, 0, 16)
-					dataRow.SetValue(columnIndex, int16(i16))
-				case DataType.Int32:
-					i32, _ := strconv.ParseInt(value, 0, 32)
-					dataRow.SetValue(columnIndex, int32(i32))
-				case DataType.Int64:
-					i64, _ := strconv.ParseInt(value, 0, 64)
-					dataRow.SetValue(columnIndex, i64)
-				case DataType.UInt8:
-					ui8, _ := strconv.ParseUint(value, 0, 8)
-					dataRow.SetValue(columnIndex, uint8(ui8))
-				case DataType.UInt16:
-					ui16, _ := strconv.ParseUint(value, 0, 16)
-					dataRow.SetValue(columnIndex, uint16(ui16))
-				case DataType.UInt32:
-					ui32, _ := strconv.ParseUint(value, 0, 32)
-					dataRow.SetValue(columnIndex, uint32(ui32))
-				case DataType.UInt64:
-					ui64, _ := strconv.ParseUint(value, 0, 64)
-					dataRow.SetValue(columnIndex, ui64)
-				}
+				setXmlValue(dataRow, column, field.InnerText())
 			}
 
 			table.AddRow(dataRow)
@@ -341,10 +480,282 @@ func (ds *DataSet) loadRecords(root *xml.XmlNode) {
 	}
 }
 
-// // WriteXML saves the DataSet information as XML into the specified buffer.
-// func (ds *DataSet) WriteXml(data *[]byte, dataSetName string) {
-// // TODO: Will be needed by DataPublisher
-// }
+// setXmlValue parses the XML text value of a record field and assigns it to the row column.
+//
+//gocyclo:ignore
+func setXmlValue(dataRow *DataRow, column *DataColumn, value string) {
+	columnIndex := column.Index()
+
+	switch column.Type() {
+	case DataType.String:
+		dataRow.SetValue(columnIndex, value)
+	case DataType.Boolean:
+		dataRow.SetValue(columnIndex, value == "true")
+	case DataType.DateTime:
+		dt, _ := time.Parse(DateTimeFormat, value)
+		dataRow.SetValue(columnIndex, dt)
+	case DataType.Single:
+		f32, _ := strconv.ParseFloat(value, 32)
+		dataRow.SetValue(columnIndex, float32(f32))
+	case DataType.Double:
+		f64, _ := strconv.ParseFloat(value, 64)
+		dataRow.SetValue(columnIndex, f64)
+	case DataType.Decimal:
+		d, _ := decimal.NewFromString(value)
+		dataRow.SetValue(columnIndex, d)
+	case DataType.Guid:
+		g, _ := guid.Parse(value)
+		dataRow.SetValue(columnIndex, g)
+	case DataType.Int8:
+		i8, _ := strconv.ParseInt(value, 0, 8)
+		dataRow.SetValue(columnIndex, int8(i8))
+	case DataType.Int16:
+		i16, _ := strconv.ParseInt(value, 0, 16)
+		dataRow.SetValue(columnIndex, int16(i16))
+	case DataType.Int32:
+		i32, _ := strconv.ParseInt(value, 0, 32)
+		dataRow.SetValue(columnIndex, int32(i32))
+	case DataType.Int64:
+		i64, _ := strconv.ParseInt(value, 0, 64)
+		dataRow.SetValue(columnIndex, i64)
+	case DataType.UInt8:
+		ui8, _ := strconv.ParseUint(value, 0, 8)
+		dataRow.SetValue(columnIndex, uint8(ui8))
+	case DataType.UInt16:
+		ui16, _ := strconv.ParseUint(value, 0, 16)
+		dataRow.SetValue(columnIndex, uint16(ui16))
+	case DataType.UInt32:
+		ui32, _ := strconv.ParseUint(value, 0, 32)
+		dataRow.SetValue(columnIndex, uint32(ui32))
+	case DataType.UInt64:
+		ui64, _ := strconv.ParseUint(value, 0, 64)
+		dataRow.SetValue(columnIndex, ui64)
+	}
+}
+
+// WriteXml writes the DataSet, including its inline XSD schema, as XML to the specified writer.
+// Tables are written in name order. Null values are omitted and computed column values are not
+// written since they are derived from the column expression defined in the schema. Since DataSet,
+// table and column names are written as element names, an error will be returned, before anything
+// is written, if any name is not a valid XML element name.
+func (ds *DataSet) WriteXml(writer io.Writer) error {
+	tables := ds.Tables()
+
+	sort.Slice(tables, func(i, j int) bool {
+		return tables[i].Name() < tables[j].Name()
+	})
+
+	if err := ds.validateXmlNames(tables); err != nil {
+		return err
+	}
+
+	xmlWriter := bufio.NewWriter(writer)
+
+	xmlWriter.WriteString("<?xml version=\"1.0\" standalone=\"yes\"?>\n<")
+	xmlWriter.WriteString(ds.Name)
+	xmlWriter.WriteString(">\n")
+
+	ds.writeSchema(xmlWriter, tables)
+
+	for _, table := range tables {
+		for _, row := range table.Rows() {
+			if row != nil {
+				writeRecord(xmlWriter, table, row)
+			}
+		}
+	}
+
+	xmlWriter.WriteString("</")
+	xmlWriter.WriteString(ds.Name)
+	xmlWriter.WriteString(">\n")
+
+	return xmlWriter.Flush()
+}
+
+func (ds *DataSet) validateXmlNames(tables []*DataTable) error {
+	if !isXmlName(ds.Name) {
+		return errors.New("cannot write XML, DataSet name \"" + ds.Name + "\" is not a valid XML element name")
+	}
+
+	for _, table := range tables {
+		if !isXmlName(table.Name()) {
+			return errors.New("cannot write XML, table name \"" + table.Name() + "\" is not a valid XML element name")
+		}
+
+		for i := 0; i < table.ColumnCount(); i++ {
+			if column := table.Column(i); !isXmlName(column.Name()) {
+				return errors.New("cannot write XML, column name \"" + column.Name() + "\" in table \"" + table.Name() + "\" is not a valid XML element name")
+			}
+		}
+	}
+
+	return nil
+}
+
+// isXmlName determines if name is a valid XML element name without a namespace prefix,
+// i.e., an NCName as defined by the XML 1.0 and XML Namespaces specifications.
+func isXmlName(name string) bool {
+	if len(name) == 0 || !utf8.ValidString(name) {
+		return false
+	}
+
+	for i, r := range name {
+		if !isXmlNameStartChar(r) && (i == 0 || !isXmlNameChar(r)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isXmlNameStartChar(r rune) bool {
+	return r >= 'A' && r <= 'Z' || r == '_' || r >= 'a' && r <= 'z' ||
+		r >= 0xC0 && r <= 0xD6 || r >= 0xD8 && r <= 0xF6 || r >= 0xF8 && r <= 0x2FF ||
+		r >= 0x370 && r <= 0x37D || r >= 0x37F && r <= 0x1FFF || r >= 0x200C && r <= 0x200D ||
+		r >= 0x2070 && r <= 0x218F || r >= 0x2C00 && r <= 0x2FEF || r >= 0x3001 && r <= 0xD7FF ||
+		r >= 0xF900 && r <= 0xFDCF || r >= 0xFDF0 && r <= 0xFFFD || r >= 0x10000 && r <= 0xEFFFF
+}
+
+func isXmlNameChar(r rune) bool {
+	return r == '-' || r == '.' || r >= '0' && r <= '9' || r == 0xB7 ||
+		r >= 0x300 && r <= 0x36F || r >= 0x203F && r <= 0x2040
+}
+
+// MarshalXml gets the DataSet, including its inline XSD schema, as XML.
+func (ds *DataSet) MarshalXml() ([]byte, error) {
+	var buffer bytes.Buffer
+
+	if err := ds.WriteXml(&buffer); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (ds *DataSet) writeSchema(writer *bufio.Writer, tables []*DataTable) {
+	writer.WriteString("  <xs:schema id=\"")
+	writeEscaped(writer, ds.Name)
+	writer.WriteString("\" xmlns:xs=\"" + XmlSchemaNamespace + "\" xmlns:ext=\"" + ExtXmlSchemaDataNamespace + "\">\n")
+	writer.WriteString("    <xs:element name=\"")
+	writeEscaped(writer, ds.Name)
+	writer.WriteString("\">\n      <xs:complexType>\n        <xs:choice minOccurs=\"0\" maxOccurs=\"unbounded\">\n")
+
+	for _, table := range tables {
+		writer.WriteString("          <xs:element name=\"")
+		writeEscaped(writer, table.Name())
+		writer.WriteString("\">\n            <xs:complexType>\n              <xs:sequence>\n")
+
+		for i := 0; i < table.ColumnCount(); i++ {
+			column := table.Column(i)
+			xsdTypeName, extDataType := xsdDataType(column.Type())
+
+			writer.WriteString("                <xs:element name=\"")
+			writeEscaped(writer, column.Name())
+			writer.WriteString("\"")
+
+			if len(extDataType) > 0 {
+				writer.WriteString(" ext:DataType=\"")
+				writer.WriteString(extDataType)
+				writer.WriteString("\"")
+			}
+
+			if column.Computed() {
+				writer.WriteString(" ext:ReadOnly=\"true\" ext:Expression=\"")
+				writeEscaped(writer, column.Expression())
+				writer.WriteString("\"")
+			}
+
+			writer.WriteString(" type=\"xs:")
+			writer.WriteString(xsdTypeName)
+			writer.WriteString("\" minOccurs=\"0\" />\n")
+		}
+
+		writer.WriteString("              </xs:sequence>\n            </xs:complexType>\n          </xs:element>\n")
+	}
+
+	writer.WriteString("        </xs:choice>\n      </xs:complexType>\n    </xs:element>\n  </xs:schema>\n")
+}
+
+func writeRecord(writer *bufio.Writer, table *DataTable, row *DataRow) {
+	writer.WriteString("  <")
+	writer.WriteString(table.Name())
+	writer.WriteString(">\n")
+
+	for i := 0; i < table.ColumnCount(); i++ {
+		column := table.Column(i)
+
+		if column.Computed() {
+			continue
+		}
+
+		value, err := row.Value(i)
+
+		if err != nil || value == nil {
+			continue
+		}
+
+		writer.WriteString("    <")
+		writer.WriteString(column.Name())
+		writer.WriteString(">")
+		writeEscaped(writer, xmlValue(column.Type(), value))
+		writer.WriteString("</")
+		writer.WriteString(column.Name())
+		writer.WriteString(">\n")
+	}
+
+	writer.WriteString("  </")
+	writer.WriteString(table.Name())
+	writer.WriteString(">\n")
+}
+
+func writeEscaped(writer *bufio.Writer, value string) {
+	stdxml.EscapeText(writer, []byte(value))
+}
+
+// xmlValue gets the XSD lexical representation of a DataRow value.
+//
+//gocyclo:ignore
+func xmlValue(dataType DataTypeEnum, value interface{}) string {
+	switch dataType {
+	case DataType.Boolean:
+		if b, ok := value.(bool); ok {
+			return strconv.FormatBool(b)
+		}
+	case DataType.DateTime:
+		if dt, ok := value.(time.Time); ok {
+			return dt.Format(xmlDateTimeFormat)
+		}
+	case DataType.Single:
+		if f32, ok := value.(float32); ok {
+			return xmlFloat(float64(f32), 32)
+		}
+	case DataType.Double:
+		if f64, ok := value.(float64); ok {
+			return xmlFloat(f64, 64)
+		}
+	case DataType.Decimal:
+		if d, ok := value.(decimal.Decimal); ok {
+			return d.String()
+		}
+	case DataType.Guid:
+		if g, ok := value.(guid.Guid); ok {
+			return strings.Trim(g.String(), "{}")
+		}
+	}
+
+	return fmt.Sprint(value)
+}
+
+func xmlFloat(value float64, bitSize int) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "INF"
+	case math.IsInf(value, -1):
+		return "-INF"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, bitSize)
+	}
+}
 
 // FromXml creates a new DataSet as read from the XML in the specified buffer.
 func FromXml(buffer []byte) *DataSet {
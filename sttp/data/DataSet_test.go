@@ -24,9 +24,16 @@
 package data
 
 import (
+	"bytes"
+	"compress/gzip"
+	"math"
+	"os"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/sttp/goapi/sttp/guid"
 )
 
@@ -74,3 +81,360 @@ func TestCreateDataSet(t *testing.T) {
 		t.Fatal("TestCreateDataSet: expected row count of 2, received: " + strconv.Itoa(dataTable.RowCount()))
 	}
 }
+
+func createAllTypesDataSet() *DataSet {
+	dataSet := NewDataSet()
+	dataTable := dataSet.CreateTable("AllTypes")
+
+	for dataType := DataType.String; dataType <= DataType.UInt64; dataType++ {
+		createDataColumn(dataTable, dataType.String()+"Field", dataType)
+	}
+
+	dataColumn := dataTable.CreateColumn("ComputedField", DataType.Int64, "Int32Field * 2 + UInt8Field")
+	dataTable.AddColumn(dataColumn)
+
+	dateTime, _ := time.Parse(time.RFC3339Nano, "2026-10-16T08:15:30.123456789-05:00")
+
+	rows := [][]interface{}{
+		{"A & B <tag> \"quoted\" 'single'\r\n\ttabbed ", true, dateTime, float32(3.1415927), 2.718281828459045,
+			decimal.RequireFromString("-12345678901234567890.0123456789"), guid.New(),
+			int8(math.MinInt8), int16(math.MinInt16), int32(math.MinInt32), int64(math.MinInt64),
+			uint8(math.MaxUint8), uint16(math.MaxUint16), uint32(math.MaxUint32), uint64(math.MaxUint64)},
+		{"", false, time.Unix(0, 0).UTC(), float32(math.Inf(1)), math.Inf(-1),
+			decimal.Zero, guid.Empty,
+			int8(math.MaxInt8), int16(math.MaxInt16), int32(math.MaxInt32), int64(math.MaxInt64),
+			uint8(0), uint16(0), uint32(0), uint64(0)},
+		{nil, nil, nil, float32(math.NaN()), math.NaN(), nil, nil, nil, nil, int32(21), nil, uint8(2), nil, nil, nil},
+	}
+
+	for _, values := range rows {
+		dataRow := dataTable.CreateRow()
+
+		for i, value := range values {
+			dataRow.SetValue(i, value)
+		}
+
+		dataTable.AddRow(dataRow)
+	}
+
+	dataSet.AddTable(dataTable)
+
+	return dataSet
+}
+
+func valuesEqual(left, right interface{}) bool {
+	switch leftValue := left.(type) {
+	case time.Time:
+		rightValue, ok := right.(time.Time)
+		return ok && leftValue.Equal(rightValue)
+	case decimal.Decimal:
+		rightValue, ok := right.(decimal.Decimal)
+		return ok && leftValue.Equal(rightValue)
+	case float32:
+		rightValue, ok := right.(float32)
+		return ok && (leftValue == rightValue || math.IsNaN(float64(leftValue)) && math.IsNaN(float64(rightValue)))
+	case float64:
+		rightValue, ok := right.(float64)
+		return ok && (leftValue == rightValue || math.IsNaN(leftValue) && math.IsNaN(rightValue))
+	default:
+		return left == right
+	}
+}
+
+func compareDataSets(t *testing.T, expected, actual *DataSet) {
+	if actual.TableCount() != expected.TableCount() {
+		t.Fatalf("Expected table count of %d, received: %d", expected.TableCount(), actual.TableCount())
+	}
+
+	for _, expectedTable := range expected.Tables() {
+		actualTable := actual.Table(expectedTable.Name())
+
+		if actualTable == nil {
+			t.Fatalf("Table \"%s\" not found after round-trip", expectedTable.Name())
+		}
+
+		if actualTable.ColumnCount() != expectedTable.ColumnCount() {
+			t.Fatalf("Table \"%s\": expected column count of %d, received: %d", expectedTable.Name(), expectedTable.ColumnCount(), actualTable.ColumnCount())
+		}
+
+		for i := 0; i < expectedTable.ColumnCount(); i++ {
+			expectedColumn := expectedTable.Column(i)
+			actualColumn := actualTable.Column(i)
+
+			if actualColumn.Name() != expectedColumn.Name() || actualColumn.Type() != expectedColumn.Type() || actualColumn.Expression() != expectedColumn.Expression() {
+				t.Fatalf("Table \"%s\": expected column %s, received: %s", expectedTable.Name(), expectedColumn.String(), actualColumn.String())
+			}
+		}
+
+		if actualTable.RowCount() != expectedTable.RowCount() {
+			t.Fatalf("Table \"%s\": expected row count of %d, received: %d", expectedTable.Name(), expectedTable.RowCount(), actualTable.RowCount())
+		}
+
+		for i := 0; i < expectedTable.RowCount(); i++ {
+			for j := 0; j < expectedTable.ColumnCount(); j++ {
+				expectedValue, err := expectedTable.Row(i).Value(j)
+
+				if err != nil {
+					t.Fatalf("Table \"%s\": failed to read expected value: %s", expectedTable.Name(), err.Error())
+				}
+
+				actualValue, err := actualTable.Row(i).Value(j)
+
+				if err != nil {
+					t.Fatalf("Table \"%s\": failed to read actual value: %s", expectedTable.Name(), err.Error())
+				}
+
+				if !valuesEqual(expectedValue, actualValue) {
+					t.Fatalf("Table \"%s\", row %d, column \"%s\": expected %#v, received: %#v", expectedTable.Name(), i, expectedTable.Column(j).Name(), expectedValue, actualValue)
+				}
+			}
+		}
+	}
+}
+
+func TestWriteXmlRoundTrip(t *testing.T) {
+	dataSet := createAllTypesDataSet()
+
+	var buffer bytes.Buffer
+
+	if err := dataSet.WriteXml(&buffer); err != nil {
+		t.Fatalf("TestWriteXmlRoundTrip: failed to write XML: %s", err.Error())
+	}
+
+	parsed := NewDataSet()
+
+	if err := parsed.ParseXml(buffer.Bytes()); err != nil {
+		t.Fatalf("TestWriteXmlRoundTrip: failed to parse written XML: %s", err.Error())
+	}
+
+	compareDataSets(t, dataSet, parsed)
+
+	table := parsed.Table("AllTypes")
+	computed, err := table.Row(2).Value(table.ColumnIndex("ComputedField"))
+
+	if err != nil {
+		t.Fatalf("TestWriteXmlRoundTrip: failed to read computed value: %s", err.Error())
+	}
+
+	if computed != int64(44) {
+		t.Fatalf("TestWriteXmlRoundTrip: unexpected computed value: %v", computed)
+	}
+}
+
+func TestMarshalXmlSampleMetadata(t *testing.T) {
+	data, err := os.ReadFile("../../test/SampleMetadata.xml")
+
+	if err != nil {
+		t.Fatalf("TestMarshalXmlSampleMetadata: failed to load sample metadata: %s", err.Error())
+	}
+
+	dataSet := NewDataSet()
+
+	if err = dataSet.ParseXml(data); err != nil {
+		t.Fatalf("TestMarshalXmlSampleMetadata: failed to parse sample metadata: %s", err.Error())
+	}
+
+	marshaled, err := dataSet.MarshalXml()
+
+	if err != nil {
+		t.Fatalf("TestMarshalXmlSampleMetadata: failed to marshal XML: %s", err.Error())
+	}
+
+	parsed := NewDataSet()
+
+	if err = parsed.ParseXml(marshaled); err != nil {
+		t.Fatalf("TestMarshalXmlSampleMetadata: failed to parse marshaled XML: %s", err.Error())
+	}
+
+	compareDataSets(t, dataSet, parsed)
+
+	remarshaled, _ := parsed.MarshalXml()
+
+	if !bytes.Equal(marshaled, remarshaled) {
+		t.Fatal("TestMarshalXmlSampleMetadata: marshaled XML is not stable across round-trips")
+	}
+}
+
+func TestReadXmlSampleMetadata(t *testing.T) {
+	data, err := os.ReadFile("../../test/SampleMetadata.xml")
+
+	if err != nil {
+		t.Fatalf("TestReadXmlSampleMetadata: failed to load sample metadata: %s", err.Error())
+	}
+
+	expected := NewDataSet()
+
+	if err = expected.ParseXml(data); err != nil {
+		t.Fatalf("TestReadXmlSampleMetadata: failed to parse sample metadata: %s", err.Error())
+	}
+
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	writer.Write(data)
+	writer.Close()
+
+	reader, err := gzip.NewReader(&buffer)
+
+	if err != nil {
+		t.Fatalf("TestReadXmlSampleMetadata: failed to create gzip reader: %s", err.Error())
+	}
+
+	actual := NewDataSet()
+
+	if err = actual.ReadXml(reader); err != nil {
+		t.Fatalf("TestReadXmlSampleMetadata: failed to read sample metadata: %s", err.Error())
+	}
+
+	compareDataSets(t, expected, actual)
+
+	if actual.Table("MeasurementDetail").RowCount() != 130 {
+		t.Fatalf("TestReadXmlSampleMetadata: expected 130 MeasurementDetail rows, received: %d", actual.Table("MeasurementDetail").RowCount())
+	}
+}
+
+func TestReadXmlRoundTrip(t *testing.T) {
+	dataSet := createAllTypesDataSet()
+	marshaled, _ := dataSet.MarshalXml()
+	parsed := NewDataSet()
+
+	if err := parsed.ReadXml(bytes.NewReader(marshaled)); err != nil {
+		t.Fatalf("TestReadXmlRoundTrip: failed to read written XML: %s", err.Error())
+	}
+
+	compareDataSets(t, dataSet, parsed)
+
+	table := parsed.Table("AllTypes")
+	computed, err := table.Row(2).Value(table.ColumnIndex("ComputedField"))
+
+	if err != nil {
+		t.Fatalf("TestReadXmlRoundTrip: failed to read computed value: %s", err.Error())
+	}
+
+	if computed != int64(44) {
+		t.Fatalf("TestReadXmlRoundTrip: unexpected computed value: %v", computed)
+	}
+}
+
+func TestReadXmlSkipsUnknownElements(t *testing.T) {
+	const metadata = `<?xml version="1.0"?>
+<DataSet>
+  <Preamble><Ignored>1</Ignored></Preamble>
+  <xs:schema id="DataSet" xmlns:xs="http://www.w3.org/2001/XMLSchema">
+    <xs:element name="DataSet">
+      <xs:complexType>
+        <xs:choice minOccurs="0" maxOccurs="unbounded">
+          <xs:element name="Device">
+            <xs:complexType>
+              <xs:sequence>
+                <xs:element name="ID" type="xs:int" minOccurs="0" />
+                <xs:element name="Acronym" type="xs:string" minOccurs="0" />
+              </xs:sequence>
+            </xs:complexType>
+          </xs:element>
+        </xs:choice>
+      </xs:complexType>
+    </xs:element>
+  </xs:schema>
+  <Device><ID>1</ID><Unknown><ID>99</ID></Unknown><Acronym>A<![CDATA[&]]>B</Acronym></Device>
+  <Other><ID>2</ID></Other>
+  <Device><Acronym>C &amp; D</Acronym><ID>3</ID></Device>
+</DataSet>`
+
+	dataSet := NewDataSet()
+
+	if err := dataSet.ReadXml(strings.NewReader(metadata)); err != nil {
+		t.Fatalf("TestReadXmlSkipsUnknownElements: failed to read XML: %s", err.Error())
+	}
+
+	if dataSet.TableCount() != 1 {
+		t.Fatalf("TestReadXmlSkipsUnknownElements: expected 1 table, received: %d", dataSet.TableCount())
+	}
+
+	table := dataSet.Table("Device")
+
+	if table.RowCount() != 2 {
+		t.Fatalf("TestReadXmlSkipsUnknownElements: expected 2 rows, received: %d", table.RowCount())
+	}
+
+	if table.RowValueAsString(0, 0) != "1" || table.RowValueAsString(0, 1) != "A&B" {
+		t.Fatalf("TestReadXmlSkipsUnknownElements: unexpected first row: %s", table.Row(0).String())
+	}
+
+	if table.RowValueAsString(1, 0) != "3" || table.RowValueAsString(1, 1) != "C & D" {
+		t.Fatalf("TestReadXmlSkipsUnknownElements: unexpected second row: %s", table.Row(1).String())
+	}
+}
+
+func TestReadXmlErrors(t *testing.T) {
+	invalid := map[string]string{
+		"empty":            "",
+		"no schema":        "<DataSet><Device><ID>1</ID></Device></DataSet>",
+		"schema id":        `<DataSet><xs:schema id="Other" xmlns:xs="http://www.w3.org/2001/XMLSchema" /></DataSet>`,
+		"schema namespace": `<DataSet><schema id="DataSet" /></DataSet>`,
+		"truncated":        `<DataSet><xs:schema id="DataSet" xmlns:xs="http://www.w3.org/2001/XMLSchema" /><Device><ID>1`,
+		"malformed":        `<DataSet><xs:schema id="DataSet" xmlns:xs="http://www.w3.org/2001/XMLSchema" /><Device></ID></DataSet>`,
+	}
+
+	for name, metadata := range invalid {
+		if err := NewDataSet().ReadXml(strings.NewReader(metadata)); err == nil {
+			t.Fatalf("TestReadXmlErrors: expected error for %s XML", name)
+		}
+	}
+}
+
+func TestWriteXmlInvalidNames(t *testing.T) {
+	for _, name := range []string{"Active Measurements", "1Table", "ns:Table", "", "Table<1>", "-Table"} {
+		for _, target := range []string{"DataSet", "table", "column"} {
+			dataSet := NewDataSet()
+			tableName, columnName := "Measurements", "SignalID"
+
+			switch target {
+			case "DataSet":
+				dataSet.Name = name
+			case "table":
+				tableName = name
+			default:
+				columnName = name
+			}
+
+			table := dataSet.CreateTable(tableName)
+			createDataColumn(table, columnName, DataType.Guid)
+			dataSet.AddTable(table)
+
+			var buffer bytes.Buffer
+
+			if err := dataSet.WriteXml(&buffer); err == nil {
+				t.Fatalf("TestWriteXmlInvalidNames: expected error for %s name \"%s\"", target, name)
+			}
+
+			if buffer.Len() > 0 {
+				t.Fatalf("TestWriteXmlInvalidNames: unexpected XML written for %s name \"%s\"", target, name)
+			}
+		}
+	}
+
+	for _, name := range []string{"Table_1", "_Table", "Tab.le-1", "Mesuresé", "測定"} {
+		dataSet := NewDataSet()
+		table := dataSet.CreateTable(name)
+		createDataColumn(table, name, DataType.String)
+		dataSet.AddTable(table)
+
+		row := table.CreateRow()
+		row.SetValue(0, "value")
+		table.AddRow(row)
+
+		marshaled, err := dataSet.MarshalXml()
+
+		if err != nil {
+			t.Fatalf("TestWriteXmlInvalidNames: unexpected error for name \"%s\": %s", name, err.Error())
+		}
+
+		parsed := NewDataSet()
+
+		if err = parsed.ReadXml(bytes.NewReader(marshaled)); err != nil {
+			t.Fatalf("TestWriteXmlInvalidNames: failed to read XML for name \"%s\": %s", name, err.Error())
+		}
+
+		compareDataSets(t, dataSet, parsed)
+	}
+}
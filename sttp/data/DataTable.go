@@ -42,6 +42,7 @@ type DataTable struct {
 	columnIndexes map[string]int
 	columns       []*DataColumn
 	rows          []*DataRow
+	indexes       map[int]*dataTableIndex
 }
 
 func newDataTable(parent *DataSet, name string) *DataTable {
@@ -67,6 +68,7 @@ func (dt *DataTable) Name() string {
 func (dt *DataTable) InitColumns(length int) {
 	dt.columns = make([]*DataColumn, 0, length)
 	dt.columnIndexes = make(map[string]int, length)
+	dt.indexes = nil
 }
 
 // AddColumn adds the specified column to the DataTable.
@@ -128,6 +130,10 @@ func (dt *DataTable) ColumnCount() int {
 // Any existing rows will be deleted.
 func (dt *DataTable) InitRows(length int) {
 	dt.rows = make([]*DataRow, 0, length)
+
+	for _, index := range dt.indexes {
+		index.clear()
+	}
 }
 
 func (dt *DataTable) Rows() []*DataRow {
@@ -137,6 +143,14 @@ func (dt *DataTable) Rows() []*DataRow {
 // AddRow adds the specified row to the DataTable.
 func (dt *DataTable) AddRow(row *DataRow) {
 	dt.rows = append(dt.rows, row)
+
+	if row == nil {
+		return
+	}
+
+	for _, index := range dt.indexes {
+		index.add(len(dt.rows)-1, row)
+	}
 }
 
 // Row gets the DataRow at the specified rowIndex if the index is in range;
@@ -193,6 +207,75 @@ func (dt *DataTable) CloneRow(source *DataRow) *DataRow {
 	return row
 }
 
+// CreateIndex creates an index for the values of the specified columnName, if one does not already exist.
+// Indexes are updated as rows are added and are used by Select and filter expression statements to find
+// rows matching "=", "IN", "IS NULL" and range comparisons of the column to constant values without
+// evaluating every row, so any evaluation errors for excluded rows will not be reported. Assigning a
+// value of an indexed column causes the index to be rebuilt before its next use, so row values are best
+// assigned before rows are added to the DataTable. An error will be returned if the column does not
+// exist, is computed or has a UInt64 data type. Lookup is case-insensitive.
+func (dt *DataTable) CreateIndex(columnName string) error {
+	column := dt.ColumnByName(columnName)
+
+	if column == nil {
+		return errors.New("failed to create index, column \"" + columnName + "\" not found in table \"" + dt.name + "\"")
+	}
+
+	if _, ok := dt.indexes[column.Index()]; ok {
+		return nil
+	}
+
+	if column.Computed() {
+		return errors.New("failed to create index, column \"" + column.Name() + "\" is computed")
+	}
+
+	index := newDataTableIndex(column)
+
+	if index == nil {
+		return errors.New("failed to create index, column \"" + column.Name() + "\" data type \"" + column.Type().String() + "\" is not supported")
+	}
+
+	for i, row := range dt.rows {
+		if row != nil {
+			index.add(i, row)
+		}
+	}
+
+	if dt.indexes == nil {
+		dt.indexes = make(map[int]*dataTableIndex)
+	}
+
+	dt.indexes[column.Index()] = index
+	return nil
+}
+
+// indexedRows gets the ordered indexes of the rows with column values that can satisfy the comparison
+// operatorType with the constant when the column is the left operand, using an index of the column.
+// Returns false if the column is not indexed or the comparison cannot use the index.
+func (dt *DataTable) indexedRows(column *DataColumn, operatorType ExpressionOperatorTypeEnum, constant *ValueExpression) ([]int, bool) {
+	index := dt.index(column)
+
+	if index == nil {
+		return nil, false
+	}
+
+	return index.lookup(operatorType, constant)
+}
+
+func (dt *DataTable) index(column *DataColumn) *dataTableIndex {
+	if column == nil || column.Parent() != dt {
+		return nil
+	}
+
+	index := dt.indexes[column.Index()]
+
+	if index != nil {
+		index.refresh(dt.rows)
+	}
+
+	return index
+}
+
 // RowCount gets the total number of rows defined in the DataTable.
 func (dt *DataTable) RowCount() int {
 	return len(dt.rows)
@@ -0,0 +1,363 @@
+//******************************************************************************************************
+//  DataTableIndex.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"cmp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// dataTableIndex is an index of the values of a DataTable column. Values are hashed for equality lookups and
+// ordered for range lookups, where the ordering is sorted on demand after rows have been added.
+type dataTableIndex struct {
+	column    *DataColumn
+	valueType ExpressionValueTypeEnum
+	readValue compiledExpression
+	compare   func(left, right interface{}) int
+
+	// hash maps value keys to ordered row indexes, or is nil when values are only ordered, e.g., Decimal values
+	// which can be equal with different representations.
+	hash map[interface{}][]int
+
+	entries []indexEntry
+	sorted  bool
+	mutex   sync.Mutex
+
+	// stale is set when a row value has been assigned since the index was built, the index is
+	// rebuilt from the table rows before its next lookup.
+	stale bool
+
+	nullRows []int
+
+	// errorRows are indexes of rows with values that could not be read, these rows are always included in
+	// lookups so that any errors are reported when the rows are evaluated.
+	errorRows []int
+}
+
+type indexEntry struct {
+	value    interface{}
+	rowIndex int
+}
+
+func newDataTableIndex(column *DataColumn) *dataTableIndex {
+	// Expression value type of UInt64 columns depends on magnitude of value, so these are not indexed
+	node := compileColumn(NewColumnExpression(column))
+
+	if !node.typed {
+		return nil
+	}
+
+	index := &dataTableIndex{
+		column:    column,
+		valueType: node.valueType,
+		readValue: node.evaluate,
+		compare:   indexValueComparison(node.valueType),
+	}
+
+	index.clear()
+	return index
+}
+
+// clear removes all row values from the index.
+func (index *dataTableIndex) clear() {
+	if index.valueType != ExpressionValueType.Decimal && index.valueType != ExpressionValueType.DateTime {
+		index.hash = make(map[interface{}][]int)
+	}
+
+	index.entries = nil
+	index.sorted = true
+	index.nullRows = nil
+	index.errorRows = nil
+	index.stale = false
+}
+
+// invalidate marks the index as needing to be rebuilt, e.g., after a row value has been assigned.
+func (index *dataTableIndex) invalidate() {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	index.stale = true
+}
+
+// refresh rebuilds the index from the specified rows if the index has been invalidated.
+func (index *dataTableIndex) refresh(rows []*DataRow) {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	if !index.stale {
+		return
+	}
+
+	index.clear()
+
+	for i, row := range rows {
+		if row != nil {
+			index.add(i, row)
+		}
+	}
+}
+
+// add adds the column value of the row at the specified rowIndex to the index. Rows are expected
+// to be added in row index order.
+func (index *dataTableIndex) add(rowIndex int, row *DataRow) {
+	value, err := index.readValue(row)
+
+	if err != nil {
+		index.errorRows = append(index.errorRows, rowIndex)
+		return
+	}
+
+	if value.IsNull() {
+		index.nullRows = append(index.nullRows, rowIndex)
+		return
+	}
+
+	if index.hash != nil {
+		key := indexHashKey(value)
+		index.hash[key] = append(index.hash[key], rowIndex)
+	}
+
+	index.entries = append(index.entries, indexEntry{indexSortValue(value), rowIndex})
+	index.sorted = false
+}
+
+// nullLookup gets the ordered indexes of the rows that can have a Null column value.
+func (index *dataTableIndex) nullLookup() []int {
+	return index.rowIndexes(index.nullRows)
+}
+
+// lookup gets the ordered indexes of the rows with column values that can satisfy the comparison operatorType
+// with the constant when the column is the left operand. Returns false if the comparison cannot use the index.
+//
+//gocyclo:ignore
+func (index *dataTableIndex) lookup(operatorType ExpressionOperatorTypeEnum, constant *ValueExpression) ([]int, bool) {
+	if constant.ValueType() != index.valueType || constant.IsNull() {
+		return nil, false
+	}
+
+	isEquality := operatorType == ExpressionOperatorType.Equal || operatorType == ExpressionOperatorType.EqualExactMatch
+
+	// Case-sensitive string equality is a subset of case-insensitive equality, so same hash key is used
+	if isEquality && index.hash != nil {
+		return index.rowIndexes(index.hash[indexHashKey(constant)]), true
+	}
+
+	value := indexSortValue(constant)
+
+	// NaN values are not ordered with other values
+	if floatValue, ok := value.(float64); ok && floatValue != floatValue {
+		return nil, false
+	}
+
+	index.sort()
+
+	entries := index.entries
+
+	lower := sort.Search(len(entries), func(i int) bool {
+		return index.compare(entries[i].value, value) >= 0
+	})
+
+	upper := sort.Search(len(entries), func(i int) bool {
+		return index.compare(entries[i].value, value) > 0
+	})
+
+	switch {
+	case isEquality:
+		entries = entries[lower:upper]
+	case operatorType == ExpressionOperatorType.LessThan:
+		entries = entries[:lower]
+	case operatorType == ExpressionOperatorType.LessThanOrEqual:
+		entries = entries[:upper]
+	case operatorType == ExpressionOperatorType.GreaterThan:
+		entries = entries[upper:]
+	case operatorType == ExpressionOperatorType.GreaterThanOrEqual:
+		entries = entries[lower:]
+	default:
+		return nil, false
+	}
+
+	rowIndexes := make([]int, len(entries))
+
+	for i, entry := range entries {
+		rowIndexes[i] = entry.rowIndex
+	}
+
+	sort.Ints(rowIndexes)
+
+	return index.rowIndexes(rowIndexes), true
+}
+
+// sort orders the index entries by value, and then by row index, if rows have been added since last sorted.
+func (index *dataTableIndex) sort() {
+	index.mutex.Lock()
+	defer index.mutex.Unlock()
+
+	if index.sorted {
+		return
+	}
+
+	sort.SliceStable(index.entries, func(i, j int) bool {
+		return index.compare(index.entries[i].value, index.entries[j].value) < 0
+	})
+
+	index.sorted = true
+}
+
+// rowIndexes gets a copy of the ordered row indexes merged with the indexes of any rows with unreadable values.
+func (index *dataTableIndex) rowIndexes(rowIndexes []int) []int {
+	return unionRowIndexes(append([]int(nil), rowIndexes...), index.errorRows)
+}
+
+// indexSortValue gets the native value of a non-null value expression used to order values in an index.
+// String values are ordered case-insensitively, as with filter expression comparison operators.
+func indexSortValue(value *ValueExpression) interface{} {
+	switch value.ValueType() {
+	case ExpressionValueType.Boolean:
+		return value.booleanValue()
+	case ExpressionValueType.Int32:
+		return value.int32Value()
+	case ExpressionValueType.Int64:
+		return value.int64Value()
+	case ExpressionValueType.Decimal:
+		return value.decimalValue()
+	case ExpressionValueType.Double:
+		return value.doubleValue()
+	case ExpressionValueType.String:
+		return strings.ToUpper(value.stringValue())
+	case ExpressionValueType.Guid:
+		return value.guidValue()
+	case ExpressionValueType.DateTime:
+		return value.dateTimeValue()
+	default:
+		return nil
+	}
+}
+
+// indexHashKey gets the key of a non-null value expression used to hash values in an index.
+// String values are hashed case-insensitively, as with filter expression equality operators.
+func indexHashKey(value *ValueExpression) interface{} {
+	if value.ValueType() == ExpressionValueType.String {
+		return foldString(value.stringValue())
+	}
+
+	return indexSortValue(value)
+}
+
+// foldString gets a key for the string value that is the same for all strings that are equal under
+// Unicode case-folding, i.e., as compared by strings.EqualFold.
+func foldString(value string) string {
+	return strings.Map(func(r rune) rune {
+		// Smallest rune in case-folding orbit represents all equivalent runes
+		folded := r
+
+		for next := unicode.SimpleFold(r); next != r; next = unicode.SimpleFold(next) {
+			if next < folded {
+				folded = next
+			}
+		}
+
+		return folded
+	}, value)
+}
+
+func indexValueComparison(valueType ExpressionValueTypeEnum) func(left, right interface{}) int {
+	switch valueType {
+	case ExpressionValueType.Boolean:
+		return func(left, right interface{}) int { return compareBooleans(left.(bool), right.(bool)) }
+	case ExpressionValueType.Int32:
+		return func(left, right interface{}) int { return cmp.Compare(left.(int32), right.(int32)) }
+	case ExpressionValueType.Int64:
+		return func(left, right interface{}) int { return cmp.Compare(left.(int64), right.(int64)) }
+	case ExpressionValueType.Decimal:
+		return func(left, right interface{}) int { return left.(decimal.Decimal).Cmp(right.(decimal.Decimal)) }
+	case ExpressionValueType.Double:
+		return func(left, right interface{}) int { return cmp.Compare(left.(float64), right.(float64)) }
+	case ExpressionValueType.String:
+		return func(left, right interface{}) int { return strings.Compare(left.(string), right.(string)) }
+	case ExpressionValueType.Guid:
+		return func(left, right interface{}) int { return guid.Compare(left.(guid.Guid), right.(guid.Guid)) }
+	default:
+		return func(left, right interface{}) int { return left.(time.Time).Compare(right.(time.Time)) }
+	}
+}
+
+// unionRowIndexes merges the ordered row indexes of right into the ordered row indexes of left.
+func unionRowIndexes(left, right []int) []int {
+	if len(right) == 0 {
+		return left
+	}
+
+	if len(left) == 0 {
+		return append([]int(nil), right...)
+	}
+
+	union := make([]int, 0, len(left)+len(right))
+	i, j := 0, 0
+
+	for i < len(left) && j < len(right) {
+		switch {
+		case left[i] < right[j]:
+			union = append(union, left[i])
+			i++
+		case left[i] > right[j]:
+			union = append(union, right[j])
+			j++
+		default:
+			union = append(union, left[i])
+			i++
+			j++
+		}
+	}
+
+	union = append(union, left[i:]...)
+	return append(union, right[j:]...)
+}
+
+// intersectRowIndexes gets the row indexes found in both of the ordered row indexes of left and right.
+func intersectRowIndexes(left, right []int) []int {
+	intersection := make([]int, 0)
+	i, j := 0, 0
+
+	for i < len(left) && j < len(right) {
+		switch {
+		case left[i] < right[j]:
+			i++
+		case left[i] > right[j]:
+			j++
+		default:
+			intersection = append(intersection, left[i])
+			i++
+			j++
+		}
+	}
+
+	return intersection
+}
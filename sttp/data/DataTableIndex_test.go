@@ -0,0 +1,415 @@
+//******************************************************************************************************
+//  DataTableIndex_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// indexColumns creates an index for each column of the table that supports indexing.
+func indexColumns(t *testing.T, table *DataTable) {
+	for i := 0; i < table.ColumnCount(); i++ {
+		column := table.Column(i)
+
+		if column.Computed() || column.Type() == DataType.UInt64 {
+			continue
+		}
+
+		if err := table.CreateIndex(column.Name()); err != nil {
+			t.Fatal("failed to create index for \"" + column.Name() + "\": " + err.Error())
+		}
+	}
+}
+
+func rowImages(rows []*DataRow) string {
+	var image strings.Builder
+
+	for _, row := range rows {
+		image.WriteString(row.String())
+		image.WriteRune('\n')
+	}
+
+	return image.String()
+}
+
+// testIndexedSelect validates that selecting rows with each expression tree of the filterExpression
+// matches selecting rows without indexes. Returns the number of selects that used column indexes.
+func testIndexedSelect(t *testing.T, dataSet *DataSet, table *DataTable, filterExpression string) int {
+	expressionTrees, err := GenerateExpressionTrees(dataSet, table.Name(), filterExpression, true)
+
+	if err != nil {
+		t.Fatal("failed to parse \"" + filterExpression + "\": " + err.Error())
+	}
+
+	indexedSelects := 0
+
+	for _, expressionTree := range expressionTrees {
+		expected, expectedErr := expressionTree.selectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
+			if resultExpression.ValueType() != ExpressionValueType.Boolean {
+				return false, errors.New("non-boolean result")
+			}
+
+			return resultExpression.booleanValue(), nil
+		}, true, true, false)
+
+		actual, actualErr := expressionTree.Select(table)
+		_, indexed := lookupRows(expressionTree, table)
+
+		if indexed {
+			indexedSelects++
+		}
+
+		// Rows skipped using an index are not evaluated, so these rows cannot produce errors
+		// Rows excluded by an index are not evaluated, so these rows cannot produce errors,
+		// e.g., a Null string result from an "IN" list for a row with a Null column value
+		if expectedErr != nil {
+			if actualErr == nil && !indexed {
+				t.Fatal("expected Select error for \"" + expressionTree.String() + "\": " + expectedErr.Error())
+			}
+
+			continue
+		}
+
+		if actualErr != nil {
+			t.Fatal("unexpected Select error for \"" + expressionTree.String() + "\": " + actualErr.Error())
+		}
+
+		if rowImages(expected) != rowImages(actual) {
+			t.Fatal("indexed Select for \"" + expressionTree.String() + "\" expected rows:\n" + rowImages(expected) + "received:\n" + rowImages(actual))
+		}
+	}
+
+	return indexedSelects
+}
+
+func lookupRows(expressionTree *ExpressionTree, table *DataTable) ([]int, bool) {
+	root := expressionTree.compileNode(expressionTree.Root)
+
+	if root.lookup == nil {
+		return nil, false
+	}
+
+	return root.lookup(table)
+}
+
+func TestDataTableIndexSelect(t *testing.T) {
+	dataSet := createAllTypesDataSet()
+	table := dataSet.Table("AllTypes")
+	indexColumns(t, table)
+
+	operators := []string{"=", "==", "===", "!=", "<", "<=", ">", ">="}
+
+	constants := []string{"'A & B'", "''", "'3.1415927'", "1", "-1", "0", "21", "2.5", "9223372036854775807", "NULL", "True", "False",
+		"#1970-01-01T00:00:00Z#", "#2026-10-16#", guid.Empty.String()}
+
+	indexedSelects := 0
+
+	for columnIndex := 0; columnIndex < table.ColumnCount(); columnIndex++ {
+		column := table.Column(columnIndex)
+
+		for _, constant := range constants {
+			filterExpressions := []string{
+				column.Name() + " IS NULL",
+				column.Name() + " IN (" + constant + ", 0, 'a & b')",
+				column.Name() + " NOT IN (" + constant + ")",
+				column.Name() + " IN BINARY (" + constant + ", 'A & B')",
+				column.Name() + " IS NULL OR " + column.Name() + " = " + constant,
+				column.Name() + " >= " + constant + " AND Int32Field > 0",
+			}
+
+			for _, operator := range operators {
+				filterExpressions = append(filterExpressions,
+					column.Name()+" "+operator+" "+constant,
+					constant+" "+operator+" "+column.Name())
+			}
+
+			indexedSelects += testIndexedSelect(t, dataSet, table, strings.Join(filterExpressions, "; "))
+		}
+	}
+
+	if indexedSelects < 1000 {
+		t.Fatal("TestDataTableIndexSelect: expected at least 1000 indexed selects, received: " + strconv.Itoa(indexedSelects))
+	}
+}
+
+func TestDataTableIndexMetadata(t *testing.T) {
+	dataSet := loadMetadataSample(t)
+	indexedDataSet := loadMetadataSample(t)
+	indexedTable := indexedDataSet.Table("MeasurementDetail")
+	indexColumns(t, indexedTable)
+
+	filterExpressions := []string{
+		"FILTER MeasurementDetail WHERE SignalAcronym = 'FREQ'",
+		"FILTER MeasurementDetail WHERE SignalAcronym IN ('IPHM', 'iphA', 'VPHM')",
+		"FILTER MeasurementDetail WHERE DeviceAcronym IS NULL AND SignalAcronym = 'STAT'",
+		"FILTER MeasurementDetail WHERE SignalAcronym = 'FREQ' OR SignalAcronym === 'DFDT' ORDER BY PointTag",
+		"FILTER MeasurementDetail WHERE UpdatedOn >= #2019-01-04T08:01:18Z# AND Enabled",
+		"FILTER TOP 5 MeasurementDetail WHERE PointTag > 'GPA' ORDER BY PointTag DESC",
+		"FILTER MeasurementDetail WHERE 'V' <= SignalAcronym AND Len(PointTag) > 10",
+		"FILTER MeasurementDetail WHERE SignalAcronym = 'FREQ' OR Len(PointTag) > 20",
+		"FILTER MeasurementDetail WHERE NOT SignalAcronym IN ('STAT')",
+		"FILTER MeasurementDetail WHERE SignalID = '24a1c8d9-9ca5-488b-921f-00c1e230450c'",
+		"24a1c8d9-9ca5-488b-921f-00c1e230450c; STAT:87; stat:63; \"SHELBY!IS-ST10\"",
+		"\"shelby!is-st10\"; FILTER MeasurementDetail WHERE SignalAcronym = 'STAT'",
+	}
+
+	// Point tag literals cannot contain a colon, so signal references are used as point tags
+	tableIDFields := &TableIDFields{
+		SignalIDFieldName:       "SignalID",
+		MeasurementKeyFieldName: "ID",
+		PointTagFieldName:       "SignalReference",
+	}
+
+	for _, filterExpression := range filterExpressions {
+		expected, err := SelectDataRows(dataSet, filterExpression, "MeasurementDetail", tableIDFields, true)
+
+		if err != nil {
+			t.Fatal("TestDataTableIndexMetadata: failed to select rows for \"" + filterExpression + "\": " + err.Error())
+		}
+
+		if len(expected) == 0 {
+			t.Fatal("TestDataTableIndexMetadata: expected selected rows for \"" + filterExpression + "\"")
+		}
+
+		actual, err := SelectDataRows(indexedDataSet, filterExpression, "MeasurementDetail", tableIDFields, true)
+
+		if err != nil {
+			t.Fatal("TestDataTableIndexMetadata: failed to select indexed rows for \"" + filterExpression + "\": " + err.Error())
+		}
+
+		if rowImages(expected) != rowImages(actual) {
+			t.Fatal("TestDataTableIndexMetadata: indexed rows for \"" + filterExpression + "\" expected:\n" + rowImages(expected) + "received:\n" + rowImages(actual))
+		}
+	}
+
+	expressionTree, err := GenerateExpressionTree(indexedTable, "SignalAcronym = 'FREQ' AND Enabled", true)
+
+	if err != nil {
+		t.Fatal("TestDataTableIndexMetadata: failed to parse expression: " + err.Error())
+	}
+
+	rowIndexes, indexed := lookupRows(expressionTree, indexedTable)
+
+	if !indexed {
+		t.Fatal("TestDataTableIndexMetadata: expected index lookup for \"SignalAcronym\"")
+	}
+
+	for _, rowIndex := range rowIndexes {
+		if value := indexedTable.RowValueAsStringByName(rowIndex, "SignalAcronym"); value != "FREQ" {
+			t.Fatal("TestDataTableIndexMetadata: expected looked up \"SignalAcronym\" of \"FREQ\", received: " + value)
+		}
+	}
+}
+
+func TestDataTableIndexAddRow(t *testing.T) {
+	dataSet := NewDataSet()
+	table := dataSet.CreateTable("Values")
+	valueField := createDataColumn(table, "Value", DataType.Int32)
+	dataSet.AddTable(table)
+
+	if err := table.CreateIndex("value"); err != nil {
+		t.Fatal("TestDataTableIndexAddRow: failed to create index: " + err.Error())
+	}
+
+	addRows := func(values ...interface{}) {
+		for _, value := range values {
+			row := table.CreateRow()
+			row.SetValue(valueField, value)
+			table.AddRow(row)
+		}
+	}
+
+	addRows(int32(8), nil, int32(3), int32(5), int32(8), int32(1))
+	table.AddRow(nil)
+	addRows(int32(13), int32(2), nil)
+
+	testSelect := func(filterExpression string, expected ...int) {
+		rows, err := table.Select(filterExpression, "", -1)
+
+		if err != nil {
+			t.Fatal("TestDataTableIndexAddRow: failed to select rows for \"" + filterExpression + "\": " + err.Error())
+		}
+
+		if len(rows) != len(expected) {
+			t.Fatal("TestDataTableIndexAddRow: expected " + strconv.Itoa(len(expected)) + " rows for \"" + filterExpression + "\", received: " + strconv.Itoa(len(rows)))
+		}
+
+		for i, row := range rows {
+			if row != table.Row(expected[i]) {
+				t.Fatal("TestDataTableIndexAddRow: unexpected row " + strconv.Itoa(i) + " for \"" + filterExpression + "\": " + row.String())
+			}
+		}
+
+		expressionTree, _ := GenerateExpressionTree(table, filterExpression, true)
+
+		if rowIndexes, indexed := lookupRows(expressionTree, table); !indexed || len(rowIndexes) != len(expected) {
+			t.Fatal("TestDataTableIndexAddRow: expected " + strconv.Itoa(len(expected)) + " indexed rows for \"" + filterExpression + "\"")
+		}
+	}
+
+	testSelect("Value = 8", 0, 4)
+	testSelect("Value IN (1, 2, 3)", 2, 5, 8)
+	testSelect("Value IS NULL", 1, 9)
+	testSelect("Value > 5", 0, 4, 7)
+	testSelect("5 >= Value", 2, 3, 5, 8)
+	testSelect("Value >= 3 AND Value < 8", 2, 3)
+	testSelect("Value < 2 OR Value > 8", 5, 7)
+
+	// Indexed values are added in order
+	addRows(int32(0), int32(8))
+	testSelect("Value = 8", 0, 4, 11)
+	testSelect("Value <= 1", 5, 10)
+
+	table.InitRows(0)
+	testSelect("Value = 8")
+	addRows(int32(8))
+	testSelect("Value = 8", 0)
+}
+
+func TestDataTableIndexSetValue(t *testing.T) {
+	dataSet := NewDataSet()
+	table := dataSet.CreateTable("Devices")
+	nameField := createDataColumn(table, "Name", DataType.String)
+	dataSet.AddTable(table)
+
+	if err := table.CreateIndex("Name"); err != nil {
+		t.Fatal("TestDataTableIndexSetValue: failed to create index: " + err.Error())
+	}
+
+	for _, name := range []string{"A", "C"} {
+		row := table.CreateRow()
+		row.SetValue(nameField, name)
+		table.AddRow(row)
+	}
+
+	testSelect := func(filterExpression string, expected ...int) {
+		rows, err := table.Select(filterExpression, "", -1)
+
+		if err != nil {
+			t.Fatal("TestDataTableIndexSetValue: failed to select rows for \"" + filterExpression + "\": " + err.Error())
+		}
+
+		if len(rows) != len(expected) {
+			t.Fatal("TestDataTableIndexSetValue: expected " + strconv.Itoa(len(expected)) + " rows for \"" + filterExpression + "\", received: " + strconv.Itoa(len(rows)))
+		}
+
+		for i, row := range rows {
+			if row != table.Row(expected[i]) {
+				t.Fatal("TestDataTableIndexSetValue: unexpected row " + strconv.Itoa(i) + " for \"" + filterExpression + "\": " + row.String())
+			}
+		}
+	}
+
+	testSelect("Name = 'A'", 0)
+
+	// Assigning a value of an added row updates the index
+	table.Row(0).SetValue(nameField, "B")
+	testSelect("Name = 'A'")
+	testSelect("Name = 'B'", 0)
+	testSelect("Name > 'A'", 0, 1)
+
+	table.Row(1).SetValueByName("Name", nil)
+	testSelect("Name IS NULL", 1)
+	testSelect("Name = 'C'")
+}
+
+func TestCreateIndexErrors(t *testing.T) {
+	dataSet := createAllTypesDataSet()
+	table := dataSet.Table("AllTypes")
+
+	for _, columnName := range []string{"MissingField", "ComputedField", "UInt64Field"} {
+		if err := table.CreateIndex(columnName); err == nil {
+			t.Fatal("TestCreateIndexErrors: expected error creating index for \"" + columnName + "\"")
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := table.CreateIndex("StringField"); err != nil {
+			t.Fatal("TestCreateIndexErrors: failed to create index: " + err.Error())
+		}
+	}
+}
+
+func TestFoldString(t *testing.T) {
+	equivalents := [][2]string{
+		{"Shelby", "SHELBY"},
+		{"k", "\u212A"},
+		{"straße", "STRAßE"},
+		{"ſ", "S"},
+		{"\xff", "�"},
+	}
+
+	for _, equivalent := range equivalents {
+		if !strings.EqualFold(equivalent[0], equivalent[1]) {
+			t.Fatal("TestFoldString: expected strings.EqualFold for \"" + equivalent[0] + "\" and \"" + equivalent[1] + "\"")
+		}
+
+		if foldString(equivalent[0]) != foldString(equivalent[1]) {
+			t.Fatal("TestFoldString: expected same key for \"" + equivalent[0] + "\" and \"" + equivalent[1] + "\"")
+		}
+	}
+
+	if foldString("Shelby") == foldString("Shelbi") {
+		t.Fatal("TestFoldString: expected different keys for \"Shelby\" and \"Shelbi\"")
+	}
+}
+
+func benchmarkSelect(b *testing.B, indexed bool) {
+	dataSet := loadMetadataSample(b)
+	table := dataSet.Table("MeasurementDetail")
+
+	if indexed {
+		if err := table.CreateIndex("SignalAcronym"); err != nil {
+			b.Fatal("error creating index: " + err.Error())
+		}
+	}
+
+	expressionTree, err := GenerateExpressionTree(table, "SignalAcronym IN ('IPHM', 'IPHA') AND Enabled", true)
+
+	if err != nil {
+		b.Fatal("error generating expression tree: " + err.Error())
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		if _, err := expressionTree.Select(table); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkSelect(b *testing.B) {
+	benchmarkSelect(b, false)
+}
+
+func BenchmarkIndexedSelect(b *testing.B) {
+	benchmarkSelect(b, true)
+}
@@ -160,3 +160,42 @@ func ParseXsdDataType(xsdTypeName, extDataType string) (DataTypeEnum, bool) {
 		return DataType.String, false
 	}
 }
+
+// xsdDataType gets the XSD data type name and any extended data type for the provided DataType,
+// i.e., the inverse of ParseXsdDataType.
+//
+//gocyclo:ignore
+func xsdDataType(dataType DataTypeEnum) (xsdTypeName, extDataType string) {
+	switch dataType {
+	case DataType.Boolean:
+		return "boolean", ""
+	case DataType.DateTime:
+		return "dateTime", ""
+	case DataType.Single:
+		return "float", ""
+	case DataType.Double:
+		return "double", ""
+	case DataType.Decimal:
+		return "decimal", ""
+	case DataType.Guid:
+		return "string", "System.Guid"
+	case DataType.Int8:
+		return "byte", ""
+	case DataType.Int16:
+		return "short", ""
+	case DataType.Int32:
+		return "int", ""
+	case DataType.Int64:
+		return "long", ""
+	case DataType.UInt8:
+		return "unsignedByte", ""
+	case DataType.UInt16:
+		return "unsignedShort", ""
+	case DataType.UInt32:
+		return "unsignedInt", ""
+	case DataType.UInt64:
+		return "unsignedLong", ""
+	default:
+		return "string", ""
+	}
+}
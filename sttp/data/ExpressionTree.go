@@ -67,13 +67,139 @@ func NewExpressionTree() *ExpressionTree {
 	}
 }
 
+// String gets an indented representation of the ExpressionTree structure, including any "FILTER" statement
+// table name, "TOP" limit and "ORDER BY" terms, followed by the expression nodes from the Root expression.
+func (et *ExpressionTree) String() string {
+	var image strings.Builder
+
+	if len(et.TableName) > 0 {
+		image.WriteString("Table: ")
+		image.WriteString(et.TableName)
+		image.WriteRune('\n')
+	}
+
+	if et.TopLimit > -1 {
+		image.WriteString("Top: ")
+		image.WriteString(strconv.Itoa(et.TopLimit))
+		image.WriteRune('\n')
+	}
+
+	if len(et.OrderByTerms) > 0 {
+		image.WriteString("Order By: ")
+
+		for i, orderByTerm := range et.OrderByTerms {
+			if i > 0 {
+				image.WriteString(", ")
+			}
+
+			if orderByTerm.Column != nil {
+				image.WriteString(orderByTerm.Column.Name())
+			}
+
+			if orderByTerm.Ascending {
+				image.WriteString(" ASC")
+			} else {
+				image.WriteString(" DESC")
+			}
+		}
+
+		image.WriteRune('\n')
+	}
+
+	if et.Root == nil {
+		image.WriteString("Where: <none>\n")
+	} else {
+		image.WriteString("Where:\n")
+		writeExpression(&image, et.Root, 1)
+	}
+
+	return image.String()
+}
+
+func writeExpression(image *strings.Builder, expression Expression, depth int) {
+	image.WriteString(strings.Repeat("    ", depth))
+
+	if expression == nil {
+		image.WriteString("<nil>\n")
+		return
+	}
+
+	switch expression.Type() {
+	case ExpressionType.Value:
+		valueExpression := expression.(*ValueExpression)
+		image.WriteString("Value ")
+
+		switch {
+		case valueExpression.IsNull():
+			image.WriteString("NULL")
+		case valueExpression.ValueType() == ExpressionValueType.String ||
+			valueExpression.ValueType() == ExpressionValueType.Guid ||
+			valueExpression.ValueType() == ExpressionValueType.DateTime:
+			image.WriteString("'" + valueExpression.String() + "'")
+		default:
+			image.WriteString(valueExpression.String())
+		}
+
+		image.WriteString(" (" + valueExpression.ValueType().String() + ")\n")
+	case ExpressionType.Column:
+		column := expression.(*ColumnExpression).DataColumn()
+		image.WriteString("Column ")
+
+		if column == nil {
+			image.WriteString("<undefined>\n")
+		} else {
+			image.WriteString(column.Name() + " (" + column.Type().String() + ")\n")
+		}
+	case ExpressionType.Unary:
+		unaryExpression := expression.(*UnaryExpression)
+		image.WriteString("Unary " + unaryExpression.UnaryType().String() + "\n")
+		writeExpression(image, unaryExpression.Value(), depth+1)
+	case ExpressionType.InList:
+		inListExpression := expression.(*InListExpression)
+		image.WriteString("InList")
+
+		if inListExpression.HasNotKeyword() {
+			image.WriteString(" NOT")
+		}
+
+		if inListExpression.ExtactMatch() {
+			image.WriteString(" BINARY")
+		}
+
+		image.WriteRune('\n')
+		writeExpression(image, inListExpression.Value(), depth+1)
+		image.WriteString(strings.Repeat("    ", depth+1) + "List\n")
+
+		for _, argument := range inListExpression.Arguments() {
+			writeExpression(image, argument, depth+2)
+		}
+	case ExpressionType.Function:
+		functionExpression := expression.(*FunctionExpression)
+		image.WriteString("Function " + functionExpression.FunctionType().String() + "\n")
+
+		for _, argument := range functionExpression.Arguments() {
+			writeExpression(image, argument, depth+1)
+		}
+	case ExpressionType.Operator:
+		operatorExpression := expression.(*OperatorExpression)
+		image.WriteString("Operator " + operatorExpression.OperatorType().String() + "\n")
+		writeExpression(image, operatorExpression.LeftValue(), depth+1)
+
+		if operatorExpression.RightValue() != nil {
+			writeExpression(image, operatorExpression.RightValue(), depth+1)
+		}
+	default:
+		image.WriteString(expression.Type().String() + "\n")
+	}
+}
+
 // Select returns the rows matching the the ExpressionTree. The expression tree result type is expected
 // to be a Boolean for this filtering operation. This works like the "WHERE" clause of a SQL expression.
 // Any "TOP" limit and "ORDER BY" sorting clauses found in filter expressions will be respected. An
 // error will be returned if the table parameter is nil, the expression tree does not yield a boolean
 // value or any row expresssion evaluation fails.
 func (et *ExpressionTree) Select(table *DataTable) ([]*DataRow, error) {
-	return et.SelectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
+	return et.selectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
 		// Final expression should have a boolean data type (operates as a WHERE clause)
 		if resultExpression.ValueType() != ExpressionValueType.Boolean {
 			return false, errors.New("cannot execute select operation, final expression tree evaluation did not result in a boolean value, result data type is \"" + resultExpression.ValueType().String() + "\"")
@@ -81,14 +207,21 @@ func (et *ExpressionTree) Select(table *DataTable) ([]*DataRow, error) {
 
 		// If final result is Null, i.e., has no value due to Null propagation, treat result as False
 		return resultExpression.booleanValue(), nil
-	}, true, true)
+	}, true, true, true)
 }
 
 // SelectWhere returns each table row evaluated from the ExpressionTree that matches the specified predicate expression.
 // The applyLimit and applySort flags determine if any encountered "TOP" limit and "ORDER BY" sorting clauses will be respected.
-// An error will be returned if the table parameter is nil or any row expresssion evaluation fails.
-//gocyclo: ignore
+// An error will be returned if the table parameter is nil or any row expresssion evaluation fails. Since the predicate can
+// match any evaluated value, the ExpressionTree is evaluated for every table row without using any table column indexes.
 func (et *ExpressionTree) SelectWhere(table *DataTable, predicate func(*ValueExpression) (bool, error), applyLimit bool, applySort bool) ([]*DataRow, error) {
+	return et.selectWhere(table, predicate, applyLimit, applySort, false)
+}
+
+// selectWhere implements SelectWhere. When useIndexes is true, the predicate must only match True boolean values so
+// that rows which cannot evaluate to True, as found from table column indexes, can be skipped.
+//gocyclo: ignore
+func (et *ExpressionTree) selectWhere(table *DataTable, predicate func(*ValueExpression) (bool, error), applyLimit bool, applySort bool, useIndexes bool) ([]*DataRow, error) {
 	if table == nil {
 		return nil, errors.New("cannot execute select operation, table parameter is nil")
 	}
@@ -99,17 +232,41 @@ func (et *ExpressionTree) SelectWhere(table *DataTable, predicate func(*ValueExp
 	var result bool
 	var err error
 
+	// Expression tree is compiled once for evaluation of each table row
+	root := et.compileNode(et.Root)
+	evaluate := root.evaluate
+
+	// Only rows found from any column indexes need to be evaluated
+	var rowIndexes []int
+	indexed := false
+
+	if useIndexes && root.lookup != nil {
+		rowIndexes, indexed = root.lookup(table)
+	}
+
+	rowCount := table.RowCount()
+
+	if indexed {
+		rowCount = len(rowIndexes)
+	}
+
 	// Find rows matching expression tree
-	for i := 0; i < table.RowCount(); i++ {
+	for i := 0; i < rowCount; i++ {
 		if applyLimit && et.TopLimit > -1 && len(matchedRows) >= et.TopLimit {
 			break
 		}
 
-		if row = table.Row(i); row == nil {
+		rowIndex := i
+
+		if indexed {
+			rowIndex = rowIndexes[i]
+		}
+
+		if row = table.Row(rowIndex); row == nil {
 			continue
 		}
 
-		if resultExpression, err = et.Evaluate(row); err != nil {
+		if resultExpression, err = evaluate(row); err != nil {
 			return nil, err
 		}
 
@@ -212,6 +369,10 @@ func (et *ExpressionTree) evaluateUnary(expression Expression) (*ValueExpression
 		return nil, errors.New("failed while evaluating unary expression value: " + err.Error())
 	}
 
+	return et.unary(unaryExpression, unaryValue)
+}
+
+func (et *ExpressionTree) unary(unaryExpression *UnaryExpression, unaryValue *ValueExpression) (*ValueExpression, error) {
 	unaryValueType := unaryValue.ValueType()
 
 	// If unary value is Null, result is Null
@@ -487,6 +648,13 @@ func (et *ExpressionTree) evaluateFunction(expression Expression) (*ValueExpress
 	}
 }
 
+// argumentEvaluator gets a function that evaluates the function argument at the specified index on demand.
+func (et *ExpressionTree) argumentEvaluator(arguments []Expression) func(int) (*ValueExpression, error) {
+	return func(index int) (*ValueExpression, error) {
+		return et.evaluate(arguments[index])
+	}
+}
+
 func (et *ExpressionTree) evaluateAbs(arguments []Expression) (*ValueExpression, error) {
 	if len(arguments) != 1 {
 		return nil, errors.New("\"Abs\" function expects 1 argument, received " + strconv.Itoa(len(arguments)))
@@ -523,7 +691,7 @@ func (et *ExpressionTree) evaluateCoalesce(arguments []Expression) (*ValueExpres
 	}
 
 	// Not pre-evaluating Coalesce arguments - arguments will be evaluated only up to first non-null value
-	return et.coalesce(arguments)
+	return et.coalesce(len(arguments), et.argumentEvaluator(arguments))
 }
 
 func (et *ExpressionTree) evaluateConvert(arguments []Expression) (*ValueExpression, error) {
@@ -691,12 +859,12 @@ func (et *ExpressionTree) evaluateIIf(arguments []Expression) (*ValueExpression,
 	var testValue *ValueExpression
 	var err error
 
-	if testValue, err = et.evaluate(arguments[1]); err != nil {
+	if testValue, err = et.evaluate(arguments[0]); err != nil {
 		return nil, errors.New("failed while evaluating \"IIf\" function test value, first argument: " + err.Error())
 	}
 
 	// Not pre-evaluating IIf result value arguments - only evaluating desired path
-	return et.iif(testValue, arguments[1], arguments[2])
+	return et.iif(testValue, et.argumentEvaluator(arguments[1:]))
 }
 
 func (et *ExpressionTree) evaluateIndexOf(arguments []Expression) (*ValueExpression, error) {
@@ -871,7 +1039,7 @@ func (et *ExpressionTree) evaluateMaxOf(arguments []Expression) (*ValueExpressio
 		return nil, errors.New("\"MaxOf\" function expects at least 2 arguments, received " + strconv.Itoa(len(arguments)))
 	}
 
-	return et.maxOf(arguments)
+	return et.maxOf(len(arguments), et.argumentEvaluator(arguments))
 }
 
 func (et *ExpressionTree) evaluateMinOf(arguments []Expression) (*ValueExpression, error) {
@@ -879,7 +1047,7 @@ func (et *ExpressionTree) evaluateMinOf(arguments []Expression) (*ValueExpressio
 		return nil, errors.New("\"MinOf\" function expects at least 2 arguments, received " + strconv.Itoa(len(arguments)))
 	}
 
-	return et.minOf(arguments)
+	return et.minOf(len(arguments), et.argumentEvaluator(arguments))
 }
 
 func (et *ExpressionTree) evaluateNthIndexOf(arguments []Expression) (*ValueExpression, error) {
@@ -1427,8 +1595,8 @@ func (et *ExpressionTree) ceiling(sourceValue *ValueExpression) (*ValueExpressio
 	}
 }
 
-func (et *ExpressionTree) coalesce(arguments []Expression) (*ValueExpression, error) {
-	testValue, err := et.evaluate(arguments[0])
+func (et *ExpressionTree) coalesce(count int, argument func(int) (*ValueExpression, error)) (*ValueExpression, error) {
+	testValue, err := argument(0)
 
 	if err != nil {
 		return nil, errors.New("failed while evaluating \"Coalesce\" function argument 0: " + err.Error())
@@ -1438,8 +1606,8 @@ func (et *ExpressionTree) coalesce(arguments []Expression) (*ValueExpression, er
 		return testValue, nil
 	}
 
-	for i := 1; i < len(arguments); i++ {
-		listValue, err := et.evaluate(arguments[i])
+	for i := 1; i < count; i++ {
+		listValue, err := argument(i)
 
 		if err != nil {
 			return nil, errors.New("failed while evaluating \"Coalesce\" function argument " + strconv.Itoa(i) + ": " + err.Error())
@@ -1804,7 +1972,7 @@ func (et *ExpressionTree) floor(sourceValue *ValueExpression) (*ValueExpression,
 	}
 }
 
-func (et *ExpressionTree) iif(testValue *ValueExpression, leftResultValue, rightResultValue Expression) (*ValueExpression, error) {
+func (et *ExpressionTree) iif(testValue *ValueExpression, resultValue func(int) (*ValueExpression, error)) (*ValueExpression, error) {
 	if testValue.ValueType() != ExpressionValueType.Boolean {
 		return nil, errors.New("\"IIf\" function test value, first argument, must be a \"Boolean\"")
 	}
@@ -1814,7 +1982,7 @@ func (et *ExpressionTree) iif(testValue *ValueExpression, leftResultValue, right
 
 	// Null test expression evaluates to false, that is, right expression
 	if testValue.booleanValue() {
-		result, err = et.evaluate(leftResultValue)
+		result, err = resultValue(0)
 
 		if err != nil {
 			return nil, errors.New("failed while evaluating \"IIf\" function left result value, second argument: " + err.Error())
@@ -1823,7 +1991,7 @@ func (et *ExpressionTree) iif(testValue *ValueExpression, leftResultValue, right
 		return result, nil
 	}
 
-	result, err = et.evaluate(rightResultValue)
+	result, err = resultValue(1)
 
 	if err != nil {
 		return nil, errors.New("failed while evaluating \"IIf\" function right result value, third argument: " + err.Error())
@@ -2009,15 +2177,15 @@ func (et *ExpressionTree) lower(sourceValue *ValueExpression) (*ValueExpression,
 	return newValueExpression(ExpressionValueType.String, strings.ToLower(sourceValue.stringValue())), nil
 }
 
-func (et *ExpressionTree) maxOf(arguments []Expression) (*ValueExpression, error) {
-	testValue, err := et.evaluate(arguments[0])
+func (et *ExpressionTree) maxOf(count int, argument func(int) (*ValueExpression, error)) (*ValueExpression, error) {
+	testValue, err := argument(0)
 
 	if err != nil {
 		return nil, errors.New("failed while evaluating \"MaxOf\" function argument 0: " + err.Error())
 	}
 
-	for i := 1; i < len(arguments); i++ {
-		nextValue, err := et.evaluate(arguments[i])
+	for i := 1; i < count; i++ {
+		nextValue, err := argument(i)
 
 		if err != nil {
 			return nil, errors.New("failed while evaluating \"MaxOf\" function argument " + strconv.Itoa(i) + ": " + err.Error())
@@ -2043,15 +2211,15 @@ func (et *ExpressionTree) maxOf(arguments []Expression) (*ValueExpression, error
 	return testValue, nil
 }
 
-func (et *ExpressionTree) minOf(arguments []Expression) (*ValueExpression, error) {
-	testValue, err := et.evaluate(arguments[0])
+func (et *ExpressionTree) minOf(count int, argument func(int) (*ValueExpression, error)) (*ValueExpression, error) {
+	testValue, err := argument(0)
 
 	if err != nil {
 		return nil, errors.New("failed while evaluating \"MinOf\" function argument 0: " + err.Error())
 	}
 
-	for i := 1; i < len(arguments); i++ {
-		nextValue, err := et.evaluate(arguments[i])
+	for i := 1; i < count; i++ {
+		nextValue, err := argument(i)
 
 		if err != nil {
 			return nil, errors.New("failed while evaluating \"MinOf\" function argument " + strconv.Itoa(i) + ": " + err.Error())
@@ -2202,24 +2370,27 @@ func (et *ExpressionTree) evaluateRegEx(functionName string, regexValue, testVal
 		return nil, errors.New("failed while compiling \"" + functionName + "\" function expression value, first argument: " + err.Error())
 	}
 
-	testText := testValue.stringValue()
+	return matchRegEx(regex, testValue.stringValue(), returnMatchedValue), nil
+}
+
+func matchRegEx(regex *regexp.Regexp, testText string, returnMatchedValue bool) *ValueExpression {
 	result := regex.FindStringIndex(testText)
 
 	if returnMatchedValue {
 		// RegExVal returns any left-most matched value, otherwise empty string
 		if result == nil {
-			return EmptyString, nil
+			return EmptyString
 		}
 
-		return newValueExpression(ExpressionValueType.String, testText[result[0]:result[1]]), nil
+		return newValueExpression(ExpressionValueType.String, testText[result[0]:result[1]])
 	}
 
 	// RegExMatch returns boolean result determining if there was a matched value
 	if result == nil {
-		return False, nil
+		return False
 	}
 
-	return True, nil
+	return True
 }
 
 func (et *ExpressionTree) replace(sourceValue, testValue, replaceValue, ignoreCase *ValueExpression) (*ValueExpression, error) {
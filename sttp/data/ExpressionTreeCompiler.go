@@ -0,0 +1,1328 @@
+//******************************************************************************************************
+//  ExpressionTreeCompiler.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// compiledExpression evaluates a compiled expression tree node for the provided data row.
+type compiledExpression func(dataRow *DataRow) (*ValueExpression, error)
+
+// compiledNode is an expression tree node compiled into a closure, along with any details
+// about the node's results that were resolved during compilation.
+type compiledNode struct {
+	evaluate compiledExpression
+
+	// constant is the folded result of a node that does not depend on row values, if any.
+	constant *ValueExpression
+
+	// column is the referenced data column when the node is a column expression.
+	column *DataColumn
+
+	// valueType is the value type of every result produced by the node when typed is true.
+	valueType ExpressionValueTypeEnum
+	typed     bool
+
+	// lookup gets the ordered indexes of the only rows of a table that the node can evaluate to True, using
+	// column indexes of the table, or false when the rows cannot be found from an index. Can be nil.
+	lookup func(table *DataTable) ([]int, bool)
+}
+
+// columnReader gets the value of a data row column converted to the native type of its expression value type.
+type columnReader[T any] func(dataRow *DataRow, columnIndex int) (T, bool, error)
+
+// Compile translates the ExpressionTree into a function that evaluates the tree for a provided data row with
+// the same results as Evaluate. Column indexes, value types and function dispatch are resolved once during
+// compilation and any sub-expressions that do not depend on row values are folded into constants, so the
+// returned function can be reused for many rows without walking the tree. Unlike Evaluate, the returned
+// function does not track a current row. The returned function will not reflect any later changes to Root.
+func (et *ExpressionTree) Compile() func(*DataRow) (*ValueExpression, error) {
+	return et.compile(et.Root)
+}
+
+func (et *ExpressionTree) compile(expression Expression) compiledExpression {
+	return et.compileAs(expression, ExpressionValueType.Boolean).evaluate
+}
+
+func (et *ExpressionTree) compileNode(expression Expression) *compiledNode {
+	return et.compileAs(expression, ExpressionValueType.Boolean)
+}
+
+func (et *ExpressionTree) compileAs(expression Expression, targetValueType ExpressionValueTypeEnum) *compiledNode {
+	// Expressions that do not depend on row values are evaluated once
+	if isConstantExpression(expression) {
+		if node := et.compileConstant(expression, targetValueType); node != nil {
+			return node
+		}
+	}
+
+	switch expression.Type() {
+	case ExpressionType.Unary:
+		return et.compileUnary(expression.(*UnaryExpression))
+	case ExpressionType.Column:
+		return compileColumn(expression.(*ColumnExpression))
+	case ExpressionType.InList:
+		return et.compileInList(expression.(*InListExpression))
+	case ExpressionType.Function:
+		return et.compileFunction(expression.(*FunctionExpression))
+	case ExpressionType.Operator:
+		return et.compileOperator(expression.(*OperatorExpression))
+	default:
+		return errorNode(errors.New("unexpected expression type encountered"))
+	}
+}
+
+// isConstantExpression determines if expression evaluation produces the same result for every data row.
+func isConstantExpression(expression Expression) bool {
+	if expression == nil {
+		return true
+	}
+
+	switch expression.Type() {
+	case ExpressionType.Value:
+		return true
+	case ExpressionType.Unary:
+		return isConstantExpression(expression.(*UnaryExpression).Value())
+	case ExpressionType.InList:
+		inListExpression := expression.(*InListExpression)
+		return isConstantExpression(inListExpression.Value()) && areConstantExpressions(inListExpression.Arguments())
+	case ExpressionType.Function:
+		functionExpression := expression.(*FunctionExpression)
+
+		switch functionExpression.FunctionType() {
+		case ExpressionFunctionType.Now:
+			fallthrough
+		case ExpressionFunctionType.UtcNow:
+			return false
+		default:
+			return areConstantExpressions(functionExpression.Arguments())
+		}
+	case ExpressionType.Operator:
+		operatorExpression := expression.(*OperatorExpression)
+		return isConstantExpression(operatorExpression.LeftValue()) && isConstantExpression(operatorExpression.RightValue())
+	default:
+		return false
+	}
+}
+
+// compileConstant folds a constant expression into its value, or into its error which is reported when the node
+// is evaluated. Expressions that panic during evaluation, e.g., integer division by zero, are not folded so that,
+// as with Evaluate, the panic only occurs if the expression is evaluated.
+func (et *ExpressionTree) compileConstant(expression Expression, targetValueType ExpressionValueTypeEnum) (node *compiledNode) {
+	defer func() {
+		if recover() != nil {
+			node = nil
+		}
+	}()
+
+	value, err := et.evaluateAs(expression, targetValueType)
+
+	if err != nil {
+		return errorNode(err)
+	}
+
+	return constantNode(value)
+}
+
+func areConstantExpressions(expressions []Expression) bool {
+	for _, expression := range expressions {
+		if !isConstantExpression(expression) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func constantNode(value *ValueExpression) *compiledNode {
+	return &compiledNode{
+		evaluate: func(*DataRow) (*ValueExpression, error) {
+			return value, nil
+		},
+		constant:  value,
+		valueType: value.ValueType(),
+		typed:     true,
+	}
+}
+
+func errorNode(err error) *compiledNode {
+	return &compiledNode{
+		evaluate: func(*DataRow) (*ValueExpression, error) {
+			return nil, err
+		},
+	}
+}
+
+func booleanResult(value bool) *ValueExpression {
+	if value {
+		return True
+	}
+
+	return False
+}
+
+func (et *ExpressionTree) compileUnary(unaryExpression *UnaryExpression) *compiledNode {
+	unaryValue := et.compile(unaryExpression.Value())
+
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			value, err := unaryValue(dataRow)
+
+			if err != nil {
+				return nil, errors.New("failed while evaluating unary expression value: " + err.Error())
+			}
+
+			return et.unary(unaryExpression, value)
+		},
+	}
+}
+
+// Compiled Column Access
+
+var errCurrentRowUndefined = errors.New("failed while evaluating column expression, current data row is not defined")
+
+//gocyclo:ignore
+func compileColumn(columnExpression *ColumnExpression) *compiledNode {
+	column := columnExpression.DataColumn()
+
+	if column == nil {
+		return rowErrorNode(errors.New("failed while evaluating column expression, data column reference is not defined"))
+	}
+
+	switch column.Type() {
+	case DataType.String:
+		return compileColumnValue(column, ExpressionValueType.String, (*DataRow).StringValue)
+	case DataType.Boolean:
+		return compileColumnValue(column, ExpressionValueType.Boolean, (*DataRow).BooleanValue)
+	case DataType.DateTime:
+		return compileColumnValue(column, ExpressionValueType.DateTime, (*DataRow).DateTimeValue)
+	case DataType.Single:
+		fallthrough
+	case DataType.Double:
+		return compileColumnValue(column, ExpressionValueType.Double, doubleColumnReader(column.Type()))
+	case DataType.Decimal:
+		return compileColumnValue(column, ExpressionValueType.Decimal, (*DataRow).DecimalValue)
+	case DataType.Guid:
+		return compileColumnValue(column, ExpressionValueType.Guid, (*DataRow).GuidValue)
+	case DataType.Int8:
+		fallthrough
+	case DataType.Int16:
+		fallthrough
+	case DataType.Int32:
+		fallthrough
+	case DataType.UInt8:
+		fallthrough
+	case DataType.UInt16:
+		return compileColumnValue(column, ExpressionValueType.Int32, int32ColumnReader(column.Type()))
+	case DataType.Int64:
+		fallthrough
+	case DataType.UInt32:
+		return compileColumnValue(column, ExpressionValueType.Int64, int64ColumnReader(column.Type()))
+	case DataType.UInt64:
+		return compileUInt64Column(column)
+	default:
+		return rowErrorNode(errors.New("unexpected column data type encountered"))
+	}
+}
+
+// rowErrorNode reports an error for column evaluation, after validating a data row is defined.
+func rowErrorNode(err error) *compiledNode {
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			if dataRow == nil {
+				return nil, errCurrentRowUndefined
+			}
+
+			return nil, err
+		},
+	}
+}
+
+func compileColumnValue[T any](column *DataColumn, valueType ExpressionValueTypeEnum, read columnReader[T]) *compiledNode {
+	readColumn := columnValueReader(column, read)
+
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			value, isNull, err := readColumn(dataRow)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if isNull {
+				return NullValue(valueType), nil
+			}
+
+			return newValueExpression(valueType, value), nil
+		},
+		column:    column,
+		valueType: valueType,
+		typed:     true,
+	}
+}
+
+func compileUInt64Column(column *DataColumn) *compiledNode {
+	readColumn := columnValueReader(column, (*DataRow).UInt64Value)
+
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			value, isNull, err := readColumn(dataRow)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if isNull {
+				return NullValue(ExpressionValueType.Int64), nil
+			}
+
+			// Value type of UInt64 columns depends on magnitude of value
+			if value > math.MaxInt64 {
+				return newValueExpression(ExpressionValueType.Double, float64(value)), nil
+			}
+
+			return newValueExpression(ExpressionValueType.Int64, int64(value)), nil
+		},
+		column: column,
+	}
+}
+
+// columnValueReader gets a function that reads the column value of a data row with column expression error handling.
+func columnValueReader[T any](column *DataColumn, read columnReader[T]) func(*DataRow) (T, bool, error) {
+	columnIndex := column.Index()
+	errorPrefix := "failed while getting column \"" + column.Name() + "\" " + column.Type().String() + " value for current row: "
+
+	return func(dataRow *DataRow) (T, bool, error) {
+		if dataRow == nil {
+			var value T
+			return value, false, errCurrentRowUndefined
+		}
+
+		value, isNull, err := read(dataRow, columnIndex)
+
+		if err != nil {
+			return value, false, errors.New(errorPrefix + err.Error())
+		}
+
+		return value, isNull, nil
+	}
+}
+
+func doubleColumnReader(dataType DataTypeEnum) columnReader[float64] {
+	if dataType == DataType.Single {
+		return func(dataRow *DataRow, columnIndex int) (float64, bool, error) {
+			value, isNull, err := dataRow.SingleValue(columnIndex)
+			return float64(value), isNull, err
+		}
+	}
+
+	return (*DataRow).DoubleValue
+}
+
+func int32ColumnReader(dataType DataTypeEnum) columnReader[int32] {
+	switch dataType {
+	case DataType.Int8:
+		return func(dataRow *DataRow, columnIndex int) (int32, bool, error) {
+			value, isNull, err := dataRow.Int8Value(columnIndex)
+			return int32(value), isNull, err
+		}
+	case DataType.Int16:
+		return func(dataRow *DataRow, columnIndex int) (int32, bool, error) {
+			value, isNull, err := dataRow.Int16Value(columnIndex)
+			return int32(value), isNull, err
+		}
+	case DataType.UInt8:
+		return func(dataRow *DataRow, columnIndex int) (int32, bool, error) {
+			value, isNull, err := dataRow.UInt8Value(columnIndex)
+			return int32(value), isNull, err
+		}
+	case DataType.UInt16:
+		return func(dataRow *DataRow, columnIndex int) (int32, bool, error) {
+			value, isNull, err := dataRow.UInt16Value(columnIndex)
+			return int32(value), isNull, err
+		}
+	default:
+		return (*DataRow).Int32Value
+	}
+}
+
+func int64ColumnReader(dataType DataTypeEnum) columnReader[int64] {
+	if dataType == DataType.UInt32 {
+		return func(dataRow *DataRow, columnIndex int) (int64, bool, error) {
+			value, isNull, err := dataRow.UInt32Value(columnIndex)
+			return int64(value), isNull, err
+		}
+	}
+
+	return (*DataRow).Int64Value
+}
+
+// Compiled Column Tests
+
+// columnTest defines a comparison of a column value to a set of constant values that is matched
+// when the comparison is true for any of the constants. Constants must already be converted to
+// the value type of the column.
+type columnTest struct {
+	column       *DataColumn
+	valueType    ExpressionValueTypeEnum
+	operatorType ExpressionOperatorTypeEnum
+	columnOnLeft bool
+	constants    []*ValueExpression
+	negate       bool
+	errorPrefix  string
+}
+
+// lookup gets the ordered indexes of the rows of the table that can pass the column test using an index of the column.
+func (test *columnTest) lookup(table *DataTable) ([]int, bool) {
+	if test.negate {
+		return nil, false
+	}
+
+	operatorType := test.operatorType
+
+	// Lookups are made with column as left operand
+	if !test.columnOnLeft {
+		switch operatorType {
+		case ExpressionOperatorType.LessThan:
+			operatorType = ExpressionOperatorType.GreaterThan
+		case ExpressionOperatorType.LessThanOrEqual:
+			operatorType = ExpressionOperatorType.GreaterThanOrEqual
+		case ExpressionOperatorType.GreaterThan:
+			operatorType = ExpressionOperatorType.LessThan
+		case ExpressionOperatorType.GreaterThanOrEqual:
+			operatorType = ExpressionOperatorType.LessThanOrEqual
+		}
+	}
+
+	var rowIndexes []int
+
+	for _, constant := range test.constants {
+		constantRowIndexes, ok := table.indexedRows(test.column, operatorType, constant)
+
+		if !ok {
+			return nil, false
+		}
+
+		rowIndexes = unionRowIndexes(rowIndexes, constantRowIndexes)
+	}
+
+	return rowIndexes, true
+}
+
+func compileColumnTestNode(test *columnTest) *compiledNode {
+	if test == nil {
+		return nil
+	}
+
+	evaluate := compileColumnTest(test)
+
+	if evaluate == nil {
+		return nil
+	}
+
+	return &compiledNode{
+		evaluate: evaluate,
+		lookup:   test.lookup,
+	}
+}
+
+// compileColumnTest compiles the column test into a closure that compares native column
+// values without allocating intermediate value expressions.
+//
+//gocyclo:ignore
+func compileColumnTest(test *columnTest) compiledExpression {
+	operatorType := test.operatorType
+
+	switch test.valueType {
+	case ExpressionValueType.Boolean:
+		return compileTypedColumnTest(test, (*DataRow).BooleanValue, (*ValueExpression).booleanValue, comparisonOf(operatorType, compareBooleans))
+	case ExpressionValueType.Int32:
+		return compileTypedColumnTest(test, int32ColumnReader(test.column.Type()), (*ValueExpression).int32Value, orderedComparison[int32](operatorType))
+	case ExpressionValueType.Int64:
+		return compileTypedColumnTest(test, int64ColumnReader(test.column.Type()), (*ValueExpression).int64Value, orderedComparison[int64](operatorType))
+	case ExpressionValueType.Decimal:
+		return compileTypedColumnTest(test, (*DataRow).DecimalValue, (*ValueExpression).decimalValue, comparisonOf(operatorType, decimal.Decimal.Cmp))
+	case ExpressionValueType.Double:
+		return compileTypedColumnTest(test, doubleColumnReader(test.column.Type()), (*ValueExpression).doubleValue, orderedComparison[float64](operatorType))
+	case ExpressionValueType.String:
+		return compileTypedColumnTest(test, (*DataRow).StringValue, (*ValueExpression).stringValue, stringComparison(operatorType))
+	case ExpressionValueType.Guid:
+		return compileTypedColumnTest(test, (*DataRow).GuidValue, (*ValueExpression).guidValue, comparisonOf(operatorType, guid.Compare))
+	case ExpressionValueType.DateTime:
+		return compileTypedColumnTest(test, (*DataRow).DateTimeValue, (*ValueExpression).dateTimeValue, comparisonOf(operatorType, time.Time.Compare))
+	default:
+		return nil
+	}
+}
+
+func compileTypedColumnTest[T any](test *columnTest, read columnReader[T], nativeValue func(*ValueExpression) T, compare func(left, right T) bool) compiledExpression {
+	readColumn := columnValueReader(test.column, read)
+	constants := make([]T, len(test.constants))
+
+	for i, constant := range test.constants {
+		constants[i] = nativeValue(constant)
+	}
+
+	nullValue := NullValue(test.valueType)
+	matched, unmatched := True, False
+
+	if test.negate {
+		matched, unmatched = False, True
+	}
+
+	columnOnLeft := test.columnOnLeft
+	errorPrefix := test.errorPrefix
+
+	return func(dataRow *DataRow) (*ValueExpression, error) {
+		value, isNull, err := readColumn(dataRow)
+
+		if err != nil {
+			return nil, errors.New(errorPrefix + err.Error())
+		}
+
+		// If column value is Null, result is Null
+		if isNull {
+			return nullValue, nil
+		}
+
+		for _, constant := range constants {
+			var result bool
+
+			if columnOnLeft {
+				result = compare(value, constant)
+			} else {
+				result = compare(constant, value)
+			}
+
+			if result {
+				return matched, nil
+			}
+		}
+
+		return unmatched, nil
+	}
+}
+
+func isComparisonOperator(operatorType ExpressionOperatorTypeEnum) bool {
+	switch operatorType {
+	case ExpressionOperatorType.LessThan:
+		fallthrough
+	case ExpressionOperatorType.LessThanOrEqual:
+		fallthrough
+	case ExpressionOperatorType.GreaterThan:
+		fallthrough
+	case ExpressionOperatorType.GreaterThanOrEqual:
+		fallthrough
+	case ExpressionOperatorType.Equal:
+		fallthrough
+	case ExpressionOperatorType.EqualExactMatch:
+		fallthrough
+	case ExpressionOperatorType.NotEqual:
+		fallthrough
+	case ExpressionOperatorType.NotEqualExactMatch:
+		return true
+	default:
+		return false
+	}
+}
+
+// orderedComparison gets a function that applies the comparison operatorType to natively ordered values.
+func orderedComparison[T cmp.Ordered](operatorType ExpressionOperatorTypeEnum) func(left, right T) bool {
+	switch operatorType {
+	case ExpressionOperatorType.LessThan:
+		return func(left, right T) bool { return left < right }
+	case ExpressionOperatorType.LessThanOrEqual:
+		return func(left, right T) bool { return left <= right }
+	case ExpressionOperatorType.GreaterThan:
+		return func(left, right T) bool { return left > right }
+	case ExpressionOperatorType.GreaterThanOrEqual:
+		return func(left, right T) bool { return left >= right }
+	case ExpressionOperatorType.Equal:
+		fallthrough
+	case ExpressionOperatorType.EqualExactMatch:
+		return func(left, right T) bool { return left == right }
+	default:
+		return func(left, right T) bool { return left != right }
+	}
+}
+
+// comparisonOf gets a function that applies the comparison operatorType to values ordered by the compare function.
+func comparisonOf[T any](operatorType ExpressionOperatorTypeEnum, compare func(left, right T) int) func(left, right T) bool {
+	test := orderedComparison[int](operatorType)
+
+	return func(left, right T) bool {
+		return test(compare(left, right), 0)
+	}
+}
+
+// stringComparison gets a function that applies the comparison operatorType to string values, where
+// ordering is case-insensitive and equality is case-insensitive unless operator is an exact match.
+func stringComparison(operatorType ExpressionOperatorTypeEnum) func(left, right string) bool {
+	switch operatorType {
+	case ExpressionOperatorType.Equal:
+		return strings.EqualFold
+	case ExpressionOperatorType.EqualExactMatch:
+		return func(left, right string) bool { return left == right }
+	case ExpressionOperatorType.NotEqual:
+		return func(left, right string) bool { return !strings.EqualFold(left, right) }
+	case ExpressionOperatorType.NotEqualExactMatch:
+		return func(left, right string) bool { return left != right }
+	default:
+		test := orderedComparison[string](operatorType)
+
+		return func(left, right string) bool {
+			return test(strings.ToUpper(left), strings.ToUpper(right))
+		}
+	}
+}
+
+func compareBooleans(left, right bool) int {
+	var leftValue, rightValue int
+
+	if left {
+		leftValue = 1
+	}
+
+	if right {
+		rightValue = 1
+	}
+
+	return leftValue - rightValue
+}
+
+// Compiled Operators
+
+func (et *ExpressionTree) compileOperator(operatorExpression *OperatorExpression) *compiledNode {
+	operatorType := operatorExpression.OperatorType()
+	leftNode := et.compileNode(operatorExpression.LeftValue())
+	rightNode := et.compileNode(operatorExpression.RightValue())
+
+	if node := compileColumnTestNode(columnComparison(operatorType, leftNode, rightNode)); node != nil {
+		return node
+	}
+
+	operatorName := operatorType.String()
+	apply := et.operatorFunction(operatorType)
+	leftValue, rightValue := leftNode.evaluate, rightNode.evaluate
+
+	// Operation value type can be derived up front when operand value types are known
+	derived := leftNode.typed && rightNode.typed
+	var derivedValueType ExpressionValueTypeEnum
+	var deriveErr error
+
+	if derived {
+		derivedValueType, deriveErr = operatorType.deriveOperationValueType(leftNode.valueType, rightNode.valueType)
+	}
+
+	node := &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			left, err := leftValue(dataRow)
+
+			if err != nil {
+				return nil, errors.New("failed while evaluating \"" + operatorName + "\" operator left operand: " + err.Error())
+			}
+
+			right, err := rightValue(dataRow)
+
+			if err != nil {
+				return nil, errors.New("failed while evaluating \"" + operatorName + "\" operator right operand: " + err.Error())
+			}
+
+			valueType, err := derivedValueType, deriveErr
+
+			if !derived {
+				valueType, err = operatorType.deriveOperationValueType(left.ValueType(), right.ValueType())
+			}
+
+			if err != nil {
+				return nil, errors.New("failed while deriving \"" + operatorName + "\" operator value type: " + err.Error())
+			}
+
+			return apply(left, right, valueType)
+		},
+	}
+
+	switch operatorType {
+	case ExpressionOperatorType.IsNull:
+		node.lookup = nullLookup(leftNode)
+		fallthrough
+	case ExpressionOperatorType.IsNotNull:
+		node.valueType = ExpressionValueType.Boolean
+		node.typed = true
+	case ExpressionOperatorType.And:
+		node.lookup = andLookup(leftNode, rightNode)
+		node.valueType = ExpressionValueType.Boolean
+		node.typed = true
+	case ExpressionOperatorType.Or:
+		node.lookup = orLookup(leftNode, rightNode)
+		node.valueType = ExpressionValueType.Boolean
+		node.typed = true
+	}
+
+	return node
+}
+
+// nullLookup gets a lookup of the rows with Null values when the node is a column expression.
+func nullLookup(node *compiledNode) func(*DataTable) ([]int, bool) {
+	column := node.column
+
+	if column == nil {
+		return nil
+	}
+
+	return func(table *DataTable) ([]int, bool) {
+		index := table.index(column)
+
+		if index == nil {
+			return nil, false
+		}
+
+		return index.nullLookup(), true
+	}
+}
+
+// andLookup gets a lookup of the rows found by both operand lookups, or by either operand lookup when
+// the other operand rows cannot be found from an index.
+func andLookup(leftNode, rightNode *compiledNode) func(*DataTable) ([]int, bool) {
+	leftLookup, rightLookup := leftNode.lookup, rightNode.lookup
+
+	if leftLookup == nil {
+		return rightLookup
+	}
+
+	if rightLookup == nil {
+		return leftLookup
+	}
+
+	return func(table *DataTable) ([]int, bool) {
+		leftRowIndexes, leftFound := leftLookup(table)
+		rightRowIndexes, rightFound := rightLookup(table)
+
+		switch {
+		case leftFound && rightFound:
+			return intersectRowIndexes(leftRowIndexes, rightRowIndexes), true
+		case leftFound:
+			return leftRowIndexes, true
+		default:
+			return rightRowIndexes, rightFound
+		}
+	}
+}
+
+// orLookup gets a lookup of the rows found by either operand lookup, when rows for both operands can be found from an index.
+func orLookup(leftNode, rightNode *compiledNode) func(*DataTable) ([]int, bool) {
+	leftLookup, rightLookup := leftNode.lookup, rightNode.lookup
+
+	if leftLookup == nil || rightLookup == nil {
+		return nil
+	}
+
+	return func(table *DataTable) ([]int, bool) {
+		leftRowIndexes, leftFound := leftLookup(table)
+
+		if !leftFound {
+			return nil, false
+		}
+
+		rightRowIndexes, rightFound := rightLookup(table)
+
+		if !rightFound {
+			return nil, false
+		}
+
+		return unionRowIndexes(leftRowIndexes, rightRowIndexes), true
+	}
+}
+
+// columnComparison gets a column test for a comparison between a column and a non-null constant, returning
+// nil when the comparison requires the column value to be converted or operands are not suitable.
+func columnComparison(operatorType ExpressionOperatorTypeEnum, leftNode, rightNode *compiledNode) *columnTest {
+	if !isComparisonOperator(operatorType) {
+		return nil
+	}
+
+	var columnNode, constantNode *compiledNode
+	var columnOnLeft bool
+
+	if leftNode.column != nil && leftNode.typed && rightNode.constant != nil {
+		columnNode, constantNode, columnOnLeft = leftNode, rightNode, true
+	} else if rightNode.column != nil && rightNode.typed && leftNode.constant != nil {
+		columnNode, constantNode = rightNode, leftNode
+	} else {
+		return nil
+	}
+
+	if constantNode.constant.IsNull() {
+		return nil
+	}
+
+	valueType, err := operatorType.deriveOperationValueType(leftNode.valueType, rightNode.valueType)
+
+	if err != nil || valueType != columnNode.valueType {
+		return nil
+	}
+
+	constant, err := constantNode.constant.Convert(valueType)
+
+	if err != nil {
+		return nil
+	}
+
+	operand := "right"
+
+	if columnOnLeft {
+		operand = "left"
+	}
+
+	return &columnTest{
+		column:       columnNode.column,
+		valueType:    valueType,
+		operatorType: operatorType,
+		columnOnLeft: columnOnLeft,
+		constants:    []*ValueExpression{constant},
+		errorPrefix:  "failed while evaluating \"" + operatorType.String() + "\" operator " + operand + " operand: ",
+	}
+}
+
+//gocyclo:ignore
+func (et *ExpressionTree) operatorFunction(operatorType ExpressionOperatorTypeEnum) func(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+	switch operatorType {
+	case ExpressionOperatorType.Multiply:
+		return et.multiplyOp
+	case ExpressionOperatorType.Divide:
+		return et.divideOp
+	case ExpressionOperatorType.Modulus:
+		return et.modulusOp
+	case ExpressionOperatorType.Add:
+		return et.addOp
+	case ExpressionOperatorType.Subtract:
+		return et.subtractOp
+	case ExpressionOperatorType.BitShiftLeft:
+		return func(leftValue, rightValue *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.bitShiftLeftOp(leftValue, rightValue)
+		}
+	case ExpressionOperatorType.BitShiftRight:
+		return func(leftValue, rightValue *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.bitShiftRightOp(leftValue, rightValue)
+		}
+	case ExpressionOperatorType.BitwiseAnd:
+		return et.bitwiseAndOp
+	case ExpressionOperatorType.BitwiseOr:
+		return et.bitwiseOrOp
+	case ExpressionOperatorType.BitwiseXor:
+		return et.bitwiseXorOp
+	case ExpressionOperatorType.LessThan:
+		return et.lessThanOp
+	case ExpressionOperatorType.LessThanOrEqual:
+		return et.lessThanOrEqualOp
+	case ExpressionOperatorType.GreaterThan:
+		return et.greaterThanOp
+	case ExpressionOperatorType.GreaterThanOrEqual:
+		return et.greaterThanOrEqualOp
+	case ExpressionOperatorType.Equal:
+		return func(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.equalOp(leftValue, rightValue, valueType, false)
+		}
+	case ExpressionOperatorType.EqualExactMatch:
+		return func(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.equalOp(leftValue, rightValue, valueType, true)
+		}
+	case ExpressionOperatorType.NotEqual:
+		return func(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.notEqualOp(leftValue, rightValue, valueType, false)
+		}
+	case ExpressionOperatorType.NotEqualExactMatch:
+		return func(leftValue, rightValue *ValueExpression, valueType ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.notEqualOp(leftValue, rightValue, valueType, true)
+		}
+	case ExpressionOperatorType.IsNull:
+		return func(leftValue, _ *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return booleanResult(leftValue.IsNull()), nil
+		}
+	case ExpressionOperatorType.IsNotNull:
+		return func(leftValue, _ *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return booleanResult(!leftValue.IsNull()), nil
+		}
+	case ExpressionOperatorType.Like:
+		return func(leftValue, rightValue *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.likeOp(leftValue, rightValue, false)
+		}
+	case ExpressionOperatorType.LikeExactMatch:
+		return func(leftValue, rightValue *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.likeOp(leftValue, rightValue, true)
+		}
+	case ExpressionOperatorType.NotLike:
+		return func(leftValue, rightValue *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.notLikeOp(leftValue, rightValue, false)
+		}
+	case ExpressionOperatorType.NotLikeExactMatch:
+		return func(leftValue, rightValue *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.notLikeOp(leftValue, rightValue, true)
+		}
+	case ExpressionOperatorType.And:
+		return func(leftValue, rightValue *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.andOp(leftValue, rightValue)
+		}
+	case ExpressionOperatorType.Or:
+		return func(leftValue, rightValue *ValueExpression, _ ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return et.orOp(leftValue, rightValue)
+		}
+	default:
+		return func(*ValueExpression, *ValueExpression, ExpressionValueTypeEnum) (*ValueExpression, error) {
+			return nil, errors.New("unexpected operator type encountered")
+		}
+	}
+}
+
+// Compiled "IN" Lists
+
+func (et *ExpressionTree) compileInList(inListExpression *InListExpression) *compiledNode {
+	valueNode := et.compileNode(inListExpression.Value())
+	hasNotKeyWord := inListExpression.HasNotKeyword()
+	exactMatch := inListExpression.ExtactMatch()
+	arguments := inListExpression.Arguments()
+	argumentNodes := make([]*compiledNode, len(arguments))
+
+	for i, argument := range arguments {
+		argumentNodes[i] = et.compileNode(argument)
+	}
+
+	if node := compileColumnTestNode(columnInList(valueNode, argumentNodes, hasNotKeyWord, exactMatch)); node != nil {
+		return node
+	}
+
+	inListValue := valueNode.evaluate
+
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			value, err := inListValue(dataRow)
+
+			if err != nil {
+				return nil, errors.New("failed while evaluating \"IN\" expression source value: " + err.Error())
+			}
+
+			// If in list test value is Null, result is Null
+			if value.IsNull() {
+				return NullValue(value.ValueType()), nil
+			}
+
+			for i, argumentNode := range argumentNodes {
+				argumentValue, err := argumentNode.evaluate(dataRow)
+
+				if err != nil {
+					return nil, errors.New("failed while evaluating \"IN\" expression argument " + strconv.Itoa(i) + ": " + err.Error())
+				}
+
+				valueType, err := ExpressionOperatorType.Equal.deriveComparisonOperationValueType(value.ValueType(), argumentValue.ValueType())
+
+				if err != nil {
+					return nil, errors.New("failed while deriving \"IN\" expresssion equality comparison operation value type: " + err.Error())
+				}
+
+				result, err := et.equalOp(value, argumentValue, valueType, exactMatch)
+
+				if err != nil {
+					return nil, errors.New("failed while comparing \"IN\" expresssion source value to argument " + strconv.Itoa(i) + " for equality: " + err.Error())
+				}
+
+				if result.booleanValue() {
+					return booleanResult(!hasNotKeyWord), nil
+				}
+			}
+
+			return booleanResult(hasNotKeyWord), nil
+		},
+	}
+}
+
+// columnInList gets a column test for an "IN" list of non-null constants for a column,
+// returning nil when any comparison requires the column value to be converted.
+func columnInList(valueNode *compiledNode, argumentNodes []*compiledNode, hasNotKeyWord, exactMatch bool) *columnTest {
+	if valueNode.column == nil || !valueNode.typed {
+		return nil
+	}
+
+	constants := make([]*ValueExpression, len(argumentNodes))
+
+	for i, argumentNode := range argumentNodes {
+		if argumentNode.constant == nil || argumentNode.constant.IsNull() {
+			return nil
+		}
+
+		valueType, err := ExpressionOperatorType.Equal.deriveComparisonOperationValueType(valueNode.valueType, argumentNode.valueType)
+
+		if err != nil || valueType != valueNode.valueType {
+			return nil
+		}
+
+		if constants[i], err = argumentNode.constant.Convert(valueType); err != nil {
+			return nil
+		}
+	}
+
+	operatorType := ExpressionOperatorType.Equal
+
+	if exactMatch {
+		operatorType = ExpressionOperatorType.EqualExactMatch
+	}
+
+	return &columnTest{
+		column:       valueNode.column,
+		valueType:    valueNode.valueType,
+		operatorType: operatorType,
+		columnOnLeft: true,
+		constants:    constants,
+		negate:       hasNotKeyWord,
+		errorPrefix:  "failed while evaluating \"IN\" expression source value: ",
+	}
+}
+
+// Compiled Functions
+
+// functionArgument describes a function argument and the value type Null literals take for the argument.
+type functionArgument struct {
+	description string
+	valueType   ExpressionValueTypeEnum
+}
+
+// functionSignature describes the arguments of a function, where arguments after the required count are
+// optional and default to Null, and the implementation invoked with the evaluated argument values.
+type functionSignature struct {
+	arguments []functionArgument
+	required  int
+	invoke    func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error)
+}
+
+func (fs *functionSignature) expects() string {
+	count := len(fs.arguments)
+
+	if fs.required < count {
+		return strconv.Itoa(fs.required) + " or " + strconv.Itoa(count) + " arguments"
+	}
+
+	if count == 1 {
+		return "1 argument"
+	}
+
+	return strconv.Itoa(count) + " arguments"
+}
+
+var (
+	sourceDoubleArgument   = functionArgument{"source value, first argument", ExpressionValueType.Double}
+	sourceStringArgument   = functionArgument{"source value, first argument", ExpressionValueType.String}
+	sourceDateTimeArgument = functionArgument{"source value, first argument", ExpressionValueType.DateTime}
+	testValueArgument      = functionArgument{"test value, first argument", ExpressionValueType.Boolean}
+	testStringArgument     = functionArgument{"test value, second argument", ExpressionValueType.String}
+	ignoreCaseArgument3    = functionArgument{"optional ignore case value, third argument", ExpressionValueType.Boolean}
+	ignoreCaseArgument4    = functionArgument{"optional ignore case value, fourth argument", ExpressionValueType.Boolean}
+	indexArgument3         = functionArgument{"index value, third argument", ExpressionValueType.Int32}
+	intervalTypeArgument2  = functionArgument{"interval type, second argument", ExpressionValueType.String}
+	intervalTypeArgument3  = functionArgument{"interval type, third argument", ExpressionValueType.String}
+	regexArgument          = functionArgument{"expression value, first argument", ExpressionValueType.String}
+)
+
+// functionSignatures defines the signatures of functions that evaluate all their arguments before invocation.
+var functionSignatures = map[ExpressionFunctionTypeEnum]*functionSignature{
+	ExpressionFunctionType.Abs: {[]functionArgument{sourceDoubleArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.abs(values[0])
+	}},
+	ExpressionFunctionType.Ceiling: {[]functionArgument{sourceDoubleArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.ceiling(values[0])
+	}},
+	ExpressionFunctionType.Convert: {[]functionArgument{{"source value, first argument", ExpressionValueType.Boolean}, {"target type, second argument", ExpressionValueType.String}}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.convert(values[0], values[1])
+	}},
+	ExpressionFunctionType.Contains: {[]functionArgument{sourceStringArgument, testStringArgument, ignoreCaseArgument3}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.contains(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.DateAdd: {[]functionArgument{sourceDateTimeArgument, {"add value, second argument", ExpressionValueType.Int32}, intervalTypeArgument3}, 3, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.dateAdd(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.DateDiff: {[]functionArgument{{"left value, first argument", ExpressionValueType.DateTime}, {"right value, second argument", ExpressionValueType.DateTime}, intervalTypeArgument3}, 3, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.dateDiff(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.DatePart: {[]functionArgument{sourceDateTimeArgument, intervalTypeArgument2}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.datePart(values[0], values[1])
+	}},
+	ExpressionFunctionType.EndsWith: {[]functionArgument{sourceStringArgument, testStringArgument, ignoreCaseArgument3}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.endsWith(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.Floor: {[]functionArgument{sourceDoubleArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.floor(values[0])
+	}},
+	ExpressionFunctionType.IndexOf: {[]functionArgument{sourceStringArgument, testStringArgument, ignoreCaseArgument3}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.indexOf(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.IsDate: {[]functionArgument{testValueArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.isDate(values[0]), nil
+	}},
+	ExpressionFunctionType.IsInteger: {[]functionArgument{testValueArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.isInteger(values[0]), nil
+	}},
+	ExpressionFunctionType.IsGuid: {[]functionArgument{testValueArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.isGuid(values[0]), nil
+	}},
+	ExpressionFunctionType.IsNull: {[]functionArgument{{"test value, first argument", ExpressionValueType.String}, {"default value, second argument", ExpressionValueType.String}}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.isNull(values[0], values[1])
+	}},
+	ExpressionFunctionType.IsNumeric: {[]functionArgument{testValueArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.isNumeric(values[0]), nil
+	}},
+	ExpressionFunctionType.LastIndexOf: {[]functionArgument{sourceStringArgument, testStringArgument, ignoreCaseArgument3}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.lastIndexOf(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.Len: {[]functionArgument{sourceStringArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.len(values[0])
+	}},
+	ExpressionFunctionType.Lower: {[]functionArgument{sourceStringArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.lower(values[0])
+	}},
+	ExpressionFunctionType.NthIndexOf: {[]functionArgument{sourceStringArgument, testStringArgument, indexArgument3, ignoreCaseArgument4}, 3, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.nthIndexOf(values[0], values[1], values[2], values[3])
+	}},
+	ExpressionFunctionType.Power: {[]functionArgument{sourceDoubleArgument, {"exponent value, second argument", ExpressionValueType.Int32}}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.power(values[0], values[1])
+	}},
+	ExpressionFunctionType.RegExMatch: {[]functionArgument{regexArgument, testStringArgument}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.regExMatch(values[0], values[1])
+	}},
+	ExpressionFunctionType.RegExVal: {[]functionArgument{regexArgument, testStringArgument}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.regExVal(values[0], values[1])
+	}},
+	ExpressionFunctionType.Replace: {[]functionArgument{sourceStringArgument, testStringArgument, {"replace value, third argument", ExpressionValueType.String}, ignoreCaseArgument4}, 3, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.replace(values[0], values[1], values[2], values[3])
+	}},
+	ExpressionFunctionType.Reverse: {[]functionArgument{sourceStringArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.reverse(values[0])
+	}},
+	ExpressionFunctionType.Round: {[]functionArgument{sourceDoubleArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.round(values[0])
+	}},
+	ExpressionFunctionType.Split: {[]functionArgument{sourceStringArgument, {"delimiter value, second argument", ExpressionValueType.String}, indexArgument3, ignoreCaseArgument4}, 3, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.split(values[0], values[1], values[2], values[3])
+	}},
+	ExpressionFunctionType.Sqrt: {[]functionArgument{sourceDoubleArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.sqrt(values[0])
+	}},
+	ExpressionFunctionType.StartsWith: {[]functionArgument{sourceStringArgument, testStringArgument, ignoreCaseArgument3}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.startsWith(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.StrCount: {[]functionArgument{sourceStringArgument, testStringArgument, ignoreCaseArgument3}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.strCount(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.StrCmp: {[]functionArgument{{"left value, first argument", ExpressionValueType.String}, {"right value, second argument", ExpressionValueType.String}, ignoreCaseArgument3}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.strCmp(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.SubStr: {[]functionArgument{sourceStringArgument, {"index value, second argument", ExpressionValueType.Int32}, {"optional length value, third argument", ExpressionValueType.Int32}}, 2, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.subStr(values[0], values[1], values[2])
+	}},
+	ExpressionFunctionType.Trim: {[]functionArgument{sourceStringArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.trim(values[0])
+	}},
+	ExpressionFunctionType.TrimLeft: {[]functionArgument{sourceStringArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.trimLeft(values[0])
+	}},
+	ExpressionFunctionType.TrimRight: {[]functionArgument{sourceStringArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.trimRight(values[0])
+	}},
+	ExpressionFunctionType.Upper: {[]functionArgument{sourceStringArgument}, 1, func(et *ExpressionTree, values [4]*ValueExpression) (*ValueExpression, error) {
+		return et.upper(values[0])
+	}},
+}
+
+//gocyclo:ignore
+func (et *ExpressionTree) compileFunction(functionExpression *FunctionExpression) *compiledNode {
+	functionType := functionExpression.FunctionType()
+	arguments := functionExpression.Arguments()
+
+	switch functionType {
+	case ExpressionFunctionType.Coalesce:
+		return et.compileVariadicFunction(functionExpression, et.coalesce)
+	case ExpressionFunctionType.MaxOf:
+		return et.compileVariadicFunction(functionExpression, et.maxOf)
+	case ExpressionFunctionType.MinOf:
+		return et.compileVariadicFunction(functionExpression, et.minOf)
+	case ExpressionFunctionType.IIf:
+		return et.compileIIf(arguments)
+	case ExpressionFunctionType.Now:
+		fallthrough
+	case ExpressionFunctionType.UtcNow:
+		// Functions without arguments do not depend on row values
+		return &compiledNode{
+			evaluate: func(*DataRow) (*ValueExpression, error) {
+				return et.evaluateFunction(functionExpression)
+			},
+		}
+	}
+
+	signature, ok := functionSignatures[functionType]
+
+	if !ok {
+		return errorNode(errors.New("unexpected function type encountered"))
+	}
+
+	functionName := functionType.String()
+
+	if len(arguments) < signature.required || len(arguments) > len(signature.arguments) {
+		return errorNode(errors.New("\"" + functionName + "\" function expects " + signature.expects() + ", received " + strconv.Itoa(len(arguments))))
+	}
+
+	argumentNodes := make([]*compiledNode, len(arguments))
+
+	for i, argument := range arguments {
+		argumentNodes[i] = et.compileAs(argument, signature.arguments[i].valueType)
+	}
+
+	if functionType == ExpressionFunctionType.RegExMatch || functionType == ExpressionFunctionType.RegExVal {
+		if node := et.compileRegEx(functionName, argumentNodes, functionType == ExpressionFunctionType.RegExVal); node != nil {
+			return node
+		}
+	}
+
+	// Missing optional arguments are evaluated as Null
+	var defaultValues [4]*ValueExpression
+
+	for i := len(arguments); i < len(signature.arguments); i++ {
+		defaultValues[i] = NullValue(signature.arguments[i].valueType)
+	}
+
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			values := defaultValues
+
+			for i, argumentNode := range argumentNodes {
+				value, err := argumentNode.evaluate(dataRow)
+
+				if err != nil {
+					return nil, errors.New("failed while evaluating \"" + functionName + "\" function " + signature.arguments[i].description + ": " + err.Error())
+				}
+
+				values[i] = value
+			}
+
+			return signature.invoke(et, values)
+		},
+	}
+}
+
+// compileVariadicFunction compiles a function that takes two or more arguments which it evaluates on demand.
+func (et *ExpressionTree) compileVariadicFunction(functionExpression *FunctionExpression, invoke func(int, func(int) (*ValueExpression, error)) (*ValueExpression, error)) *compiledNode {
+	arguments := functionExpression.Arguments()
+
+	if len(arguments) < 2 {
+		return errorNode(errors.New("\"" + functionExpression.FunctionType().String() + "\" function expects at least 2 arguments, received " + strconv.Itoa(len(arguments))))
+	}
+
+	argumentValues := make([]compiledExpression, len(arguments))
+
+	for i, argument := range arguments {
+		argumentValues[i] = et.compile(argument)
+	}
+
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			return invoke(len(argumentValues), func(index int) (*ValueExpression, error) {
+				return argumentValues[index](dataRow)
+			})
+		},
+	}
+}
+
+func (et *ExpressionTree) compileIIf(arguments []Expression) *compiledNode {
+	if len(arguments) != 3 {
+		return errorNode(errors.New("\"IIf\" function expects 3 arguments, received " + strconv.Itoa(len(arguments))))
+	}
+
+	testValue := et.compile(arguments[0])
+	resultValues := [2]compiledExpression{et.compile(arguments[1]), et.compile(arguments[2])}
+
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			value, err := testValue(dataRow)
+
+			if err != nil {
+				return nil, errors.New("failed while evaluating \"IIf\" function test value, first argument: " + err.Error())
+			}
+
+			// Only the selected result value is evaluated
+			return et.iif(value, func(index int) (*ValueExpression, error) {
+				return resultValues[index](dataRow)
+			})
+		},
+	}
+}
+
+// compileRegEx compiles a regular expression function with a constant expression value so the expression
+// is only parsed once, returning nil when expression value is not a valid, non-null constant string.
+func (et *ExpressionTree) compileRegEx(functionName string, argumentNodes []*compiledNode, returnMatchedValue bool) *compiledNode {
+	regexValue := argumentNodes[0].constant
+
+	if regexValue == nil || regexValue.ValueType() != ExpressionValueType.String || regexValue.IsNull() {
+		return nil
+	}
+
+	regex, err := regexp.Compile(regexValue.stringValue())
+
+	if err != nil {
+		return nil
+	}
+
+	testValue := argumentNodes[1].evaluate
+	nullValue := NullValue(ExpressionValueType.Boolean)
+
+	if returnMatchedValue {
+		nullValue = NullValue(ExpressionValueType.String)
+	}
+
+	return &compiledNode{
+		evaluate: func(dataRow *DataRow) (*ValueExpression, error) {
+			value, err := testValue(dataRow)
+
+			if err != nil {
+				return nil, errors.New("failed while evaluating \"" + functionName + "\" function test value, second argument: " + err.Error())
+			}
+
+			if value.ValueType() != ExpressionValueType.String {
+				return nil, errors.New("\"" + functionName + "\" function test value, second argument, must be a \"String\"")
+			}
+
+			// If test value is Null, result is Null
+			if value.IsNull() {
+				return nullValue, nil
+			}
+
+			return matchRegEx(regex, value.stringValue(), returnMatchedValue), nil
+		},
+	}
+}
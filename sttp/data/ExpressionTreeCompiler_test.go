@@ -0,0 +1,341 @@
+//******************************************************************************************************
+//  ExpressionTreeCompiler_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/xml"
+)
+
+func loadMetadataSample(t testing.TB) *DataSet {
+	var doc xml.XmlDocument
+
+	if err := doc.LoadXmlFromFile("../../test/MetadataSample2.xml"); err != nil {
+		t.Fatal("error loading XML document: " + err.Error())
+	}
+
+	dataSet := NewDataSet()
+
+	if err := dataSet.ParseXmlDocument(&doc); err != nil {
+		t.Fatal("error loading DataSet from XML document: " + err.Error())
+	}
+
+	return dataSet
+}
+
+// compareCompiledResult gets a description of any difference between interpreted and compiled evaluation results.
+func compareCompiledResult(expected, actual *ValueExpression, expectedErr, actualErr error) string {
+	if expectedErr != nil || actualErr != nil {
+		if expectedErr == nil || actualErr == nil || expectedErr.Error() != actualErr.Error() {
+			return "expected error " + errorText(expectedErr) + ", received " + errorText(actualErr)
+		}
+
+		return ""
+	}
+
+	if expected.ValueType() != actual.ValueType() {
+		return "expected value type " + expected.ValueType().String() + ", received " + actual.ValueType().String()
+	}
+
+	expectedValue, actualValue := expected.Value(), actual.Value()
+
+	if expectedFloat, ok := expectedValue.(float64); ok && math.IsNaN(expectedFloat) {
+		if actualFloat, ok := actualValue.(float64); ok && math.IsNaN(actualFloat) {
+			return ""
+		}
+	}
+
+	if !reflect.DeepEqual(expectedValue, actualValue) {
+		return "expected value " + expected.String() + ", received " + actual.String()
+	}
+
+	return ""
+}
+
+func errorText(err error) string {
+	if err == nil {
+		return "<nil>"
+	}
+
+	return "\"" + err.Error() + "\""
+}
+
+// testCompiledExpression compares compiled evaluation of each expression tree parsed from filterExpression
+// to interpreted evaluation for every row of the tree's table, along with a nil data row. Returns the number
+// of compared expression trees, or zero if the filterExpression could not be parsed for the primary table.
+func testCompiledExpression(t *testing.T, dataSet *DataSet, primaryTable string, filterExpression string) int {
+	expressionTrees, err := GenerateExpressionTrees(dataSet, primaryTable, filterExpression, true)
+
+	if err != nil {
+		return 0
+	}
+
+	for _, expressionTree := range expressionTrees {
+		tableName := expressionTree.TableName
+
+		if len(tableName) == 0 {
+			tableName = primaryTable
+		}
+
+		table := dataSet.Table(tableName)
+		evaluate := expressionTree.Compile()
+		rows := append([]*DataRow{nil}, table.Rows()...)
+
+		for i, row := range rows {
+			expected, expectedErr := expressionTree.Evaluate(row)
+			actual, actualErr := evaluate(row)
+
+			if difference := compareCompiledResult(expected, actual, expectedErr, actualErr); len(difference) > 0 {
+				t.Fatal("TestCompiledExpressions: compiled result for \"" + filterExpression + "\" on table \"" + tableName + "\" row " + strconv.Itoa(i-1) + " does not match: " + difference)
+			}
+		}
+	}
+
+	return len(expressionTrees)
+}
+
+// suiteFilterExpressions gets the filter expressions passed as string literals to the
+// expression functions exercised by ExpressionTree_test.go.
+func suiteFilterExpressions(t *testing.T) []string {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, "ExpressionTree_test.go", nil, 0)
+
+	if err != nil {
+		t.Fatal("TestCompiledExpressions: error parsing ExpressionTree_test.go: " + err.Error())
+	}
+
+	functions := map[string]int{
+		"EvaluateExpression":        0,
+		"EvaluateDataRowExpression": 1,
+		"SelectDataRows":            1,
+		"SelectSignalIDSet":         1,
+		"Select":                    0,
+	}
+
+	filterExpressions := make([]string, 0)
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+
+		if !ok {
+			return true
+		}
+
+		var name string
+
+		switch function := call.Fun.(type) {
+		case *ast.Ident:
+			name = function.Name
+		case *ast.SelectorExpr:
+			name = function.Sel.Name
+		}
+
+		index, ok := functions[name]
+
+		if !ok || index >= len(call.Args) {
+			return true
+		}
+
+		if literal, ok := call.Args[index].(*ast.BasicLit); ok && literal.Kind == token.STRING {
+			if filterExpression, err := strconv.Unquote(literal.Value); err == nil {
+				filterExpressions = append(filterExpressions, filterExpression)
+			}
+		}
+
+		return true
+	})
+
+	return filterExpressions
+}
+
+func TestCompiledExpressions(t *testing.T) {
+	dataSets := []*DataSet{loadMetadataSample(t), createAllTypesDataSet()}
+	dataSet, _, _, _, _ := createDataSet()
+	dataSets = append(dataSets, dataSet)
+
+	filterExpressions := suiteFilterExpressions(t)
+	compared := 0
+
+	for _, filterExpression := range filterExpressions {
+		// Current time functions cannot produce identical results across evaluations
+		if strings.Contains(strings.ToLower(filterExpression), "now(") {
+			continue
+		}
+
+		parsed := false
+
+		for _, dataSet := range dataSets {
+			for _, table := range dataSet.Tables() {
+				if testCompiledExpression(t, dataSet, table.Name(), filterExpression) > 0 {
+					parsed = true
+				}
+			}
+		}
+
+		if parsed {
+			compared++
+		}
+	}
+
+	if compared < 100 {
+		t.Fatal("TestCompiledExpressions: expected at least 100 compared suite expressions, received: " + strconv.Itoa(compared) + " of " + strconv.Itoa(len(filterExpressions)))
+	}
+}
+
+func TestCompiledColumnComparisons(t *testing.T) {
+	dataSet := createAllTypesDataSet()
+	table := dataSet.Table("AllTypes")
+
+	operators := []string{"=", "==", "===", "!=", "!==", "<>", "<", "<=", ">", ">="}
+
+	constants := []string{"'A & B'", "''", "'3.1415927'", "1", "-1", "0", "21", "2.5", "9223372036854775807", "NULL", "True", "False",
+		"#1970-01-01T00:00:00Z#", "#2026-10-16#", guid.Empty.String(), "Int32Field"}
+
+	compared := 0
+
+	for columnIndex := 0; columnIndex < table.ColumnCount(); columnIndex++ {
+		column := table.Column(columnIndex)
+
+		for _, constant := range constants {
+			filterExpressions := []string{
+				column.Name() + " IS NULL",
+				column.Name() + " IS NOT NULL",
+				column.Name() + " IN (" + constant + ", 0, 'a & b')",
+				column.Name() + " NOT IN (" + constant + ")",
+				column.Name() + " IN BINARY (" + constant + ", 'A & B')",
+				"-" + column.Name() + " < " + constant,
+			}
+
+			for _, operator := range operators {
+				filterExpressions = append(filterExpressions,
+					column.Name()+" "+operator+" "+constant,
+					constant+" "+operator+" "+column.Name())
+			}
+
+			// Statements are parsed together, each producing its own expression tree
+			if testCompiledExpression(t, dataSet, table.Name(), strings.Join(filterExpressions, "; ")) != len(filterExpressions) {
+				t.Fatal("TestCompiledColumnComparisons: failed to parse expressions for \"" + column.Name() + "\" and " + constant)
+			}
+
+			compared += len(filterExpressions)
+		}
+	}
+
+	if compared < 1000 {
+		t.Fatal("TestCompiledColumnComparisons: expected at least 1000 compared expressions, received: " + strconv.Itoa(compared))
+	}
+}
+
+func TestCompiledFunctions(t *testing.T) {
+	dataSet := loadMetadataSample(t)
+
+	filterExpressions := []string{
+		"RegExMatch('^[A-Z]+_', PointTag)",
+		"RegExVal('[0-9]+', SignalReference) = '1'",
+		"RegExMatch(SignalAcronym, 'FREQ')",
+		"RegExMatch('[', PointTag)",
+		"RegExMatch(NULL, PointTag)",
+		"IIf(SignalAcronym = 'FREQ', 1, 2) = 1",
+		"IIf(PhasorSourceIndex, 1, 2) = 1",
+		"IIf(SignalAcronym = 'NONE', 1 / 0, 2) = 2",
+		"Coalesce(NULL, PhasorSourceIndex, 0) > 0",
+		"MaxOf(PhasorSourceIndex, 2, NULL) = 2",
+		"MinOf(PhasorSourceIndex, 2, 'a') = 2",
+		"Len(PointTag) > 10 AND Contains(PointTag, 'phs', True)",
+		"SubStr(PointTag, 2) = SubStr(PointTag, 2, 100)",
+		"Split(PointTag, '_', 1) = 'PMU'",
+		"NthIndexOf(PointTag, '-', 1) > 0",
+		"Replace(PointTag, '-', '_', NULL) LIKE '%_%'",
+		"StrCmp(SignalAcronym, 'freq', True) = 0",
+		"Abs(PhasorSourceIndex) = Floor(PhasorSourceIndex)",
+		"Len(PointTag, 1) > 0",
+		"IsNull(PhasorSourceIndex, 'none') = 'none'",
+		"Convert(PhasorSourceIndex, 'System.String') = '1'",
+		"DatePart(UpdatedOn, 'Year') > 2000",
+		"SignalAcronym + '_' + DeviceAcronym LIKE 'FREQ_%'",
+		"PhasorSourceIndex * 2 + 1 > 3 OR NOT Internal",
+		"PhasorSourceIndex << 1 > 2",
+		"Enabled AND PhasorSourceIndex",
+		"SignalAcronym > 1",
+		"PointTag + PhasorSourceIndex = 0",
+	}
+
+	for _, filterExpression := range filterExpressions {
+		if testCompiledExpression(t, dataSet, "MeasurementDetail", filterExpression) == 0 {
+			t.Fatal("TestCompiledFunctions: failed to parse \"" + filterExpression + "\"")
+		}
+	}
+}
+
+const benchmarkFilterExpression = "SignalAcronym IN ('IPHM', 'IPHA', 'VPHM', 'VPHA') AND Enabled AND Len(PointTag) > 10 OR SignalAcronym = 'FREQ'"
+
+func benchmarkExpressionTree(b *testing.B) (*ExpressionTree, []*DataRow) {
+	dataSet := loadMetadataSample(b)
+	table := dataSet.Table("MeasurementDetail")
+	expressionTree, err := GenerateExpressionTree(table, benchmarkFilterExpression, true)
+
+	if err != nil {
+		b.Fatal("error generating expression tree: " + err.Error())
+	}
+
+	return expressionTree, table.Rows()
+}
+
+func BenchmarkEvaluateExpression(b *testing.B) {
+	expressionTree, rows := benchmarkExpressionTree(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, row := range rows {
+			if _, err := expressionTree.Evaluate(row); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkCompiledExpression(b *testing.B) {
+	expressionTree, rows := benchmarkExpressionTree(b)
+	evaluate := expressionTree.Compile()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		for _, row := range rows {
+			if _, err := evaluate(row); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
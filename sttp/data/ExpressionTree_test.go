@@ -2627,6 +2627,37 @@ func TestMiscExpressions(t *testing.T) {
 	}
 }
 
+func TestIIfExpression(t *testing.T) {
+	// Test value is the first argument, so result arguments must not be evaluated as the test value
+	for expression, expected := range map[string]int32{
+		"IIf(1 > 2, 1, 2)":              2,
+		"IIf(2 > 1, 0, 2)":              0,
+		"IIf(NULL, 1, 2)":               2,
+		"IIf(False, 1 / 0, 3)":          3,
+		"IIf(Len('abc') = 3, 4, 1 / 0)": 4,
+	} {
+		valueExpression, err := EvaluateExpression(expression, true)
+
+		if err != nil {
+			t.Fatal("TestIIfExpression: error during EvaluateExpression for \"" + expression + "\": " + err.Error())
+		}
+
+		if valueExpression.ValueType() != ExpressionValueType.Int32 {
+			t.Fatal("TestIIfExpression: unexpected value expression type for \"" + expression + "\": " + valueExpression.ValueType().String())
+		}
+
+		i32, err := valueExpression.Int32Value()
+
+		if err != nil {
+			t.Fatal("TestIIfExpression: error getting value: " + err.Error())
+		}
+
+		if i32 != expected {
+			t.Fatal("TestIIfExpression: unexpected result for \"" + expression + "\": " + strconv.Itoa(int(i32)))
+		}
+	}
+}
+
 func TestFilterExpressionStatementCount(t *testing.T) {
 	dataSet, _, _, statID, freqID := createDataSet()
 
@@ -2658,3 +2689,74 @@ func TestFilterExpressionStatementCount(t *testing.T) {
 		t.Fatal("TestFilterExpressionStatementCount: expected 4 results, received: " + strconv.Itoa(parser.FilterExpressionStatementCount()))
 	}
 }
+
+func TestExpressionTreeString(t *testing.T) {
+	dataSet, _, _, _, _ := createDataSet()
+
+	expressionTrees, err := GenerateExpressionTrees(dataSet, "ActiveMeasurements", "FILTER TOP 5 ActiveMeasurements WHERE SignalType IN ('FREQ', 'STAT') AND NOT Len(SignalType) > 3 ORDER BY SignalID DESC", true)
+
+	if err != nil {
+		t.Fatal("TestExpressionTreeString: error generating expression trees: " + err.Error())
+	}
+
+	if len(expressionTrees) != 1 {
+		t.Fatal("TestExpressionTreeString: expected 1 expression tree, received: " + strconv.Itoa(len(expressionTrees)))
+	}
+
+	expected := `Table: ActiveMeasurements
+Top: 5
+Order By: SignalID DESC
+Where:
+    Operator AND
+        InList
+            Column SignalType (String)
+            List
+                Value 'FREQ' (String)
+                Value 'STAT' (String)
+        Unary ~
+            Operator >
+                Function Len
+                    Column SignalType (String)
+                Value 3 (Int32)
+`
+
+	if image := expressionTrees[0].String(); image != expected {
+		t.Fatal("TestExpressionTreeString: unexpected expression tree image:\n" + image)
+	}
+}
+
+func TestSyntaxErrorPosition(t *testing.T) {
+	dataSet, _, _, _, _ := createDataSet()
+
+	parser, err := NewFilterExpressionParserForDataSet(dataSet, "FILTER ActiveMeasurements WHERE SignalType = 'FREQ' AND", "ActiveMeasurements", nil, true)
+
+	if err != nil {
+		t.Fatal("TestSyntaxErrorPosition: unexpected NewFilterExpressionParserForDataSet error: " + err.Error())
+	}
+
+	var positions []int
+	var messages []string
+
+	parser.SetSyntaxErrorCallback(func(line, column int, message string) {
+		if line != 1 {
+			t.Fatal("TestSyntaxErrorPosition: unexpected syntax error line: " + strconv.Itoa(line))
+		}
+
+		positions = append(positions, column)
+	})
+
+	parser.SetParsingExceptionCallback(func(message string) {
+		messages = append(messages, message)
+	})
+
+	parser.Evaluate(true, false)
+
+	if len(positions) == 0 || len(positions) != len(messages) {
+		t.Fatal("TestSyntaxErrorPosition: expected syntax error callbacks for each parsing exception")
+	}
+
+	// Error is reported at end of input following the dangling AND
+	if positions[0] != 55 {
+		t.Fatal("TestSyntaxErrorPosition: unexpected syntax error column: " + strconv.Itoa(positions[0]))
+	}
+}
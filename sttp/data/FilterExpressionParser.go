@@ -96,9 +96,11 @@ func NewFilterExpressionParser(filterExpression string, suppressConsoleErrorOutp
 	fep.TrackFilteredRows = true
 
 	if suppressConsoleErrorOutput {
+		fep.lexer.RemoveErrorListeners()
 		fep.parser.RemoveErrorListeners()
 	}
 
+	fep.lexer.AddErrorListener(fep.errorListener)
 	fep.parser.AddErrorListener(fep.errorListener)
 
 	return fep
@@ -136,6 +138,12 @@ func (fep *FilterExpressionParser) SetParsingExceptionCallback(callback func(mes
 	fep.errorListener.ParsingExceptionCallback = callback
 }
 
+// SetSyntaxErrorCallback registers a callback for receiving syntax errors with their position in the filter
+// expression, where line is 1-based and column is the 0-based character offset within the line.
+func (fep *FilterExpressionParser) SetSyntaxErrorCallback(callback func(line, column int, message string)) {
+	fep.errorListener.SyntaxErrorCallback = callback
+}
+
 // ExpressionTrees gets the expression trees, parsing the filter expression if needed.
 func (fep *FilterExpressionParser) ExpressionTrees() ([]*ExpressionTree, error) {
 	if len(fep.expressionTrees) == 0 {
@@ -240,7 +248,7 @@ func (fep *FilterExpressionParser) Evaluate(applyLimit bool, applySort bool) err
 		}
 
 		// Select all matching boolean results from expression tree evaluated for each table row
-		matchedRows, err := expressionTree.SelectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
+		matchedRows, err := expressionTree.selectWhere(table, func(resultExpression *ValueExpression) (bool, error) {
 			resultType := resultExpression.ValueType()
 
 			if resultType == ExpressionValueType.Boolean {
@@ -249,7 +257,7 @@ func (fep *FilterExpressionParser) Evaluate(applyLimit bool, applySort bool) err
 
 			// Filtered results will already have any matched literals
 			return false, nil
-		}, applyLimit, applySort)
+		}, applyLimit, applySort, true)
 
 		if err != nil {
 			return err
@@ -363,11 +371,24 @@ func (fep *FilterExpressionParser) mapMatchedFieldRow(primaryTable *DataTable, c
 		return
 	}
 
+	rowIndexes, indexed := primaryTable.indexedRows(column, ExpressionOperatorType.Equal, newValueExpression(ExpressionValueType.String, matchValue))
+	rowCount := primaryTable.RowCount()
+
+	if indexed {
+		rowCount = len(rowIndexes)
+	}
+
 	matchValue = strings.ToUpper(matchValue)
 	columnIndex := column.Index()
 
-	for i := 0; i < primaryTable.RowCount(); i++ {
-		row := primaryTable.Row(i)
+	for i := 0; i < rowCount; i++ {
+		rowIndex := i
+
+		if indexed {
+			rowIndex = rowIndexes[i]
+		}
+
+		row := primaryTable.Row(rowIndex)
 
 		if row == nil {
 			continue
@@ -544,9 +565,22 @@ func (fep *FilterExpressionParser) ExitIdentifierStatement(context *parser.Ident
 	signalIDColumnIndex := signalIDColumn.Index()
 
 	if fep.TrackFilteredRows && !signalID.IsZero() {
-		// Map matching row for manually specified Guid
-		for i := 0; i < primaryTable.RowCount(); i++ {
-			row := primaryTable.Row(i)
+		// Map matching row for manually specified Guid, using any index of signal ID column
+		rowIndexes, indexed := primaryTable.indexedRows(signalIDColumn, ExpressionOperatorType.Equal, newValueExpression(ExpressionValueType.Guid, signalID))
+		rowCount := primaryTable.RowCount()
+
+		if indexed {
+			rowCount = len(rowIndexes)
+		}
+
+		for i := 0; i < rowCount; i++ {
+			rowIndex := i
+
+			if indexed {
+				rowIndex = rowIndexes[i]
+			}
+
+			row := primaryTable.Row(rowIndex)
 
 			if row == nil {
 				continue
@@ -0,0 +1,539 @@
+//******************************************************************************************************
+//  Marshal.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	guidType    = reflect.TypeOf(guid.Guid{})
+	decimalType = reflect.TypeOf(decimal.Decimal{})
+)
+
+// dataTypeGoTypes defines the Go type of the values stored for each DataType, in DataType order.
+var dataTypeGoTypes = []reflect.Type{
+	reflect.TypeOf(""),
+	reflect.TypeOf(false),
+	timeType,
+	reflect.TypeOf(float32(0)),
+	reflect.TypeOf(float64(0)),
+	decimalType,
+	guidType,
+	reflect.TypeOf(int8(0)),
+	reflect.TypeOf(int16(0)),
+	reflect.TypeOf(int32(0)),
+	reflect.TypeOf(int64(0)),
+	reflect.TypeOf(uint8(0)),
+	reflect.TypeOf(uint16(0)),
+	reflect.TypeOf(uint32(0)),
+	reflect.TypeOf(uint64(0)),
+}
+
+// fieldMapping defines the DataColumn mapping of a struct field.
+type fieldMapping struct {
+	name       string
+	index      []int
+	columnName string
+	goType     reflect.Type
+	dataType   DataTypeEnum
+	nullable   bool
+}
+
+// UnmarshalRow copies the values of the DataRow into the struct pointed to by target.
+//
+// Each exported struct field maps to the DataColumn named by its `sttp:"ColumnName"` tag, or to
+// the column with the same name as the field when the field is not tagged. Column name lookups
+// are case-insensitive. Fields tagged with `sttp:"-"` and untagged fields with types that do not
+// map to a DataType are ignored, as are fields with no matching column. Fields of embedded
+// structs are mapped as if they were fields of the outer struct.
+//
+// Supported field types are string, bool, time.Time, float32, float64, decimal.Decimal, guid.Guid
+// and the sized integer types, along with int and uint which map to Int64 and UInt64. Pointers to
+// these types are nullable: they are set to nil for null values, other fields are set to their zero
+// value. Values are converted to the field type when the column type differs using the conversions
+// applied to computed column values, a failed conversion, including a numeric value that is out of
+// range for the field type, is reported as an *UnmarshalError.
+func UnmarshalRow(row *DataRow, target interface{}) error {
+	if row == nil {
+		return errors.New("cannot unmarshal nil DataRow")
+	}
+
+	value := reflect.ValueOf(target)
+
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return errors.New("unmarshal target must be a non-nil pointer to a struct")
+	}
+
+	mappings, err := structFieldMappings(value.Elem().Type())
+
+	if err != nil {
+		return err
+	}
+
+	return unmarshalRow(row, -1, value.Elem(), mappings, mappedColumns(row.Parent(), mappings))
+}
+
+// UnmarshalTable copies the rows of the DataTable into the slice pointed to by target, replacing
+// any existing elements. Target must point to a slice of structs or of pointers to structs. Fields
+// are mapped to columns as described for UnmarshalRow. Nil rows are skipped.
+func UnmarshalTable(table *DataTable, target interface{}) error {
+	if table == nil {
+		return errors.New("cannot unmarshal nil DataTable")
+	}
+
+	value := reflect.ValueOf(target)
+
+	if value.Kind() != reflect.Pointer || value.IsNil() || value.Elem().Kind() != reflect.Slice {
+		return errors.New("unmarshal target must be a non-nil pointer to a slice")
+	}
+
+	sliceValue := value.Elem()
+	elementType := sliceValue.Type().Elem()
+	pointerElements := elementType.Kind() == reflect.Pointer
+
+	if pointerElements {
+		elementType = elementType.Elem()
+	}
+
+	if elementType.Kind() != reflect.Struct {
+		return errors.New("unmarshal target must be a pointer to a slice of structs or of pointers to structs")
+	}
+
+	mappings, err := structFieldMappings(elementType)
+
+	if err != nil {
+		return err
+	}
+
+	columns := mappedColumns(table, mappings)
+	result := reflect.MakeSlice(sliceValue.Type(), 0, table.RowCount())
+
+	for i, row := range table.Rows() {
+		if row == nil {
+			continue
+		}
+
+		element := reflect.New(elementType)
+
+		if err := unmarshalRow(row, i, element.Elem(), mappings, columns); err != nil {
+			return err
+		}
+
+		if pointerElements {
+			result = reflect.Append(result, element)
+		} else {
+			result = reflect.Append(result, element.Elem())
+		}
+	}
+
+	sliceValue.Set(result)
+
+	return nil
+}
+
+// MarshalTable creates a new DataTable associated with the DataSet from source, a slice of structs
+// or of pointers to structs. A DataColumn is created for each mapped struct field, see UnmarshalRow,
+// with the column data type inferred from the Go field type and a DataRow is created for each non-nil
+// slice element. Nil pointer field values are stored as null. Use AddTable to add the new table to
+// the DataSet.
+func MarshalTable(dataSet *DataSet, tableName string, source interface{}) (*DataTable, error) {
+	if dataSet == nil {
+		return nil, errors.New("cannot marshal table for nil DataSet")
+	}
+
+	sliceValue := reflect.ValueOf(source)
+
+	if sliceValue.Kind() != reflect.Slice {
+		return nil, errors.New("marshal source must be a slice of structs or of pointers to structs")
+	}
+
+	elementType := sliceValue.Type().Elem()
+	pointerElements := elementType.Kind() == reflect.Pointer
+
+	if pointerElements {
+		elementType = elementType.Elem()
+	}
+
+	if elementType.Kind() != reflect.Struct {
+		return nil, errors.New("marshal source must be a slice of structs or of pointers to structs")
+	}
+
+	mappings, err := structFieldMappings(elementType)
+
+	if err != nil {
+		return nil, err
+	}
+
+	table := dataSet.CreateTable(tableName)
+	table.InitColumns(len(mappings))
+
+	for _, mapping := range mappings {
+		if table.ColumnByName(mapping.columnName) != nil {
+			return nil, errors.New("field \"" + mapping.name + "\" maps to duplicate column name \"" + mapping.columnName + "\"")
+		}
+
+		table.AddColumn(table.CreateColumn(mapping.columnName, mapping.dataType, ""))
+	}
+
+	table.InitRows(sliceValue.Len())
+
+	for i := 0; i < sliceValue.Len(); i++ {
+		element := sliceValue.Index(i)
+
+		if pointerElements {
+			if element.IsNil() {
+				continue
+			}
+
+			element = element.Elem()
+		}
+
+		row := table.CreateRow()
+
+		for columnIndex, mapping := range mappings {
+			field, found := fieldByIndex(element, mapping.index, false)
+
+			if !found {
+				continue
+			}
+
+			if mapping.nullable {
+				if field.IsNil() {
+					continue
+				}
+
+				field = field.Elem()
+			}
+
+			columnType := dataTypeGoTypes[mapping.dataType]
+
+			if err := checkValueRange(field.Interface(), columnType); err != nil {
+				return nil, fmt.Errorf("cannot marshal field \"%s\" of element %d into column \"%s\" of table \"%s\": %s", mapping.name, i, mapping.columnName, tableName, err.Error())
+			}
+
+			row.SetValue(columnIndex, field.Convert(columnType).Interface())
+		}
+
+		table.AddRow(row)
+	}
+
+	return table, nil
+}
+
+// structFieldMappings gets the DataColumn mappings for the fields of the struct type.
+func structFieldMappings(structType reflect.Type) ([]fieldMapping, error) {
+	var mappings []fieldMapping
+
+	for _, field := range reflect.VisibleFields(structType) {
+		if !field.IsExported() || field.Anonymous {
+			continue
+		}
+
+		tag, tagged := field.Tag.Lookup("sttp")
+
+		if tag == "-" {
+			continue
+		}
+
+		columnName := field.Name
+
+		if tagged && len(tag) > 0 {
+			columnName = tag
+		}
+
+		fieldType := field.Type
+		nullable := fieldType.Kind() == reflect.Pointer
+
+		if nullable {
+			fieldType = fieldType.Elem()
+		}
+
+		dataType, found := goTypeDataType(fieldType)
+
+		if !found {
+			if tagged {
+				return nil, errors.New("field \"" + field.Name + "\" has unsupported type \"" + field.Type.String() + "\"")
+			}
+
+			continue
+		}
+
+		mappings = append(mappings, fieldMapping{
+			name:       field.Name,
+			index:      field.Index,
+			columnName: columnName,
+			goType:     fieldType,
+			dataType:   dataType,
+			nullable:   nullable,
+		})
+	}
+
+	return mappings, nil
+}
+
+// goTypeDataType gets the DataType that corresponds to the Go type.
+//
+//gocyclo:ignore
+func goTypeDataType(goType reflect.Type) (DataTypeEnum, bool) {
+	switch goType {
+	case timeType:
+		return DataType.DateTime, true
+	case guidType:
+		return DataType.Guid, true
+	case decimalType:
+		return DataType.Decimal, true
+	}
+
+	switch goType.Kind() {
+	case reflect.String:
+		return DataType.String, true
+	case reflect.Bool:
+		return DataType.Boolean, true
+	case reflect.Float32:
+		return DataType.Single, true
+	case reflect.Float64:
+		return DataType.Double, true
+	case reflect.Int8:
+		return DataType.Int8, true
+	case reflect.Int16:
+		return DataType.Int16, true
+	case reflect.Int32:
+		return DataType.Int32, true
+	case reflect.Int64, reflect.Int:
+		return DataType.Int64, true
+	case reflect.Uint8:
+		return DataType.UInt8, true
+	case reflect.Uint16:
+		return DataType.UInt16, true
+	case reflect.Uint32:
+		return DataType.UInt32, true
+	case reflect.Uint64, reflect.Uint:
+		return DataType.UInt64, true
+	default:
+		return 0, false
+	}
+}
+
+// mappedColumns gets the DataColumn for each field mapping, or nil if the table has no matching column.
+func mappedColumns(table *DataTable, mappings []fieldMapping) []*DataColumn {
+	columns := make([]*DataColumn, len(mappings))
+
+	for i, mapping := range mappings {
+		columns[i] = table.ColumnByName(mapping.columnName)
+	}
+
+	return columns
+}
+
+// fieldByIndex gets the nested struct field for the index sequence. When allocate is true, nil
+// embedded struct pointers are allocated when settable; otherwise, found is false when one is
+// encountered.
+func fieldByIndex(value reflect.Value, index []int, allocate bool) (field reflect.Value, found bool) {
+	for i, fieldIndex := range index {
+		if i > 0 && value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				if !allocate || !value.CanSet() {
+					return reflect.Value{}, false
+				}
+
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+
+			value = value.Elem()
+		}
+
+		value = value.Field(fieldIndex)
+	}
+
+	return value, true
+}
+
+func unmarshalRow(row *DataRow, rowIndex int, structValue reflect.Value, mappings []fieldMapping, columns []*DataColumn) error {
+	for i, mapping := range mappings {
+		column := columns[i]
+
+		if column == nil {
+			continue
+		}
+
+		value, err := row.Value(column.Index())
+
+		if err == nil && value != nil {
+			if err = checkValueRange(value, mapping.goType); err == nil {
+				value, err = convertValue(value, mapping.dataType)
+			}
+		}
+
+		if err != nil {
+			return &UnmarshalError{
+				Table:  row.Parent().Name(),
+				Row:    rowIndex,
+				Column: column.Name(),
+				Field:  mapping.name,
+				Err:    err,
+			}
+		}
+
+		field, found := fieldByIndex(structValue, mapping.index, true)
+
+		if !found {
+			continue
+		}
+
+		if value == nil {
+			field.SetZero()
+			continue
+		}
+
+		fieldType := field.Type()
+
+		if mapping.nullable {
+			pointer := reflect.New(fieldType.Elem())
+			pointer.Elem().Set(reflect.ValueOf(value).Convert(fieldType.Elem()))
+			field.Set(pointer)
+		} else {
+			field.Set(reflect.ValueOf(value).Convert(fieldType))
+		}
+	}
+
+	return nil
+}
+
+// checkValueRange verifies that a numeric value is within the range of the numeric targetType so
+// that a conversion to the type does not silently truncate or wrap. Fractional parts are ignored
+// since conversions to integer types truncate toward zero.
+//
+//gocyclo:ignore
+func checkValueRange(value interface{}, targetType reflect.Type) error {
+	source := reflect.ValueOf(value)
+	target := reflect.New(targetType).Elem()
+	var overflow bool
+
+	if decimalValue, ok := value.(decimal.Decimal); ok {
+		switch targetType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			integer := decimalValue.Truncate(0).BigInt()
+			overflow = !integer.IsInt64() || target.OverflowInt(integer.Int64())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			integer := decimalValue.Truncate(0).BigInt()
+			overflow = !integer.IsUint64() || target.OverflowUint(integer.Uint64())
+		case reflect.Float32:
+			f64, _ := decimalValue.Float64()
+			overflow = target.OverflowFloat(f64)
+		}
+	} else {
+		switch targetType.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			switch source.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				overflow = target.OverflowInt(source.Int())
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				overflow = source.Uint() > math.MaxInt64 || target.OverflowInt(int64(source.Uint()))
+			case reflect.Float32, reflect.Float64:
+				f64 := math.Trunc(source.Float())
+				overflow = math.IsNaN(f64) || f64 < math.MinInt64 || f64 >= math.MaxInt64 || target.OverflowInt(int64(f64))
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			switch source.Kind() {
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				overflow = source.Int() < 0 || target.OverflowUint(uint64(source.Int()))
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+				overflow = target.OverflowUint(source.Uint())
+			case reflect.Float32, reflect.Float64:
+				f64 := math.Trunc(source.Float())
+				overflow = math.IsNaN(f64) || f64 < 0 || f64 >= math.MaxUint64 || target.OverflowUint(uint64(f64))
+			}
+		case reflect.Float32:
+			switch source.Kind() {
+			case reflect.Float32, reflect.Float64:
+				overflow = target.OverflowFloat(source.Float())
+			}
+		}
+	}
+
+	if overflow {
+		return fmt.Errorf("value %v is out of range for type \"%s\"", value, targetType.String())
+	}
+
+	return nil
+}
+
+// convertValue converts a DataRow value to the Go type of the targetType using
+// the conversions applied to computed column values.
+//
+//gocyclo:ignore
+func convertValue(value interface{}, targetType DataTypeEnum) (interface{}, error) {
+	switch value := value.(type) {
+	case string:
+		return convertFromString(value, targetType)
+	case bool:
+		return convertFromBoolean(value, targetType)
+	case time.Time:
+		return convertFromDateTime(value, targetType)
+	case float32:
+		return convertFromDouble(float64(value), targetType)
+	case float64:
+		return convertFromDouble(value, targetType)
+	case decimal.Decimal:
+		return convertFromDecimal(value, targetType)
+	case guid.Guid:
+		return convertFromGuid(value, targetType)
+	case int8:
+		return convertFromInt32(int32(value), targetType)
+	case int16:
+		return convertFromInt32(int32(value), targetType)
+	case int32:
+		return convertFromInt32(value, targetType)
+	case int64:
+		return convertFromInt64(value, targetType)
+	case uint8:
+		return convertFromInt32(int32(value), targetType)
+	case uint16:
+		return convertFromInt32(int32(value), targetType)
+	case uint32:
+		return convertFromInt64(int64(value), targetType)
+	case uint64:
+		if targetType == DataType.UInt64 {
+			return value, nil
+		}
+
+		if value > math.MaxInt64 {
+			return convertFromDecimal(decimal.NewFromBigInt(new(big.Int).SetUint64(value), 0), targetType)
+		}
+
+		return convertFromInt64(int64(value), targetType)
+	default:
+		return nil, fmt.Errorf("unexpected value type \"%T\" encountered", value)
+	}
+}
@@ -0,0 +1,365 @@
+//******************************************************************************************************
+//  Marshal_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"errors"
+	"math"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+type measurementDetail struct {
+	DeviceAcronym     *string
+	ID                string
+	SignalID          guid.Guid
+	Tag               string `sttp:"PointTag"`
+	SignalAcronym     string
+	PhasorSourceIndex *int
+	Internal          bool
+	UpdatedOn         time.Time
+	Ignored           string `sttp:"-"`
+	Unmapped          []string
+}
+
+func TestUnmarshalTableSampleMetadata(t *testing.T) {
+	buffer, err := os.ReadFile("../../test/SampleMetadata.xml")
+
+	if err != nil {
+		t.Fatalf("TestUnmarshalTableSampleMetadata: failed to load sample metadata: %s", err.Error())
+	}
+
+	dataSet := FromXml(buffer)
+	measurements := []measurementDetail{{ID: "existing"}}
+
+	if err = UnmarshalTable(dataSet.Table("MeasurementDetail"), &measurements); err != nil {
+		t.Fatalf("TestUnmarshalTableSampleMetadata: failed to unmarshal table: %s", err.Error())
+	}
+
+	if len(measurements) != 130 {
+		t.Fatalf("TestUnmarshalTableSampleMetadata: expected 130 measurements, received: %d", len(measurements))
+	}
+
+	var freq, stat *measurementDetail
+
+	for i := range measurements {
+		switch measurements[i].SignalAcronym {
+		case "FREQ":
+			freq = &measurements[i]
+		case "STAT":
+			if stat == nil && measurements[i].DeviceAcronym == nil {
+				stat = &measurements[i]
+			}
+		}
+	}
+
+	freqID, _ := guid.Parse("93673c68-d59d-4926-b7e9-e7678f9f66b4")
+
+	if freq == nil || freq.SignalID != freqID || freq.DeviceAcronym == nil || *freq.DeviceAcronym != "SHELBY" {
+		t.Fatal("TestUnmarshalTableSampleMetadata: unexpected FREQ measurement")
+	}
+
+	if freq.Tag == "" || !freq.Internal || freq.UpdatedOn.IsZero() || freq.PhasorSourceIndex != nil {
+		t.Fatalf("TestUnmarshalTableSampleMetadata: unexpected FREQ measurement fields: %+v", *freq)
+	}
+
+	if stat == nil || stat.ID == "" {
+		t.Fatal("TestUnmarshalTableSampleMetadata: expected STAT measurement with null device acronym")
+	}
+
+	var phasors []*struct {
+		Label       string
+		SourceIndex uint8
+		Type        string
+	}
+
+	if err = UnmarshalTable(dataSet.Table("PhasorDetail"), &phasors); err != nil {
+		t.Fatalf("TestUnmarshalTableSampleMetadata: failed to unmarshal phasors: %s", err.Error())
+	}
+
+	if len(phasors) != 5 || phasors[1].Label != "500 kV Bus 2" || phasors[1].SourceIndex != 2 || phasors[4].Type != "I" {
+		t.Fatal("TestUnmarshalTableSampleMetadata: unexpected phasors")
+	}
+}
+
+type marshalBase struct {
+	Name string
+	ID   guid.Guid
+}
+
+// Annotations is exported since nil embedded pointers to unexported
+// struct types cannot be allocated during unmarshal
+type Annotations struct {
+	Note *string `sttp:"Notes"`
+}
+
+type marshalRecord struct {
+	marshalBase
+	*Annotations
+	Enabled  bool
+	Created  time.Time `sttp:"CreatedOn"`
+	Ratio    float32
+	Value    float64
+	Amount   decimal.Decimal
+	Small    int8
+	Medium   int16
+	Large    int32
+	Huge     int64
+	Count    int
+	Flags    uint8
+	Port     uint16
+	Mask     uint32
+	Total    uint64
+	Index    uint
+	Level    *int32
+	internal string
+}
+
+func TestMarshalTableRoundTrip(t *testing.T) {
+	level := int32(-7)
+	note := "note"
+	created, _ := time.Parse(time.RFC3339Nano, "2026-10-16T08:15:30.123456789Z")
+
+	records := []*marshalRecord{
+		{
+			marshalBase: marshalBase{Name: "first", ID: guid.New()}, Annotations: &Annotations{Note: &note},
+			Enabled: true, Created: created, Ratio: 1.5, Value: math.Pi, Amount: decimal.RequireFromString("12.345"),
+			Small: math.MinInt8, Medium: math.MinInt16, Large: math.MinInt32, Huge: math.MinInt64, Count: 42,
+			Flags: math.MaxUint8, Port: math.MaxUint16, Mask: math.MaxUint32, Total: math.MaxUint64, Index: 7, Level: &level,
+		},
+		nil,
+		{marshalBase: marshalBase{Name: "second"}, Annotations: &Annotations{}, Amount: decimal.Zero},
+	}
+
+	dataSet := NewDataSet()
+	table, err := MarshalTable(dataSet, "Records", records)
+
+	if err != nil {
+		t.Fatalf("TestMarshalTableRoundTrip: failed to marshal table: %s", err.Error())
+	}
+
+	expectedColumns := []struct {
+		name     string
+		dataType DataTypeEnum
+	}{
+		{"Name", DataType.String}, {"ID", DataType.Guid}, {"Notes", DataType.String}, {"Enabled", DataType.Boolean},
+		{"CreatedOn", DataType.DateTime}, {"Ratio", DataType.Single}, {"Value", DataType.Double}, {"Amount", DataType.Decimal},
+		{"Small", DataType.Int8}, {"Medium", DataType.Int16}, {"Large", DataType.Int32}, {"Huge", DataType.Int64},
+		{"Count", DataType.Int64}, {"Flags", DataType.UInt8}, {"Port", DataType.UInt16}, {"Mask", DataType.UInt32},
+		{"Total", DataType.UInt64}, {"Index", DataType.UInt64}, {"Level", DataType.Int32},
+	}
+
+	if table.ColumnCount() != len(expectedColumns) || table.RowCount() != 2 || dataSet.TableCount() != 0 {
+		t.Fatalf("TestMarshalTableRoundTrip: unexpected table: %d columns, %d rows", table.ColumnCount(), table.RowCount())
+	}
+
+	for i, expected := range expectedColumns {
+		column := table.Column(i)
+
+		if column.Name() != expected.name || column.Type() != expected.dataType {
+			t.Fatalf("TestMarshalTableRoundTrip: unexpected column %d: %s %s", i, column.Name(), column.Type().String())
+		}
+	}
+
+	if value, _ := table.Row(1).ValueByName("Level"); value != nil {
+		t.Fatalf("TestMarshalTableRoundTrip: expected null Level value, received: %v", value)
+	}
+
+	var unmarshaled []*marshalRecord
+
+	if err = UnmarshalTable(table, &unmarshaled); err != nil {
+		t.Fatalf("TestMarshalTableRoundTrip: failed to unmarshal table: %s", err.Error())
+	}
+
+	expected := []*marshalRecord{records[0], records[2]}
+
+	if !reflect.DeepEqual(expected, unmarshaled) {
+		t.Fatalf("TestMarshalTableRoundTrip: unmarshaled records do not match:\n%+v\n%+v", *expected[0], *unmarshaled[0])
+	}
+
+	var record marshalRecord
+
+	if err = UnmarshalRow(table.Row(0), &record); err != nil || !reflect.DeepEqual(record, *records[0]) {
+		t.Fatal("TestMarshalTableRoundTrip: unmarshaled row does not match")
+	}
+}
+
+func TestUnmarshalRowConversion(t *testing.T) {
+	dataSet := NewDataSet()
+	table := dataSet.CreateTable("Values")
+	createDataColumn(table, "Text", DataType.String)
+	createDataColumn(table, "Number", DataType.Int32)
+	createDataColumn(table, "Key", DataType.Guid)
+	table.AddColumn(table.CreateColumn("Doubled", DataType.Int64, "Number * 2"))
+	dataSet.AddTable(table)
+
+	for _, values := range [][]interface{}{{"42", int32(7), guid.New()}, {"abc", nil, nil}} {
+		row := table.CreateRow()
+
+		for i, value := range values {
+			row.SetValue(i, value)
+		}
+
+		table.AddRow(row)
+	}
+
+	var converted struct {
+		Text    float64
+		Number  string
+		Doubled *uint16
+	}
+
+	if err := UnmarshalRow(table.Row(0), &converted); err != nil {
+		t.Fatalf("TestUnmarshalRowConversion: failed to unmarshal row: %s", err.Error())
+	}
+
+	if converted.Text != 42 || converted.Number != "7" || converted.Doubled == nil || *converted.Doubled != 14 {
+		t.Fatalf("TestUnmarshalRowConversion: unexpected converted values: %+v", converted)
+	}
+
+	converted.Number = "stale"
+
+	var unmarshalErr *UnmarshalError
+	err := UnmarshalRow(table.Row(1), &converted)
+
+	if !errors.As(err, &unmarshalErr) || unmarshalErr.Row != -1 || unmarshalErr.Column != "Text" || unmarshalErr.Field != "Text" || unmarshalErr.Table != "Values" {
+		t.Fatalf("TestUnmarshalRowConversion: expected UnmarshalError for Text column, received: %v", err)
+	}
+
+	var keys []struct{ Key int }
+	err = UnmarshalTable(table, &keys)
+
+	if !errors.As(err, &unmarshalErr) || unmarshalErr.Row != 0 || unmarshalErr.Column != "Key" || unmarshalErr.Unwrap() == nil {
+		t.Fatalf("TestUnmarshalRowConversion: expected UnmarshalError for Key column, received: %v", err)
+	}
+
+	var nullable struct {
+		Number int32
+		Key    *guid.Guid
+	}
+
+	nullable.Number = 99
+	nullable.Key = &guid.Empty
+
+	if err = UnmarshalRow(table.Row(1), &nullable); err != nil || nullable.Number != 0 || nullable.Key != nil {
+		t.Fatalf("TestUnmarshalRowConversion: expected zero values for null columns: %+v", nullable)
+	}
+}
+
+func TestMarshalInvalidArguments(t *testing.T) {
+	dataSet, _, _, _, _ := createDataSet()
+	table := dataSet.Tables()[0]
+	var record marshalRecord
+	var records []marshalRecord
+
+	if UnmarshalRow(table.Row(0), record) == nil || UnmarshalRow(table.Row(0), &records) == nil || UnmarshalRow(nil, &record) == nil {
+		t.Fatal("TestMarshalInvalidArguments: expected UnmarshalRow errors")
+	}
+
+	if UnmarshalTable(table, records) == nil || UnmarshalTable(table, &[]int{}) == nil || UnmarshalTable(nil, &records) == nil {
+		t.Fatal("TestMarshalInvalidArguments: expected UnmarshalTable errors")
+	}
+
+	if _, err := MarshalTable(dataSet, "Invalid", record); err == nil {
+		t.Fatal("TestMarshalInvalidArguments: expected MarshalTable error for non-slice source")
+	}
+
+	if _, err := MarshalTable(dataSet, "Invalid", []struct {
+		Channel chan int `sttp:"Channel"`
+	}{}); err == nil {
+		t.Fatal("TestMarshalInvalidArguments: expected MarshalTable error for unsupported tagged field")
+	}
+
+	if _, err := MarshalTable(dataSet, "Invalid", []struct {
+		Name  string
+		Label string `sttp:"name"`
+	}{}); err == nil {
+		t.Fatal("TestMarshalInvalidArguments: expected MarshalTable error for duplicate column name")
+	}
+}
+
+func TestUnmarshalRowOutOfRange(t *testing.T) {
+	dataSet := NewDataSet()
+	table := dataSet.CreateTable("Values")
+	createDataColumn(table, "Number", DataType.Int32)
+	createDataColumn(table, "Signed", DataType.Int64)
+	createDataColumn(table, "Ratio", DataType.Double)
+	createDataColumn(table, "Amount", DataType.Decimal)
+	createDataColumn(table, "Large", DataType.UInt64)
+	dataSet.AddTable(table)
+
+	row := table.CreateRow()
+	row.SetValue(0, int32(300))
+	row.SetValue(1, int64(-1))
+	row.SetValue(2, 1e40)
+	row.SetValue(3, decimal.NewFromInt(-5))
+	row.SetValue(4, uint64(math.MaxUint64))
+	table.AddRow(row)
+
+	var unmarshalErr *UnmarshalError
+
+	checkOutOfRange := func(target interface{}, column string) {
+		t.Helper()
+		err := UnmarshalRow(table.Row(0), target)
+
+		if !errors.As(err, &unmarshalErr) || unmarshalErr.Column != column || unmarshalErr.Table != "Values" || unmarshalErr.Row != -1 {
+			t.Fatalf("TestUnmarshalRowOutOfRange: expected UnmarshalError for %s column, received: %v", column, err)
+		}
+	}
+
+	checkOutOfRange(&struct{ Number int8 }{}, "Number")
+	checkOutOfRange(&struct{ Number *uint8 }{}, "Number")
+	checkOutOfRange(&struct{ Signed uint64 }{}, "Signed")
+	checkOutOfRange(&struct{ Ratio int64 }{}, "Ratio")
+	checkOutOfRange(&struct{ Ratio float32 }{}, "Ratio")
+	checkOutOfRange(&struct{ Amount uint }{}, "Amount")
+	checkOutOfRange(&struct{ Large int64 }{}, "Large")
+
+	var inRange struct {
+		Number int16
+		Signed int8
+		Ratio  float64
+		Amount int8
+		Large  uint64
+	}
+
+	if err := UnmarshalRow(table.Row(0), &inRange); err != nil {
+		t.Fatalf("TestUnmarshalRowOutOfRange: failed to unmarshal in range values: %s", err.Error())
+	}
+
+	if inRange.Number != 300 || inRange.Signed != -1 || inRange.Amount != -5 || inRange.Large != math.MaxUint64 {
+		t.Fatalf("TestUnmarshalRowOutOfRange: unexpected values: %+v", inRange)
+	}
+
+	var keys []struct{ Number uint8 }
+
+	if err := UnmarshalTable(table, &keys); !errors.As(err, &unmarshalErr) || unmarshalErr.Row != 0 {
+		t.Fatalf("TestUnmarshalRowOutOfRange: expected UnmarshalError for table row, received: %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+//******************************************************************************************************
+//  UnmarshalError.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package data
+
+import (
+	"fmt"
+)
+
+// UnmarshalError describes a failure to convert a DataRow column value to the Go type
+// of the struct field the column is mapped to.
+type UnmarshalError struct {
+	// Table is the name of the DataTable that contains the value.
+	Table string
+	// Row is the index of the DataRow in the table, or -1 if the index is unknown.
+	Row int
+	// Column is the name of the DataColumn that contains the value.
+	Column string
+	// Field is the name of the struct field the column is mapped to.
+	Field string
+	// Err is the conversion error.
+	Err error
+}
+
+// Error gets the error message of the UnmarshalError.
+func (e *UnmarshalError) Error() string {
+	if e.Row < 0 {
+		return fmt.Sprintf("cannot unmarshal column \"%s\" of table \"%s\" into field \"%s\": %s", e.Column, e.Table, e.Field, e.Err.Error())
+	}
+
+	return fmt.Sprintf("cannot unmarshal column \"%s\" of table \"%s\", row %d, into field \"%s\": %s", e.Column, e.Table, e.Row, e.Field, e.Err.Error())
+}
+
+// Unwrap gets the conversion error of the UnmarshalError.
+func (e *UnmarshalError) Unwrap() error {
+	return e.Err
+}
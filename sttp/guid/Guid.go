@@ -42,6 +42,12 @@ func New() Guid {
 	return Guid(uuid.New())
 }
 
+// FromName creates a deterministic, name-based Guid value, i.e., an RFC 4122 version 5 UUID,
+// from the specified namespace and name. The same namespace and name always yield the same Guid.
+func FromName(namespace Guid, name string) Guid {
+	return Guid(uuid.NewSHA1(uuid.UUID(namespace), []byte(name)))
+}
+
 // IsZero determines if the Guid value is its zero value, i.e., empty.
 func (g Guid) IsZero() bool {
 	return g == Empty
@@ -166,6 +166,27 @@ func TestZeroGuid(t *testing.T) {
 	}
 }
 
+func TestGuidFromName(t *testing.T) {
+	namespace, _ := Parse(gs3)
+
+	g1 := FromName(namespace, "SHELBY-VPH1-POS-MAG")
+	g2 := FromName(namespace, "SHELBY-VPH1-POS-MAG")
+	g3 := FromName(namespace, "SHELBY-VPH1-POS-ANG")
+
+	if g1 != g2 {
+		t.Fatalf("TestGuidFromName: expected same Guid for same namespace and name")
+	}
+
+	if g1 == g3 || FromName(Empty, "SHELBY-VPH1-POS-MAG") == g1 {
+		t.Fatalf("TestGuidFromName: expected different Guid for different namespace or name")
+	}
+
+	// Version 5 UUID
+	if g1[6]>>4 != 5 {
+		t.Fatalf("TestGuidFromName: expected version 5 Guid, received version %d", g1[6]>>4)
+	}
+}
+
 // gocyclo: ignore
 func TestGuidCompare(t *testing.T) {
 	var g1, g2, g3, g4, g5, g6 Guid
@@ -0,0 +1,116 @@
+//******************************************************************************************************
+//  Device.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// Device defines the metadata of a device, e.g., a PMU or a phasor data concentrator,
+// as defined in the DeviceDetail metadata table.
+type Device struct {
+	// NodeID is the identifier of the node that owns the device.
+	NodeID guid.Guid
+
+	// UniqueID is the globally unique identifier of the device.
+	UniqueID guid.Guid
+
+	// OriginalSource is the original acronym of a device that has been re-published.
+	OriginalSource string
+
+	// IsConcentrator determines if the device is a phasor data concentrator.
+	IsConcentrator bool
+
+	// Acronym is the unique acronym of the device.
+	Acronym string
+
+	// Name is the free-form name of the device.
+	Name string
+
+	// AccessID is the identification number used by the source protocol to access the device, e.g., an IEEE C37.118 ID code.
+	AccessID int
+
+	// ParentAcronym is the acronym of the concentrator device this device is a member of, if any.
+	ParentAcronym string
+
+	// ProtocolName is the name of the protocol used to communicate with the device.
+	ProtocolName string
+
+	// FramesPerSecond is the data rate of the device.
+	FramesPerSecond int
+
+	// CompanyAcronym is the acronym of the company that owns the device.
+	CompanyAcronym string
+
+	// VendorAcronym is the acronym of the device vendor.
+	VendorAcronym string
+
+	// VendorDeviceName is the name of the vendor's device model.
+	VendorDeviceName string
+
+	// Longitude is the geographic longitude of the device.
+	Longitude float64
+
+	// Latitude is the geographic latitude of the device.
+	Latitude float64
+
+	// InterconnectionName is the name of the power system interconnection where the device is located.
+	InterconnectionName string
+
+	// ContactList is the contact information for the device.
+	ContactList string
+
+	// Enabled determines if the device is enabled.
+	Enabled bool
+
+	// UpdatedOn is the timestamp of when the device metadata was last updated.
+	UpdatedOn time.Time
+
+	// Parent is the concentrator device this device is a member of, or nil if there is none.
+	Parent *Device
+
+	// Children are the devices that are members of this device when it is a concentrator.
+	Children []*Device
+
+	// Measurements are the measurements associated with the device.
+	Measurements []*Measurement
+
+	// Phasors are the phasors defined for the device, in source index order.
+	Phasors []*Phasor
+}
+
+// Measurement gets the device measurement with the specified signal acronym, e.g., FREQ,
+// or nil if the device has no such measurement. When the device has multiple measurements
+// with the signal acronym, the first one is returned.
+func (d *Device) Measurement(signalAcronym string) *Measurement {
+	for _, measurement := range d.Measurements {
+		if measurement.SignalAcronym == signalAcronym {
+			return measurement
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+//******************************************************************************************************
+//  Measurement.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// Measurement defines the metadata of a measurement as defined in the MeasurementDetail metadata table.
+type Measurement struct {
+	// SignalID is the globally unique identifier of the measurement.
+	SignalID guid.Guid
+
+	// Source is the source used in the human-readable measurement key, e.g., PPA.
+	Source string
+
+	// ID is the identification number used in the human-readable measurement key.
+	ID uint64
+
+	// DeviceAcronym is the acronym of the device associated with the measurement, if any.
+	DeviceAcronym string
+
+	// PointTag is the human-readable tag name of the measurement.
+	PointTag string
+
+	// SignalReference is the reference to the signal based on the source protocol, e.g., SHELBY-PA1.
+	SignalReference string
+
+	// SignalAcronym is the signal type acronym of the measurement, e.g., FREQ or VPHM.
+	SignalAcronym string
+
+	// PhasorSourceIndex is the source index of the phasor associated with the measurement, or
+	// zero when the measurement is not a phasor component.
+	PhasorSourceIndex int
+
+	// Description is the general description of the measurement.
+	Description string
+
+	// Internal determines if the measurement is defined locally by the publisher.
+	Internal bool
+
+	// Enabled determines if the measurement is enabled.
+	Enabled bool
+
+	// UpdatedOn is the timestamp of when the measurement metadata was last updated.
+	UpdatedOn time.Time
+
+	// Device is the device associated with the measurement, or nil if there is none.
+	Device *Device
+
+	// Phasor is the phasor for which the measurement is the magnitude or angle, or nil if there is none.
+	Phasor *Phasor
+}
+
+// Key gets the human-readable measurement key, e.g., PPA:12.
+func (m *Measurement) Key() string {
+	return m.Source + ":" + strconv.FormatUint(m.ID, 10)
+}
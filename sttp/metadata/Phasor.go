@@ -0,0 +1,74 @@
+//******************************************************************************************************
+//  Phasor.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"time"
+)
+
+// Phasor defines the metadata of a phasor as defined in the PhasorDetail metadata table.
+type Phasor struct {
+	// ID is the identification number of the phasor.
+	ID int
+
+	// DeviceAcronym is the acronym of the device that defines the phasor.
+	DeviceAcronym string
+
+	// Label is the free-form label of the phasor.
+	Label string
+
+	// Type is the phasor type, V for voltage or I for current.
+	Type string
+
+	// Phase is the phase of the phasor, e.g., + for positive sequence or A for phase A.
+	Phase string
+
+	// DestinationPhasorID is the ID of the voltage phasor a current phasor flows toward, if any.
+	DestinationPhasorID int
+
+	// SourceIndex is the one-based index of the phasor within its device.
+	SourceIndex int
+
+	// UpdatedOn is the timestamp of when the phasor metadata was last updated.
+	UpdatedOn time.Time
+
+	// Device is the device that defines the phasor, or nil if the device is not defined.
+	Device *Device
+
+	// Magnitude is the measurement for the phasor magnitude, or nil if it is not defined.
+	Magnitude *Measurement
+
+	// Angle is the measurement for the phasor angle, or nil if it is not defined.
+	Angle *Measurement
+}
+
+// IsVoltage determines if the phasor is a voltage phasor.
+func (p *Phasor) IsVoltage() bool {
+	return p.Type == "V"
+}
+
+// IsCurrent determines if the phasor is a current phasor.
+func (p *Phasor) IsCurrent() bool {
+	return p.Type == "I"
+}
@@ -0,0 +1,421 @@
+//******************************************************************************************************
+//  Snapshot.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+// Snapshot represents an immutable, strongly typed view of the DeviceDetail, MeasurementDetail,
+// PhasorDetail and SchemaVersion tables of a received metadata DataSet. Devices, measurements and
+// phasors are cross-linked, e.g., a measurement links to its device and a phasor links to its
+// magnitude and angle measurements. A Snapshot, and the entities it references, are shared and
+// must not be modified.
+// Note that this implementation uses case-insensitive maps for acronym and point tag lookups.
+// Internally, case-insensitive lookups are accomplished using `strings.ToUpper`.
+type Snapshot struct {
+	devices      []*Device
+	measurements []*Measurement
+	phasors      []*Phasor
+
+	deviceAcronyms       map[string]*Device
+	measurementSignalIDs map[guid.Guid]*Measurement
+	measurementPointTags map[string]*Measurement
+	measurementKeys      map[string]*Measurement
+	phasorIDs            map[int]*Phasor
+
+	schemaVersion int
+}
+
+// NewSnapshot creates a new Snapshot from the metadata tables in the specified dataSet.
+// Missing tables and columns are tolerated and yield empty collections and zero values,
+// measurement records without a valid SignalID are skipped.
+func NewSnapshot(dataSet *data.DataSet) *Snapshot {
+	snapshot := &Snapshot{
+		deviceAcronyms:       make(map[string]*Device),
+		measurementSignalIDs: make(map[guid.Guid]*Measurement),
+		measurementPointTags: make(map[string]*Measurement),
+		measurementKeys:      make(map[string]*Measurement),
+		phasorIDs:            make(map[int]*Phasor),
+	}
+
+	if dataSet != nil {
+		snapshot.loadDevices(dataSet.Table("DeviceDetail"))
+		snapshot.loadMeasurements(dataSet.Table("MeasurementDetail"))
+		snapshot.loadPhasors(dataSet.Table("PhasorDetail"))
+		snapshot.loadSchemaVersion(dataSet.Table("SchemaVersion"))
+	}
+
+	return snapshot
+}
+
+func (s *Snapshot) loadDevices(table *data.DataTable) {
+	if table == nil {
+		return
+	}
+
+	for _, row := range table.Rows() {
+		if row == nil {
+			continue
+		}
+
+		device := &Device{
+			NodeID:              guidValue(row, "NodeID"),
+			UniqueID:            guidValue(row, "UniqueID"),
+			OriginalSource:      stringValue(row, "OriginalSource"),
+			IsConcentrator:      boolValue(row, "IsConcentrator"),
+			Acronym:             stringValue(row, "Acronym"),
+			Name:                stringValue(row, "Name"),
+			AccessID:            intValue(row, "AccessID"),
+			ParentAcronym:       stringValue(row, "ParentAcronym"),
+			ProtocolName:        stringValue(row, "ProtocolName"),
+			FramesPerSecond:     intValue(row, "FramesPerSecond"),
+			CompanyAcronym:      stringValue(row, "CompanyAcronym"),
+			VendorAcronym:       stringValue(row, "VendorAcronym"),
+			VendorDeviceName:    stringValue(row, "VendorDeviceName"),
+			Longitude:           floatValue(row, "Longitude"),
+			Latitude:            floatValue(row, "Latitude"),
+			InterconnectionName: stringValue(row, "InterconnectionName"),
+			ContactList:         stringValue(row, "ContactList"),
+			Enabled:             boolValue(row, "Enabled"),
+			UpdatedOn:           timeValue(row, "UpdatedOn"),
+		}
+
+		s.devices = append(s.devices, device)
+
+		if len(device.Acronym) > 0 {
+			s.deviceAcronyms[strings.ToUpper(device.Acronym)] = device
+		}
+	}
+
+	// Link devices to their parent concentrator
+	for _, device := range s.devices {
+		if len(device.ParentAcronym) == 0 {
+			continue
+		}
+
+		if parent := s.Device(device.ParentAcronym); parent != nil && parent != device {
+			device.Parent = parent
+			parent.Children = append(parent.Children, device)
+		}
+	}
+}
+
+func (s *Snapshot) loadMeasurements(table *data.DataTable) {
+	if table == nil {
+		return
+	}
+
+	for _, row := range table.Rows() {
+		if row == nil {
+			continue
+		}
+
+		signalID := guidValue(row, "SignalID")
+
+		if signalID.IsZero() {
+			continue
+		}
+
+		measurement := &Measurement{
+			SignalID:          signalID,
+			DeviceAcronym:     stringValue(row, "DeviceAcronym"),
+			PointTag:          stringValue(row, "PointTag"),
+			SignalReference:   stringValue(row, "SignalReference"),
+			SignalAcronym:     stringValue(row, "SignalAcronym"),
+			PhasorSourceIndex: intValue(row, "PhasorSourceIndex"),
+			Description:       stringValue(row, "Description"),
+			Internal:          boolValue(row, "Internal"),
+			Enabled:           boolValue(row, "Enabled"),
+			UpdatedOn:         timeValue(row, "UpdatedOn"),
+		}
+
+		// Measurement key is formatted as "Source:ID", e.g., PPA:12
+		if parts := strings.Split(stringValue(row, "ID"), ":"); len(parts) == 2 {
+			measurement.Source = parts[0]
+			measurement.ID, _ = strconv.ParseUint(parts[1], 10, 64)
+			s.measurementKeys[strings.ToUpper(measurement.Key())] = measurement
+		}
+
+		s.measurements = append(s.measurements, measurement)
+		s.measurementSignalIDs[signalID] = measurement
+
+		if len(measurement.PointTag) > 0 {
+			s.measurementPointTags[strings.ToUpper(measurement.PointTag)] = measurement
+		}
+
+		if device := s.Device(measurement.DeviceAcronym); device != nil {
+			measurement.Device = device
+			device.Measurements = append(device.Measurements, measurement)
+		}
+	}
+}
+
+//gocyclo:ignore
+func (s *Snapshot) loadPhasors(table *data.DataTable) {
+	if table == nil {
+		return
+	}
+
+	for _, row := range table.Rows() {
+		if row == nil {
+			continue
+		}
+
+		phasor := &Phasor{
+			ID:                  intValue(row, "ID"),
+			DeviceAcronym:       stringValue(row, "DeviceAcronym"),
+			Label:               stringValue(row, "Label"),
+			Type:                stringValue(row, "Type"),
+			Phase:               stringValue(row, "Phase"),
+			DestinationPhasorID: intValue(row, "DestinationPhasorID"),
+			SourceIndex:         intValue(row, "SourceIndex"),
+			UpdatedOn:           timeValue(row, "UpdatedOn"),
+		}
+
+		s.phasors = append(s.phasors, phasor)
+		s.phasorIDs[phasor.ID] = phasor
+
+		device := s.Device(phasor.DeviceAcronym)
+
+		if device == nil {
+			continue
+		}
+
+		phasor.Device = device
+		device.Phasors = append(device.Phasors, phasor)
+
+		// Phasor components are the device measurements with a matching phasor source index
+		for _, measurement := range device.Measurements {
+			if measurement.PhasorSourceIndex != phasor.SourceIndex {
+				continue
+			}
+
+			switch {
+			case strings.HasSuffix(measurement.SignalAcronym, "PHM"):
+				phasor.Magnitude = measurement
+			case strings.HasSuffix(measurement.SignalAcronym, "PHA"):
+				phasor.Angle = measurement
+			default:
+				continue
+			}
+
+			measurement.Phasor = phasor
+		}
+	}
+
+	for _, device := range s.devices {
+		phasors := device.Phasors
+
+		sort.SliceStable(phasors, func(i, j int) bool {
+			return phasors[i].SourceIndex < phasors[j].SourceIndex
+		})
+	}
+}
+
+func (s *Snapshot) loadSchemaVersion(table *data.DataTable) {
+	if table == nil || table.RowCount() == 0 || table.Row(0) == nil {
+		return
+	}
+
+	s.schemaVersion = intValue(table.Row(0), "VersionNumber")
+}
+
+// Devices gets the devices defined in the Snapshot in metadata order.
+func (s *Snapshot) Devices() []*Device {
+	return append([]*Device(nil), s.devices...)
+}
+
+// Measurements gets the measurements defined in the Snapshot in metadata order.
+func (s *Snapshot) Measurements() []*Measurement {
+	return append([]*Measurement(nil), s.measurements...)
+}
+
+// Phasors gets the phasors defined in the Snapshot in metadata order.
+func (s *Snapshot) Phasors() []*Phasor {
+	return append([]*Phasor(nil), s.phasors...)
+}
+
+// DeviceCount gets the total number of devices defined in the Snapshot.
+func (s *Snapshot) DeviceCount() int {
+	return len(s.devices)
+}
+
+// MeasurementCount gets the total number of measurements defined in the Snapshot.
+func (s *Snapshot) MeasurementCount() int {
+	return len(s.measurements)
+}
+
+// PhasorCount gets the total number of phasors defined in the Snapshot.
+func (s *Snapshot) PhasorCount() int {
+	return len(s.phasors)
+}
+
+// Device gets the Device with the specified acronym, or nil if it does not exist.
+// Lookup is case-insensitive.
+func (s *Snapshot) Device(acronym string) *Device {
+	return s.deviceAcronyms[strings.ToUpper(acronym)]
+}
+
+// Measurement gets the Measurement with the specified signalID, or nil if it does not exist.
+func (s *Snapshot) Measurement(signalID guid.Guid) *Measurement {
+	return s.measurementSignalIDs[signalID]
+}
+
+// MeasurementByPointTag gets the Measurement with the specified pointTag, or nil if it does
+// not exist. Lookup is case-insensitive.
+func (s *Snapshot) MeasurementByPointTag(pointTag string) *Measurement {
+	return s.measurementPointTags[strings.ToUpper(pointTag)]
+}
+
+// MeasurementByKey gets the Measurement with the specified human-readable measurement key,
+// e.g., PPA:12, or nil if it does not exist. Lookup is case-insensitive.
+func (s *Snapshot) MeasurementByKey(key string) *Measurement {
+	return s.measurementKeys[strings.ToUpper(key)]
+}
+
+// Phasor gets the Phasor with the specified id, or nil if it does not exist.
+func (s *Snapshot) Phasor(id int) *Phasor {
+	return s.phasorIDs[id]
+}
+
+// SchemaVersion gets the metadata schema version number, or zero if it is not defined.
+func (s *Snapshot) SchemaVersion() int {
+	return s.schemaVersion
+}
+
+// Column values are read without regard to the column data type so that metadata from publishers
+// that define columns with different, but compatible, XSD types still loads. Missing columns, null
+// values and values that cannot be converted are returned as zero values.
+
+func stringValue(row *data.DataRow, columnName string) string {
+	value, _ := row.ValueByName(columnName)
+
+	switch value := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	default:
+		return row.ValueAsStringByName(columnName)
+	}
+}
+
+func boolValue(row *data.DataRow, columnName string) bool {
+	value, _ := row.ValueByName(columnName)
+
+	switch value := value.(type) {
+	case bool:
+		return value
+	case string:
+		result, _ := strconv.ParseBool(value)
+		return result
+	default:
+		return intValue(row, columnName) != 0
+	}
+}
+
+//gocyclo:ignore
+func intValue(row *data.DataRow, columnName string) int {
+	value, _ := row.ValueByName(columnName)
+
+	switch value := value.(type) {
+	case int8:
+		return int(value)
+	case int16:
+		return int(value)
+	case int32:
+		return int(value)
+	case int64:
+		return int(value)
+	case uint8:
+		return int(value)
+	case uint16:
+		return int(value)
+	case uint32:
+		return int(value)
+	case uint64:
+		return int(value)
+	case float32:
+		return int(value)
+	case float64:
+		return int(value)
+	case decimal.Decimal:
+		return int(value.IntPart())
+	case string:
+		result, _ := strconv.Atoi(value)
+		return result
+	default:
+		return 0
+	}
+}
+
+func floatValue(row *data.DataRow, columnName string) float64 {
+	value, _ := row.ValueByName(columnName)
+
+	switch value := value.(type) {
+	case float32:
+		return float64(value)
+	case float64:
+		return value
+	case decimal.Decimal:
+		return value.InexactFloat64()
+	case string:
+		result, _ := strconv.ParseFloat(value, 64)
+		return result
+	default:
+		return float64(intValue(row, columnName))
+	}
+}
+
+func guidValue(row *data.DataRow, columnName string) guid.Guid {
+	value, _ := row.ValueByName(columnName)
+
+	switch value := value.(type) {
+	case guid.Guid:
+		return value
+	case string:
+		result, _ := guid.Parse(value)
+		return result
+	default:
+		return guid.Empty
+	}
+}
+
+func timeValue(row *data.DataRow, columnName string) time.Time {
+	value, _ := row.ValueByName(columnName)
+
+	if value, ok := value.(time.Time); ok {
+		return value
+	}
+
+	return time.Time{}
+}
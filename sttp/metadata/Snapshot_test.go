@@ -0,0 +1,211 @@
+//******************************************************************************************************
+//  Snapshot_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metadata
+
+import (
+	"os"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+)
+
+func loadSampleSnapshot(t *testing.T) *Snapshot {
+	buffer, err := os.ReadFile("../../test/SampleMetadata.xml")
+
+	if err != nil {
+		t.Fatalf("Failed to load sample metadata: %s", err.Error())
+	}
+
+	dataSet := data.NewDataSet()
+
+	if err = dataSet.ParseXml(buffer); err != nil {
+		t.Fatalf("Failed to parse sample metadata: %s", err.Error())
+	}
+
+	return NewSnapshot(dataSet)
+}
+
+func TestSnapshotSampleMetadata(t *testing.T) {
+	snapshot := loadSampleSnapshot(t)
+
+	if snapshot.DeviceCount() != 1 || snapshot.MeasurementCount() != 130 || snapshot.PhasorCount() != 5 {
+		t.Fatalf("TestSnapshotSampleMetadata: unexpected counts: %d devices, %d measurements, %d phasors", snapshot.DeviceCount(), snapshot.MeasurementCount(), snapshot.PhasorCount())
+	}
+
+	if snapshot.SchemaVersion() != 9 {
+		t.Fatalf("TestSnapshotSampleMetadata: unexpected schema version: %d", snapshot.SchemaVersion())
+	}
+
+	device := snapshot.Device("shelby")
+
+	if device == nil || device.Acronym != "SHELBY" {
+		t.Fatal("TestSnapshotSampleMetadata: failed to find SHELBY device")
+	}
+
+	if len(device.Measurements) != 49 {
+		t.Fatalf("TestSnapshotSampleMetadata: expected 49 SHELBY measurements, received: %d", len(device.Measurements))
+	}
+
+	freq := device.Measurement("FREQ")
+	signalID, _ := guid.Parse("93673c68-d59d-4926-b7e9-e7678f9f66b4")
+
+	if freq == nil || freq.SignalID != signalID || snapshot.Measurement(signalID) != freq || freq.Device != device {
+		t.Fatal("TestSnapshotSampleMetadata: unexpected FREQ measurement")
+	}
+
+	if snapshot.MeasurementByPointTag(freq.PointTag) != freq || snapshot.MeasurementByKey(freq.Key()) != freq {
+		t.Fatal("TestSnapshotSampleMetadata: FREQ measurement lookup by point tag or key failed")
+	}
+
+	if freq.UpdatedOn.IsZero() || !freq.Enabled {
+		t.Fatal("TestSnapshotSampleMetadata: FREQ measurement fields not loaded")
+	}
+}
+
+func TestSnapshotPhasorLinks(t *testing.T) {
+	snapshot := loadSampleSnapshot(t)
+	device := snapshot.Device("SHELBY")
+
+	if len(device.Phasors) != 5 {
+		t.Fatalf("TestSnapshotPhasorLinks: expected 5 SHELBY phasors, received: %d", len(device.Phasors))
+	}
+
+	for i, phasor := range device.Phasors {
+		if phasor.SourceIndex != i+1 || phasor.Device != device || snapshot.Phasor(phasor.ID) != phasor {
+			t.Fatalf("TestSnapshotPhasorLinks: unexpected phasor at index %d: %s", i, phasor.Label)
+		}
+
+		if phasor.Magnitude == nil || phasor.Angle == nil {
+			t.Fatalf("TestSnapshotPhasorLinks: phasor \"%s\" is missing magnitude or angle measurement", phasor.Label)
+		}
+
+		if phasor.Magnitude.Phasor != phasor || phasor.Angle.Phasor != phasor {
+			t.Fatalf("TestSnapshotPhasorLinks: phasor \"%s\" measurements do not link back to phasor", phasor.Label)
+		}
+	}
+
+	bus1 := snapshot.Phasor(1)
+
+	if bus1.Label != "500 kV Bus 1" || !bus1.IsVoltage() || bus1.Magnitude.SignalReference != "SHELBY-PM1" || bus1.Angle.SignalReference != "SHELBY-PA1" {
+		t.Fatal("TestSnapshotPhasorLinks: unexpected \"500 kV Bus 1\" phasor")
+	}
+
+	ppa12 := snapshot.MeasurementByKey("ppa:12")
+
+	if ppa12 == nil || ppa12.Phasor == nil || ppa12.Phasor.SourceIndex != 4 || !ppa12.Phasor.IsCurrent() || ppa12.Phasor.Angle != ppa12 {
+		t.Fatal("TestSnapshotPhasorLinks: unexpected PPA:12 phasor angle link")
+	}
+
+	if freq := device.Measurement("FREQ"); freq.Phasor != nil {
+		t.Fatal("TestSnapshotPhasorLinks: FREQ measurement should not link to a phasor")
+	}
+}
+
+func TestSnapshotConcentrator(t *testing.T) {
+	dataSet := data.NewDataSet()
+	devices := dataSet.CreateTable("DeviceDetail")
+	devices.AddColumn(devices.CreateColumn("Acronym", data.DataType.String, ""))
+	devices.AddColumn(devices.CreateColumn("ParentAcronym", data.DataType.String, ""))
+	devices.AddColumn(devices.CreateColumn("AccessID", data.DataType.Int64, ""))
+	devices.AddColumn(devices.CreateColumn("Longitude", data.DataType.Double, ""))
+	dataSet.AddTable(devices)
+
+	measurements := dataSet.CreateTable("MeasurementDetail")
+	measurements.AddColumn(measurements.CreateColumn("SignalID", data.DataType.String, ""))
+	measurements.AddColumn(measurements.CreateColumn("DeviceAcronym", data.DataType.String, ""))
+	measurements.AddColumn(measurements.CreateColumn("PhasorSourceIndex", data.DataType.String, ""))
+	dataSet.AddTable(measurements)
+
+	for _, values := range [][]interface{}{
+		{"PDC", nil, int64(1), -89.5},
+		{"PMU1", "pdc", int64(10), nil},
+		{"PMU2", "PDC", int64(20), nil},
+	} {
+		row := devices.CreateRow()
+
+		for i, value := range values {
+			row.SetValue(i, value)
+		}
+
+		devices.AddRow(row)
+	}
+
+	signalID := guid.New()
+
+	for _, values := range [][]interface{}{
+		{signalID.String(), "PMU2", "3"},
+		{"not a guid", "PMU1", nil},
+	} {
+		row := measurements.CreateRow()
+
+		for i, value := range values {
+			row.SetValue(i, value)
+		}
+
+		measurements.AddRow(row)
+	}
+
+	snapshot := NewSnapshot(dataSet)
+	pdc := snapshot.Device("PDC")
+
+	if pdc == nil || pdc.Parent != nil || len(pdc.Children) != 2 || pdc.AccessID != 1 || pdc.Longitude != -89.5 {
+		t.Fatal("TestSnapshotConcentrator: unexpected concentrator device")
+	}
+
+	pmu2 := snapshot.Device("PMU2")
+
+	if pmu2.Parent != pdc || pdc.Children[1] != pmu2 || pmu2.AccessID != 20 {
+		t.Fatal("TestSnapshotConcentrator: unexpected member device")
+	}
+
+	if snapshot.MeasurementCount() != 1 {
+		t.Fatalf("TestSnapshotConcentrator: expected 1 measurement, received: %d", snapshot.MeasurementCount())
+	}
+
+	measurement := snapshot.Measurement(signalID)
+
+	if measurement == nil || measurement.Device != pmu2 || measurement.PhasorSourceIndex != 3 || measurement.Key() != ":0" {
+		t.Fatal("TestSnapshotConcentrator: unexpected measurement")
+	}
+
+	if snapshot.SchemaVersion() != 0 || snapshot.PhasorCount() != 0 {
+		t.Fatal("TestSnapshotConcentrator: expected missing tables to yield zero values")
+	}
+
+	// Returned collections are copies that do not modify the snapshot
+	snapshot.Devices()[0] = nil
+
+	if snapshot.Devices()[0] != pdc {
+		t.Fatal("TestSnapshotConcentrator: modifying returned devices changed snapshot")
+	}
+}
+
+func TestSnapshotEmpty(t *testing.T) {
+	snapshot := NewSnapshot(nil)
+
+	if snapshot.DeviceCount() != 0 || snapshot.Device("PDC") != nil || snapshot.Measurement(guid.New()) != nil || len(snapshot.Phasors()) != 0 {
+		t.Fatal("TestSnapshotEmpty: expected empty snapshot")
+	}
+}
@@ -0,0 +1,325 @@
+//******************************************************************************************************
+//  Exporter.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metrics
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// ContentType defines the HTTP content type of the OpenMetrics text exposition format.
+const ContentType = "application/openmetrics-text; version=1.0.0; charset=utf-8"
+
+// minRateInterval defines the minimum time between samples used to calculate measurement rates,
+// scrapes that occur more frequently report the previously calculated rate.
+const minRateInterval = time.Second
+
+// Source defines a provider of subscriber statistics, e.g., a sttp.Subscriber or transport.DataSubscriber.
+type Source interface {
+	Statistics() transport.SubscriberStatistics
+}
+
+// Exporter represents an OpenMetrics exporter for the statistics of one or more STTP subscribers.
+// Exporter implements http.Handler so it can be served directly, e.g.:
+//
+//	http.Handle("/metrics", exporter)
+//
+// Each registered source is identified by a "subscriber" label with the registered name.
+type Exporter struct {
+	sources map[string]*source
+	mutex   sync.Mutex
+	now     func() time.Time
+}
+
+type source struct {
+	provider  Source
+	lastCount uint64
+	lastTime  time.Time
+	rate      float64
+}
+
+type snapshot struct {
+	name       string
+	statistics transport.SubscriberStatistics
+	rate       float64
+}
+
+// NewExporter creates a new Exporter.
+func NewExporter() *Exporter {
+	return &Exporter{
+		sources: make(map[string]*source),
+		now:     time.Now,
+	}
+}
+
+// Register adds a statistics source to the Exporter with the specified name.
+func (e *Exporter) Register(name string, provider Source) error {
+	if len(name) == 0 {
+		return errors.New("source name cannot be empty")
+	}
+
+	if provider == nil {
+		return errors.New("source cannot be nil")
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	if _, found := e.sources[name]; found {
+		return errors.New("source \"" + name + "\" is already registered")
+	}
+
+	e.sources[name] = &source{provider: provider}
+	return nil
+}
+
+// Unregister removes the statistics source with the specified name from the Exporter.
+func (e *Exporter) Unregister(name string) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	delete(e.sources, name)
+}
+
+// ServeHTTP writes the current statistics of all registered sources in OpenMetrics text format.
+func (e *Exporter) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet && request.Method != http.MethodHead {
+		writer.Header().Set("Allow", "GET, HEAD")
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	writer.Header().Set("Content-Type", ContentType)
+
+	if request.Method == http.MethodHead {
+		return
+	}
+
+	e.WriteTo(writer)
+}
+
+// WriteTo writes the current statistics of all registered sources to writer in OpenMetrics text format.
+func (e *Exporter) WriteTo(writer io.Writer) (int64, error) {
+	snapshots := e.collect()
+	var buffer bytes.Buffer
+
+	writeGauge(&buffer, "sttp_subscriber_connected", "", "Determines if subscriber is connected to a publisher.", snapshots, func(s *snapshot) float64 {
+		return boolValue(s.statistics.Connected)
+	})
+
+	writeGauge(&buffer, "sttp_subscriber_subscribed", "", "Determines if subscriber has an active subscription.", snapshots, func(s *snapshot) float64 {
+		return boolValue(s.statistics.Subscribed)
+	})
+
+	writeCounter(&buffer, "sttp_subscriber_connections", "Connections established to a publisher.", snapshots, func(s *snapshot) uint64 {
+		return s.statistics.Connections
+	})
+
+	writeCounter(&buffer, "sttp_subscriber_reconnects", "Connections established by automatic reconnection or failover.", snapshots, func(s *snapshot) uint64 {
+		return s.statistics.Reconnects
+	})
+
+	writeCounter(&buffer, "sttp_subscriber_measurements_received", "Measurements received from a publisher.", snapshots, func(s *snapshot) uint64 {
+		return s.statistics.MeasurementsReceived
+	})
+
+	writeGauge(&buffer, "sttp_subscriber_measurements_per_second", "", "Rate of measurements received since the previous scrape.", snapshots, func(s *snapshot) float64 {
+		return s.rate
+	})
+
+	writeSummary(&buffer, "sttp_subscriber_metadata_refresh_latency_seconds", "Time between metadata requests and their responses.", snapshots, func(s *snapshot) (uint64, float64) {
+		return s.statistics.MetadataRefreshes, s.statistics.MetadataRefreshLatency.Seconds()
+	})
+
+	writeGauge(&buffer, "sttp_subscriber_last_metadata_refresh_latency_seconds", "seconds", "Time between the last metadata request and its response.", snapshots, func(s *snapshot) float64 {
+		return s.statistics.LastMetadataRefreshLatency.Seconds()
+	})
+
+	writeCounter(&buffer, "sttp_subscriber_tssc_resets", "TSSC decompressor resets requested by a publisher.", snapshots, func(s *snapshot) uint64 {
+		return s.statistics.TSSCResets
+	})
+
+	writeCounter(&buffer, "sttp_subscriber_tssc_out_of_sequence", "TSSC data packets ignored because they were out of sequence.", snapshots, func(s *snapshot) uint64 {
+		return s.statistics.TSSCOutOfSequence
+	})
+
+	writeCounter(&buffer, "sttp_subscriber_signal_index_cache_swaps", "Signal index caches received and made active.", snapshots, func(s *snapshot) uint64 {
+		return s.statistics.SignalIndexCacheSwaps
+	})
+
+	writeCounter(&buffer, "sttp_subscriber_decryption_failures", "Data packets that could not be decrypted.", snapshots, func(s *snapshot) uint64 {
+		return s.statistics.DecryptionFailures
+	})
+
+	writeCounter(&buffer, "sttp_subscriber_events_dropped", "Events that could not be delivered to the event channel.", snapshots, func(s *snapshot) uint64 {
+		return s.statistics.EventsDropped
+	})
+
+	writeCallbackSummary(&buffer, snapshots)
+
+	buffer.WriteString("# EOF\n")
+
+	return buffer.WriteTo(writer)
+}
+
+// collect takes a statistics snapshot from each registered source, sorted by name,
+// and updates the measurement rates.
+func (e *Exporter) collect() []*snapshot {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	now := e.now()
+	snapshots := make([]*snapshot, 0, len(e.sources))
+
+	for name, source := range e.sources {
+		statistics := source.provider.Statistics()
+		count := statistics.MeasurementsReceived
+
+		if source.lastTime.IsZero() || count < source.lastCount {
+			source.lastCount = count
+			source.lastTime = now
+			source.rate = 0
+		} else if elapsed := now.Sub(source.lastTime); elapsed >= minRateInterval {
+			source.rate = float64(count-source.lastCount) / elapsed.Seconds()
+			source.lastCount = count
+			source.lastTime = now
+		}
+
+		snapshots = append(snapshots, &snapshot{name: name, statistics: statistics, rate: source.rate})
+	}
+
+	sort.Slice(snapshots, func(i, j int) bool {
+		return snapshots[i].name < snapshots[j].name
+	})
+
+	return snapshots
+}
+
+func writeMetadata(buffer *bytes.Buffer, name string, metricType string, unit string, help string) {
+	buffer.WriteString("# TYPE " + name + " " + metricType + "\n")
+
+	if len(unit) > 0 {
+		buffer.WriteString("# UNIT " + name + " " + unit + "\n")
+	}
+
+	buffer.WriteString("# HELP " + name + " " + help + "\n")
+}
+
+func writeSample(buffer *bytes.Buffer, name string, labels string, value string) {
+	buffer.WriteString(name + "{" + labels + "} " + value + "\n")
+}
+
+func writeGauge(buffer *bytes.Buffer, name string, unit string, help string, snapshots []*snapshot, value func(*snapshot) float64) {
+	writeMetadata(buffer, name, "gauge", unit, help)
+
+	for _, s := range snapshots {
+		writeSample(buffer, name, subscriberLabel(s.name), formatFloat(value(s)))
+	}
+}
+
+func writeCounter(buffer *bytes.Buffer, name string, help string, snapshots []*snapshot, value func(*snapshot) uint64) {
+	writeMetadata(buffer, name, "counter", "", help)
+
+	for _, s := range snapshots {
+		writeSample(buffer, name+"_total", subscriberLabel(s.name), strconv.FormatUint(value(s), 10))
+	}
+}
+
+func writeSummary(buffer *bytes.Buffer, name string, help string, snapshots []*snapshot, value func(*snapshot) (uint64, float64)) {
+	writeMetadata(buffer, name, "summary", "seconds", help)
+
+	for _, s := range snapshots {
+		count, sum := value(s)
+		labels := subscriberLabel(s.name)
+		writeSample(buffer, name+"_count", labels, strconv.FormatUint(count, 10))
+		writeSample(buffer, name+"_sum", labels, formatFloat(sum))
+	}
+}
+
+func writeCallbackSummary(buffer *bytes.Buffer, snapshots []*snapshot) {
+	const name = "sttp_subscriber_callback_duration_seconds"
+	const maxName = "sttp_subscriber_callback_max_duration_seconds"
+
+	writeMetadata(buffer, name, "summary", "seconds", "Execution time of subscriber callbacks.")
+
+	for _, s := range snapshots {
+		for _, callback := range callbackNames(s) {
+			statistics := s.statistics.Callbacks[callback]
+			labels := subscriberLabel(s.name) + `,callback="` + escapeLabel(callback) + `"`
+			writeSample(buffer, name+"_count", labels, strconv.FormatUint(statistics.Invocations, 10))
+			writeSample(buffer, name+"_sum", labels, formatFloat(statistics.TotalDuration.Seconds()))
+		}
+	}
+
+	writeMetadata(buffer, maxName, "gauge", "seconds", "Longest execution time of subscriber callbacks.")
+
+	for _, s := range snapshots {
+		for _, callback := range callbackNames(s) {
+			labels := subscriberLabel(s.name) + `,callback="` + escapeLabel(callback) + `"`
+			writeSample(buffer, maxName, labels, formatFloat(s.statistics.Callbacks[callback].MaxDuration.Seconds()))
+		}
+	}
+}
+
+func callbackNames(s *snapshot) []string {
+	names := make([]string, 0, len(s.statistics.Callbacks))
+
+	for name := range s.statistics.Callbacks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func subscriberLabel(name string) string {
+	return `subscriber="` + escapeLabel(name) + `"`
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(value string) string {
+	return labelEscaper.Replace(value)
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func boolValue(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,184 @@
+//******************************************************************************************************
+//  Exporter_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package metrics
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+type testSource struct {
+	statistics transport.SubscriberStatistics
+}
+
+func (ts *testSource) Statistics() transport.SubscriberStatistics {
+	return ts.statistics
+}
+
+func TestExporterOutput(t *testing.T) {
+	exporter := NewExporter()
+
+	source := &testSource{statistics: transport.SubscriberStatistics{
+		Connected:              true,
+		Connections:            3,
+		Reconnects:             2,
+		MeasurementsReceived:   1000,
+		MetadataRefreshes:      2,
+		MetadataRefreshLatency: 1500 * time.Millisecond,
+		TSSCOutOfSequence:      4,
+		Callbacks: map[string]transport.CallbackStatistics{
+			"NewMeasurementsCallback": {Invocations: 10, TotalDuration: 250 * time.Millisecond, MaxDuration: 50 * time.Millisecond},
+		},
+	}}
+
+	if err := exporter.Register("primary \"A\"", source); err != nil {
+		t.Fatalf("TestExporterOutput: failed to register source: %s", err.Error())
+	}
+
+	if err := exporter.Register("primary \"A\"", source); err == nil {
+		t.Fatalf("TestExporterOutput: expected error for duplicate source name")
+	}
+
+	var output strings.Builder
+
+	if _, err := exporter.WriteTo(&output); err != nil {
+		t.Fatalf("TestExporterOutput: failed to write metrics: %s", err.Error())
+	}
+
+	text := output.String()
+
+	for _, expected := range []string{
+		"# TYPE sttp_subscriber_connected gauge\n",
+		`sttp_subscriber_connected{subscriber="primary \"A\""} 1` + "\n",
+		`sttp_subscriber_subscribed{subscriber="primary \"A\""} 0` + "\n",
+		"# TYPE sttp_subscriber_reconnects counter\n",
+		`sttp_subscriber_reconnects_total{subscriber="primary \"A\""} 2` + "\n",
+		`sttp_subscriber_measurements_received_total{subscriber="primary \"A\""} 1000` + "\n",
+		"# UNIT sttp_subscriber_metadata_refresh_latency_seconds seconds\n",
+		`sttp_subscriber_metadata_refresh_latency_seconds_count{subscriber="primary \"A\""} 2` + "\n",
+		`sttp_subscriber_metadata_refresh_latency_seconds_sum{subscriber="primary \"A\""} 1.5` + "\n",
+		`sttp_subscriber_tssc_out_of_sequence_total{subscriber="primary \"A\""} 4` + "\n",
+		`sttp_subscriber_callback_duration_seconds_count{subscriber="primary \"A\"",callback="NewMeasurementsCallback"} 10` + "\n",
+		`sttp_subscriber_callback_duration_seconds_sum{subscriber="primary \"A\"",callback="NewMeasurementsCallback"} 0.25` + "\n",
+		`sttp_subscriber_callback_max_duration_seconds{subscriber="primary \"A\"",callback="NewMeasurementsCallback"} 0.05` + "\n",
+	} {
+		if !strings.Contains(text, expected) {
+			t.Fatalf("TestExporterOutput: expected output to contain %q, received:\n%s", expected, text)
+		}
+	}
+
+	if !strings.HasSuffix(text, "\n# EOF\n") {
+		t.Fatalf("TestExporterOutput: expected output to end with EOF marker")
+	}
+
+	exporter.Unregister("primary \"A\"")
+	output.Reset()
+	exporter.WriteTo(&output)
+
+	if strings.Contains(output.String(), "primary") {
+		t.Fatalf("TestExporterOutput: expected unregistered source to be removed")
+	}
+}
+
+func TestExporterMeasurementRate(t *testing.T) {
+	exporter := NewExporter()
+	now := time.Unix(0, 0)
+	exporter.now = func() time.Time { return now }
+
+	source := &testSource{}
+	exporter.Register("test", source)
+
+	rate := func() float64 {
+		snapshots := exporter.collect()
+		return snapshots[0].rate
+	}
+
+	if value := rate(); value != 0 {
+		t.Fatalf("TestExporterMeasurementRate: expected initial rate of 0, received %v", value)
+	}
+
+	source.statistics.MeasurementsReceived = 500
+	now = now.Add(2 * time.Second)
+
+	if value := rate(); value != 250 {
+		t.Fatalf("TestExporterMeasurementRate: expected rate of 250, received %v", value)
+	}
+
+	// Scrapes within the minimum interval report the previous rate
+	source.statistics.MeasurementsReceived = 600
+	now = now.Add(100 * time.Millisecond)
+
+	if value := rate(); value != 250 {
+		t.Fatalf("TestExporterMeasurementRate: expected previous rate of 250, received %v", value)
+	}
+
+	now = now.Add(900 * time.Millisecond)
+
+	if value := rate(); value != 100 {
+		t.Fatalf("TestExporterMeasurementRate: expected rate of 100, received %v", value)
+	}
+}
+
+func TestExporterHTTP(t *testing.T) {
+	exporter := NewExporter()
+	exporter.Register("test", &testSource{})
+
+	server := httptest.NewServer(exporter)
+	defer server.Close()
+
+	response, err := http.Get(server.URL)
+
+	if err != nil {
+		t.Fatalf("TestExporterHTTP: request failed: %s", err.Error())
+	}
+
+	body, _ := io.ReadAll(response.Body)
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK || response.Header.Get("Content-Type") != ContentType {
+		t.Fatalf("TestExporterHTTP: unexpected response: %d, %s", response.StatusCode, response.Header.Get("Content-Type"))
+	}
+
+	if !strings.Contains(string(body), `sttp_subscriber_connected{subscriber="test"} 0`) {
+		t.Fatalf("TestExporterHTTP: unexpected body:\n%s", body)
+	}
+
+	response, err = http.Post(server.URL, "text/plain", nil)
+
+	if err != nil {
+		t.Fatalf("TestExporterHTTP: request failed: %s", err.Error())
+	}
+
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusMethodNotAllowed {
+		t.Fatalf("TestExporterHTTP: expected method not allowed, received %d", response.StatusCode)
+	}
+}
@@ -0,0 +1,173 @@
+//******************************************************************************************************
+//  Format.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package recording
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// A recording is an append-only file of records following a fixed size header. All values are
+// big-endian. Each record has a 13 byte header, i.e., a 1 byte type, an 8 byte timestamp of when the
+// record data was received and a 4 byte payload length, followed by the payload. When a recording is
+// closed, an index record and a trailer are appended; recordings that were not closed, e.g., due to a
+// crash, remain readable and are indexed by scanning record headers.
+//
+// Measurements refer to signals by a 32-bit recording ID, defined by signal definition records that
+// precede first use, so each measurement takes 24 bytes: ID, timestamp, flags and value.
+
+const (
+	headerSize       = 16
+	recordHeaderSize = 13
+	trailerSize      = 16
+	measurementSize  = 24
+
+	// Version is the recording format version written by this library.
+	Version uint16 = 1
+
+	// IndexInterval defines the minimum time between indexed measurement records.
+	IndexInterval = ticks.PerSecond
+)
+
+var (
+	headerMagic  = [8]byte{'S', 'T', 'T', 'P', 'R', 'E', 'C', 0}
+	trailerMagic = [8]byte{'S', 'T', 'T', 'P', 'I', 'D', 'X', 0}
+)
+
+// ErrInvalidRecording is the error returned when a recording has an invalid header.
+var ErrInvalidRecording = errors.New("file is not an STTP recording")
+
+// ErrCorruptRecording is the error returned when a recording record cannot be decoded.
+var ErrCorruptRecording = errors.New("recording is corrupt")
+
+// ErrWriterClosed is the error returned when writing to a Writer that has been closed.
+var ErrWriterClosed = errors.New("recording writer is closed")
+
+// RecordTypeEnum defines the type of the RecordType enumeration.
+type RecordTypeEnum byte
+
+// RecordType is an enumeration of the possible types of records in a recording.
+var RecordType = struct {
+	// Metadata defines a record containing received metadata XML.
+	Metadata RecordTypeEnum
+	// SignalIndexCache defines a record containing a received signal index cache.
+	SignalIndexCache RecordTypeEnum
+	// Measurements defines a record containing a batch of received measurements.
+	Measurements RecordTypeEnum
+}{
+	Metadata:         1,
+	SignalIndexCache: 2,
+	Measurements:     4,
+}
+
+// Internal record types, not returned by Reader
+const (
+	signalDefinitionsRecordType RecordTypeEnum = 3
+	indexRecordType             RecordTypeEnum = 5
+)
+
+// String gets the RecordType enumeration value as a string.
+func (rte RecordTypeEnum) String() string {
+	switch rte {
+	case RecordType.Metadata:
+		return "Metadata"
+	case RecordType.SignalIndexCache:
+		return "SignalIndexCache"
+	case RecordType.Measurements:
+		return "Measurements"
+	default:
+		return "0x" + strconv.FormatInt(int64(rte), 16)
+	}
+}
+
+// Record represents a record read from a recording.
+type Record struct {
+	// Type is the type of the record.
+	Type RecordTypeEnum
+
+	// Time is the time the record data was received when it was recorded.
+	Time ticks.Ticks
+
+	// Metadata is the metadata XML of a Metadata record.
+	Metadata []byte
+
+	// SignalIndexCache is the signal index cache of a SignalIndexCache record.
+	SignalIndexCache *transport.SignalIndexCache
+
+	// Measurements are the measurements of a Measurements record.
+	Measurements []transport.Measurement
+}
+
+// IndexEntry defines the location of a Measurements record in a recording along with the
+// locations of the Metadata and SignalIndexCache records that were active at that time.
+type IndexEntry struct {
+	// Time is the time the measurements were received.
+	Time ticks.Ticks
+
+	// Offset is the file offset of the Measurements record.
+	Offset int64
+
+	// MetadataOffset is the file offset of the preceding Metadata record, or -1 if there is none.
+	MetadataOffset int64
+
+	// SignalIndexCacheOffset is the file offset of the preceding SignalIndexCache record, or -1 if there is none.
+	SignalIndexCacheOffset int64
+}
+
+// indexBuilder tracks record offsets to build a recording index.
+type indexBuilder struct {
+	entries                []IndexEntry
+	lastIndexed            ticks.Ticks
+	metadataOffset         int64
+	signalIndexCacheOffset int64
+}
+
+func newIndexBuilder() *indexBuilder {
+	return &indexBuilder{metadataOffset: -1, signalIndexCacheOffset: -1}
+}
+
+func (ib *indexBuilder) add(recordType RecordTypeEnum, time ticks.Ticks, offset int64) {
+	switch recordType {
+	case RecordType.Metadata:
+		ib.metadataOffset = offset
+	case RecordType.SignalIndexCache:
+		ib.signalIndexCacheOffset = offset
+	case RecordType.Measurements:
+		if len(ib.entries) > 0 && time < ib.lastIndexed+IndexInterval {
+			return
+		}
+
+		ib.entries = append(ib.entries, IndexEntry{
+			Time:                   time,
+			Offset:                 offset,
+			MetadataOffset:         ib.metadataOffset,
+			SignalIndexCacheOffset: ib.signalIndexCacheOffset,
+		})
+
+		ib.lastIndexed = time
+	}
+}
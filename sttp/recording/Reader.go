@@ -0,0 +1,530 @@
+//******************************************************************************************************
+//  Reader.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package recording
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Reader reads records from an STTP recording. A Reader is not safe for concurrent use.
+type Reader struct {
+	reader  io.ReadSeeker
+	closer  io.Closer
+	signals []guid.Guid
+	index   []IndexEntry
+	offset  int64
+	end     int64
+	pending []int64
+	header  [recordHeaderSize]byte
+}
+
+// NewReader creates a new Reader that reads a recording from the specified io.ReadSeeker.
+// If reader is an io.Closer, it will be closed when the Reader is closed.
+func NewReader(reader io.ReadSeeker) (*Reader, error) {
+	rr := &Reader{reader: reader}
+
+	if closer, ok := reader.(io.Closer); ok {
+		rr.closer = closer
+	}
+
+	header := make([]byte, headerSize)
+
+	if _, err := io.ReadFull(reader, header); err != nil || !bytes.Equal(header[:8], headerMagic[:]) {
+		return nil, ErrInvalidRecording
+	}
+
+	if version := binary.BigEndian.Uint16(header[8:]); version > Version {
+		return nil, errors.New("unsupported recording version " + strconv.Itoa(int(version)))
+	}
+
+	size, err := reader.Seek(0, io.SeekEnd)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rr.end = size
+
+	if err := rr.loadIndex(size); err != nil {
+		// Recording was not closed, e.g., due to a crash, so build index by scanning records
+		rr.end = size
+		rr.signals = nil
+
+		if err := rr.buildIndex(); err != nil {
+			return nil, err
+		}
+	}
+
+	return rr, rr.seek(headerSize)
+}
+
+// Open opens the named recording file for reading.
+func Open(path string) (*Reader, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rr, err := NewReader(file)
+
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return rr, nil
+}
+
+// Index gets the index of the recording, i.e., locations of Measurements records in time order
+// at approximately IndexInterval spacing.
+func (rr *Reader) Index() []IndexEntry {
+	return rr.index
+}
+
+// StartTime gets the receive time of the first Measurements record in the recording, or zero
+// if the recording has no measurements.
+func (rr *Reader) StartTime() ticks.Ticks {
+	if len(rr.index) == 0 {
+		return 0
+	}
+
+	return rr.index[0].Time
+}
+
+// Next reads the next record from the recording. Returns io.EOF when no more records remain.
+// An incomplete final record, e.g., from a recording that was not closed, is treated as the
+// end of the recording.
+func (rr *Reader) Next() (*Record, error) {
+	for {
+		if len(rr.pending) > 0 {
+			offset := rr.pending[0]
+			rr.pending = rr.pending[1:]
+			resume := rr.offset
+
+			if err := rr.seek(offset); err != nil {
+				return nil, err
+			}
+
+			record, err := rr.readRecord()
+
+			if err != nil {
+				return nil, err
+			}
+
+			if err := rr.seek(resume); err != nil {
+				return nil, err
+			}
+
+			return record, nil
+		}
+
+		record, err := rr.readRecord()
+
+		if err != nil || record != nil {
+			return record, err
+		}
+	}
+}
+
+// SeekTime positions the Reader at the last indexed Measurements record received at or before the
+// specified time, or at the start of the recording if there is none. The next records read will be
+// the Metadata and SignalIndexCache records that were active at that position, if any.
+func (rr *Reader) SeekTime(time ticks.Ticks) error {
+	position := sort.Search(len(rr.index), func(i int) bool {
+		return rr.index[i].Time > time
+	}) - 1
+
+	rr.pending = nil
+
+	if position < 0 {
+		return rr.seek(headerSize)
+	}
+
+	entry := rr.index[position]
+
+	if entry.MetadataOffset >= 0 {
+		rr.pending = append(rr.pending, entry.MetadataOffset)
+	}
+
+	if entry.SignalIndexCacheOffset >= 0 {
+		rr.pending = append(rr.pending, entry.SignalIndexCacheOffset)
+	}
+
+	return rr.seek(entry.Offset)
+}
+
+// Close closes the underlying io.ReadSeeker when it is an io.Closer.
+func (rr *Reader) Close() error {
+	if rr.closer != nil {
+		return rr.closer.Close()
+	}
+
+	return nil
+}
+
+func (rr *Reader) seek(offset int64) error {
+	if _, err := rr.reader.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	rr.offset = offset
+	return nil
+}
+
+// readRecordHeader reads the next record header, returning io.EOF at the end of the records
+func (rr *Reader) readRecordHeader() (RecordTypeEnum, ticks.Ticks, int64, error) {
+	if rr.offset+recordHeaderSize > rr.end {
+		return 0, 0, 0, io.EOF
+	}
+
+	if _, err := io.ReadFull(rr.reader, rr.header[:]); err != nil {
+		return 0, 0, 0, io.EOF
+	}
+
+	rr.offset += recordHeaderSize
+	length := int64(binary.BigEndian.Uint32(rr.header[9:]))
+
+	if rr.offset+length > rr.end {
+		return 0, 0, 0, io.EOF
+	}
+
+	return RecordTypeEnum(rr.header[0]), ticks.Ticks(binary.BigEndian.Uint64(rr.header[1:])), length, nil
+}
+
+func (rr *Reader) readPayload(length int64) ([]byte, error) {
+	payload := make([]byte, length)
+
+	if _, err := io.ReadFull(rr.reader, payload); err != nil {
+		return nil, io.EOF
+	}
+
+	rr.offset += length
+	return payload, nil
+}
+
+// readRecord reads the next record, returning nil for internal or unknown record types
+func (rr *Reader) readRecord() (*Record, error) {
+	recordType, time, length, err := rr.readRecordHeader()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if recordType == indexRecordType {
+		return nil, io.EOF
+	}
+
+	payload, err := rr.readPayload(length)
+
+	if err != nil {
+		return nil, err
+	}
+
+	record := &Record{Type: recordType, Time: time}
+
+	switch recordType {
+	case RecordType.Metadata:
+		record.Metadata, err = decodeMetadata(payload)
+	case RecordType.SignalIndexCache:
+		record.SignalIndexCache, err = decodeSignalIndexCache(payload)
+	case RecordType.Measurements:
+		record.Measurements, err = rr.decodeMeasurements(payload)
+	case signalDefinitionsRecordType:
+		return nil, rr.decodeSignalDefinitions(payload)
+	default:
+		// Skip unknown record types for forward compatibility
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (rr *Reader) loadIndex(size int64) error {
+	if size < headerSize+trailerSize {
+		return ErrCorruptRecording
+	}
+
+	trailer := make([]byte, trailerSize)
+
+	if _, err := rr.reader.Seek(size-trailerSize, io.SeekStart); err != nil {
+		return err
+	}
+
+	if _, err := io.ReadFull(rr.reader, trailer); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(trailer[8:], trailerMagic[:]) {
+		return ErrCorruptRecording
+	}
+
+	indexOffset := int64(binary.BigEndian.Uint64(trailer))
+
+	if indexOffset < headerSize || indexOffset > size-trailerSize {
+		return ErrCorruptRecording
+	}
+
+	if err := rr.seek(indexOffset); err != nil {
+		return err
+	}
+
+	rr.end = size - trailerSize
+	recordType, _, length, err := rr.readRecordHeader()
+
+	if err != nil || recordType != indexRecordType {
+		return ErrCorruptRecording
+	}
+
+	payload, err := rr.readPayload(length)
+
+	if err != nil {
+		return err
+	}
+
+	if err := rr.decodeIndex(payload); err != nil {
+		return err
+	}
+
+	rr.end = indexOffset
+	return nil
+}
+
+func (rr *Reader) decodeIndex(payload []byte) error {
+	if len(payload) < 4 {
+		return ErrCorruptRecording
+	}
+
+	count := int(binary.BigEndian.Uint32(payload))
+	payload = payload[4:]
+
+	if len(payload) < count*16+4 {
+		return ErrCorruptRecording
+	}
+
+	rr.signals = make([]guid.Guid, count)
+
+	for i := 0; i < count; i++ {
+		copy(rr.signals[i][:], payload[i*16:])
+	}
+
+	payload = payload[count*16:]
+	count = int(binary.BigEndian.Uint32(payload))
+	payload = payload[4:]
+
+	if len(payload) < count*32 {
+		return ErrCorruptRecording
+	}
+
+	rr.index = make([]IndexEntry, count)
+
+	for i := range rr.index {
+		entry := payload[i*32:]
+		rr.index[i] = IndexEntry{
+			Time:                   ticks.Ticks(binary.BigEndian.Uint64(entry)),
+			Offset:                 int64(binary.BigEndian.Uint64(entry[8:])),
+			MetadataOffset:         int64(binary.BigEndian.Uint64(entry[16:])),
+			SignalIndexCacheOffset: int64(binary.BigEndian.Uint64(entry[24:])),
+		}
+	}
+
+	return nil
+}
+
+// buildIndex scans all record headers to build the index and signal table of an unclosed recording
+func (rr *Reader) buildIndex() error {
+	builder := newIndexBuilder()
+
+	if err := rr.seek(headerSize); err != nil {
+		return err
+	}
+
+	for {
+		offset := rr.offset
+		recordType, time, length, err := rr.readRecordHeader()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		builder.add(recordType, time, offset)
+
+		if recordType == signalDefinitionsRecordType {
+			payload, err := rr.readPayload(length)
+
+			if err == io.EOF {
+				break
+			}
+
+			if err := rr.decodeSignalDefinitions(payload); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := rr.seek(rr.offset + length); err != nil {
+			return err
+		}
+	}
+
+	rr.index = builder.entries
+	return nil
+}
+
+func (rr *Reader) decodeSignalDefinitions(payload []byte) error {
+	if len(payload) < 4 {
+		return ErrCorruptRecording
+	}
+
+	count := int(binary.BigEndian.Uint32(payload))
+	payload = payload[4:]
+
+	if len(payload) < count*20 {
+		return ErrCorruptRecording
+	}
+
+	for i := 0; i < count; i++ {
+		definition := payload[i*20:]
+		recordingID := int(binary.BigEndian.Uint32(definition))
+
+		if recordingID > len(rr.signals) {
+			return ErrCorruptRecording
+		}
+
+		var signalID guid.Guid
+		copy(signalID[:], definition[4:20])
+
+		if recordingID == len(rr.signals) {
+			rr.signals = append(rr.signals, signalID)
+		} else {
+			rr.signals[recordingID] = signalID
+		}
+	}
+
+	return nil
+}
+
+func (rr *Reader) decodeMeasurements(payload []byte) ([]transport.Measurement, error) {
+	if len(payload) < 4 {
+		return nil, ErrCorruptRecording
+	}
+
+	count := int(binary.BigEndian.Uint32(payload))
+	payload = payload[4:]
+
+	if len(payload) < count*measurementSize {
+		return nil, ErrCorruptRecording
+	}
+
+	measurements := make([]transport.Measurement, count)
+
+	for i := range measurements {
+		encoded := payload[i*measurementSize:]
+		recordingID := int(binary.BigEndian.Uint32(encoded))
+
+		if recordingID >= len(rr.signals) {
+			return nil, ErrCorruptRecording
+		}
+
+		measurements[i] = transport.Measurement{
+			SignalID:  rr.signals[recordingID],
+			Timestamp: ticks.Ticks(binary.BigEndian.Uint64(encoded[4:])),
+			Flags:     transport.StateFlagsEnum(binary.BigEndian.Uint32(encoded[12:])),
+			Value:     math.Float64frombits(binary.BigEndian.Uint64(encoded[16:])),
+		}
+	}
+
+	return measurements, nil
+}
+
+func decodeMetadata(payload []byte) ([]byte, error) {
+	decompressor, err := gzip.NewReader(bytes.NewReader(payload))
+
+	if err != nil {
+		return nil, ErrCorruptRecording
+	}
+
+	metadata, err := io.ReadAll(decompressor)
+
+	if err != nil {
+		return nil, ErrCorruptRecording
+	}
+
+	return metadata, nil
+}
+
+func decodeSignalIndexCache(payload []byte) (*transport.SignalIndexCache, error) {
+	if len(payload) < 4 {
+		return nil, ErrCorruptRecording
+	}
+
+	count := int(binary.BigEndian.Uint32(payload))
+	payload = payload[4:]
+	signalIndexCache := transport.NewSignalIndexCache()
+
+	for i := 0; i < count; i++ {
+		if len(payload) < 30 {
+			return nil, ErrCorruptRecording
+		}
+
+		signalIndex := int32(binary.BigEndian.Uint32(payload))
+
+		var signalID guid.Guid
+		copy(signalID[:], payload[4:20])
+
+		id := binary.BigEndian.Uint64(payload[20:])
+		sourceLength := int(binary.BigEndian.Uint16(payload[28:]))
+		payload = payload[30:]
+
+		if len(payload) < sourceLength {
+			return nil, ErrCorruptRecording
+		}
+
+		signalIndexCache.AddRecord(signalIndex, signalID, string(payload[:sourceLength]), id)
+		payload = payload[sourceLength:]
+	}
+
+	return signalIndexCache, nil
+}
@@ -0,0 +1,298 @@
+//******************************************************************************************************
+//  Reader_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package recording
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+const testMetadata = `<?xml version="1.0" standalone="yes"?><DataSet><MeasurementDetail><SignalID>00000000-0000-0000-0000-000000000001</SignalID></MeasurementDetail></DataSet>`
+
+type testRecording struct {
+	path      string
+	startTime ticks.Ticks
+	signals   []guid.Guid
+	cache     *transport.SignalIndexCache
+}
+
+// writeTestRecording writes metadata, a signal index cache and ten seconds of measurement
+// batches, received at 10 batches per second, to a new recording
+func writeTestRecording(t *testing.T, close bool) *testRecording {
+	recording := &testRecording{
+		path:      filepath.Join(t.TempDir(), "test.sttp"),
+		startTime: ticks.Ticks(638000000000000000),
+		signals:   []guid.Guid{guid.New(), guid.New(), guid.New()},
+		cache:     transport.NewSignalIndexCache(),
+	}
+
+	for i, signalID := range recording.signals {
+		recording.cache.AddRecord(int32(i+1), signalID, "PPA", uint64(i+10))
+	}
+
+	writer, err := Create(recording.path)
+
+	if err != nil {
+		t.Fatalf("writeTestRecording: failed to create recording: %v", err)
+	}
+
+	if err := writer.WriteMetadata(recording.startTime, []byte(testMetadata)); err != nil {
+		t.Fatalf("writeTestRecording: failed to write metadata: %v", err)
+	}
+
+	if err := writer.WriteSignalIndexCache(recording.startTime, recording.cache); err != nil {
+		t.Fatalf("writeTestRecording: failed to write signal index cache: %v", err)
+	}
+
+	for i := 0; i < 100; i++ {
+		time := recording.startTime + ticks.Ticks(i)*ticks.PerSecond/10
+		measurements := make([]transport.Measurement, 0, 3)
+
+		// Third signal first appears mid-recording
+		for j, signalID := range recording.signals {
+			if j == 2 && i < 50 {
+				continue
+			}
+
+			measurements = append(measurements, transport.Measurement{
+				SignalID:  signalID,
+				Timestamp: time,
+				Value:     float64(i*10 + j),
+				Flags:     transport.StateFlags.Normal,
+			})
+		}
+
+		if err := writer.WriteMeasurements(time, measurements); err != nil {
+			t.Fatalf("writeTestRecording: failed to write measurements: %v", err)
+		}
+	}
+
+	if close {
+		if err := writer.Close(); err != nil {
+			t.Fatalf("writeTestRecording: failed to close recording: %v", err)
+		}
+	} else if err := writer.Flush(); err != nil {
+		t.Fatalf("writeTestRecording: failed to flush recording: %v", err)
+	}
+
+	return recording
+}
+
+func readAllRecords(t *testing.T, reader *Reader) []*Record {
+	var records []*Record
+
+	for {
+		record, err := reader.Next()
+
+		if err == io.EOF {
+			return records
+		}
+
+		if err != nil {
+			t.Fatalf("readAllRecords: failed to read record: %v", err)
+		}
+
+		records = append(records, record)
+	}
+}
+
+func validateRecords(t *testing.T, testName string, recording *testRecording, records []*Record) {
+	if len(records) != 102 {
+		t.Fatalf("%s: expected 102 records, received %d", testName, len(records))
+	}
+
+	if records[0].Type != RecordType.Metadata || string(records[0].Metadata) != testMetadata {
+		t.Fatalf("%s: expected metadata record, received %s", testName, records[0].Type)
+	}
+
+	cache := records[1].SignalIndexCache
+
+	if records[1].Type != RecordType.SignalIndexCache || cache == nil || cache.Count() != 3 {
+		t.Fatalf("%s: expected signal index cache record with 3 signals", testName)
+	}
+
+	for i, signalID := range recording.signals {
+		recordSignalID, source, id, ok := cache.Record(int32(i + 1))
+
+		if !ok || recordSignalID != signalID || source != "PPA" || id != uint64(i+10) {
+			t.Fatalf("%s: unexpected signal index cache record for index %d", testName, i+1)
+		}
+	}
+
+	for i, record := range records[2:] {
+		time := recording.startTime + ticks.Ticks(i)*ticks.PerSecond/10
+
+		if record.Type != RecordType.Measurements || record.Time != time {
+			t.Fatalf("%s: unexpected measurements record %d", testName, i)
+		}
+
+		for j, measurement := range record.Measurements {
+			if measurement.SignalID != recording.signals[j] || measurement.Timestamp != time || measurement.Value != float64(i*10+j) || measurement.Flags != transport.StateFlags.Normal {
+				t.Fatalf("%s: unexpected measurement %d in record %d: %s", testName, j, i, measurement.String())
+			}
+		}
+	}
+}
+
+func TestReaderRoundTrip(t *testing.T) {
+	recording := writeTestRecording(t, true)
+	reader, err := Open(recording.path)
+
+	if err != nil {
+		t.Fatalf("TestReaderRoundTrip: failed to open recording: %v", err)
+	}
+
+	defer reader.Close()
+
+	if index := reader.Index(); len(index) != 10 {
+		t.Fatalf("TestReaderRoundTrip: expected 10 index entries, received %d", len(index))
+	}
+
+	if reader.StartTime() != recording.startTime {
+		t.Fatalf("TestReaderRoundTrip: unexpected start time %s", reader.StartTime())
+	}
+
+	validateRecords(t, "TestReaderRoundTrip", recording, readAllRecords(t, reader))
+}
+
+func TestReaderSeekTime(t *testing.T) {
+	recording := writeTestRecording(t, true)
+	reader, err := Open(recording.path)
+
+	if err != nil {
+		t.Fatalf("TestReaderSeekTime: failed to open recording: %v", err)
+	}
+
+	defer reader.Close()
+
+	if err := reader.SeekTime(recording.startTime + 7*ticks.PerSecond + ticks.PerSecond/2); err != nil {
+		t.Fatalf("TestReaderSeekTime: failed to seek: %v", err)
+	}
+
+	records := readAllRecords(t, reader)
+
+	if len(records) != 32 {
+		t.Fatalf("TestReaderSeekTime: expected 32 records after seek, received %d", len(records))
+	}
+
+	if records[0].Type != RecordType.Metadata || records[1].Type != RecordType.SignalIndexCache {
+		t.Fatalf("TestReaderSeekTime: expected active metadata and signal index cache to be delivered first")
+	}
+
+	first := records[2]
+
+	if first.Time != recording.startTime+7*ticks.PerSecond || len(first.Measurements) != 3 || first.Measurements[2].SignalID != recording.signals[2] {
+		t.Fatalf("TestReaderSeekTime: unexpected first measurements record after seek")
+	}
+
+	// Seeking before start returns to beginning of recording
+	if err := reader.SeekTime(0); err != nil {
+		t.Fatalf("TestReaderSeekTime: failed to seek to start: %v", err)
+	}
+
+	validateRecords(t, "TestReaderSeekTime", recording, readAllRecords(t, reader))
+}
+
+func TestReaderUnclosedRecording(t *testing.T) {
+	recording := writeTestRecording(t, false)
+
+	// Simulate a crash mid-write by truncating the final record
+	info, err := os.Stat(recording.path)
+
+	if err != nil {
+		t.Fatalf("TestReaderUnclosedRecording: failed to stat recording: %v", err)
+	}
+
+	if err := os.Truncate(recording.path, info.Size()-5); err != nil {
+		t.Fatalf("TestReaderUnclosedRecording: failed to truncate recording: %v", err)
+	}
+
+	reader, err := Open(recording.path)
+
+	if err != nil {
+		t.Fatalf("TestReaderUnclosedRecording: failed to open recording: %v", err)
+	}
+
+	defer reader.Close()
+
+	if index := reader.Index(); len(index) != 10 {
+		t.Fatalf("TestReaderUnclosedRecording: expected 10 index entries, received %d", len(index))
+	}
+
+	records := readAllRecords(t, reader)
+
+	if len(records) != 101 {
+		t.Fatalf("TestReaderUnclosedRecording: expected 101 complete records, received %d", len(records))
+	}
+
+	if err := reader.SeekTime(recording.startTime + 6*ticks.PerSecond); err != nil {
+		t.Fatalf("TestReaderUnclosedRecording: failed to seek: %v", err)
+	}
+
+	if records = readAllRecords(t, reader); len(records) != 41 || len(records[2].Measurements) != 3 {
+		t.Fatalf("TestReaderUnclosedRecording: unexpected records after seek")
+	}
+}
+
+func TestReaderInvalidRecording(t *testing.T) {
+	if _, err := NewReader(bytes.NewReader([]byte("not a recording file"))); err != ErrInvalidRecording {
+		t.Fatalf("TestReaderInvalidRecording: expected ErrInvalidRecording, received %v", err)
+	}
+}
+
+func TestWriterClosed(t *testing.T) {
+	var buffer bytes.Buffer
+	writer, err := NewWriter(&buffer)
+
+	if err != nil {
+		t.Fatalf("TestWriterClosed: failed to create writer: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("TestWriterClosed: failed to close writer: %v", err)
+	}
+
+	measurements := []transport.Measurement{{SignalID: guid.New()}}
+
+	if err := writer.WriteMeasurements(0, measurements); err != ErrWriterClosed {
+		t.Fatalf("TestWriterClosed: expected ErrWriterClosed, received %v", err)
+	}
+
+	reader, err := NewReader(bytes.NewReader(buffer.Bytes()))
+
+	if err != nil {
+		t.Fatalf("TestWriterClosed: failed to read empty recording: %v", err)
+	}
+
+	if _, err := reader.Next(); err != io.EOF {
+		t.Fatalf("TestWriterClosed: expected io.EOF for empty recording, received %v", err)
+	}
+}
@@ -0,0 +1,295 @@
+//******************************************************************************************************
+//  Writer.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"sync"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport"
+)
+
+// Writer writes an STTP recording. Writer methods are safe for concurrent use.
+type Writer struct {
+	writer  *bufio.Writer
+	closer  io.Closer
+	offset  int64
+	signals map[guid.Guid]uint32
+	index   *indexBuilder
+	buffer  []byte
+	closed  bool
+	mutex   sync.Mutex
+}
+
+// NewWriter creates a new Writer that writes a recording to the specified io.Writer.
+// If writer is an io.Closer, it will be closed when the Writer is closed.
+func NewWriter(writer io.Writer) (*Writer, error) {
+	rw := &Writer{
+		writer:  bufio.NewWriter(writer),
+		signals: make(map[guid.Guid]uint32),
+		index:   newIndexBuilder(),
+	}
+
+	if closer, ok := writer.(io.Closer); ok {
+		rw.closer = closer
+	}
+
+	header := make([]byte, headerSize)
+	copy(header, headerMagic[:])
+	binary.BigEndian.PutUint16(header[8:], Version)
+
+	if err := rw.write(header); err != nil {
+		return nil, err
+	}
+
+	return rw, nil
+}
+
+// Create creates, or truncates, the named file and returns a Writer that writes a recording to it.
+func Create(path string) (*Writer, error) {
+	file, err := os.Create(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	rw, err := NewWriter(file)
+
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return rw, nil
+}
+
+// WriteMetadata writes received metadata XML to the recording.
+func (rw *Writer) WriteMetadata(time ticks.Ticks, metadata []byte) error {
+	var payload bytes.Buffer
+	compressor := gzip.NewWriter(&payload)
+
+	if _, err := compressor.Write(metadata); err != nil {
+		return err
+	}
+
+	if err := compressor.Close(); err != nil {
+		return err
+	}
+
+	rw.mutex.Lock()
+	defer rw.mutex.Unlock()
+
+	return rw.writeRecord(RecordType.Metadata, time, payload.Bytes())
+}
+
+// WriteSignalIndexCache writes a received signal index cache to the recording.
+func (rw *Writer) WriteSignalIndexCache(time ticks.Ticks, signalIndexCache *transport.SignalIndexCache) error {
+	signalIndexes := signalIndexCache.SignalIndexes()
+	payload := binary.BigEndian.AppendUint32(nil, uint32(len(signalIndexes)))
+
+	for _, signalIndex := range signalIndexes {
+		signalID, source, id, _ := signalIndexCache.Record(signalIndex)
+
+		if len(source) > math.MaxUint16 {
+			source = source[:math.MaxUint16]
+		}
+
+		payload = binary.BigEndian.AppendUint32(payload, uint32(signalIndex))
+		payload = append(payload, signalID[:]...)
+		payload = binary.BigEndian.AppendUint64(payload, id)
+		payload = binary.BigEndian.AppendUint16(payload, uint16(len(source)))
+		payload = append(payload, source...)
+	}
+
+	rw.mutex.Lock()
+	defer rw.mutex.Unlock()
+
+	return rw.writeRecord(RecordType.SignalIndexCache, time, payload)
+}
+
+// WriteMeasurements writes a batch of received measurements to the recording.
+func (rw *Writer) WriteMeasurements(time ticks.Ticks, measurements []transport.Measurement) error {
+	if len(measurements) == 0 {
+		return nil
+	}
+
+	rw.mutex.Lock()
+	defer rw.mutex.Unlock()
+
+	if rw.closed {
+		return ErrWriterClosed
+	}
+
+	// Define any signals not seen before so that measurements can refer to them by recording ID
+	definitions := rw.buffer[:0]
+	defined := 0
+
+	for i := range measurements {
+		signalID := measurements[i].SignalID
+
+		if _, ok := rw.signals[signalID]; ok {
+			continue
+		}
+
+		if defined == 0 {
+			definitions = binary.BigEndian.AppendUint32(definitions, 0)
+		}
+
+		recordingID := uint32(len(rw.signals))
+		rw.signals[signalID] = recordingID
+		definitions = binary.BigEndian.AppendUint32(definitions, recordingID)
+		definitions = append(definitions, signalID[:]...)
+		defined++
+	}
+
+	if defined > 0 {
+		binary.BigEndian.PutUint32(definitions, uint32(defined))
+
+		if err := rw.writeRecord(signalDefinitionsRecordType, time, definitions); err != nil {
+			return err
+		}
+	}
+
+	payload := binary.BigEndian.AppendUint32(definitions[:0], uint32(len(measurements)))
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		payload = binary.BigEndian.AppendUint32(payload, rw.signals[measurement.SignalID])
+		payload = binary.BigEndian.AppendUint64(payload, uint64(measurement.Timestamp))
+		payload = binary.BigEndian.AppendUint32(payload, uint32(measurement.Flags))
+		payload = binary.BigEndian.AppendUint64(payload, math.Float64bits(measurement.Value))
+	}
+
+	rw.buffer = payload
+
+	return rw.writeRecord(RecordType.Measurements, time, payload)
+}
+
+// Flush writes any buffered records to the underlying io.Writer.
+func (rw *Writer) Flush() error {
+	rw.mutex.Lock()
+	defer rw.mutex.Unlock()
+
+	if rw.closed {
+		return ErrWriterClosed
+	}
+
+	return rw.writer.Flush()
+}
+
+// Close writes the recording index, flushes buffered records and closes the underlying io.Writer
+// when it is an io.Closer.
+func (rw *Writer) Close() error {
+	rw.mutex.Lock()
+	defer rw.mutex.Unlock()
+
+	if rw.closed {
+		return nil
+	}
+
+	rw.closed = true
+	indexOffset := rw.offset
+
+	// Index record includes full signal table so that readers can seek without scanning
+	payload := binary.BigEndian.AppendUint32(nil, uint32(len(rw.signals)))
+	signalIDs := make([]guid.Guid, len(rw.signals))
+
+	for signalID, recordingID := range rw.signals {
+		signalIDs[recordingID] = signalID
+	}
+
+	for i := range signalIDs {
+		payload = append(payload, signalIDs[i][:]...)
+	}
+
+	payload = binary.BigEndian.AppendUint32(payload, uint32(len(rw.index.entries)))
+
+	for _, entry := range rw.index.entries {
+		payload = binary.BigEndian.AppendUint64(payload, uint64(entry.Time))
+		payload = binary.BigEndian.AppendUint64(payload, uint64(entry.Offset))
+		payload = binary.BigEndian.AppendUint64(payload, uint64(entry.MetadataOffset))
+		payload = binary.BigEndian.AppendUint64(payload, uint64(entry.SignalIndexCacheOffset))
+	}
+
+	err := rw.writeRecordHeader(indexRecordType, 0, payload)
+
+	if err == nil {
+		err = rw.write(payload)
+	}
+
+	if err == nil {
+		trailer := binary.BigEndian.AppendUint64(nil, uint64(indexOffset))
+		err = rw.write(append(trailer, trailerMagic[:]...))
+	}
+
+	if err == nil {
+		err = rw.writer.Flush()
+	}
+
+	if rw.closer != nil {
+		if closeErr := rw.closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+func (rw *Writer) writeRecord(recordType RecordTypeEnum, time ticks.Ticks, payload []byte) error {
+	if rw.closed {
+		return ErrWriterClosed
+	}
+
+	rw.index.add(recordType, time, rw.offset)
+
+	if err := rw.writeRecordHeader(recordType, time, payload); err != nil {
+		return err
+	}
+
+	return rw.write(payload)
+}
+
+func (rw *Writer) writeRecordHeader(recordType RecordTypeEnum, time ticks.Ticks, payload []byte) error {
+	var header [recordHeaderSize]byte
+
+	header[0] = byte(recordType)
+	binary.BigEndian.PutUint64(header[1:], uint64(time))
+	binary.BigEndian.PutUint32(header[9:], uint32(len(payload)))
+
+	return rw.write(header[:])
+}
+
+func (rw *Writer) write(data []byte) error {
+	written, err := rw.writer.Write(data)
+	rw.offset += int64(written)
+	return err
+}
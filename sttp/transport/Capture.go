@@ -0,0 +1,349 @@
+//******************************************************************************************************
+//  Capture.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// A capture file holds the raw STTP frames sent and received by a DataSubscriber. A 16 byte file header,
+// i.e., an 8 byte magic value, a 2 byte version and 6 reserved bytes, is followed by frames. Each frame has
+// a 14 byte header, i.e., an 8 byte timestamp, a 1 byte direction, a 1 byte channel and a 4 byte length,
+// followed by the frame data. All values are big-endian.
+const (
+	captureHeaderSize      = 16
+	captureFrameHeaderSize = 14
+
+	// CaptureVersion is the capture file format version written by this library.
+	CaptureVersion uint16 = 1
+)
+
+var captureMagic = [8]byte{'S', 'T', 'T', 'P', 'C', 'A', 'P', 0}
+
+// ErrInvalidCapture is the error returned when a capture file has an invalid header or frame length.
+var ErrInvalidCapture = errors.New("file is not an STTP capture")
+
+// ErrCaptureClosed is the error returned when writing to a CaptureWriter that has been closed.
+var ErrCaptureClosed = errors.New("capture writer is closed")
+
+// CaptureDirectionEnum defines the type of the CaptureDirection enumeration.
+type CaptureDirectionEnum byte
+
+// CaptureDirection is an enumeration of the possible directions of a captured frame.
+var CaptureDirection = struct {
+	// Received defines a frame received from the DataPublisher.
+	Received CaptureDirectionEnum
+	// Sent defines a frame sent to the DataPublisher.
+	Sent CaptureDirectionEnum
+}{
+	Received: 0,
+	Sent:     1,
+}
+
+// String gets the CaptureDirection enumeration value as a string.
+func (cde CaptureDirectionEnum) String() string {
+	switch cde {
+	case CaptureDirection.Received:
+		return "Received"
+	case CaptureDirection.Sent:
+		return "Sent"
+	default:
+		return "0x" + strconv.FormatInt(int64(cde), 16)
+	}
+}
+
+// CaptureChannelEnum defines the type of the CaptureChannel enumeration.
+type CaptureChannelEnum byte
+
+// CaptureChannel is an enumeration of the possible channels of a captured frame.
+var CaptureChannel = struct {
+	// Command defines a frame sent or received on the TCP command channel.
+	Command CaptureChannelEnum
+	// Data defines a frame received on the UDP data channel.
+	Data CaptureChannelEnum
+}{
+	Command: 0,
+	Data:    1,
+}
+
+// String gets the CaptureChannel enumeration value as a string.
+func (cce CaptureChannelEnum) String() string {
+	switch cce {
+	case CaptureChannel.Command:
+		return "Command"
+	case CaptureChannel.Data:
+		return "Data"
+	default:
+		return "0x" + strconv.FormatInt(int64(cce), 16)
+	}
+}
+
+// CaptureFrame represents a raw STTP frame sent or received by a DataSubscriber.
+type CaptureFrame struct {
+	// Time is the local time the frame was sent or received.
+	Time ticks.Ticks
+
+	// Direction is the direction of the frame.
+	Direction CaptureDirectionEnum
+
+	// Channel is the channel on which the frame was sent or received.
+	Channel CaptureChannelEnum
+
+	// Data is the frame data excluding the payload header, i.e., for received frames, the response code,
+	// command code, payload size and payload, and for sent frames, the command code and payload.
+	Data []byte
+}
+
+// CaptureWriter writes raw STTP frames to a capture file. CaptureWriter methods are safe for concurrent use.
+type CaptureWriter struct {
+	writer *bufio.Writer
+	closer io.Closer
+	closed bool
+	mutex  sync.Mutex
+}
+
+// NewCaptureWriter creates a new CaptureWriter that writes a capture to the specified io.Writer.
+// If writer is an io.Closer, it will be closed when the CaptureWriter is closed.
+func NewCaptureWriter(writer io.Writer) (*CaptureWriter, error) {
+	cw := &CaptureWriter{writer: bufio.NewWriter(writer)}
+
+	if closer, ok := writer.(io.Closer); ok {
+		cw.closer = closer
+	}
+
+	header := make([]byte, captureHeaderSize)
+	copy(header, captureMagic[:])
+	binary.BigEndian.PutUint16(header[8:], CaptureVersion)
+
+	if _, err := cw.writer.Write(header); err != nil {
+		return nil, err
+	}
+
+	return cw, nil
+}
+
+// CreateCapture creates, or truncates, the named file and returns a CaptureWriter that writes a capture to it.
+func CreateCapture(path string) (*CaptureWriter, error) {
+	file, err := os.Create(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cw, err := NewCaptureWriter(file)
+
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return cw, nil
+}
+
+// WriteFrame writes a frame to the capture.
+func (cw *CaptureWriter) WriteFrame(frame *CaptureFrame) error {
+	var header [captureFrameHeaderSize]byte
+
+	binary.BigEndian.PutUint64(header[:], uint64(frame.Time))
+	header[8] = byte(frame.Direction)
+	header[9] = byte(frame.Channel)
+	binary.BigEndian.PutUint32(header[10:], uint32(len(frame.Data)))
+
+	cw.mutex.Lock()
+	defer cw.mutex.Unlock()
+
+	if cw.closed {
+		return ErrCaptureClosed
+	}
+
+	if _, err := cw.writer.Write(header[:]); err != nil {
+		return err
+	}
+
+	_, err := cw.writer.Write(frame.Data)
+	return err
+}
+
+// Flush writes any buffered frames to the underlying io.Writer.
+func (cw *CaptureWriter) Flush() error {
+	cw.mutex.Lock()
+	defer cw.mutex.Unlock()
+
+	if cw.closed {
+		return ErrCaptureClosed
+	}
+
+	return cw.writer.Flush()
+}
+
+// Close flushes buffered frames and closes the underlying io.Writer when it is an io.Closer.
+func (cw *CaptureWriter) Close() error {
+	cw.mutex.Lock()
+	defer cw.mutex.Unlock()
+
+	if cw.closed {
+		return nil
+	}
+
+	cw.closed = true
+	err := cw.writer.Flush()
+
+	if cw.closer != nil {
+		if closeErr := cw.closer.Close(); err == nil {
+			err = closeErr
+		}
+	}
+
+	return err
+}
+
+// CaptureReader reads raw STTP frames from a capture file.
+type CaptureReader struct {
+	reader *bufio.Reader
+	closer io.Closer
+	header [captureFrameHeaderSize]byte
+}
+
+// NewCaptureReader creates a new CaptureReader that reads a capture from the specified io.Reader.
+// If reader is an io.Closer, it will be closed when the CaptureReader is closed.
+func NewCaptureReader(reader io.Reader) (*CaptureReader, error) {
+	cr := &CaptureReader{reader: bufio.NewReader(reader)}
+
+	if closer, ok := reader.(io.Closer); ok {
+		cr.closer = closer
+	}
+
+	header := make([]byte, captureHeaderSize)
+
+	if _, err := io.ReadFull(cr.reader, header); err != nil || !bytes.Equal(header[:8], captureMagic[:]) {
+		return nil, ErrInvalidCapture
+	}
+
+	if version := binary.BigEndian.Uint16(header[8:]); version > CaptureVersion {
+		return nil, errors.New("unsupported capture version " + strconv.Itoa(int(version)))
+	}
+
+	return cr, nil
+}
+
+// OpenCapture opens the named capture file for reading.
+func OpenCapture(path string) (*CaptureReader, error) {
+	file, err := os.Open(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cr, err := NewCaptureReader(file)
+
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return cr, nil
+}
+
+// Next reads the next frame from the capture. Returns io.EOF when no more frames remain. An incomplete
+// final frame, e.g., from a capture that was not closed, is treated as the end of the capture.
+func (cr *CaptureReader) Next() (*CaptureFrame, error) {
+	if _, err := io.ReadFull(cr.reader, cr.header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, io.EOF
+		}
+
+		return nil, err
+	}
+
+	frame := &CaptureFrame{
+		Time:      ticks.Ticks(binary.BigEndian.Uint64(cr.header[:])),
+		Direction: CaptureDirectionEnum(cr.header[8]),
+		Channel:   CaptureChannelEnum(cr.header[9]),
+	}
+
+	length := binary.BigEndian.Uint32(cr.header[10:])
+
+	// Frames received on the data channel never exceed the maximum packet size
+	if length > maxPacketSize && frame.Channel == CaptureChannel.Data {
+		return nil, ErrInvalidCapture
+	}
+
+	var err error
+
+	if frame.Data, err = readCaptureData(cr.reader, length); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, io.EOF
+		}
+
+		return nil, err
+	}
+
+	return frame, nil
+}
+
+// readCaptureData reads length bytes of frame data. Command frames, e.g., metadata responses, can exceed the
+// maximum packet size, so larger frames are read incrementally such that a corrupt length cannot force an
+// allocation beyond the size of the data actually available.
+func readCaptureData(reader io.Reader, length uint32) ([]byte, error) {
+	if length <= maxPacketSize {
+		data := make([]byte, length)
+
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+
+		return data, nil
+	}
+
+	var buffer bytes.Buffer
+	buffer.Grow(maxPacketSize)
+
+	if _, err := io.CopyN(&buffer, reader, int64(length)); err != nil {
+		if err == io.EOF {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// Close closes the underlying io.Reader when it is an io.Closer.
+func (cr *CaptureReader) Close() error {
+	if cr.closer != nil {
+		return cr.closer.Close()
+	}
+
+	return nil
+}
@@ -0,0 +1,413 @@
+//******************************************************************************************************
+//  CaptureDecoder.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport/tssc"
+)
+
+// ErrMalformedFrame is the error returned when a captured frame is too short for its contents to be decoded.
+var ErrMalformedFrame = errors.New("malformed frame")
+
+// DecodedFrame represents a captured frame decoded by a CaptureDecoder.
+type DecodedFrame struct {
+	// Frame is the captured frame.
+	Frame *CaptureFrame
+
+	// Command is the command code of a sent frame, or the command code a received response relates to.
+	Command ServerCommandEnum
+
+	// Response is the response code of a received frame.
+	Response ServerResponseEnum
+
+	// Payload is the frame payload following the command or response header.
+	Payload []byte
+
+	// Message is the decoded text of the frame, e.g., a response message or subscription connection
+	// string, if any.
+	Message string
+
+	// OperationalModes are the operational modes of a sent DefineOperationalModes command.
+	OperationalModes OperationalModesEnum
+
+	// DataPacketFlags are the flags of a received data packet.
+	DataPacketFlags DataPacketFlagsEnum
+
+	// Measurements are the measurements decoded from a received data packet.
+	Measurements []Measurement
+
+	// SignalIndexCache is the signal index cache decoded from a received signal index cache update.
+	SignalIndexCache *SignalIndexCache
+
+	// Metadata is the metadata XML decoded from a received metadata refresh response.
+	Metadata []byte
+
+	// Err is the error, if any, encountered while decoding the frame.
+	Err error
+}
+
+// CaptureDecoder decodes the frames of a capture, in order, into readable form. Decoding of measurements
+// depends on session state, e.g., operational modes, subscription settings, base time offsets, cipher keys,
+// signal index caches and TSSC decoder state, which is tracked from previously decoded frames.
+type CaptureDecoder struct {
+	// SwapGuidEndianness determines if Guid wire serialization should swap endianness, see DataSubscriber.
+	SwapGuidEndianness bool
+
+	// Detached DataSubscriber used to hold and parse session state
+	ds *DataSubscriber
+
+	includeTime              bool
+	useMillisecondResolution bool
+}
+
+// NewCaptureDecoder creates a new CaptureDecoder. Until a DefineOperationalModes command is decoded,
+// the operational modes default to those of a new DataSubscriber.
+func NewCaptureDecoder() *CaptureDecoder {
+	return &CaptureDecoder{
+		ds:          NewDataSubscriber(),
+		includeTime: true,
+	}
+}
+
+// Decode decodes a captured frame. Decoding errors are reported in the Err field of the DecodedFrame.
+func (cd *CaptureDecoder) Decode(frame *CaptureFrame) *DecodedFrame {
+	decoded := &DecodedFrame{Frame: frame}
+	cd.ds.SwapGuidEndianness = cd.SwapGuidEndianness
+
+	if frame.Direction == CaptureDirection.Sent {
+		cd.decodeCommand(decoded)
+	} else {
+		cd.decodeResponse(decoded)
+	}
+
+	return decoded
+}
+
+func (cd *CaptureDecoder) decodeCommand(decoded *DecodedFrame) {
+	data := decoded.Frame.Data
+
+	if len(data) < 1 {
+		decoded.Err = ErrMalformedFrame
+		return
+	}
+
+	decoded.Command = ServerCommandEnum(data[0])
+	decoded.Payload = data[1:]
+	payload := decoded.Payload
+	ds := cd.ds
+
+	switch decoded.Command {
+	case ServerCommand.DefineOperationalModes:
+		if len(payload) < 4 {
+			decoded.Err = ErrMalformedFrame
+			return
+		}
+
+		operationalModes := OperationalModesEnum(binary.BigEndian.Uint32(payload))
+		version := byte(operationalModes & OperationalModes.VersionMask)
+
+		// Pre-standard versions of STTP, i.e., less than 10, include compression modes within the version mask
+		if version&legacyVersionMask < 10 {
+			version &= legacyVersionMask
+		}
+
+		decoded.OperationalModes = operationalModes
+		ds.Version = version
+		ds.CompressMetadata = operationalModes&OperationalModes.CompressMetadata > 0
+		ds.CompressSignalIndexCache = operationalModes&OperationalModes.CompressSignalIndexCache > 0
+	case ServerCommand.Subscribe:
+		if len(payload) < 5 {
+			decoded.Err = ErrMalformedFrame
+			return
+		}
+
+		length := binary.BigEndian.Uint32(payload[1:])
+
+		if uint64(length) > uint64(len(payload)-5) {
+			decoded.Err = ErrMalformedFrame
+			return
+		}
+
+		decoded.Message = ds.DecodeString(payload[5 : 5+length])
+
+		if subscription, err := parseSubscriptionInfo(parseKeyValuePairs(decoded.Message)); err == nil {
+			cd.includeTime = subscription.IncludeTime
+			cd.useMillisecondResolution = subscription.UseMillisecondResolution
+		} else {
+			decoded.Err = err
+		}
+	case ServerCommand.MetadataRefresh, ServerCommand.UserCommand00:
+		if len(payload) > 0 {
+			decoded.Message = ds.DecodeString(payload)
+		}
+	}
+}
+
+func (cd *CaptureDecoder) decodeResponse(decoded *DecodedFrame) {
+	data := decoded.Frame.Data
+
+	if len(data) < responseHeaderSize {
+		decoded.Err = ErrMalformedFrame
+		return
+	}
+
+	decoded.Response = ServerResponseEnum(data[0])
+	decoded.Command = ServerCommandEnum(data[1])
+	decoded.Payload = data[responseHeaderSize:]
+	payload := decoded.Payload
+	ds := cd.ds
+
+	switch decoded.Response {
+	case ServerResponse.Succeeded:
+		if decoded.Command == ServerCommand.MetadataRefresh {
+			decoded.Metadata = payload
+
+			if ds.CompressMetadata {
+				var err error
+
+				if decoded.Metadata, err = decompressGZip(payload); err != nil {
+					decoded.Err = fmt.Errorf("%w: %w", ErrMetadataDecompression, err)
+				}
+			}
+		} else {
+			decoded.Message = ds.DecodeString(payload)
+		}
+	case ServerResponse.Failed, ServerResponse.ProcessingComplete:
+		decoded.Message = ds.DecodeString(payload)
+	case ServerResponse.Notify:
+		if len(payload) < 4 {
+			decoded.Err = ErrMalformedFrame
+			return
+		}
+
+		decoded.Message = ds.DecodeString(payload[4:])
+	case ServerResponse.DataStartTime:
+		if len(payload) < 8 {
+			decoded.Err = ErrMalformedFrame
+			return
+		}
+
+		decoded.Message = ticks.Ticks(binary.BigEndian.Uint64(payload)).String()
+	case ServerResponse.DataPacket:
+		cd.decodeDataPacket(decoded)
+	case ServerResponse.UpdateSignalIndexCache:
+		cd.decodeSignalIndexCache(decoded)
+	case ServerResponse.UpdateBaseTimes:
+		if len(payload) < 20 {
+			decoded.Err = ErrMalformedFrame
+			return
+		}
+
+		ds.handleUpdateBaseTimes(payload)
+		decoded.Message = "time index " + strconv.Itoa(int(ds.timeIndex)) + ", base times " +
+			ticks.Ticks(ds.baseTimeOffsets[0]).String() + " / " + ticks.Ticks(ds.baseTimeOffsets[1]).String()
+	case ServerResponse.UpdateCipherKeys:
+		keyIVs, err := parseCipherKeys(payload)
+
+		if err != nil {
+			decoded.Err = fmt.Errorf("%w: %w", ErrMalformedFrame, err)
+			return
+		}
+
+		ds.keyIVs = keyIVs
+		decoded.Message = "cipher keys updated"
+	}
+}
+
+func (cd *CaptureDecoder) decodeSignalIndexCache(decoded *DecodedFrame) {
+	ds := cd.ds
+	data := decoded.Payload
+	var cacheIndex int32
+
+	if ds.Version > 1 {
+		if len(data) < 1 {
+			decoded.Err = ErrMalformedFrame
+			return
+		}
+
+		if data[0] > 0 {
+			cacheIndex = 1
+		}
+
+		data = data[1:]
+	}
+
+	if ds.CompressSignalIndexCache {
+		var err error
+
+		if data, err = decompressGZip(data); err != nil {
+			decoded.Err = fmt.Errorf("%w: %w", ErrSignalIndexCache, err)
+			return
+		}
+	}
+
+	signalIndexCache := NewSignalIndexCache()
+
+	if err := signalIndexCache.decode(ds, data, &ds.subscriberID); err != nil {
+		decoded.Err = fmt.Errorf("%w: %w", ErrSignalIndexCache, err)
+		return
+	}
+
+	ds.signalIndexCache[cacheIndex] = signalIndexCache
+	decoded.Message = "cache index " + strconv.Itoa(int(cacheIndex))
+	decoded.SignalIndexCache = signalIndexCache
+}
+
+func (cd *CaptureDecoder) decodeDataPacket(decoded *DecodedFrame) {
+	ds := cd.ds
+	data := decoded.Payload
+
+	if len(data) < 1 {
+		decoded.Err = ErrMalformedFrame
+		return
+	}
+
+	dataPacketFlags := DataPacketFlagsEnum(data[0])
+	decoded.DataPacketFlags = dataPacketFlags
+	data = data[1:]
+
+	if dataPacketFlags&(DataPacketFlags.Compressed|DataPacketFlags.Compact) == 0 {
+		decoded.Err = ErrUnsupportedDataPacket
+		return
+	}
+
+	if ds.keyIVs != nil {
+		var cipherIndex int
+		var err error
+
+		if dataPacketFlags&DataPacketFlags.CipherIndex > 0 {
+			cipherIndex = 1
+		}
+
+		if data, err = decipherAES(ds.keyIVs[cipherIndex][keyIndex], ds.keyIVs[cipherIndex][ivIndex], data); err != nil {
+			decoded.Err = fmt.Errorf("%w: %w", ErrDecryptionFailed, err)
+			return
+		}
+	}
+
+	if len(data) < 4 {
+		decoded.Err = ErrMalformedFrame
+		return
+	}
+
+	count := binary.BigEndian.Uint32(data)
+	data = data[4:]
+	var cacheIndex int
+
+	if dataPacketFlags&DataPacketFlags.CacheIndex > 0 {
+		cacheIndex = 1
+	}
+
+	signalIndexCache := ds.signalIndexCache[cacheIndex]
+	// Count is not trusted for allocation, so initial capacity is also limited by the size of the data
+	decoded.Measurements = make([]Measurement, 0, min(count, uint32(len(data)/compactMeasurementMinSize)))
+
+	if dataPacketFlags&DataPacketFlags.Compressed > 0 {
+		decoded.Err = cd.decodeTSSCMeasurements(decoded, signalIndexCache, data)
+	} else {
+		decoded.Err = cd.decodeCompactMeasurements(decoded, signalIndexCache, data, count)
+	}
+}
+
+func (cd *CaptureDecoder) decodeTSSCMeasurements(decoded *DecodedFrame, signalIndexCache *SignalIndexCache, data []byte) error {
+	if len(data) < 3 {
+		return ErrMalformedFrame
+	}
+
+	if data[0] != tssc.Version {
+		return fmt.Errorf("%w: received version %d", ErrUnsupportedTSSCVersion, data[0])
+	}
+
+	decoder := signalIndexCache.tsscDecoder
+	sequenceNumber := binary.BigEndian.Uint16(data[1:])
+
+	if decoder == nil || sequenceNumber == 0 {
+		decoder = tssc.NewDecoder()
+		signalIndexCache.tsscDecoder = decoder
+	}
+
+	if decoder.SequenceNumber != sequenceNumber {
+		return fmt.Errorf("%w: expecting %d, received %d", ErrTSSCOutOfSequence, decoder.SequenceNumber, sequenceNumber)
+	}
+
+	decoder.SetBuffer(data[3:])
+
+	var id int32
+	var timestamp int64
+	var stateFlags uint32
+	var value float32
+
+	for {
+		ok, err := decoder.TryGetMeasurement(&id, &timestamp, &stateFlags, &value)
+
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrMeasurementParse, err)
+		}
+
+		if !ok {
+			break
+		}
+
+		decoded.Measurements = append(decoded.Measurements, Measurement{
+			SignalID:  signalIndexCache.SignalID(id),
+			Value:     float64(value),
+			Timestamp: ticks.Ticks(timestamp),
+			Flags:     StateFlagsEnum(stateFlags),
+		})
+	}
+
+	decoder.SequenceNumber++
+
+	// Do not increment to 0 on roll-over
+	if decoder.SequenceNumber == 0 {
+		decoder.SequenceNumber = 1
+	}
+
+	return nil
+}
+
+func (cd *CaptureDecoder) decodeCompactMeasurements(decoded *DecodedFrame, signalIndexCache *SignalIndexCache, data []byte, count uint32) error {
+	if signalIndexCache.Count() == 0 {
+		return ErrSignalIndexCacheMissing
+	}
+
+	for i := uint32(0); i < count; i++ {
+		cm, n, err := NewCompactMeasurement(cd.includeTime, cd.useMillisecondResolution, &cd.ds.baseTimeOffsets, data)
+
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrMeasurementParse, err)
+		}
+
+		data = data[n:]
+		decoded.Measurements = append(decoded.Measurements, cm.Expand(signalIndexCache))
+	}
+
+	return nil
+}
@@ -0,0 +1,353 @@
+//******************************************************************************************************
+//  Capture_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/internal/transporttest"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+// failingWriter is an io.Writer that always fails
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestCaptureDecodeCompact(t *testing.T) {
+	testCaptureDecode(t, false)
+}
+
+func TestCaptureDecodeTSSC(t *testing.T) {
+	testCaptureDecode(t, true)
+}
+
+func testCaptureDecode(t *testing.T, compressPayloadData bool) {
+	publisher := NewDataPublisher()
+	metadata := transporttest.StartPublisher(t, publisher)
+	defer publisher.Dispose()
+
+	subscriber := NewDataSubscriber()
+	subscriber.CompressPayloadData = compressPayloadData
+	defer subscriber.Dispose()
+
+	var buffer bytes.Buffer
+	captureWriter, err := NewCaptureWriter(&buffer)
+
+	if err != nil {
+		t.Fatalf("TestCaptureDecode: failed to create capture writer: %s", err.Error())
+	}
+
+	subscriber.SetCaptureWriter(captureWriter)
+
+	received := make(chan []Measurement, 10)
+	receivedMetadata := make(chan []byte, 1)
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.MetadataReceivedCallback = func(metadata []byte) {
+		receivedMetadata <- metadata
+	}
+	subscriber.NewMeasurementsCallback = func(measurements *[]Measurement) {
+		received <- append([]Measurement(nil), *measurements...)
+	}
+	subscriber.EndCallbackAssignment()
+
+	connection := connectTestSubscriber(t, publisher, subscriber)
+	subscriber.SendServerCommand(ServerCommand.MetadataRefresh)
+
+	select {
+	case <-receivedMetadata:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestCaptureDecode: timed out waiting for metadata")
+	}
+
+	subscriber.Subscription().FilterExpression = "FILTER ActiveMeasurements WHERE SignalType = 'FREQ'"
+
+	if err := subscriber.Subscribe(); err != nil {
+		t.Fatalf("TestCaptureDecode: failed to subscribe: %s", err.Error())
+	}
+
+	waitFor(t, "signal index cache confirmation", func() bool {
+		return connection.ActiveSignalIndexCache() != nil && subscriber.IsSubscribed()
+	})
+
+	freqID, _ := guid.Parse(freqSignalID)
+	now := ticks.UtcNow()
+
+	for i := 0; i < 2; i++ {
+		publisher.PublishMeasurements([]Measurement{
+			{SignalID: freqID, Value: 59.95 + float64(i)/100, Timestamp: now + ticks.Ticks(i)*ticks.PerSecond/30},
+		})
+
+		select {
+		case <-received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("TestCaptureDecode: timed out waiting for measurements")
+		}
+	}
+
+	subscriber.SetCaptureWriter(nil)
+
+	if err := captureWriter.Close(); err != nil {
+		t.Fatalf("TestCaptureDecode: failed to close capture writer: %s", err.Error())
+	}
+
+	captureReader, err := NewCaptureReader(bytes.NewReader(buffer.Bytes()))
+
+	if err != nil {
+		t.Fatalf("TestCaptureDecode: failed to read capture: %s", err.Error())
+	}
+
+	decoder := NewCaptureDecoder()
+	var sentCommands []ServerCommandEnum
+	var measurements []Measurement
+	var signalIndexCache *SignalIndexCache
+	var decodedMetadata []byte
+	var connectionString string
+
+	for {
+		frame, err := captureReader.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("TestCaptureDecode: failed to read frame: %s", err.Error())
+		}
+
+		decoded := decoder.Decode(frame)
+
+		if decoded.Err != nil {
+			t.Fatalf("TestCaptureDecode: failed to decode %s %s frame: %s", frame.Direction, decoded.Response, decoded.Err.Error())
+		}
+
+		if frame.Direction == CaptureDirection.Sent {
+			sentCommands = append(sentCommands, decoded.Command)
+
+			if decoded.Command == ServerCommand.Subscribe {
+				connectionString = decoded.Message
+			}
+
+			continue
+		}
+
+		switch decoded.Response {
+		case ServerResponse.DataPacket:
+			if compressed := decoded.DataPacketFlags&DataPacketFlags.Compressed > 0; compressed != compressPayloadData {
+				t.Fatalf("TestCaptureDecode: unexpected data packet flags %d", decoded.DataPacketFlags)
+			}
+
+			measurements = append(measurements, decoded.Measurements...)
+		case ServerResponse.UpdateSignalIndexCache:
+			signalIndexCache = decoded.SignalIndexCache
+		case ServerResponse.Succeeded:
+			if decoded.Command == ServerCommand.MetadataRefresh {
+				decodedMetadata = decoded.Metadata
+			}
+		}
+	}
+
+	if len(sentCommands) < 3 || sentCommands[0] != ServerCommand.DefineOperationalModes || sentCommands[1] != ServerCommand.MetadataRefresh || sentCommands[2] != ServerCommand.Subscribe {
+		t.Fatalf("TestCaptureDecode: unexpected sent commands: %v", sentCommands)
+	}
+
+	if !strings.Contains(connectionString, "SignalType = 'FREQ'") {
+		t.Fatalf("TestCaptureDecode: unexpected subscribe connection string: %s", connectionString)
+	}
+
+	if !bytes.Equal(decodedMetadata, metadata) {
+		t.Fatalf("TestCaptureDecode: decoded metadata does not match defined metadata")
+	}
+
+	if signalIndexCache == nil || signalIndexCache.SignalIndex(freqID) < 0 {
+		t.Fatalf("TestCaptureDecode: expected decoded signal index cache to contain frequency signal")
+	}
+
+	if len(measurements) != 2 {
+		t.Fatalf("TestCaptureDecode: expected 2 decoded measurements, received %d", len(measurements))
+	}
+
+	for i, measurement := range measurements {
+		if measurement.SignalID != freqID || float32(measurement.Value) != float32(59.95+float64(i)/100) || measurement.Timestamp != now+ticks.Ticks(i)*ticks.PerSecond/30 {
+			t.Fatalf("TestCaptureDecode: unexpected decoded measurement: %s", measurement.String())
+		}
+	}
+}
+
+func TestCaptureTruncated(t *testing.T) {
+	var buffer bytes.Buffer
+	captureWriter, _ := NewCaptureWriter(&buffer)
+
+	for i := 0; i < 3; i++ {
+		captureWriter.WriteFrame(&CaptureFrame{Time: ticks.Ticks(i), Direction: CaptureDirection.Sent, Data: []byte{byte(ServerCommand.Subscribe), 1, 2, 3}})
+	}
+
+	captureWriter.Close()
+
+	// Truncate final frame as if capture was interrupted mid-write
+	captureReader, err := NewCaptureReader(bytes.NewReader(buffer.Bytes()[:buffer.Len()-2]))
+
+	if err != nil {
+		t.Fatalf("TestCaptureTruncated: failed to read capture: %s", err.Error())
+	}
+
+	var count int
+
+	for {
+		frame, err := captureReader.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("TestCaptureTruncated: unexpected error: %s", err.Error())
+		}
+
+		if frame.Time != ticks.Ticks(count) || len(frame.Data) != 4 {
+			t.Fatalf("TestCaptureTruncated: unexpected frame %d", count)
+		}
+
+		count++
+	}
+
+	if count != 2 {
+		t.Fatalf("TestCaptureTruncated: expected 2 complete frames, received %d", count)
+	}
+
+	if _, err := NewCaptureReader(strings.NewReader("not a capture file")); err != ErrInvalidCapture {
+		t.Fatalf("TestCaptureTruncated: expected ErrInvalidCapture, received %v", err)
+	}
+}
+
+func TestCaptureFrameLength(t *testing.T) {
+	var buffer bytes.Buffer
+	captureWriter, _ := NewCaptureWriter(&buffer)
+
+	// Received command frames, e.g., metadata, can exceed the maximum packet size
+	metadata := make([]byte, 3*maxPacketSize)
+	captureWriter.WriteFrame(&CaptureFrame{Direction: CaptureDirection.Received, Channel: CaptureChannel.Command, Data: metadata})
+	captureWriter.Close()
+
+	captureReader, _ := NewCaptureReader(bytes.NewReader(buffer.Bytes()))
+
+	if frame, err := captureReader.Next(); err != nil || len(frame.Data) != len(metadata) {
+		t.Fatalf("TestCaptureFrameLength: failed to read large command frame: %v", err)
+	}
+
+	header := make([]byte, captureHeaderSize+captureFrameHeaderSize)
+	copy(header, buffer.Bytes()[:captureHeaderSize])
+
+	frameHeader := header[captureHeaderSize:]
+	binary.BigEndian.PutUint32(frameHeader[10:], math.MaxUint32)
+
+	// Corrupt command frame length beyond available data is treated as an incomplete final frame
+	captureReader, _ = NewCaptureReader(bytes.NewReader(append(header, 1, 2, 3)))
+
+	if _, err := captureReader.Next(); err != io.EOF {
+		t.Fatalf("TestCaptureFrameLength: expected io.EOF for corrupt command frame length, received %v", err)
+	}
+
+	// Data channel frames cannot exceed the maximum packet size
+	frameHeader[9] = byte(CaptureChannel.Data)
+	captureReader, _ = NewCaptureReader(bytes.NewReader(append(header, 1, 2, 3)))
+
+	if _, err := captureReader.Next(); err != ErrInvalidCapture {
+		t.Fatalf("TestCaptureFrameLength: expected ErrInvalidCapture for corrupt data frame length, received %v", err)
+	}
+}
+
+func TestCaptureDecodeMalformed(t *testing.T) {
+	response := func(response ServerResponseEnum, command ServerCommandEnum, payload ...byte) *CaptureFrame {
+		data := []byte{byte(response), byte(command), 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(data[2:], uint32(len(payload)))
+		return &CaptureFrame{Direction: CaptureDirection.Received, Data: append(data, payload...)}
+	}
+
+	command := func(command ServerCommandEnum, payload ...byte) *CaptureFrame {
+		return &CaptureFrame{Direction: CaptureDirection.Sent, Data: append([]byte{byte(command)}, payload...)}
+	}
+
+	frames := []*CaptureFrame{
+		command(ServerCommand.DefineOperationalModes, 1, 2),
+		command(ServerCommand.Subscribe, 0, 0xFF, 0xFF, 0xFF, 0xFF, 'a'),
+		response(ServerResponse.Notify, ServerCommand.Subscribe, 1, 2),
+		response(ServerResponse.DataStartTime, ServerCommand.Subscribe, 1, 2, 3),
+		response(ServerResponse.UpdateBaseTimes, ServerCommand.Subscribe, 1, 2, 3, 4, 5),
+		response(ServerResponse.UpdateCipherKeys, ServerCommand.Subscribe, 0, 0xFF, 0xFF, 0xFF, 0xFF, 1),
+		response(ServerResponse.UpdateSignalIndexCache, ServerCommand.Subscribe),
+		response(ServerResponse.UpdateSignalIndexCache, ServerCommand.Subscribe, append([]byte{0, 0, 0, 0, 24}, append(make([]byte, 16), 0xFF, 0xFF, 0xFF, 0xFF)...)...),
+		response(ServerResponse.DataPacket, ServerCommand.Subscribe),
+		response(ServerResponse.DataPacket, ServerCommand.Subscribe, byte(DataPacketFlags.Compact), 0xFF, 0xFF),
+		response(ServerResponse.DataPacket, ServerCommand.Subscribe, byte(DataPacketFlags.Compact), 0xFF, 0xFF, 0xFF, 0xFF, 1, 2),
+		response(ServerResponse.DataPacket, ServerCommand.Subscribe, byte(DataPacketFlags.Compressed), 0xFF, 0xFF, 0xFF, 0xFF, 1),
+	}
+
+	decoder := NewCaptureDecoder()
+	decoder.ds.CompressSignalIndexCache = false
+	decoder.ds.signalIndexCache[0].addRecord(decoder.ds, 0, guid.New(), "", 0, 1)
+
+	for i, frame := range frames {
+		if decoded := decoder.Decode(frame); !errors.Is(decoded.Err, ErrMalformedFrame) && !errors.Is(decoded.Err, ErrSignalIndexCache) && !errors.Is(decoded.Err, ErrMeasurementParse) {
+			t.Fatalf("TestCaptureDecodeMalformed: expected error decoding frame %d, received %v", i, decoded.Err)
+		}
+	}
+}
+
+func TestCaptureWriteFailure(t *testing.T) {
+	subscriber := NewDataSubscriber()
+	events := make(chan Event, 10)
+	subscriber.EventChannel = events
+
+	captureWriter, _ := NewCaptureWriter(failingWriter{})
+	subscriber.SetCaptureWriter(captureWriter)
+
+	// Frames are buffered, so write enough data to force a flush to the failing writer
+	data := make([]byte, 8192)
+	subscriber.captureFrame(CaptureDirection.Received, CaptureChannel.Data, data)
+
+	select {
+	case event := <-events:
+		if event.Kind != EventKind.Capture || event.Severity != EventSeverity.Error {
+			t.Fatalf("TestCaptureWriteFailure: unexpected event: %s", event.Message)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestCaptureWriteFailure: timed out waiting for capture failure event")
+	}
+
+	if subscriber.captureWriter != nil {
+		t.Fatalf("TestCaptureWriteFailure: expected capture to stop after write failure")
+	}
+}
@@ -26,8 +26,11 @@ package transport
 import (
 	"bytes"
 	"compress/gzip"
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/tls"
+	"errors"
 	"io"
 	"net"
 	"strings"
@@ -41,6 +44,10 @@ func decipherAES(key, iv, data []byte) ([]byte, error) {
 		return nil, err
 	}
 
+	if len(iv) != block.BlockSize() || len(data)%block.BlockSize() != 0 {
+		return nil, errors.New("invalid initialization vector or data length for AES decryption")
+	}
+
 	mode := cipher.NewCBCDecrypter(block, iv)
 	out := make([]byte, len(data))
 	mode.CryptBlocks(out, data)
@@ -65,6 +72,69 @@ func decompressGZip(data []byte) ([]byte, error) {
 	return data, nil
 }
 
+func compressGZip(data []byte) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+
+	if _, err := writer.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// parseKeyValuePairs parses an STTP connection string, e.g., "key1=value1; key2={nested=value}", into a map
+// of lower-case keys to values. Values wrapped in braces are unwrapped one level, nested braces are preserved.
+func parseKeyValuePairs(connectionString string) map[string]string {
+	settings := make(map[string]string)
+	var pair strings.Builder
+	depth := 0
+
+	addPair := func() {
+		key, value, found := strings.Cut(pair.String(), "=")
+		pair.Reset()
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		if !found || len(key) == 0 {
+			return
+		}
+
+		value = strings.TrimSpace(value)
+
+		if len(value) > 1 && value[0] == '{' && value[len(value)-1] == '}' {
+			value = strings.TrimSpace(value[1 : len(value)-1])
+		}
+
+		settings[key] = value
+	}
+
+	for _, char := range connectionString {
+		switch char {
+		case '{':
+			depth++
+		case '}':
+			if depth > 0 {
+				depth--
+			}
+		case ';':
+			if depth == 0 {
+				addPair()
+				continue
+			}
+		}
+
+		pair.WriteRune(char)
+	}
+
+	addPair()
+
+	return settings
+}
+
 func resolveDNSName(addr string) string {
 	if strings.Contains(addr, ":") {
 		host, _, err := net.SplitHostPort(addr)
@@ -82,3 +152,39 @@ func resolveDNSName(addr string) string {
 
 	return addr
 }
+
+// tlsClientHandshake wraps an established TCP connection as a TLS client and completes the
+// handshake. When config does not define a ServerName, the host name is used to verify the
+// server certificate. The connection is closed when the handshake fails or ctx is canceled.
+func tlsClientHandshake(ctx context.Context, conn net.Conn, config *tls.Config, hostName string, address string) (net.Conn, error) {
+	if len(config.ServerName) == 0 && !config.InsecureSkipVerify {
+		config = config.Clone()
+		config.ServerName = hostName
+	}
+
+	return tlsHandshake(ctx, tls.Client(conn, config), address)
+}
+
+// tlsServerHandshake wraps an accepted TCP connection as a TLS server and completes the
+// handshake. The connection is closed when the handshake fails or ctx is canceled.
+func tlsServerHandshake(ctx context.Context, conn net.Conn, config *tls.Config) (net.Conn, error) {
+	address := "<unknown>"
+
+	if addr := conn.RemoteAddr(); addr != nil {
+		address = addr.String()
+	}
+
+	return tlsHandshake(ctx, tls.Server(conn, config), address)
+}
+
+func tlsHandshake(ctx context.Context, conn *tls.Conn, address string) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, tlsHandshakeTimeout)
+	defer cancel()
+
+	if err := conn.HandshakeContext(ctx); err != nil {
+		conn.Close()
+		return nil, &TLSHandshakeError{Address: address, Err: err}
+	}
+
+	return conn, nil
+}
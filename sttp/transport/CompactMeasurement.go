@@ -124,6 +124,9 @@ func (fullFlags StateFlagsEnum) mapToCompactFlags() compactStateFlagsEnum {
 	return compactFlags
 }
 
+// compactMeasurementMinSize is the size of a compact measurement without a timestamp.
+const compactMeasurementMinSize = 9
+
 // CompactMeasurement defines a measured value, in simple compact format, for transmission or reception in STTP.
 type CompactMeasurement struct {
 	Value       float32
@@ -136,7 +139,7 @@ type CompactMeasurement struct {
 func NewCompactMeasurement(includeTime, useMillisecondResolution bool, baseTimeOffsets *[2]int64, buffer []byte) (CompactMeasurement, int, error) {
 	var cm CompactMeasurement
 
-	if len(buffer) < 9 {
+	if len(buffer) < compactMeasurementMinSize {
 		return cm, 0, errors.New("not enough buffer available to deserialize compact measurement")
 	}
 
@@ -156,6 +159,20 @@ func NewCompactMeasurement(includeTime, useMillisecondResolution bool, baseTimeO
 		return cm, 9, nil
 	}
 
+	size := 17
+
+	if (cm.Flags & compactStateFlags.BaseTimeOffset) != 0 {
+		if useMillisecondResolution {
+			size = 11
+		} else {
+			size = 13
+		}
+	}
+
+	if len(buffer) < size {
+		return cm, 0, errors.New("not enough buffer available to deserialize compact measurement timestamp")
+	}
+
 	if (cm.Flags & compactStateFlags.BaseTimeOffset) != 0 {
 		timeIndex := (cm.Flags & compactStateFlags.TimeIndex) >> 7
 		baseTimeOffset := baseTimeOffsets[timeIndex]
@@ -180,6 +197,16 @@ func NewCompactMeasurement(includeTime, useMillisecondResolution bool, baseTimeO
 	}
 }
 
+// newCompactMeasurementFrom constructs a CompactMeasurement from a full Measurement using the specified signalIndex.
+func newCompactMeasurementFrom(measurement *Measurement, signalIndex int32) CompactMeasurement {
+	return CompactMeasurement{
+		Value:       float32(measurement.Value),
+		Timestamp:   measurement.Timestamp,
+		SignalIndex: uint32(signalIndex),
+		Flags:       measurement.Flags.mapToCompactFlags(),
+	}
+}
+
 // Compute the full measurement from the compact representation
 func (cm *CompactMeasurement) Expand(signalIndexCache *SignalIndexCache) Measurement {
 	return Measurement{
@@ -190,6 +217,67 @@ func (cm *CompactMeasurement) Expand(signalIndexCache *SignalIndexCache) Measure
 	}
 }
 
+// encode serializes a CompactMeasurement to the specified byte buffer, which must have at least 17 bytes available,
+// for publication to a DataSubscriber; returns the number of bytes written. When a base time offset is available
+// and the timestamp is within range of the offset, timestamp will be encoded as a 2-byte millisecond offset or a
+// 4-byte tick offset, otherwise the full 8-byte timestamp is encoded.
+func (cm *CompactMeasurement) encode(includeTime, useMillisecondResolution bool, baseTimeOffsets *[2]int64, timeIndex int32, buffer []byte) int {
+	flags := cm.Flags &^ (compactStateFlags.BaseTimeOffset | compactStateFlags.TimeIndex)
+	var difference int64
+	var offsetTime bool
+
+	if includeTime {
+		baseTimeOffset := baseTimeOffsets[timeIndex]
+
+		// Note that only a full fidelity timestamp can carry leap second flags, such
+		// timestamps will always produce an out of range difference
+		if baseTimeOffset > 0 {
+			difference = int64(cm.Timestamp) - baseTimeOffset
+
+			if difference > 0 {
+				if useMillisecondResolution {
+					difference /= int64(ticks.PerMillisecond)
+					offsetTime = difference < math.MaxUint16
+				} else {
+					offsetTime = difference < math.MaxUint32
+				}
+			}
+		}
+
+		if offsetTime {
+			flags |= compactStateFlags.BaseTimeOffset
+
+			if timeIndex != 0 {
+				flags |= compactStateFlags.TimeIndex
+			}
+		}
+	}
+
+	buffer[0] = byte(flags)
+	binary.BigEndian.PutUint32(buffer[1:], cm.SignalIndex)
+	binary.BigEndian.PutUint32(buffer[5:], math.Float32bits(cm.Value))
+
+	if !includeTime {
+		return 9
+	}
+
+	if offsetTime {
+		if useMillisecondResolution {
+			// Encode 2-byte millisecond offset timestamp
+			binary.BigEndian.PutUint16(buffer[9:], uint16(difference))
+			return 11
+		}
+
+		// Encode 4-byte tick offset timestamp
+		binary.BigEndian.PutUint32(buffer[9:], uint32(difference))
+		return 13
+	}
+
+	// Encode 8-byte full fidelity timestamp
+	binary.BigEndian.PutUint64(buffer[9:], uint64(cm.Timestamp))
+	return 17
+}
+
 // // Serializes a CompactMeasurement to a byte buffer for publication to a DataSubscriber.
 func (cm *CompactMeasurement) Marshal(b []byte) {
 	b[0] = byte(cm.Flags)
@@ -26,6 +26,9 @@ package transport
 import (
 	"strconv"
 	"strings"
+	"time"
+
+	"github.com/sttp/goapi/sttp/ticks"
 )
 
 const (
@@ -40,6 +43,29 @@ const (
 	defaultLagTime              = 5.0
 	defaultLeadTime             = 5.0
 	defaultPublishInterval      = 1.0
+	maxSupportedVersion         = 3
+	legacyVersionMask           = 0x1F
+
+	// Data packets carry a 1-byte flags field and a 4-byte measurement count before the measurements
+	dataPacketHeaderSize     = 5
+	maxDataPacketPayloadSize = maxPacketSize - payloadHeaderSize - responseHeaderSize
+
+	// Base time offsets rotate within range of the 4-byte tick offset, ~429 seconds, or 2-byte millisecond offset, ~65 seconds
+	baseTimeRotationInterval            = 420 * ticks.PerSecond
+	millisecondBaseTimeRotationInterval = 60 * ticks.PerSecond
+
+	// Measurement sets queued for publication to a subscriber before new sets are dropped
+	publishQueueSize = 256
+
+	// Range of delays between retries after failures accepting subscriber connections
+	minimumAcceptDelay = 5 * time.Millisecond
+	maximumAcceptDelay = time.Second
+
+	// Maximum time allowed for a TLS handshake to complete on the command channel
+	tlsHandshakeTimeout = 10 * time.Second
+
+	// Maximum multiple of the fail-back interval used after consecutive failed fail-backs
+	maximumFailbackBackoff = 32
 )
 
 // StateFlagsEnum defines the type of the StateFlags enumeration.
@@ -230,6 +256,32 @@ var DataPacketFlags = struct {
 	NoFlags:     0x0,
 }
 
+// String gets the DataPacketFlags enumeration bit values as a string.
+func (dpfe DataPacketFlagsEnum) String() string {
+	if dpfe == DataPacketFlags.NoFlags {
+		return "NoFlags"
+	}
+
+	var image strings.Builder
+
+	addFlag := func(flag DataPacketFlagsEnum, name string) {
+		if flag&dpfe > 0 {
+			if image.Len() > 0 {
+				image.WriteRune(',')
+			}
+
+			image.WriteString(name)
+		}
+	}
+
+	addFlag(DataPacketFlags.Compact, "Compact")
+	addFlag(DataPacketFlags.CipherIndex, "CipherIndex")
+	addFlag(DataPacketFlags.Compressed, "Compressed")
+	addFlag(DataPacketFlags.CacheIndex, "CacheIndex")
+
+	return image.String()
+}
+
 // ServerCommandEnum defines the type of the ServerCommand enumeration.
 type ServerCommandEnum byte
 
@@ -0,0 +1,606 @@
+//******************************************************************************************************
+//  DataPublisher.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/thread"
+	"github.com/tevino/abool/v2"
+)
+
+// measurementKey defines the human-readable measurement key, i.e., "source:id", of a published measurement.
+type measurementKey struct {
+	source string
+	id     uint64
+}
+
+// DataPublisher represents a publisher of streaming measurement data for STTP connections.
+type DataPublisher struct {
+	metadata          *data.DataSet
+	metadataBuffer    []byte
+	filteringMetadata *data.DataSet
+	measurementKeys   map[guid.Guid]measurementKey
+	metadataMutex     sync.RWMutex
+
+	subscriberConnections      map[guid.Guid]*SubscriberConnection
+	subscriberConnectionsMutex sync.RWMutex
+
+	listeningSocket             net.Listener
+	listeningSocketAcceptThread *thread.Thread
+	tlsHandshakes               sync.WaitGroup
+	tlsHandshakeContext         context.Context
+	cancelTLSHandshakes         context.CancelFunc
+	started                     abool.AtomicBool
+	startStopMutex              sync.Mutex
+
+	assigningHandlerMutex sync.RWMutex
+
+	// StatusMessageCallback is called when a informational message should be logged.
+	StatusMessageCallback func(string)
+
+	// ErrorMessageCallback is called when an error message should be logged.
+	ErrorMessageCallback func(string)
+
+	// ClientConnectedCallback is called when a DataSubscriber connection has been accepted.
+	ClientConnectedCallback func(connection *SubscriberConnection)
+
+	// ClientDisconnectedCallback is called when a DataSubscriber connection has been terminated.
+	ClientDisconnectedCallback func(connection *SubscriberConnection)
+
+	// MaximumAllowedConnections defines the maximum number of simultaneous subscriber connections.
+	// Set to -1 for no limit. Defaults to -1.
+	MaximumAllowedConnections int32
+
+	// MetadataRefreshAllowed determines if subscribers are allowed to request metadata. Defaults to true.
+	MetadataRefreshAllowed bool
+
+	// NaNValueFilterAllowed determines if subscribers are allowed to request that NaN values be filtered. Defaults to true.
+	NaNValueFilterAllowed bool
+
+	// NaNValueFilterForced determines if NaN values are always filtered, regardless of subscriber request. Defaults to false.
+	NaNValueFilterForced bool
+
+	// CompressPayloadData determines if payload data compression, i.e., TSSC, is used when requested by subscriber.
+	// Defaults to true.
+	CompressPayloadData bool
+
+	// CompressMetadata determines if metadata compression, i.e., GZip, is used when requested by subscriber. Defaults to true.
+	CompressMetadata bool
+
+	// CompressSignalIndexCache determines if signal index cache compression, i.e., GZip, is used when requested by subscriber.
+	// Defaults to true.
+	CompressSignalIndexCache bool
+
+	// UseBaseTimeOffsets determines if compact measurement timestamps are serialized as offsets from rotating base times.
+	// Defaults to true.
+	UseBaseTimeOffsets bool
+
+	// SwapGuidEndianness determines if Guid wire serialization should swap endianness. This should only be enabled for
+	// implementations using non-RFC Guid byte ordering, i.e., little-endian. Default to false.
+	SwapGuidEndianness bool
+
+	// TLSConfig defines the Transport Layer Security configuration for subscriber command channel connections. Set value
+	// to nil for unencrypted connections. When defined, Certificates must include the server certificate; set ClientCAs
+	// and ClientAuth to tls.RequireAndVerifyClientCert to require mutual TLS. Defaults to nil.
+	TLSConfig *tls.Config
+}
+
+// NewDataPublisher creates a new DataPublisher.
+func NewDataPublisher() *DataPublisher {
+	return &DataPublisher{
+		subscriberConnections:     make(map[guid.Guid]*SubscriberConnection),
+		MaximumAllowedConnections: -1,
+		MetadataRefreshAllowed:    true,
+		NaNValueFilterAllowed:     true,
+		CompressPayloadData:       true,
+		CompressMetadata:          true,
+		CompressSignalIndexCache:  true,
+		UseBaseTimeOffsets:        true,
+	}
+}
+
+// Dispose cleanly shuts down a DataPublisher that is no longer being used, e.g.,
+// during a normal application exit.
+func (dp *DataPublisher) Dispose() {
+	dp.Stop()
+}
+
+// BeginCallbackAssignment informs DataPublisher that a callback change has been initiated.
+func (dp *DataPublisher) BeginCallbackAssignment() {
+	dp.assigningHandlerMutex.Lock()
+}
+
+// BeginCallbackSync begins a callback synchronization operation.
+func (dp *DataPublisher) BeginCallbackSync() {
+	dp.assigningHandlerMutex.RLock()
+}
+
+// EndCallbackSync ends a callback synchronization operation.
+func (dp *DataPublisher) EndCallbackSync() {
+	dp.assigningHandlerMutex.RUnlock()
+}
+
+// EndCallbackAssignment informs DataPublisher that a callback change has been completed.
+func (dp *DataPublisher) EndCallbackAssignment() {
+	dp.assigningHandlerMutex.Unlock()
+}
+
+// IsStarted determines if the DataPublisher is currently listening for subscriber connections.
+func (dp *DataPublisher) IsStarted() bool {
+	return dp.started.IsSet()
+}
+
+// Start requests that the DataPublisher begin listening for DataSubscriber connections on the specified
+// port and network interface. Use an empty networkInterface to listen on all interfaces.
+func (dp *DataPublisher) Start(port uint16, networkInterface string) error {
+	dp.startStopMutex.Lock()
+	defer dp.startStopMutex.Unlock()
+
+	if dp.started.IsSet() {
+		return errors.New("publisher is already started; stop first")
+	}
+
+	if dp.TLSConfig != nil && len(dp.TLSConfig.Certificates) == 0 && dp.TLSConfig.GetCertificate == nil {
+		return errors.New("TLS configuration does not define a server certificate")
+	}
+
+	var err error
+
+	dp.listeningSocket, err = net.Listen("tcp", net.JoinHostPort(networkInterface, strconv.Itoa(int(port))))
+
+	if err != nil {
+		return err
+	}
+
+	dp.tlsHandshakeContext, dp.cancelTLSHandshakes = context.WithCancel(context.Background())
+	dp.listeningSocketAcceptThread = thread.NewThread(dp.runListeningSocketAcceptThread)
+	dp.started.Set()
+	dp.listeningSocketAcceptThread.Start()
+
+	return nil
+}
+
+// Stop shuts down the DataPublisher listening socket and terminates all subscriber connections.
+func (dp *DataPublisher) Stop() {
+	dp.startStopMutex.Lock()
+	defer dp.startStopMutex.Unlock()
+
+	if dp.started.IsNotSet() {
+		return
+	}
+
+	dp.started.UnSet()
+
+	if err := dp.listeningSocket.Close(); err != nil {
+		dp.dispatchErrorMessage("Exception while stopping data publisher TCP listening socket: " + err.Error())
+	}
+
+	dp.listeningSocketAcceptThread.Join()
+
+	// Abort any pending TLS handshakes before terminating connections
+	dp.cancelTLSHandshakes()
+	dp.tlsHandshakes.Wait()
+
+	for _, connection := range dp.SubscriberConnections() {
+		connection.Stop()
+	}
+}
+
+// Port gets the TCP port the DataPublisher is listening on; returns 0 if the publisher is not started.
+// This is useful when the publisher was started on port 0, i.e., an ephemeral port.
+func (dp *DataPublisher) Port() uint16 {
+	if dp.started.IsNotSet() {
+		return 0
+	}
+
+	if addr, ok := dp.listeningSocket.Addr().(*net.TCPAddr); ok {
+		return uint16(addr.Port)
+	}
+
+	return 0
+}
+
+// SubscriberConnections gets the currently connected DataSubscriber connections.
+func (dp *DataPublisher) SubscriberConnections() []*SubscriberConnection {
+	dp.subscriberConnectionsMutex.RLock()
+	defer dp.subscriberConnectionsMutex.RUnlock()
+
+	connections := make([]*SubscriberConnection, 0, len(dp.subscriberConnections))
+
+	for _, connection := range dp.subscriberConnections {
+		connections = append(connections, connection)
+	}
+
+	return connections
+}
+
+// Metadata gets the metadata DataSet defined for the DataPublisher, if any.
+func (dp *DataPublisher) Metadata() *data.DataSet {
+	dp.metadataMutex.RLock()
+	defer dp.metadataMutex.RUnlock()
+
+	return dp.metadata
+}
+
+// FilteringMetadata gets the DataSet used to evaluate subscriber filter expressions, if any. This DataSet
+// contains an "ActiveMeasurements" table derived from the defined metadata.
+func (dp *DataPublisher) FilteringMetadata() *data.DataSet {
+	dp.metadataMutex.RLock()
+	defer dp.metadataMutex.RUnlock()
+
+	return dp.filteringMetadata
+}
+
+// DefineMetadataXml defines the metadata for the DataPublisher from the provided XML encoded DataSet, see
+// sttp/data DataSet.ParseXml. Metadata must contain either an "ActiveMeasurements" or "MeasurementDetail"
+// table with "SignalID" and "ID" columns to be used for filtering subscriptions. Any connected subscribers
+// are notified that configuration has changed.
+func (dp *DataPublisher) DefineMetadataXml(metadata []byte) error {
+	dataSet := data.NewDataSet()
+
+	if err := dataSet.ParseXml(metadata); err != nil {
+		return errors.New("failed to parse metadata: " + err.Error())
+	}
+
+	return dp.defineMetadata(dataSet, metadata)
+}
+
+// DefineMetadata defines the metadata for the DataPublisher from the provided DataSet. The DataSet is
+// serialized to XML, see sttp/data DataSet.WriteXml, for delivery to subscribers. Metadata requirements
+// match those of DefineMetadataXml. Any connected subscribers are notified that configuration has changed.
+func (dp *DataPublisher) DefineMetadata(dataSet *data.DataSet) error {
+	metadata, err := dataSet.MarshalXml()
+
+	if err != nil {
+		return errors.New("failed to serialize metadata: " + err.Error())
+	}
+
+	return dp.defineMetadata(dataSet, metadata)
+}
+
+func (dp *DataPublisher) defineMetadata(dataSet *data.DataSet, metadata []byte) error {
+	filteringMetadata, measurementKeys, err := createFilteringMetadata(dataSet)
+
+	if err != nil {
+		return err
+	}
+
+	dp.metadataMutex.Lock()
+	dp.metadata = dataSet
+	dp.metadataBuffer = metadata
+	dp.filteringMetadata = filteringMetadata
+	dp.measurementKeys = measurementKeys
+	dp.metadataMutex.Unlock()
+
+	for _, connection := range dp.SubscriberConnections() {
+		if connection.IsValidated() {
+			connection.SendResponse(ServerResponse.ConfigurationChanged, ServerCommand.Subscribe, nil)
+		}
+	}
+
+	return nil
+}
+
+func (dp *DataPublisher) metadataXml() []byte {
+	dp.metadataMutex.RLock()
+	defer dp.metadataMutex.RUnlock()
+
+	return dp.metadataBuffer
+}
+
+// createFilteringMetadata derives the "ActiveMeasurements" filtering DataSet from the provided metadata.
+//
+//gocyclo:ignore
+func createFilteringMetadata(metadata *data.DataSet) (*data.DataSet, map[guid.Guid]measurementKey, error) {
+	filteringMetadata := metadata
+	activeMeasurements := metadata.Table("ActiveMeasurements")
+
+	if activeMeasurements == nil {
+		measurementDetail := metadata.Table("MeasurementDetail")
+
+		if measurementDetail == nil {
+			return nil, nil, errors.New("metadata does not define an \"ActiveMeasurements\" or \"MeasurementDetail\" table")
+		}
+
+		// Derive ActiveMeasurements from MeasurementDetail, adding the SignalType field commonly used by filter expressions
+		filteringMetadata = data.NewDataSet()
+		activeMeasurements = filteringMetadata.CreateTable("ActiveMeasurements")
+
+		for i := 0; i < measurementDetail.ColumnCount(); i++ {
+			activeMeasurements.AddColumn(activeMeasurements.CloneColumn(measurementDetail.Column(i)))
+		}
+
+		signalAcronymIndex := measurementDetail.ColumnIndex("SignalAcronym")
+		signalTypeIndex := activeMeasurements.ColumnIndex("SignalType")
+
+		if signalTypeIndex < 0 && signalAcronymIndex > -1 {
+			signalTypeIndex = activeMeasurements.ColumnCount()
+			activeMeasurements.AddColumn(activeMeasurements.CreateColumn("SignalType", data.DataType.String, ""))
+		} else {
+			signalAcronymIndex = -1
+		}
+
+		activeMeasurements.InitRows(measurementDetail.RowCount())
+
+		for i := 0; i < measurementDetail.RowCount(); i++ {
+			sourceRow := measurementDetail.Row(i)
+
+			if sourceRow == nil {
+				continue
+			}
+
+			row := activeMeasurements.CreateRow()
+
+			for j := 0; j < measurementDetail.ColumnCount(); j++ {
+				if len(measurementDetail.Column(j).Expression()) > 0 {
+					continue
+				}
+
+				value, _ := sourceRow.ValueFast(j)
+				row.SetValue(j, value)
+			}
+
+			if signalAcronymIndex > -1 {
+				value, _ := sourceRow.ValueFast(signalAcronymIndex)
+				row.SetValue(signalTypeIndex, value)
+			}
+
+			activeMeasurements.AddRow(row)
+		}
+
+		filteringMetadata.AddTable(activeMeasurements)
+	}
+
+	signalIDColumn := activeMeasurements.ColumnByName("SignalID")
+
+	if signalIDColumn == nil || signalIDColumn.Type() != data.DataType.Guid {
+		return nil, nil, errors.New("metadata \"" + activeMeasurements.Name() + "\" table does not define a Guid based \"SignalID\" column")
+	}
+
+	idColumnIndex := activeMeasurements.ColumnIndex("ID")
+	measurementKeys := make(map[guid.Guid]measurementKey, activeMeasurements.RowCount())
+
+	for i := 0; i < activeMeasurements.RowCount(); i++ {
+		row := activeMeasurements.Row(i)
+
+		if row == nil {
+			continue
+		}
+
+		signalID, null, err := row.GuidValue(signalIDColumn.Index())
+
+		if null || err != nil {
+			continue
+		}
+
+		var key measurementKey
+
+		if idColumnIndex > -1 {
+			if id, null, err := row.StringValue(idColumnIndex); !null && err == nil {
+				source, value, _ := strings.Cut(id, ":")
+				key.source = strings.TrimSpace(source)
+				key.id, _ = strconv.ParseUint(strings.TrimSpace(value), 10, 64)
+			}
+		}
+
+		measurementKeys[signalID] = key
+	}
+
+	return filteringMetadata, measurementKeys, nil
+}
+
+// createSignalIndexCache creates a new SignalIndexCache for the measurements matching the provided filter expression.
+func (dp *DataPublisher) createSignalIndexCache(filterExpression string) (*SignalIndexCache, error) {
+	signalIndexCache := NewSignalIndexCache()
+
+	if len(strings.TrimSpace(filterExpression)) == 0 {
+		return signalIndexCache, nil
+	}
+
+	dp.metadataMutex.RLock()
+	defer dp.metadataMutex.RUnlock()
+
+	if dp.filteringMetadata == nil {
+		return nil, errors.New("no metadata has been defined by the publisher")
+	}
+
+	signalIDSet, err := data.SelectSignalIDSet(dp.filteringMetadata, filterExpression, "ActiveMeasurements", nil, true)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort signal IDs so that signal index assignments are deterministic
+	signalIDs := signalIDSet.Keys()
+	sort.Slice(signalIDs, func(i, j int) bool { return signalIDs[i].Compare(signalIDs[j]) < 0 })
+
+	var signalIndex int32
+
+	for _, signalID := range signalIDs {
+		// Only measurements defined in metadata can be published
+		if key, ok := dp.measurementKeys[signalID]; ok {
+			signalIndexCache.addRecord(nil, signalIndex, signalID, key.source, key.id, 1)
+			signalIndex++
+		}
+	}
+
+	return signalIndexCache, nil
+}
+
+// PublishMeasurements queues the provided measurements for publication to each subscriber whose subscription
+// includes them. Measurements are sent to each subscriber independently, so a slow subscriber does not delay
+// publication to other subscribers; measurements queued for a subscriber that is not keeping up are dropped.
+func (dp *DataPublisher) PublishMeasurements(measurements []Measurement) {
+	if len(measurements) == 0 {
+		return
+	}
+
+	// Measurements are copied since caller can reuse provided slice once queued
+	published := make([]Measurement, len(measurements))
+	copy(published, measurements)
+
+	for _, connection := range dp.SubscriberConnections() {
+		connection.queueMeasurements(published)
+	}
+}
+
+func (dp *DataPublisher) runListeningSocketAcceptThread() {
+	var acceptDelay time.Duration
+
+	for dp.started.IsSet() {
+		conn, err := dp.listeningSocket.Accept()
+
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) || dp.started.IsNotSet() {
+				return
+			}
+
+			// Back off on repeated errors, e.g., too many open files, to avoid spinning on accept
+			if acceptDelay == 0 {
+				acceptDelay = minimumAcceptDelay
+			} else if acceptDelay *= 2; acceptDelay > maximumAcceptDelay {
+				acceptDelay = maximumAcceptDelay
+			}
+
+			dp.dispatchErrorMessage("Exception while accepting data subscriber connection, retrying in " + acceptDelay.String() + ": " + err.Error())
+			time.Sleep(acceptDelay)
+			continue
+		}
+
+		acceptDelay = 0
+
+		// TLS handshake is completed separately so a slow connection does not delay accepting others
+		if dp.TLSConfig != nil {
+			dp.tlsHandshakes.Add(1)
+			go dp.acceptTLSConnection(conn)
+			continue
+		}
+
+		dp.acceptConnection(conn)
+	}
+}
+
+func (dp *DataPublisher) acceptTLSConnection(conn net.Conn) {
+	defer dp.tlsHandshakes.Done()
+
+	conn, err := tlsServerHandshake(dp.tlsHandshakeContext, conn, dp.TLSConfig)
+
+	if err != nil {
+		if dp.started.IsSet() {
+			dp.dispatchErrorMessage("Subscriber connection rejected: " + err.Error())
+		}
+
+		return
+	}
+
+	dp.acceptConnection(conn)
+}
+
+func (dp *DataPublisher) acceptConnection(conn net.Conn) {
+	connection := newSubscriberConnection(dp, conn)
+
+	dp.subscriberConnectionsMutex.Lock()
+
+	// Publisher may have been stopped during TLS handshake
+	if dp.started.IsNotSet() {
+		dp.subscriberConnectionsMutex.Unlock()
+		conn.Close()
+		return
+	}
+
+	if dp.MaximumAllowedConnections > -1 && len(dp.subscriberConnections) >= int(dp.MaximumAllowedConnections) {
+		dp.subscriberConnectionsMutex.Unlock()
+		conn.Close()
+
+		dp.dispatchErrorMessage("Subscriber connection from \"" + connection.ConnectionID() + "\" refused: maximum of " + strconv.Itoa(int(dp.MaximumAllowedConnections)) + " allowed connections reached.")
+		return
+	}
+
+	dp.subscriberConnections[connection.SubscriberID()] = connection
+	dp.subscriberConnectionsMutex.Unlock()
+
+	dp.dispatchStatusMessage("Accepted subscriber connection from \"" + connection.ConnectionID() + "\"")
+	connection.start()
+
+	dp.BeginCallbackSync()
+
+	if dp.ClientConnectedCallback != nil {
+		go dp.ClientConnectedCallback(connection)
+	}
+
+	dp.EndCallbackSync()
+}
+
+// connectionTerminated is called by a SubscriberConnection once its connection has been closed.
+func (dp *DataPublisher) connectionTerminated(connection *SubscriberConnection) {
+	dp.subscriberConnectionsMutex.Lock()
+	_, ok := dp.subscriberConnections[connection.SubscriberID()]
+	delete(dp.subscriberConnections, connection.SubscriberID())
+	dp.subscriberConnectionsMutex.Unlock()
+
+	if !ok {
+		return
+	}
+
+	dp.dispatchStatusMessage("Subscriber connection from \"" + connection.ConnectionID() + "\" terminated.")
+
+	dp.BeginCallbackSync()
+
+	if dp.ClientDisconnectedCallback != nil {
+		go dp.ClientDisconnectedCallback(connection)
+	}
+
+	dp.EndCallbackSync()
+}
+
+func (dp *DataPublisher) dispatchStatusMessage(message string) {
+	dp.BeginCallbackSync()
+
+	if dp.StatusMessageCallback != nil {
+		go dp.StatusMessageCallback(message)
+	}
+
+	dp.EndCallbackSync()
+}
+
+func (dp *DataPublisher) dispatchErrorMessage(message string) {
+	dp.BeginCallbackSync()
+
+	if dp.ErrorMessageCallback != nil {
+		go dp.ErrorMessageCallback(message)
+	}
+
+	dp.EndCallbackSync()
+}
@@ -0,0 +1,470 @@
+//******************************************************************************************************
+//  DataPublisher_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/internal/transporttest"
+	"github.com/sttp/goapi/sttp/data"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+const (
+	freqSignalID = "93673c68-d59d-4926-b7e9-e7678f9f66b4"
+	dfdtSignalID = "3647f729-d0ed-4f79-85ad-dae2149cd432"
+)
+
+func startTestTLSPublisher(t *testing.T, tlsConfig *tls.Config) (*DataPublisher, []byte) {
+	publisher := NewDataPublisher()
+	publisher.TLSConfig = tlsConfig
+
+	return publisher, transporttest.StartPublisher(t, publisher)
+}
+
+func connectTestSubscriber(t *testing.T, publisher *DataPublisher, subscriber *DataSubscriber) *SubscriberConnection {
+	if err := subscriber.Connect("127.0.0.1", publisher.Port()); err != nil {
+		t.Fatalf("Failed to connect subscriber: %s", err.Error())
+	}
+
+	var connection *SubscriberConnection
+
+	waitFor(t, "subscriber connection validation", func() bool {
+		connections := publisher.SubscriberConnections()
+
+		if len(connections) == 1 && connections[0].IsValidated() {
+			connection = connections[0]
+		}
+
+		return connection != nil
+	})
+
+	return connection
+}
+
+func waitFor(t *testing.T, operation string, condition func() bool) {
+	timeout := time.Now().Add(5 * time.Second)
+
+	for !condition() {
+		if time.Now().After(timeout) {
+			t.Fatalf("Timed out waiting for %s", operation)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestParseKeyValuePairs(t *testing.T) {
+	settings := parseKeyValuePairs("includeTime=true; filterExpression={FILTER ActiveMeasurements WHERE SignalType='FREQ'}; dataChannel={localport=9500}; assemblyInfo={source=STTP;version={1.0}}")
+
+	if settings["includetime"] != "true" {
+		t.Fatalf("TestParseKeyValuePairs: unexpected includeTime value: %s", settings["includetime"])
+	}
+
+	if settings["filterexpression"] != "FILTER ActiveMeasurements WHERE SignalType='FREQ'" {
+		t.Fatalf("TestParseKeyValuePairs: unexpected filterExpression value: %s", settings["filterexpression"])
+	}
+
+	if parseKeyValuePairs(settings["datachannel"])["localport"] != "9500" {
+		t.Fatalf("TestParseKeyValuePairs: unexpected dataChannel value: %s", settings["datachannel"])
+	}
+
+	if parseKeyValuePairs(settings["assemblyinfo"])["version"] != "1.0" {
+		t.Fatalf("TestParseKeyValuePairs: unexpected assemblyInfo value: %s", settings["assemblyinfo"])
+	}
+}
+
+func TestFilteringMetadata(t *testing.T) {
+	publisher := NewDataPublisher()
+	metadata, _ := os.ReadFile("../../test/SampleMetadata.xml")
+
+	if err := publisher.DefineMetadataXml(metadata); err != nil {
+		t.Fatalf("TestFilteringMetadata: failed to define metadata: %s", err.Error())
+	}
+
+	signalIndexCache, err := publisher.createSignalIndexCache("FILTER ActiveMeasurements WHERE SignalType IN ('FREQ', 'DFDT')")
+
+	if err != nil {
+		t.Fatalf("TestFilteringMetadata: failed to create signal index cache: %s", err.Error())
+	}
+
+	if signalIndexCache.Count() != 2 {
+		t.Fatalf("TestFilteringMetadata: expected 2 signals, received: %d", signalIndexCache.Count())
+	}
+
+	signalID, _ := guid.Parse(freqSignalID)
+	_, source, id, found := signalIndexCache.Record(signalIndexCache.SignalIndex(signalID))
+
+	if !found || source != "PPA" || id != 2 {
+		t.Fatalf("TestFilteringMetadata: unexpected measurement key for FREQ signal: %s:%d", source, id)
+	}
+}
+
+func TestDefineMetadataDataSet(t *testing.T) {
+	publisher := NewDataPublisher()
+	metadata, _ := os.ReadFile("../../test/SampleMetadata.xml")
+
+	if err := publisher.DefineMetadata(data.FromXml(metadata)); err != nil {
+		t.Fatalf("TestDefineMetadataDataSet: failed to define metadata: %s", err.Error())
+	}
+
+	signalIndexCache, err := publisher.createSignalIndexCache("FILTER ActiveMeasurements WHERE SignalType = 'FREQ'")
+
+	if err != nil {
+		t.Fatalf("TestDefineMetadataDataSet: failed to create signal index cache: %s", err.Error())
+	}
+
+	if signalIndexCache.Count() != 1 {
+		t.Fatalf("TestDefineMetadataDataSet: expected 1 signal, received: %d", signalIndexCache.Count())
+	}
+
+	if data.FromXml(publisher.metadataXml()).Table("MeasurementDetail") == nil {
+		t.Fatal("TestDefineMetadataDataSet: serialized metadata is missing MeasurementDetail table")
+	}
+}
+
+func TestMetadataReaderCompressed(t *testing.T) {
+	testMetadataReader(t, true)
+}
+
+func TestMetadataReaderUncompressed(t *testing.T) {
+	testMetadataReader(t, false)
+}
+
+func testMetadataReader(t *testing.T, compressMetadata bool) {
+	publisher := NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Dispose()
+
+	subscriber := NewDataSubscriber()
+	subscriber.CompressMetadata = compressMetadata
+	defer subscriber.Dispose()
+
+	parsed := make(chan error, 1)
+	dataSet := data.NewDataSet()
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.MetadataReaderCallback = func(reader io.Reader) {
+		parsed <- dataSet.ReadXml(reader)
+	}
+	subscriber.EndCallbackAssignment()
+
+	connectTestSubscriber(t, publisher, subscriber)
+	subscriber.SendServerCommand(ServerCommand.MetadataRefresh)
+
+	select {
+	case err := <-parsed:
+		if err != nil {
+			t.Fatalf("TestMetadataReader: failed to read metadata: %s", err.Error())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestMetadataReader: timed out waiting for metadata")
+	}
+
+	measurements := dataSet.Table("MeasurementDetail")
+
+	if measurements == nil || measurements.RowCount() != 130 {
+		t.Fatal("TestMetadataReader: expected 130 MeasurementDetail rows")
+	}
+}
+
+func TestRequestMetadataContext(t *testing.T) {
+	publisher := NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Dispose()
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	var readerCalls int32
+	received := make(chan struct{}, 2)
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.MetadataReaderCallback = func(reader io.Reader) {
+		atomic.AddInt32(&readerCalls, 1)
+		received <- struct{}{}
+	}
+	subscriber.EndCallbackAssignment()
+
+	connectTestSubscriber(t, publisher, subscriber)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Response to an earlier request must not complete the request
+	subscriber.SendServerCommand(ServerCommand.MetadataRefresh)
+
+	var requestedCalls int32
+	dataSet := data.NewDataSet()
+
+	err := subscriber.RequestMetadataContext(ctx, nil, func(reader io.Reader) error {
+		atomic.AddInt32(&requestedCalls, 1)
+		return dataSet.ReadXml(reader)
+	})
+
+	if err != nil {
+		t.Fatalf("TestRequestMetadataContext: RequestMetadataContext failed: %s", err.Error())
+	}
+
+	if atomic.LoadInt32(&requestedCalls) != 1 || dataSet.Table("MeasurementDetail") == nil {
+		t.Fatal("TestRequestMetadataContext: expected requested metadata to be read once")
+	}
+
+	select {
+	case <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("TestRequestMetadataContext: timed out waiting for metadata reader callback")
+	}
+
+	if atomic.LoadInt32(&readerCalls) != 1 {
+		t.Fatalf("TestRequestMetadataContext: expected one metadata reader callback, received: %d", atomic.LoadInt32(&readerCalls))
+	}
+
+	parseErr := errors.New("parse failed")
+
+	if err = subscriber.RequestMetadataContext(ctx, nil, func(io.Reader) error { return parseErr }); !errors.Is(err, parseErr) {
+		t.Fatalf("TestRequestMetadataContext: expected metadata reader error, received: %v", err)
+	}
+}
+
+func TestMetadataRefreshLatency(t *testing.T) {
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	subscriber.CompressMetadata = false
+	requested := time.Now()
+
+	// Each queued request tracks its own send time
+	subscriber.metadataRefreshes = []*metadataRefresh{{requested: requested.Add(-2 * time.Hour)}, {requested: requested.Add(-time.Hour)}}
+
+	for _, expected := range []time.Duration{2 * time.Hour, time.Hour} {
+		subscriber.handleMetadataRefresh(nil, subscriber.nextMetadataRefresh())
+
+		if latency := subscriber.Statistics().LastMetadataRefreshLatency; latency < expected || latency > expected+time.Minute {
+			t.Fatalf("TestMetadataRefreshLatency: expected latency of %s, received: %s", expected, latency)
+		}
+	}
+}
+
+func TestPublishSubscribeCompact(t *testing.T) {
+	testPublishSubscribe(t, false)
+}
+
+func TestPublishSubscribeTSSC(t *testing.T) {
+	testPublishSubscribe(t, true)
+}
+
+func testPublishSubscribe(t *testing.T, compressPayloadData bool) {
+	publisher := NewDataPublisher()
+	metadata := transporttest.StartPublisher(t, publisher)
+	defer publisher.Dispose()
+
+	subscriber := NewDataSubscriber()
+	subscriber.CompressPayloadData = compressPayloadData
+	defer subscriber.Dispose()
+
+	received := make(chan []Measurement, 10)
+	receivedMetadata := make(chan []byte, 1)
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.MetadataReceivedCallback = func(metadata []byte) {
+		receivedMetadata <- metadata
+	}
+	subscriber.NewMeasurementsCallback = func(measurements *[]Measurement) {
+		received <- append([]Measurement(nil), *measurements...)
+	}
+	subscriber.EndCallbackAssignment()
+
+	connection := connectTestSubscriber(t, publisher, subscriber)
+
+	if connection.compressPayloadData != compressPayloadData {
+		t.Fatalf("TestPublishSubscribe: expected payload compression to be %t", compressPayloadData)
+	}
+
+	subscriber.SendServerCommand(ServerCommand.MetadataRefresh)
+
+	select {
+	case buffer := <-receivedMetadata:
+		if !bytes.Equal(buffer, metadata) {
+			t.Fatalf("TestPublishSubscribe: received metadata does not match defined metadata")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestPublishSubscribe: timed out waiting for metadata")
+	}
+
+	subscriber.Subscription().FilterExpression = "FILTER ActiveMeasurements WHERE SignalType = 'FREQ'"
+	subscriber.Subscription().RequestNaNValueFilter = true
+
+	if err := subscriber.Subscribe(); err != nil {
+		t.Fatalf("TestPublishSubscribe: failed to subscribe: %s", err.Error())
+	}
+
+	waitFor(t, "signal index cache confirmation", func() bool {
+		return connection.ActiveSignalIndexCache() != nil && subscriber.IsSubscribed()
+	})
+
+	freqID, _ := guid.Parse(freqSignalID)
+	dfdtID, _ := guid.Parse(dfdtSignalID)
+	now := ticks.UtcNow()
+
+	publisher.PublishMeasurements([]Measurement{
+		{SignalID: freqID, Value: 59.95, Timestamp: now, Flags: StateFlags.Normal},
+		{SignalID: dfdtID, Value: 0.01, Timestamp: now},
+		{SignalID: freqID, Value: math.NaN(), Timestamp: now},
+		{SignalID: freqID, Value: 60.05, Timestamp: now - ticks.PerDay, Flags: StateFlags.BadData},
+	})
+
+	var measurements []Measurement
+
+	select {
+	case measurements = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestPublishSubscribe: timed out waiting for measurements")
+	}
+
+	if len(measurements) != 2 {
+		t.Fatalf("TestPublishSubscribe: expected 2 measurements, received: %d", len(measurements))
+	}
+
+	if measurements[0].SignalID != freqID || float32(measurements[0].Value) != float32(59.95) || measurements[0].Timestamp != now {
+		t.Fatalf("TestPublishSubscribe: unexpected base time offset measurement: %s", measurements[0].String())
+	}
+
+	if float32(measurements[1].Value) != float32(60.05) || measurements[1].Timestamp != now-ticks.PerDay || measurements[1].Flags&StateFlags.BadData == 0 {
+		t.Fatalf("TestPublishSubscribe: unexpected full timestamp measurement: %s", measurements[1].String())
+	}
+
+	// Publish a second set to verify TSSC encoder state remains synchronized
+	publisher.PublishMeasurements([]Measurement{
+		{SignalID: freqID, Value: 59.96, Timestamp: now + ticks.PerSecond/30},
+	})
+
+	select {
+	case measurements = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestPublishSubscribe: timed out waiting for measurements")
+	}
+
+	if len(measurements) != 1 || float32(measurements[0].Value) != float32(59.96) || measurements[0].Timestamp != now+ticks.PerSecond/30 {
+		t.Fatalf("TestPublishSubscribe: unexpected second measurement set: %v", measurements)
+	}
+
+	if connection.TotalMeasurementsSent() != 3 {
+		t.Fatalf("TestPublishSubscribe: expected 3 measurements sent, received: %d", connection.TotalMeasurementsSent())
+	}
+}
+
+func TestPublishWithStalledSubscriber(t *testing.T) {
+	publisher := NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Dispose()
+
+	received := make(chan []Measurement, 10)
+	connections := make([]*SubscriberConnection, 0, 2)
+
+	for i := 0; i < 2; i++ {
+		subscriber := NewDataSubscriber()
+		defer subscriber.Dispose()
+
+		if i == 0 {
+			subscriber.BeginCallbackAssignment()
+			subscriber.NewMeasurementsCallback = func(measurements *[]Measurement) {
+				received <- append([]Measurement(nil), *measurements...)
+			}
+			subscriber.EndCallbackAssignment()
+		}
+
+		if err := subscriber.Connect("127.0.0.1", publisher.Port()); err != nil {
+			t.Fatalf("TestPublishWithStalledSubscriber: failed to connect subscriber: %s", err.Error())
+		}
+
+		var connection *SubscriberConnection
+
+		waitFor(t, "subscriber connection validation", func() bool {
+			for _, candidate := range publisher.SubscriberConnections() {
+				if candidate.IsValidated() && (len(connections) == 0 || candidate != connections[0]) {
+					connection = candidate
+				}
+			}
+
+			return connection != nil
+		})
+
+		connections = append(connections, connection)
+		subscriber.Subscription().FilterExpression = "FILTER ActiveMeasurements WHERE SignalType = 'FREQ'"
+
+		if err := subscriber.Subscribe(); err != nil {
+			t.Fatalf("TestPublishWithStalledSubscriber: failed to subscribe: %s", err.Error())
+		}
+
+		waitFor(t, "signal index cache confirmation", func() bool {
+			return connection.ActiveSignalIndexCache() != nil && subscriber.IsSubscribed()
+		})
+	}
+
+	// Simulate a subscriber that is blocked while being sent measurements
+	stalled := connections[1]
+	stalled.publishMutex.Lock()
+	defer stalled.publishMutex.Unlock()
+
+	freqID, _ := guid.Parse(freqSignalID)
+	published := make(chan bool)
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			publisher.PublishMeasurements([]Measurement{{SignalID: freqID, Value: 60.0, Timestamp: ticks.UtcNow()}})
+		}
+
+		published <- true
+	}()
+
+	select {
+	case <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestPublishWithStalledSubscriber: publication blocked by stalled subscriber")
+	}
+
+	for i := 0; i < 3; i++ {
+		select {
+		case <-received:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("TestPublishWithStalledSubscriber: timed out waiting for measurements")
+		}
+	}
+
+	// Connection bookkeeping is not blocked by stalled subscriber
+	if len(publisher.SubscriberConnections()) != 2 {
+		t.Fatalf("TestPublishWithStalledSubscriber: expected 2 subscriber connections")
+	}
+}
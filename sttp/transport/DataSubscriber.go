@@ -25,10 +25,15 @@ package transport
 
 import (
 	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net"
 	"strconv"
 	"strings"
@@ -78,10 +83,35 @@ type DataSubscriber struct {
 	disconnected          abool.AtomicBool
 	disposing             abool.AtomicBool
 
+	responseWaiters      map[ServerCommandEnum][]chan error
+	responseWaitersMutex sync.Mutex
+
+	// Pending MetadataRefresh requests in the order they were sent, see RequestMetadataContext
+	metadataRefreshes      []*metadataRefresh
+	metadataRefreshesMutex sync.Mutex
+
+	// Raw frame capture, see SetCaptureWriter
+	captureWriter      *CaptureWriter
+	captureWriterMutex sync.RWMutex
+
 	// Statistics counters
 	totalCommandChannelBytesReceived uint64
 	totalDataChannelBytesReceived    uint64
 	totalMeasurementsReceived        uint64
+	totalEventsDropped               uint64
+
+	// Lifetime statistics counters, see Statistics
+	totalConnections           uint64
+	totalReconnects            uint64
+	lifetimeMeasurements       uint64
+	totalMetadataRefreshes     uint64
+	totalMetadataLatency       int64
+	lastMetadataLatency        int64
+	totalTSSCResets            uint64
+	totalTSSCOutOfSequence     uint64
+	totalSignalIndexCacheSwaps uint64
+	totalDecryptionFailures    uint64
+	callbackTimers             [callbackKindCount]callbackTimer
 
 	// StatusMessageCallback is called when a informational message should be logged.
 	StatusMessageCallback func(string)
@@ -101,6 +131,12 @@ type DataSubscriber struct {
 	// MetadataReceivedCallback is called when DataSubscriber receives a metadata response.
 	MetadataReceivedCallback func([]byte)
 
+	// MetadataReaderCallback is called when DataSubscriber receives a metadata response with a reader of
+	// the metadata XML. Compressed metadata is decompressed as the reader is read, so the full decompressed
+	// XML does not need to be held in memory. The reader is only valid until the callback returns. The callback
+	// is not called for responses to RequestMetadataContext requests that define their own metadata reader.
+	MetadataReaderCallback func(io.Reader)
+
 	// SubscriptionUpdatedCallback is called when DataSubscriber receives a new signal index cache.
 	SubscriptionUpdatedCallback func(signalIndexCache *SignalIndexCache)
 
@@ -123,6 +159,17 @@ type DataSubscriber struct {
 	// NotificationReceivedCallback is called when the DataPublisher sends a notification that requires receipt.
 	NotificationReceivedCallback func(string)
 
+	// EventChannel defines a channel that receives a structured Event for each diagnostic raised by the
+	// DataSubscriber and its SubscriberConnector. Events are sent without blocking, so the channel should
+	// be buffered; events that cannot be delivered are dropped and counted, see TotalEventsDropped.
+	EventChannel chan<- Event
+
+	// EventHandler defines a log/slog handler that receives each diagnostic Event as a log record,
+	// see Event.Record. Records below the minimum level enabled for the handler are not delivered.
+	// Like the message callbacks, records are delivered asynchronously, so they may not be handled
+	// in the order events were raised.
+	EventHandler slog.Handler
+
 	// CompressPayloadData determines whether payload data is compressed, defaults to TSSC.
 	CompressPayloadData bool
 
@@ -149,7 +196,6 @@ type DataSubscriber struct {
 	STTPUpdatedOnInfo string
 
 	// Measurement parsing
-	metadataRequested       time.Time
 	measurementRegistry     sync.Map
 	signalIndexCache        [2]*SignalIndexCache
 	signalIndexCacheMutex   sync.Mutex
@@ -254,6 +300,15 @@ func (ds *DataSubscriber) IsSubscribed() bool {
 	return ds.subscribed.IsSet()
 }
 
+// SecurityMode gets the SecurityMode of the current command channel connection.
+func (ds *DataSubscriber) SecurityMode() SecurityModeEnum {
+	if _, ok := ds.commandChannelSocket.(*tls.Conn); ok {
+		return SecurityMode.TLS
+	}
+
+	return SecurityMode.Off
+}
+
 // ConnectionID returns the IP address and DNS host name, if resolvable, of current STTP connection.
 func (ds *DataSubscriber) ConnectionID() string {
 	return ds.connectionID
@@ -320,10 +375,10 @@ func (ds *DataSubscriber) AdjustedValue(measurement *Measurement) float64 {
 // Connect requests the the DataSubscriber initiate a connection to the DataPublisher.
 func (ds *DataSubscriber) Connect(hostName string, port uint16) error {
 	// User requests to connection are not an auto-reconnect attempt
-	return ds.connect(hostName, port, false)
+	return ds.connect(context.Background(), hostName, port, false)
 }
 
-func (ds *DataSubscriber) connect(hostName string, port uint16, autoReconnecting bool) error {
+func (ds *DataSubscriber) connect(ctx context.Context, hostName string, port uint16, autoReconnecting bool) error {
 	if ds.connected.IsSet() {
 		return errors.New("subscriber is already connected; disconnect first")
 	}
@@ -357,14 +412,27 @@ func (ds *DataSubscriber) connect(hostName string, port uint16, autoReconnecting
 
 	ds.connector.connectionRefused.UnSet()
 
-	// TODO: Add TLS implementation options
-	conn, err := net.Dial("tcp", hostName+":"+strconv.Itoa(int(port)))
+	address := net.JoinHostPort(hostName, strconv.Itoa(int(port)))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
 
-	if err == nil {
-		ds.establishConnection(conn, false)
+	if err != nil {
+		return err
 	}
 
-	return err
+	if tlsConfig := ds.connector.TLSConfig; tlsConfig != nil {
+		if conn, err = tlsClientHandshake(ctx, conn, tlsConfig, hostName, address); err != nil {
+			return err
+		}
+	}
+
+	ds.establishConnection(conn, false)
+
+	if autoReconnecting {
+		atomic.AddUint64(&ds.totalReconnects, 1)
+	}
+
+	return nil
 }
 
 func (ds *DataSubscriber) setupConnection() {
@@ -391,13 +459,13 @@ func (ds *DataSubscriber) establishConnection(connection net.Conn, listening boo
 		}
 	} else {
 		connector := ds.connector
-		addrName = connector.Hostname + ":" + strconv.Itoa(int(connector.Port))
+		addrName = Endpoint{Hostname: connector.Hostname, Port: connector.Port}.String()
 	}
 
 	ds.connectionID = addrName
 
 	if listening {
-		ds.dispatchStatusMessage("Processing connection attempt from \"" + ds.connectionID + "\" ...")
+		ds.dispatchEvent(EventKind.Connection, EventSeverity.Information, nil, "Processing connection attempt from \""+ds.connectionID+"\" ...")
 	}
 
 	ds.commandChannelSocket = connection
@@ -405,6 +473,7 @@ func (ds *DataSubscriber) establishConnection(connection net.Conn, listening boo
 
 	ds.connected.Set()
 	ds.lastMissingCacheWarning = 0
+	atomic.AddUint64(&ds.totalConnections, 1)
 
 	ds.commandChannelResponseThread.Start()
 	ds.sendOperationalModes()
@@ -413,7 +482,9 @@ func (ds *DataSubscriber) establishConnection(connection net.Conn, listening boo
 	ds.BeginCallbackSync()
 
 	if ds.ConnectionEstablishedCallback != nil {
+		started := time.Now()
 		ds.ConnectionEstablishedCallback()
+		ds.timeCallback(callbackConnectionEstablished, started)
 	}
 
 	ds.EndCallbackSync()
@@ -440,7 +511,10 @@ func (ds *DataSubscriber) Listen(port uint16, networkInterface string) error {
 
 	var err error
 
-	// TODO: Add TLS implementation options
+	if tlsConfig := ds.connector.TLSConfig; tlsConfig != nil && len(tlsConfig.Certificates) == 0 && tlsConfig.GetCertificate == nil {
+		return errors.New("TLS configuration for listening connection does not define a server certificate")
+	}
+
 	ds.listeningSocket, err = net.Listen("tcp", networkInterface+":"+strconv.Itoa(int(port)))
 
 	if err != nil {
@@ -492,7 +566,7 @@ func (ds *DataSubscriber) Subscribe() error {
 	parameterBuilder.WriteString(strconv.FormatInt(int64(ds.subscription.ProcessingInterval), 10))
 	parameterBuilder.WriteString(";useMillisecondResolution=")
 	parameterBuilder.WriteString(strconv.FormatBool(ds.subscription.UseMillisecondResolution))
-	parameterBuilder.WriteString(";requestNaNValueFilter")
+	parameterBuilder.WriteString(";requestNaNValueFilter=")
 	parameterBuilder.WriteString(strconv.FormatBool(ds.subscription.RequestNaNValueFilter))
 	parameterBuilder.WriteString(";assemblyInfo={source=")
 	parameterBuilder.WriteString(ds.STTPSourceInfo)
@@ -581,7 +655,7 @@ func (ds *DataSubscriber) Unsubscribe() {
 
 	if ds.dataChannelSocket != nil {
 		if err := ds.dataChannelSocket.Close(); err != nil {
-			ds.dispatchErrorMessage("Exception while disconnecting data subscriber UDP data channel: " + err.Error())
+			ds.dispatchEvent(EventKind.Connection, EventSeverity.Error, err, "Exception while disconnecting data subscriber UDP data channel: "+err.Error())
 		}
 	}
 
@@ -659,19 +733,19 @@ func (ds *DataSubscriber) runDisconnectThread(autoReconnecting bool, includeList
 	// Release queues and close sockets so that threads can shut down gracefully
 	if includeListener && ds.listeningSocket != nil {
 		if err := ds.listeningSocket.Close(); err != nil {
-			ds.dispatchErrorMessage("Exception while disconnecting data subscriber TCP listening socket: " + err.Error())
+			ds.dispatchEvent(EventKind.Connection, EventSeverity.Error, err, "Exception while disconnecting data subscriber TCP listening socket: "+err.Error())
 		}
 	}
 
 	if ds.commandChannelSocket != nil {
 		if err := ds.commandChannelSocket.Close(); err != nil {
-			ds.dispatchErrorMessage("Exception while disconnecting data subscriber TCP command channel: " + err.Error())
+			ds.dispatchEvent(EventKind.Connection, EventSeverity.Error, err, "Exception while disconnecting data subscriber TCP command channel: "+err.Error())
 		}
 	}
 
 	if ds.dataChannelSocket != nil {
 		if err := ds.dataChannelSocket.Close(); err != nil {
-			ds.dispatchErrorMessage("Exception while disconnecting data subscriber UDP data channel: " + err.Error())
+			ds.dispatchEvent(EventKind.Connection, EventSeverity.Error, err, "Exception while disconnecting data subscriber UDP data channel: "+err.Error())
 		}
 	}
 
@@ -691,11 +765,17 @@ func (ds *DataSubscriber) runDisconnectThread(autoReconnecting bool, includeList
 		ds.dataChannelResponseThread = nil
 	}
 
+	// Release any operations still waiting on a server response
+	ds.abortServerResponses()
+	ds.abortMetadataRefreshes()
+
 	// Notify consumers of disconnect
 	ds.BeginCallbackSync()
 
 	if ds.ConnectionTerminatedCallback != nil {
+		started := time.Now()
 		ds.ConnectionTerminatedCallback()
+		ds.timeCallback(callbackConnectionTerminated, started)
 	}
 
 	ds.EndCallbackSync()
@@ -711,7 +791,9 @@ func (ds *DataSubscriber) runDisconnectThread(autoReconnecting bool, includeList
 		ds.BeginCallbackSync()
 
 		if ds.AutoReconnectCallback != nil && ds.disposing.IsNotSet() {
+			started := time.Now()
 			ds.AutoReconnectCallback()
+			ds.timeCallback(callbackAutoReconnect, started)
 		}
 
 		ds.EndCallbackSync()
@@ -729,24 +811,60 @@ func (ds *DataSubscriber) dispatchConnectionTerminated() {
 	ds.connectionTerminationThread.TryStart()
 }
 
-func (ds *DataSubscriber) dispatchStatusMessage(message string) {
+func (ds *DataSubscriber) dispatchEvent(kind EventKindEnum, severity EventSeverityEnum, err error, message string) {
+	ds.dispatchEventMessage(kind, severity, err, message, severity != EventSeverity.Information)
+}
+
+// dispatchStatusWarning dispatches a warning event with a message that is delivered to the status message
+// callback, rather than the error message callback, for compatibility with messages that predate events.
+func (ds *DataSubscriber) dispatchStatusWarning(kind EventKindEnum, err error, message string) {
+	ds.dispatchEventMessage(kind, EventSeverity.Warning, err, message, false)
+}
+
+func (ds *DataSubscriber) dispatchEventMessage(kind EventKindEnum, severity EventSeverityEnum, err error, message string, errorMessage bool) {
+	ds.publishEvent(&Event{
+		Time:         time.Now(),
+		Kind:         kind,
+		Severity:     severity,
+		ConnectionID: ds.connectionID,
+		Message:      message,
+		Err:          err,
+	})
+
+	// String callbacks are maintained for compatibility
 	ds.BeginCallbackSync()
 
-	if ds.StatusMessageCallback != nil {
-		go ds.StatusMessageCallback(message)
+	if !errorMessage {
+		if ds.StatusMessageCallback != nil {
+			go ds.invokeStringCallback(callbackStatusMessage, ds.StatusMessageCallback, message)
+		}
+	} else if ds.ErrorMessageCallback != nil {
+		go ds.invokeStringCallback(callbackErrorMessage, ds.ErrorMessageCallback, message)
 	}
 
 	ds.EndCallbackSync()
 }
 
-func (ds *DataSubscriber) dispatchErrorMessage(message string) {
+// publishEvent delivers an event to the structured event consumers, i.e.,
+// the event channel and event handler, when defined.
+func (ds *DataSubscriber) publishEvent(event *Event) {
 	ds.BeginCallbackSync()
+	eventChannel := ds.EventChannel
+	eventHandler := ds.EventHandler
+	ds.EndCallbackSync()
 
-	if ds.ErrorMessageCallback != nil {
-		go ds.ErrorMessageCallback(message)
+	if eventHandler != nil && eventHandler.Enabled(context.Background(), event.Severity.Level()) {
+		// Record is handled on its own Go routine so that a slow handler does not delay socket processing
+		go eventHandler.Handle(context.Background(), event.Record())
 	}
 
-	ds.EndCallbackSync()
+	if eventChannel != nil {
+		select {
+		case eventChannel <- *event:
+		default:
+			atomic.AddUint64(&ds.totalEventsDropped, 1)
+		}
+	}
 }
 
 func (ds *DataSubscriber) runListeningSocketAcceptThread() {
@@ -755,7 +873,7 @@ func (ds *DataSubscriber) runListeningSocketAcceptThread() {
 
 		if err != nil {
 			if ds.disconnecting.IsNotSet() {
-				ds.dispatchErrorMessage("Exception while accepting data publisher connection: " + err.Error())
+				ds.dispatchEvent(EventKind.Connection, EventSeverity.Error, err, "Exception while accepting data publisher connection: "+err.Error())
 			}
 
 			continue
@@ -780,22 +898,48 @@ func (ds *DataSubscriber) runListeningSocketAcceptThread() {
 				addrName = resolveDNSName(addr.String())
 			}
 
-			ds.dispatchErrorMessage("WARNING: Duplicate connection attempt detected from: \"" + addrName + "\". Existing data publisher connection already established, data subscriber will only accept one connection at a time - connection " + errMsg)
+			ds.dispatchEvent(EventKind.Connection, EventSeverity.Warning, ErrDuplicateConnection, "WARNING: Duplicate connection attempt detected from: \""+addrName+"\". Existing data publisher connection already established, data subscriber will only accept one connection at a time - connection "+errMsg)
 			continue
 		}
 
-		// Let any pending connect or disconnect operation complete before new connect,
-		// this prevents destruction disconnect before connection is completed
-		ds.connectActionMutex.Lock()
+		// TLS handshake is completed separately so a slow connection does not delay accepting others
+		if tlsConfig := ds.connector.TLSConfig; tlsConfig != nil {
+			go ds.acceptTLSConnection(conn, tlsConfig)
+			continue
+		}
 
-		// Initialize connection state
-		ds.setupConnection()
+		ds.acceptConnection(conn)
+	}
+}
 
-		// Create new command channel
-		ds.establishConnection(conn, true)
+func (ds *DataSubscriber) acceptTLSConnection(conn net.Conn, tlsConfig *tls.Config) {
+	conn, err := tlsServerHandshake(context.Background(), conn, tlsConfig)
 
-		ds.connectActionMutex.Unlock()
+	if err != nil {
+		ds.dispatchEvent(EventKind.Connection, EventSeverity.Error, err, "Data publisher connection rejected: "+err.Error())
+		return
+	}
+
+	ds.acceptConnection(conn)
+}
+
+func (ds *DataSubscriber) acceptConnection(conn net.Conn) {
+	// Let any pending connect or disconnect operation complete before new connect,
+	// this prevents destruction disconnect before connection is completed
+	ds.connectActionMutex.Lock()
+	defer ds.connectActionMutex.Unlock()
+
+	// Listener or another connection may have been closed or established during TLS handshake
+	if ds.listening.IsNotSet() || ds.connected.IsSet() {
+		conn.Close()
+		return
 	}
+
+	// Initialize connection state
+	ds.setupConnection()
+
+	// Create new command channel
+	ds.establishConnection(conn, true)
 }
 
 func (ds *DataSubscriber) runCommandChannelResponseThread() {
@@ -835,7 +979,7 @@ func (ds *DataSubscriber) readPayloadHeader(bytesTransferred int, err error) {
 			const maxInitialPacketSize = responseHeaderSize + 8192
 
 			if packetSize > maxInitialPacketSize {
-				ds.dispatchErrorMessage("Possible invalid protocol detected from \"" + ds.connectionID + "\": encountered request for " + strconv.Itoa(int(packetSize)) + " byte initial packet size -- connection likely from non-STTP client, disconnecting.")
+				ds.dispatchEvent(EventKind.Protocol, EventSeverity.Error, ErrInvalidProtocol, "Possible invalid protocol detected from \""+ds.connectionID+"\": encountered request for "+strconv.Itoa(int(packetSize))+" byte initial packet size -- connection likely from non-STTP client, disconnecting.")
 				ds.dispatchConnectionTerminated()
 				return
 			}
@@ -870,6 +1014,8 @@ func (ds *DataSubscriber) readPacket(bytesTransferred int, err error) {
 	// Gather statistics
 	atomic.AddUint64(&ds.totalCommandChannelBytesReceived, uint64(bytesTransferred))
 
+	ds.captureFrame(CaptureDirection.Received, CaptureChannel.Command, ds.readBuffer[:bytesTransferred])
+
 	// Process response
 	ds.processServerResponse(ds.readBuffer[:bytesTransferred])
 }
@@ -884,13 +1030,15 @@ func (ds *DataSubscriber) runDataChannelResponseThread() {
 		length, err := reader.Read(buffer)
 
 		if err != nil {
-			ds.dispatchErrorMessage("Error reading data from command channel: " + err.Error())
+			ds.dispatchEvent(EventKind.Connection, EventSeverity.Error, err, "Error reading data from command channel: "+err.Error())
 			break
 		}
 
 		// Gather statistics
 		atomic.AddUint64(&ds.totalDataChannelBytesReceived, uint64(length))
 
+		ds.captureFrame(CaptureDirection.Received, CaptureChannel.Data, buffer[:length])
+
 		// Process response
 		ds.processServerResponse(buffer[:length])
 	}
@@ -904,7 +1052,7 @@ func (ds *DataSubscriber) processServerResponse(buffer []byte) {
 
 	if ds.validated.IsNotSet() {
 		if responseCode != ServerResponse.NoOP && (commandCode != ServerCommand.DefineOperationalModes || (responseCode != ServerResponse.Succeeded && responseCode != ServerResponse.Failed)) {
-			ds.dispatchErrorMessage("Possible invalid protocol detected from \"" + ds.connectionID + "\": encountered unexpected initial command / response code: " + commandCode.String() + " / " + responseCode.String() + " -- connection likely from non-STTP client, disconnecting.")
+			ds.dispatchEvent(EventKind.Protocol, EventSeverity.Error, ErrInvalidProtocol, "Possible invalid protocol detected from \""+ds.connectionID+"\": encountered unexpected initial command / response code: "+commandCode.String()+" / "+responseCode.String()+" -- connection likely from non-STTP client, disconnecting.")
 			ds.dispatchConnectionTerminated()
 			return
 		}
@@ -915,8 +1063,15 @@ func (ds *DataSubscriber) processServerResponse(buffer []byte) {
 	switch responseCode {
 	case ServerResponse.Succeeded:
 		ds.handleSucceeded(commandCode, data)
+		ds.completeServerResponse(commandCode, nil)
 	case ServerResponse.Failed:
-		ds.handleFailed(commandCode, data)
+		err := &ServerCommandError{Command: commandCode, Message: ds.DecodeString(data)}
+		ds.handleFailed(err)
+		ds.completeServerResponse(commandCode, err)
+
+		if commandCode == ServerCommand.MetadataRefresh {
+			ds.nextMetadataRefresh().complete(err)
+		}
 	case ServerResponse.DataPacket:
 		ds.handleDataPacket(data)
 	case ServerResponse.DataStartTime:
@@ -938,14 +1093,14 @@ func (ds *DataSubscriber) processServerResponse(buffer []byte) {
 	case ServerResponse.NoOP:
 		// NoOP handled
 	default:
-		ds.dispatchErrorMessage("Encountered unexpected server response code: " + responseCode.String() + " from \"" + ds.connectionID + "\"")
+		ds.dispatchEvent(EventKind.Protocol, EventSeverity.Warning, ErrUnexpectedResponse, "Encountered unexpected server response code: "+responseCode.String()+" from \""+ds.connectionID+"\"")
 	}
 }
 
 func (ds *DataSubscriber) handleSucceeded(commandCode ServerCommandEnum, data []byte) {
 	switch commandCode {
 	case ServerCommand.MetadataRefresh:
-		ds.handleMetadataRefresh(data)
+		ds.handleMetadataRefresh(data, ds.nextMetadataRefresh())
 	case ServerCommand.Subscribe, ServerCommand.Unsubscribe:
 		if commandCode == ServerCommand.Subscribe {
 			ds.subscribed.Set()
@@ -968,17 +1123,18 @@ func (ds *DataSubscriber) handleSucceeded(commandCode ServerCommandEnum, data []
 			message.Write(data)
 		}
 
-		ds.dispatchStatusMessage(message.String())
+		ds.dispatchEvent(EventKind.ServerResponse, EventSeverity.Information, nil, message.String())
 	default:
 		// If we don't know what the message is, we can't interpret
 		// the data sent with the packet. Deliver an error message
 		// to the user via the error message callback.
-		ds.dispatchErrorMessage("Received success code in response to unknown server command: " + commandCode.String())
+		ds.dispatchEvent(EventKind.Protocol, EventSeverity.Warning, ErrUnexpectedResponse, "Received success code in response to unknown server command: "+commandCode.String())
 	}
 }
 
-func (ds *DataSubscriber) handleFailed(commandCode ServerCommandEnum, data []byte) {
+func (ds *DataSubscriber) handleFailed(err *ServerCommandError) {
 	var message strings.Builder
+	commandCode := err.Command
 
 	if commandCode == ServerCommand.Connect || commandCode == ServerCommand.DefineOperationalModes {
 		ds.connector.connectionRefused.Set()
@@ -987,43 +1143,98 @@ func (ds *DataSubscriber) handleFailed(commandCode ServerCommandEnum, data []byt
 		message.WriteString(commandCode.String())
 	}
 
-	if len(data) > 0 {
+	if len(err.Message) > 0 {
 		if message.Len() > 0 {
 			message.WriteRune('\n')
 		}
 
-		message.Write(data)
+		message.WriteString(err.Message)
 	}
 
 	if message.Len() > 0 {
-		ds.dispatchErrorMessage(message.String())
+		ds.dispatchEvent(EventKind.ServerResponse, EventSeverity.Error, err, message.String())
 	}
 }
 
-func (ds *DataSubscriber) handleMetadataRefresh(data []byte) {
+func (ds *DataSubscriber) handleMetadataRefresh(data []byte, refresh *metadataRefresh) {
+	latency := refresh.latency()
+	atomic.AddUint64(&ds.totalMetadataRefreshes, 1)
+	atomic.AddInt64(&ds.totalMetadataLatency, int64(latency))
+	atomic.StoreInt64(&ds.lastMetadataLatency, int64(latency))
+
+	// Metadata is parsed asynchronously, so it is copied out of the read buffer that is reused by the next read
+	data = bytes.Clone(data)
+
 	ds.BeginCallbackSync()
 	metadataReceivedCallback := ds.MetadataReceivedCallback
+	metadataReaderCallback := refresh.readerCallback(ds.MetadataReaderCallback)
 	ds.EndCallbackSync()
 
-	if metadataReceivedCallback != nil {
-		if ds.CompressMetadata {
-			ds.dispatchStatusMessage(fmt.Sprintf("Received %s bytes of metadata in %s seconds. Decompressing...", format.Int(len(data)), format.Float(time.Since(ds.metadataRequested).Seconds(), 3)))
+	if metadataReceivedCallback == nil {
+		// When only a reader is requested, metadata is decompressed while it is being parsed
+		if metadataReaderCallback != nil {
+			ds.dispatchEvent(EventKind.Metadata, EventSeverity.Information, nil, fmt.Sprintf("Received %s bytes of metadata in %s seconds. Parsing...", format.Int(len(data)), format.Float(latency.Seconds(), 3)))
+			go ds.readMetadata(data, metadataReaderCallback, refresh)
+		} else {
+			refresh.complete(nil)
+		}
 
-			decompressStarted := time.Now()
-			var err error
+		return
+	}
 
-			if data, err = decompressGZip(data); err != nil {
-				ds.dispatchErrorMessage("Failed to decompress received metadata: " + err.Error())
-				return
-			}
+	if ds.CompressMetadata {
+		ds.dispatchEvent(EventKind.Metadata, EventSeverity.Information, nil, fmt.Sprintf("Received %s bytes of metadata in %s seconds. Decompressing...", format.Int(len(data)), format.Float(latency.Seconds(), 3)))
 
-			ds.dispatchStatusMessage(fmt.Sprintf("Decompressed %s bytes of metadata in %s seconds. Parsing...", format.Int(len(data)), format.Float(time.Since(decompressStarted).Seconds(), 3)))
-		} else {
-			ds.dispatchStatusMessage(fmt.Sprintf("Received %s bytes of metadata in %s seconds. Parsing...", format.Int(len(data)), format.Float(time.Since(ds.metadataRequested).Seconds(), 3)))
+		decompressStarted := time.Now()
+		var err error
+
+		if data, err = decompressGZip(data); err != nil {
+			ds.dispatchEvent(EventKind.Metadata, EventSeverity.Error, fmt.Errorf("%w: %w", ErrMetadataDecompression, err), "Failed to decompress received metadata: "+err.Error())
+			refresh.complete(fmt.Errorf("%w: %w", ErrMetadataDecompression, err))
+			return
 		}
 
-		go metadataReceivedCallback(data)
+		ds.dispatchEvent(EventKind.Metadata, EventSeverity.Information, nil, fmt.Sprintf("Decompressed %s bytes of metadata in %s seconds. Parsing...", format.Int(len(data)), format.Float(time.Since(decompressStarted).Seconds(), 3)))
+	} else {
+		ds.dispatchEvent(EventKind.Metadata, EventSeverity.Information, nil, fmt.Sprintf("Received %s bytes of metadata in %s seconds. Parsing...", format.Int(len(data)), format.Float(latency.Seconds(), 3)))
+	}
+
+	if metadataReaderCallback != nil {
+		go func() {
+			refresh.complete(ds.invokeMetadataReaderCallback(metadataReaderCallback, bytes.NewReader(data)))
+		}()
+	} else {
+		refresh.complete(nil)
 	}
+
+	go func() {
+		defer ds.timeCallback(callbackMetadataReceived, time.Now())
+		metadataReceivedCallback(data)
+	}()
+}
+
+func (ds *DataSubscriber) readMetadata(data []byte, metadataReaderCallback func(io.Reader) error, refresh *metadataRefresh) {
+	if !ds.CompressMetadata {
+		refresh.complete(ds.invokeMetadataReaderCallback(metadataReaderCallback, bytes.NewReader(data)))
+		return
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+
+	if err != nil {
+		ds.dispatchEvent(EventKind.Metadata, EventSeverity.Error, fmt.Errorf("%w: %w", ErrMetadataDecompression, err), "Failed to decompress received metadata: "+err.Error())
+		refresh.complete(fmt.Errorf("%w: %w", ErrMetadataDecompression, err))
+		return
+	}
+
+	defer reader.Close()
+
+	refresh.complete(ds.invokeMetadataReaderCallback(metadataReaderCallback, reader))
+}
+
+func (ds *DataSubscriber) invokeMetadataReaderCallback(metadataReaderCallback func(io.Reader) error, reader io.Reader) error {
+	defer ds.timeCallback(callbackMetadataReader, time.Now())
+	return metadataReaderCallback(reader)
 }
 
 func (ds *DataSubscriber) handleDataStartTime(data []byte) {
@@ -1032,7 +1243,9 @@ func (ds *DataSubscriber) handleDataStartTime(data []byte) {
 	if ds.DataStartTimeCallback != nil {
 		// Do not use Go routine here, processing sequence may be important.
 		// Execute callback directly from socket processing thread:
+		started := time.Now()
 		ds.DataStartTimeCallback(ticks.Ticks(binary.BigEndian.Uint64(data)))
+		ds.timeCallback(callbackDataStartTime, started)
 	}
 
 	ds.EndCallbackSync()
@@ -1042,7 +1255,7 @@ func (ds *DataSubscriber) handleProcessingComplete(data []byte) {
 	ds.BeginCallbackSync()
 
 	if ds.ProcessingCompleteCallback != nil {
-		go ds.ProcessingCompleteCallback(ds.DecodeString(data))
+		go ds.invokeStringCallback(callbackProcessingComplete, ds.ProcessingCompleteCallback, ds.DecodeString(data))
 	}
 
 	ds.EndCallbackSync()
@@ -1069,7 +1282,7 @@ func (ds *DataSubscriber) handleUpdateSignalIndexCache(data []byte) {
 		var err error
 
 		if data, err = decompressGZip(data); err != nil {
-			ds.dispatchErrorMessage("Failed to decompress received signal index cache: " + err.Error())
+			ds.dispatchEvent(EventKind.SignalIndexCache, EventSeverity.Error, fmt.Errorf("%w: %w", ErrSignalIndexCache, err), "Failed to decompress received signal index cache: "+err.Error())
 			return
 		}
 	}
@@ -1078,7 +1291,7 @@ func (ds *DataSubscriber) handleUpdateSignalIndexCache(data []byte) {
 	err := signalIndexCache.decode(ds, data, &ds.subscriberID)
 
 	if err != nil {
-		ds.dispatchErrorMessage("Failed to parse signal index cache: " + err.Error())
+		ds.dispatchEvent(EventKind.SignalIndexCache, EventSeverity.Error, fmt.Errorf("%w: %w", ErrSignalIndexCache, err), "Failed to parse signal index cache: "+err.Error())
 		return
 	}
 
@@ -1087,14 +1300,19 @@ func (ds *DataSubscriber) handleUpdateSignalIndexCache(data []byte) {
 	ds.cacheIndex = cacheIndex
 	ds.signalIndexCacheMutex.Unlock()
 
+	atomic.AddUint64(&ds.totalSignalIndexCacheSwaps, 1)
+
 	if version > 1 {
 		ds.SendServerCommand(ServerCommand.ConfirmUpdateSignalIndexCache)
 	}
 
 	ds.BeginCallbackSync()
 
-	if ds.SubscriptionUpdatedCallback != nil {
-		go ds.SubscriptionUpdatedCallback(signalIndexCache)
+	if subscriptionUpdatedCallback := ds.SubscriptionUpdatedCallback; subscriptionUpdatedCallback != nil {
+		go func() {
+			defer ds.timeCallback(callbackSubscriptionUpdated, time.Now())
+			subscriptionUpdatedCallback(signalIndexCache)
+		}()
 	}
 
 	ds.EndCallbackSync()
@@ -1121,67 +1339,65 @@ func (ds *DataSubscriber) handleUpdateBaseTimes(data []byte) {
 	ds.baseTimeOffsets = baseTimeOffsets
 
 	timestamp, _ := ticks.Ticks(ds.baseTimeOffsets[ds.timeIndex^1]).ToTime().MarshalText()
-	ds.dispatchStatusMessage("Received new base time offset from publisher: " + string(timestamp))
+	ds.dispatchEvent(EventKind.BaseTimeOffsets, EventSeverity.Information, nil, "Received new base time offset from publisher: "+string(timestamp))
 }
 
 func (ds *DataSubscriber) handleUpdateCipherKeys(data []byte) {
 	// Deserialize new cipher keys
-	keyIVs := make([][][]byte, 2)
-	keyIVs[evenKey] = make([][]byte, 2)
-	keyIVs[oddKey] = make([][]byte, 2)
+	keyIVs, err := parseCipherKeys(data)
 
-	// Move past active cipher index (not currently used anywhere else)
-	var index uint32 = 1
-
-	// Read even key size
-	bufferLen := binary.BigEndian.Uint32(data[index:])
-	index += 4
+	if err != nil {
+		ds.dispatchEvent(EventKind.CipherKeys, EventSeverity.Error, err, "Failed to parse received cipher keys: "+err.Error())
+		return
+	}
 
-	// Read even key
-	keyIVs[evenKey][keyIndex] = make([]byte, bufferLen)
-	copy(keyIVs[evenKey][keyIndex], data[index:])
-	index += bufferLen
+	// Exchange keys
+	ds.keyIVs = keyIVs
 
-	// Read even initialization vector size
-	bufferLen = binary.BigEndian.Uint32(data[index:])
-	index += 4
+	ds.dispatchEvent(EventKind.CipherKeys, EventSeverity.Information, nil, "Successfully established new cipher keys for UDP data packet transmissions.")
+}
 
-	// Read even initialization vector
-	keyIVs[evenKey][ivIndex] = make([]byte, bufferLen)
-	copy(keyIVs[evenKey][ivIndex], data[index:])
-	index += bufferLen
+// parseCipherKeys parses the even and odd keys and initialization vectors of a cipher keys update.
+func parseCipherKeys(data []byte) ([][][]byte, error) {
+	keyIVs := make([][][]byte, 2)
+	keyIVs[evenKey] = make([][]byte, 2)
+	keyIVs[oddKey] = make([][]byte, 2)
 
-	// Read odd key size
-	bufferLen = binary.BigEndian.Uint32(data[index:])
-	index += 4
+	// Move past active cipher index (not currently used anywhere else)
+	index := 1
 
-	// Read odd key
-	keyIVs[oddKey][keyIndex] = make([]byte, bufferLen)
-	copy(keyIVs[oddKey][keyIndex], data[index:])
-	index += bufferLen
+	// Read even key, even initialization vector, odd key and odd initialization vector, each preceded by its size
+	for _, cipherIndex := range []int{evenKey, oddKey} {
+		for _, valueIndex := range []int{keyIndex, ivIndex} {
+			if len(data)-index < 4 {
+				return nil, errors.New("not enough buffer provided to parse cipher keys")
+			}
 
-	// Read odd initialization vector size
-	bufferLen = binary.BigEndian.Uint32(data[index:])
-	index += 4
+			bufferLen := binary.BigEndian.Uint32(data[index:])
+			index += 4
 
-	// Read odd initialization vector
-	keyIVs[oddKey][ivIndex] = make([]byte, bufferLen)
-	copy(keyIVs[oddKey][ivIndex], data[index:])
-	//index += bufferLen
+			if uint64(len(data)-index) < uint64(bufferLen) {
+				return nil, errors.New("not enough buffer provided to parse cipher keys")
+			}
 
-	// Exchange keys
-	ds.keyIVs = keyIVs
+			keyIVs[cipherIndex][valueIndex] = make([]byte, bufferLen)
+			index += copy(keyIVs[cipherIndex][valueIndex], data[index:])
+		}
+	}
 
-	ds.dispatchStatusMessage("Successfully established new cipher keys for UDP data packet transmissions.")
+	return keyIVs, nil
 }
 
 func (ds *DataSubscriber) handleConfigurationChanged() {
-	ds.dispatchStatusMessage("Received notification from publisher that configuration has changed.")
+	ds.dispatchEvent(EventKind.ConfigurationChanged, EventSeverity.Information, nil, "Received notification from publisher that configuration has changed.")
 
 	ds.BeginCallbackSync()
 
-	if ds.ConfigurationChangedCallback != nil {
-		go ds.ConfigurationChangedCallback()
+	if configurationChangedCallback := ds.ConfigurationChangedCallback; configurationChangedCallback != nil {
+		go func() {
+			defer ds.timeCallback(callbackConfigurationChanged, time.Now())
+			configurationChangedCallback()
+		}()
 	}
 
 	ds.EndCallbackSync()
@@ -1193,7 +1409,7 @@ func (ds *DataSubscriber) handleDataPacket(data []byte) {
 	compact := dataPacketFlags&DataPacketFlags.Compact > 0
 
 	if !compressed && !compact {
-		ds.dispatchErrorMessage("Go implementation of STTP only supports compact or compressed data packet encoding - disconnecting.")
+		ds.dispatchEvent(EventKind.DataPacket, EventSeverity.Error, ErrUnsupportedDataPacket, "Go implementation of STTP only supports compact or compressed data packet encoding - disconnecting.")
 		ds.dispatchConnectionTerminated()
 		return
 	}
@@ -1213,7 +1429,8 @@ func (ds *DataSubscriber) handleDataPacket(data []byte) {
 		data, err = decipherAES(keyIVs[cipherIndex][keyIndex], keyIVs[cipherIndex][ivIndex], data)
 
 		if err != nil {
-			ds.dispatchErrorMessage("Failed to decrypt data packet - disconnecting: " + err.Error())
+			atomic.AddUint64(&ds.totalDecryptionFailures, 1)
+			ds.dispatchEvent(EventKind.DataPacket, EventSeverity.Error, fmt.Errorf("%w: %w", ErrDecryptionFailed, err), "Failed to decrypt data packet - disconnecting: "+err.Error())
 			ds.dispatchConnectionTerminated()
 			return
 		}
@@ -1253,12 +1470,15 @@ func (ds *DataSubscriber) handleDataPacket(data []byte) {
 	if ds.NewMeasurementsCallback != nil {
 		// Do not use Go routine here, processing sequence may be important.
 		// Execute callback directly from socket processing thread:
+		started := time.Now()
 		ds.NewMeasurementsCallback(measurements)
+		ds.timeCallback(callbackNewMeasurements, started)
 	}
 
 	ds.EndCallbackSync()
 
 	atomic.AddUint64(&ds.totalMeasurementsReceived, uint64(count))
+	atomic.AddUint64(&ds.lifetimeMeasurements, uint64(count))
 }
 
 func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCache, data []byte, measurements []Measurement) {
@@ -1273,8 +1493,8 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 		newDecoder = true
 	}
 
-	if data[0] != 85 {
-		ds.dispatchErrorMessage("TSSC version not recognized - disconnecting. Received version: " + strconv.Itoa(int(data[0])))
+	if data[0] != tssc.Version {
+		ds.dispatchEvent(EventKind.DataPacket, EventSeverity.Error, ErrUnsupportedTSSCVersion, "TSSC version not recognized - disconnecting. Received version: "+strconv.Itoa(int(data[0])))
 		ds.dispatchConnectionTerminated()
 		return
 	}
@@ -1284,12 +1504,13 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 	if sequenceNumber == 0 {
 		if !newDecoder {
 			if decoder.SequenceNumber > 0 {
-				ds.dispatchStatusMessage("TSSC algorithm reset before sequence number: " + strconv.Itoa(int(decoder.SequenceNumber)))
+				ds.dispatchEvent(EventKind.DataPacket, EventSeverity.Information, nil, "TSSC algorithm reset before sequence number: "+strconv.Itoa(int(decoder.SequenceNumber)))
 			}
 
 			signalIndexCache.tsscDecoder = tssc.NewDecoder()
 			decoder = signalIndexCache.tsscDecoder
 			decoder.SequenceNumber = 0
+			atomic.AddUint64(&ds.totalTSSCResets, 1)
 		}
 
 		ds.tsscResetRequested.UnSet()
@@ -1300,10 +1521,11 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 
 	if decoder.SequenceNumber != sequenceNumber {
 		if ds.tsscResetRequested.IsNotSet() {
+			atomic.AddUint64(&ds.totalTSSCOutOfSequence, 1)
 			ds.tsscLastOOSReportMutex.Lock()
 
 			if time.Since(ds.tsscLastOOSReport).Seconds() > 2.0 {
-				ds.dispatchErrorMessage("TSSC is out of sequence. Expecting: " + strconv.Itoa(int(decoder.SequenceNumber)) + ", received: " + strconv.Itoa(int(sequenceNumber)))
+				ds.dispatchEvent(EventKind.DataPacket, EventSeverity.Warning, ErrTSSCOutOfSequence, "TSSC is out of sequence. Expecting: "+strconv.Itoa(int(decoder.SequenceNumber))+", received: "+strconv.Itoa(int(sequenceNumber)))
 				ds.tsscLastOOSReport = time.Now()
 			}
 
@@ -1339,7 +1561,7 @@ func (ds *DataSubscriber) parseTSSCMeasurements(signalIndexCache *SignalIndexCac
 	}
 
 	if err != nil {
-		ds.dispatchErrorMessage("Failed to parse TSSC measurements - disconnecting: " + err.Error())
+		ds.dispatchEvent(EventKind.DataPacket, EventSeverity.Error, fmt.Errorf("%w: %w", ErrMeasurementParse, err), "Failed to parse TSSC measurements - disconnecting: "+err.Error())
 		ds.dispatchConnectionTerminated()
 		return
 	}
@@ -1357,7 +1579,7 @@ func (ds *DataSubscriber) parseCompactMeasurements(signalIndexCache *SignalIndex
 		if ds.lastMissingCacheWarning+missingCacheWarningInterval < ticks.UtcNow() {
 			// Warning message for missing signal index cache
 			if ds.lastMissingCacheWarning != 0 {
-				ds.dispatchStatusMessage("WARNING: Signal index cache has not arrived. No compact measurements can be parsed.")
+				ds.dispatchStatusWarning(EventKind.SignalIndexCache, ErrSignalIndexCacheMissing, "WARNING: Signal index cache has not arrived. No compact measurements can be parsed.")
 			}
 
 			ds.lastMissingCacheWarning = ticks.UtcNow()
@@ -1374,7 +1596,7 @@ func (ds *DataSubscriber) parseCompactMeasurements(signalIndexCache *SignalIndex
 		cm, n, err := NewCompactMeasurement(includeTime, useMillisecondResolution, &ds.baseTimeOffsets, data)
 
 		if err != nil {
-			ds.dispatchErrorMessage("Failed to parse compact measurements - disconnecting: " + err.Error())
+			ds.dispatchEvent(EventKind.DataPacket, EventSeverity.Error, fmt.Errorf("%w: %w", ErrMeasurementParse, err), "Failed to parse compact measurements - disconnecting: "+err.Error())
 			ds.dispatchConnectionTerminated()
 			return
 		}
@@ -1445,7 +1667,9 @@ func (ds *DataSubscriber) handleBufferBlock(data []byte) {
 			if ds.NewBufferBlocksCallback != nil {
 				// Do not use Go routine here, processing sequence may be important.
 				// Execute callback directly from socket processing thread:
+				started := time.Now()
 				ds.NewBufferBlocksCallback(bufferBlockMeasurements)
+				ds.timeCallback(callbackNewBufferBlocks, started)
 			}
 
 			ds.EndCallbackSync()
@@ -1467,12 +1691,12 @@ func (ds *DataSubscriber) handleNotification(data []byte) {
 	// Skip the 4-byte hash and decode notification message
 	message := ds.DecodeString(data[4:])
 
-	ds.dispatchStatusMessage("NOTIFICATION: " + message)
+	ds.dispatchEvent(EventKind.Notification, EventSeverity.Information, nil, "NOTIFICATION: "+message)
 
 	ds.BeginCallbackSync()
 
 	if ds.NotificationReceivedCallback != nil {
-		go ds.NotificationReceivedCallback(message)
+		go ds.invokeStringCallback(callbackNotificationReceived, ds.NotificationReceivedCallback, message)
 	}
 
 	ds.EndCallbackSync()
@@ -1481,6 +1705,180 @@ func (ds *DataSubscriber) handleNotification(data []byte) {
 	ds.SendServerCommandWithPayload(ServerCommand.ConfirmNotification, data[:4])
 }
 
+// AwaitServerResponse registers interest in the next ServerResponse.Succeeded or ServerResponse.Failed
+// response from the DataPublisher for the specified commandCode. Register before sending the command so
+// that a fast response cannot be missed. The returned channel receives exactly one result: nil on success,
+// a *ServerCommandError on failure or ErrConnectionTerminated if the connection is closed before a response
+// arrives. Call release once the result is no longer needed, e.g., after a context expires.
+func (ds *DataSubscriber) AwaitServerResponse(commandCode ServerCommandEnum) (result <-chan error, release func()) {
+	waiter := make(chan error, 1)
+
+	ds.responseWaitersMutex.Lock()
+
+	if ds.responseWaiters == nil {
+		ds.responseWaiters = make(map[ServerCommandEnum][]chan error)
+	}
+
+	ds.responseWaiters[commandCode] = append(ds.responseWaiters[commandCode], waiter)
+	ds.responseWaitersMutex.Unlock()
+
+	release = func() {
+		ds.responseWaitersMutex.Lock()
+		defer ds.responseWaitersMutex.Unlock()
+
+		waiters := ds.responseWaiters[commandCode]
+
+		for i, pending := range waiters {
+			if pending == waiter {
+				ds.responseWaiters[commandCode] = append(waiters[:i:i], waiters[i+1:]...)
+				break
+			}
+		}
+
+		if len(ds.responseWaiters[commandCode]) == 0 {
+			delete(ds.responseWaiters, commandCode)
+		}
+	}
+
+	return waiter, release
+}
+
+// SendServerCommandContext sends a server command code to the DataPublisher along with the specified
+// data payload, then blocks until the DataPublisher responds to the command or the context expires.
+// A ServerResponse.Failed response is returned as a *ServerCommandError.
+func (ds *DataSubscriber) SendServerCommandContext(ctx context.Context, commandCode ServerCommandEnum, data []byte) error {
+	if ds.connected.IsNotSet() {
+		return ErrNotConnected
+	}
+
+	result, release := ds.AwaitServerResponse(commandCode)
+	defer release()
+
+	ds.SendServerCommandWithPayload(commandCode, data)
+
+	return waitServerResponse(ctx, result)
+}
+
+// SubscribeContext notifies the DataPublisher that a DataSubscriber would like to start receiving
+// streaming data, then blocks until the DataPublisher responds to the subscription or the context
+// expires. A rejected subscription is returned as a *ServerCommandError.
+func (ds *DataSubscriber) SubscribeContext(ctx context.Context) error {
+	if ds.connected.IsNotSet() {
+		return ErrNotConnected
+	}
+
+	result, release := ds.AwaitServerResponse(ServerCommand.Subscribe)
+	defer release()
+
+	if err := ds.Subscribe(); err != nil {
+		return err
+	}
+
+	return waitServerResponse(ctx, result)
+}
+
+func waitServerResponse(ctx context.Context, result <-chan error) error {
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (ds *DataSubscriber) completeServerResponse(commandCode ServerCommandEnum, err error) {
+	ds.responseWaitersMutex.Lock()
+	waiters := ds.responseWaiters[commandCode]
+	delete(ds.responseWaiters, commandCode)
+	ds.responseWaitersMutex.Unlock()
+
+	// Waiter channels are buffered, so sends never block
+	for _, waiter := range waiters {
+		waiter <- err
+	}
+}
+
+func (ds *DataSubscriber) abortServerResponses() {
+	ds.responseWaitersMutex.Lock()
+	responseWaiters := ds.responseWaiters
+	ds.responseWaiters = nil
+	ds.responseWaitersMutex.Unlock()
+
+	for _, waiters := range responseWaiters {
+		for _, waiter := range waiters {
+			waiter <- ErrConnectionTerminated
+		}
+	}
+}
+
+// metadataRefresh defines a pending MetadataRefresh request, see RequestMetadataContext.
+// A nil result is used for requests that are not waiting on a result.
+type metadataRefresh struct {
+	reader    func(io.Reader) error
+	result    chan error
+	requested time.Time
+}
+
+// latency gets the time elapsed since the request was sent, or zero for an unrequested response.
+func (refresh *metadataRefresh) latency() time.Duration {
+	if refresh == nil {
+		return 0
+	}
+
+	return time.Since(refresh.requested)
+}
+
+// readerCallback gets the reader to call with the metadata received for the request.
+func (refresh *metadataRefresh) readerCallback(metadataReaderCallback func(io.Reader)) func(io.Reader) error {
+	if refresh != nil && refresh.reader != nil {
+		return refresh.reader
+	}
+
+	if metadataReaderCallback == nil {
+		return nil
+	}
+
+	return func(reader io.Reader) error {
+		metadataReaderCallback(reader)
+		return nil
+	}
+}
+
+func (refresh *metadataRefresh) complete(err error) {
+	// Result channel is buffered and receives one result, so send never blocks
+	if refresh != nil && refresh.result != nil {
+		refresh.result <- err
+	}
+}
+
+// nextMetadataRefresh dequeues the oldest pending MetadataRefresh request, responses
+// are received in the order requests were sent.
+func (ds *DataSubscriber) nextMetadataRefresh() *metadataRefresh {
+	ds.metadataRefreshesMutex.Lock()
+	defer ds.metadataRefreshesMutex.Unlock()
+
+	if len(ds.metadataRefreshes) == 0 {
+		return nil
+	}
+
+	refresh := ds.metadataRefreshes[0]
+	ds.metadataRefreshes[0] = nil
+	ds.metadataRefreshes = ds.metadataRefreshes[1:]
+
+	return refresh
+}
+
+func (ds *DataSubscriber) abortMetadataRefreshes() {
+	ds.metadataRefreshesMutex.Lock()
+	refreshes := ds.metadataRefreshes
+	ds.metadataRefreshes = nil
+	ds.metadataRefreshesMutex.Unlock()
+
+	for _, refresh := range refreshes {
+		refresh.complete(ErrConnectionTerminated)
+	}
+}
+
 // SendServerCommand sends a server command code to the DataPublisher with no payload.
 func (ds *DataSubscriber) SendServerCommand(commandCode ServerCommandEnum) {
 	ds.SendServerCommandWithPayload(commandCode, nil)
@@ -1493,7 +1891,38 @@ func (ds *DataSubscriber) SendServerCommandWithMessage(commandCode ServerCommand
 
 // SendServerCommandWithPayload sends a server command code to the DataPublisher along with the specified data payload.
 func (ds *DataSubscriber) SendServerCommandWithPayload(commandCode ServerCommandEnum, data []byte) {
+	ds.sendServerCommand(commandCode, data, nil)
+}
+
+// RequestMetadataContext sends a ServerCommand.MetadataRefresh command to the DataPublisher with the specified
+// payload, e.g., encoded metadata filters, then blocks until the response to this request has been processed or
+// the context expires. Responses to other metadata requests, including those sent with SendServerCommand, do not
+// complete the request. The metadataReader, when not nil, is called in place of MetadataReaderCallback with the
+// metadata received in response to this request and its error is returned. A ServerResponse.Failed response is
+// returned as a *ServerCommandError and metadata that cannot be decompressed as an ErrMetadataDecompression error.
+func (ds *DataSubscriber) RequestMetadataContext(ctx context.Context, data []byte, metadataReader func(io.Reader) error) error {
+	if ds.connected.IsNotSet() {
+		return ErrNotConnected
+	}
+
+	refresh := &metadataRefresh{
+		reader: metadataReader,
+		result: make(chan error, 1),
+	}
+
+	ds.sendServerCommand(ServerCommand.MetadataRefresh, data, refresh)
+
+	select {
+	case err := <-refresh.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (ds *DataSubscriber) sendServerCommand(commandCode ServerCommandEnum, data []byte, refresh *metadataRefresh) {
 	if ds.connected.IsNotSet() {
+		refresh.complete(ErrNotConnected)
 		return
 	}
 
@@ -1517,18 +1946,80 @@ func (ds *DataSubscriber) SendServerCommandWithPayload(commandCode ServerCommand
 	}
 
 	if commandCode == ServerCommand.MetadataRefresh {
+		if refresh == nil {
+			refresh = &metadataRefresh{}
+		}
+
 		// Track start time of metadata request to calculate round-trip receive time
-		ds.metadataRequested = time.Now()
+		refresh.requested = time.Now()
+
+		// Queue request, in send order, so that its response can be matched to it
+		ds.metadataRefreshesMutex.Lock()
+		ds.metadataRefreshes = append(ds.metadataRefreshes, refresh)
+		ds.metadataRefreshesMutex.Unlock()
 	}
 
 	if _, err := ds.commandChannelSocket.Write(ds.writeBuffer[:commandBufferSize]); err != nil {
 		// Write error, connection may have been closed by peer; terminate connection
-		ds.dispatchErrorMessage("Failed to send server command - disconnecting: " + err.Error())
+		ds.dispatchEvent(EventKind.Connection, EventSeverity.Error, err, "Failed to send server command - disconnecting: "+err.Error())
 		ds.dispatchConnectionTerminated()
+		return
+	}
+
+	ds.captureFrame(CaptureDirection.Sent, CaptureChannel.Command, ds.writeBuffer[payloadHeaderSize:commandBufferSize])
+}
+
+// SetCaptureWriter defines a CaptureWriter that receives every raw frame sent or received by the
+// DataSubscriber on its command and data channels, e.g., to diagnose publisher issues. Capture is
+// disabled by default and can be stopped by setting a nil writer. The DataSubscriber does not close
+// the writer. Capture stops if a frame cannot be written.
+func (ds *DataSubscriber) SetCaptureWriter(writer *CaptureWriter) {
+	ds.captureWriterMutex.Lock()
+	defer ds.captureWriterMutex.Unlock()
+
+	ds.captureWriter = writer
+}
+
+func (ds *DataSubscriber) captureFrame(direction CaptureDirectionEnum, channel CaptureChannelEnum, data []byte) {
+	ds.captureWriterMutex.RLock()
+	captureWriter := ds.captureWriter
+	ds.captureWriterMutex.RUnlock()
+
+	if captureWriter == nil {
+		return
 	}
+
+	err := captureWriter.WriteFrame(&CaptureFrame{
+		Time:      ticks.UtcNow(),
+		Direction: direction,
+		Channel:   channel,
+		Data:      data,
+	})
+
+	if err == nil {
+		return
+	}
+
+	ds.captureWriterMutex.Lock()
+
+	if ds.captureWriter == captureWriter {
+		ds.captureWriter = nil
+	}
+
+	ds.captureWriterMutex.Unlock()
+
+	ds.dispatchEvent(EventKind.Capture, EventSeverity.Error, err, "Failed to capture frame, capture stopped: "+err.Error())
 }
 
 func (ds *DataSubscriber) sendOperationalModes() {
+	buffer := make([]byte, 4)
+	binary.BigEndian.PutUint32(buffer, uint32(ds.operationalModes()))
+
+	ds.SendServerCommandWithPayload(ServerCommand.DefineOperationalModes, buffer)
+}
+
+// operationalModes gets the operational modes requested by the DataSubscriber.
+func (ds *DataSubscriber) operationalModes() OperationalModesEnum {
 	var operationalModes OperationalModesEnum = OperationalModes.NoFlags
 
 	operationalModes |= OperationalModes.VersionMask & OperationalModesEnum(ds.Version)
@@ -1555,10 +2046,7 @@ func (ds *DataSubscriber) sendOperationalModes() {
 		operationalModes |= OperationalModes.CompressSignalIndexCache
 	}
 
-	buffer := make([]byte, 4)
-	binary.BigEndian.PutUint32(buffer, uint32(operationalModes))
-
-	ds.SendServerCommandWithPayload(ServerCommand.DefineOperationalModes, buffer)
+	return operationalModes
 }
 
 // Subscription gets the SubscriptionInfo associated with this DataSubscriber.
@@ -1603,3 +2091,48 @@ func (ds *DataSubscriber) TotalDataChannelBytesReceived() uint64 {
 func (ds *DataSubscriber) TotalMeasurementsReceived() uint64 {
 	return atomic.LoadUint64(&ds.totalMeasurementsReceived)
 }
+
+// TotalEventsDropped gets the total number of events that could not be sent to the EventChannel
+// because the channel was full.
+func (ds *DataSubscriber) TotalEventsDropped() uint64 {
+	return atomic.LoadUint64(&ds.totalEventsDropped)
+}
+
+// Statistics gets a snapshot of the operational statistics of the DataSubscriber.
+func (ds *DataSubscriber) Statistics() SubscriberStatistics {
+	statistics := SubscriberStatistics{
+		Connected:                  ds.IsConnected(),
+		Subscribed:                 ds.IsSubscribed(),
+		Connections:                atomic.LoadUint64(&ds.totalConnections),
+		Reconnects:                 atomic.LoadUint64(&ds.totalReconnects),
+		MeasurementsReceived:       atomic.LoadUint64(&ds.lifetimeMeasurements),
+		MetadataRefreshes:          atomic.LoadUint64(&ds.totalMetadataRefreshes),
+		MetadataRefreshLatency:     time.Duration(atomic.LoadInt64(&ds.totalMetadataLatency)),
+		LastMetadataRefreshLatency: time.Duration(atomic.LoadInt64(&ds.lastMetadataLatency)),
+		TSSCResets:                 atomic.LoadUint64(&ds.totalTSSCResets),
+		TSSCOutOfSequence:          atomic.LoadUint64(&ds.totalTSSCOutOfSequence),
+		SignalIndexCacheSwaps:      atomic.LoadUint64(&ds.totalSignalIndexCacheSwaps),
+		DecryptionFailures:         atomic.LoadUint64(&ds.totalDecryptionFailures),
+		EventsDropped:              atomic.LoadUint64(&ds.totalEventsDropped),
+		Callbacks:                  make(map[string]CallbackStatistics),
+	}
+
+	for kind := range ds.callbackTimers {
+		if callback := ds.callbackTimers[kind].statistics(); callback.Invocations > 0 {
+			statistics.Callbacks[callbackNames[kind]] = callback
+		}
+	}
+
+	return statistics
+}
+
+// timeCallback records the execution time of a callback that started at the specified time.
+func (ds *DataSubscriber) timeCallback(kind callbackKind, started time.Time) {
+	ds.callbackTimers[kind].record(time.Since(started))
+}
+
+// invokeStringCallback executes a string callback, recording its execution time.
+func (ds *DataSubscriber) invokeStringCallback(kind callbackKind, callback func(string), value string) {
+	defer ds.timeCallback(kind, time.Now())
+	callback(value)
+}
@@ -0,0 +1,44 @@
+//******************************************************************************************************
+//  Endpoint.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"net"
+	"strconv"
+)
+
+// Endpoint defines the network address of a DataPublisher.
+type Endpoint struct {
+	// Hostname is the DataPublisher DNS name or IP.
+	Hostname string
+
+	// Port is the TCP/IP listening port of the DataPublisher.
+	Port uint16
+}
+
+// String returns the Endpoint formatted as "hostname:port", or "[hostname]:port" for an IPv6 address,
+// matching the format of a connection ID.
+func (ep Endpoint) String() string {
+	return net.JoinHostPort(ep.Hostname, strconv.Itoa(int(ep.Port)))
+}
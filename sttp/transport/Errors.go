@@ -0,0 +1,75 @@
+//******************************************************************************************************
+//  Errors.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import "errors"
+
+// Sentinel errors reported by a DataSubscriber. Errors delivered with an Event wrap one of these
+// values, where applicable, along with any underlying cause, so they can be tested with errors.Is.
+var (
+	// ErrNotConnected is the error returned when an operation that requires an active
+	// connection to a DataPublisher is requested while the DataSubscriber is not connected.
+	ErrNotConnected = errors.New("subscriber is not connected")
+
+	// ErrConnectionTerminated is the error returned when the connection to the DataPublisher
+	// is terminated before a response to a pending server command is received.
+	ErrConnectionTerminated = errors.New("connection terminated before server command response was received")
+
+	// ErrMaxRetriesExceeded is reported when the maximum number of connection retries has been attempted.
+	ErrMaxRetriesExceeded = errors.New("maximum connection retries attempted")
+
+	// ErrDuplicateConnection is reported when a listening DataSubscriber rejects a connection
+	// because a DataPublisher connection is already established.
+	ErrDuplicateConnection = errors.New("duplicate data publisher connection")
+
+	// ErrInvalidProtocol is reported when the remote connection does not appear to be an STTP publisher.
+	ErrInvalidProtocol = errors.New("invalid STTP protocol")
+
+	// ErrUnexpectedResponse is reported when an unrecognized server response is received.
+	ErrUnexpectedResponse = errors.New("unexpected server response")
+
+	// ErrMetadataDecompression is reported when received metadata could not be decompressed.
+	ErrMetadataDecompression = errors.New("failed to decompress metadata")
+
+	// ErrSignalIndexCache is reported when a received signal index cache could not be decompressed or parsed.
+	ErrSignalIndexCache = errors.New("failed to parse signal index cache")
+
+	// ErrSignalIndexCacheMissing is reported when measurements are received before a signal index cache.
+	ErrSignalIndexCacheMissing = errors.New("signal index cache has not arrived")
+
+	// ErrUnsupportedDataPacket is reported when a data packet uses an unsupported encoding.
+	ErrUnsupportedDataPacket = errors.New("unsupported data packet encoding")
+
+	// ErrDecryptionFailed is reported when a data packet could not be decrypted.
+	ErrDecryptionFailed = errors.New("failed to decrypt data packet")
+
+	// ErrUnsupportedTSSCVersion is reported when a TSSC compressed data packet has an unrecognized version.
+	ErrUnsupportedTSSCVersion = errors.New("unsupported TSSC version")
+
+	// ErrTSSCOutOfSequence is reported when TSSC compressed data packets are received out of sequence.
+	ErrTSSCOutOfSequence = errors.New("TSSC data packet out of sequence")
+
+	// ErrMeasurementParse is reported when measurements in a data packet could not be parsed.
+	ErrMeasurementParse = errors.New("failed to parse measurements")
+)
@@ -0,0 +1,184 @@
+//******************************************************************************************************
+//  Event.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"log/slog"
+	"strconv"
+	"time"
+)
+
+// EventKindEnum defines the type of the EventKind enumeration.
+type EventKindEnum uint16
+
+// EventKind is an enumeration of the areas of DataSubscriber operation that raise an Event.
+var EventKind = struct {
+	// Connection defines events related to establishing, accepting, retrying or terminating a connection.
+	Connection EventKindEnum
+	// Protocol defines events raised when a connection does not follow the STTP protocol.
+	Protocol EventKindEnum
+	// ServerResponse defines events raised for success and failure responses to server commands.
+	ServerResponse EventKindEnum
+	// Metadata defines events related to the reception of metadata.
+	Metadata EventKindEnum
+	// SignalIndexCache defines events related to the reception of signal index caches.
+	SignalIndexCache EventKindEnum
+	// BaseTimeOffsets defines events related to the reception of base time offsets.
+	BaseTimeOffsets EventKindEnum
+	// CipherKeys defines events related to the reception of cipher keys.
+	CipherKeys EventKindEnum
+	// ConfigurationChanged defines events raised when the publisher reports a configuration change.
+	ConfigurationChanged EventKindEnum
+	// DataPacket defines events related to the decoding of data packets.
+	DataPacket EventKindEnum
+	// Notification defines events raised for notifications sent by the publisher.
+	Notification EventKindEnum
+	// Capture defines events related to the capture of raw protocol frames.
+	Capture EventKindEnum
+}{
+	Connection:           0,
+	Protocol:             1,
+	ServerResponse:       2,
+	Metadata:             3,
+	SignalIndexCache:     4,
+	BaseTimeOffsets:      5,
+	CipherKeys:           6,
+	ConfigurationChanged: 7,
+	DataPacket:           8,
+	Notification:         9,
+	Capture:              10,
+}
+
+// String gets the EventKind enumeration value as a string.
+func (eke EventKindEnum) String() string {
+	switch eke {
+	case EventKind.Connection:
+		return "Connection"
+	case EventKind.Protocol:
+		return "Protocol"
+	case EventKind.ServerResponse:
+		return "ServerResponse"
+	case EventKind.Metadata:
+		return "Metadata"
+	case EventKind.SignalIndexCache:
+		return "SignalIndexCache"
+	case EventKind.BaseTimeOffsets:
+		return "BaseTimeOffsets"
+	case EventKind.CipherKeys:
+		return "CipherKeys"
+	case EventKind.ConfigurationChanged:
+		return "ConfigurationChanged"
+	case EventKind.DataPacket:
+		return "DataPacket"
+	case EventKind.Notification:
+		return "Notification"
+	case EventKind.Capture:
+		return "Capture"
+	default:
+		return "0x" + strconv.FormatInt(int64(eke), 16)
+	}
+}
+
+// EventSeverityEnum defines the type of the EventSeverity enumeration.
+type EventSeverityEnum byte
+
+// EventSeverity is an enumeration of the possible severities of an Event.
+var EventSeverity = struct {
+	// Information defines an event that reports normal operation.
+	Information EventSeverityEnum
+	// Warning defines an event that reports an abnormal condition that operation recovers from.
+	Warning EventSeverityEnum
+	// Error defines an event that reports a failure, frequently followed by a disconnect.
+	Error EventSeverityEnum
+}{
+	Information: 0,
+	Warning:     1,
+	Error:       2,
+}
+
+// String gets the EventSeverity enumeration value as a string.
+func (ese EventSeverityEnum) String() string {
+	switch ese {
+	case EventSeverity.Information:
+		return "Information"
+	case EventSeverity.Warning:
+		return "Warning"
+	case EventSeverity.Error:
+		return "Error"
+	default:
+		return "0x" + strconv.FormatInt(int64(ese), 16)
+	}
+}
+
+// Level gets the log/slog level that corresponds to the EventSeverity.
+func (ese EventSeverityEnum) Level() slog.Level {
+	switch ese {
+	case EventSeverity.Warning:
+		return slog.LevelWarn
+	case EventSeverity.Error:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// Event represents a structured diagnostic raised by a DataSubscriber. Message is the same text
+// that is delivered to the string based status and error message callbacks.
+type Event struct {
+	// Time is the time the event was raised.
+	Time time.Time
+
+	// Kind is the area of DataSubscriber operation that raised the event.
+	Kind EventKindEnum
+
+	// Severity is the severity of the event.
+	Severity EventSeverityEnum
+
+	// ConnectionID is the identifier of the publisher connection, if known, see DataSubscriber.ConnectionID.
+	ConnectionID string
+
+	// Message is the human readable description of the event.
+	Message string
+
+	// Err is the cause of the event, if any. Where applicable, Err wraps one of the sentinel errors
+	// defined by this package, e.g., ErrDecryptionFailed, and can be tested with errors.Is or errors.As.
+	Err error
+}
+
+// Record gets the Event as a log/slog record. Kind, ConnectionID and Err, when defined, are
+// included as the "kind", "connectionID" and "error" attributes.
+func (e *Event) Record() slog.Record {
+	record := slog.NewRecord(e.Time, e.Severity.Level(), e.Message, 0)
+	record.AddAttrs(slog.String("kind", e.Kind.String()))
+
+	if len(e.ConnectionID) > 0 {
+		record.AddAttrs(slog.String("connectionID", e.ConnectionID))
+	}
+
+	if e.Err != nil {
+		record.AddAttrs(slog.Any("error", e.Err))
+	}
+
+	return record
+}
@@ -0,0 +1,264 @@
+//******************************************************************************************************
+//  Event_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/internal/transporttest"
+)
+
+// lockedBuffer is a bytes.Buffer that can be safely written by an event handler while being read.
+type lockedBuffer struct {
+	buffer bytes.Buffer
+	mutex  sync.Mutex
+}
+
+func (lb *lockedBuffer) Write(p []byte) (int, error) {
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+
+	return lb.buffer.Write(p)
+}
+
+func (lb *lockedBuffer) String() string {
+	lb.mutex.Lock()
+	defer lb.mutex.Unlock()
+
+	return lb.buffer.String()
+}
+
+func waitForEvent(t *testing.T, events <-chan Event, match func(*Event) bool) *Event {
+	timeout := time.After(5 * time.Second)
+
+	for {
+		select {
+		case event := <-events:
+			if match(&event) {
+				return &event
+			}
+		case <-timeout:
+			t.Fatalf("Timed out waiting for event")
+		}
+	}
+}
+
+func TestEventServerCommandFailure(t *testing.T) {
+	publisher := NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Dispose()
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	events := make(chan Event, 100)
+	var log lockedBuffer
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.EventChannel = events
+	subscriber.EventHandler = slog.NewTextHandler(&log, &slog.HandlerOptions{Level: slog.LevelError})
+	subscriber.EndCallbackAssignment()
+
+	connectTestSubscriber(t, publisher, subscriber)
+
+	subscriber.Subscription().FilterExpression = "FILTER ActiveMeasurements WHERE"
+
+	if err := subscriber.Subscribe(); err != nil {
+		t.Fatalf("TestEventServerCommandFailure: failed to subscribe: %s", err.Error())
+	}
+
+	event := waitForEvent(t, events, func(event *Event) bool {
+		return event.Kind == EventKind.ServerResponse && event.Severity == EventSeverity.Error
+	})
+
+	var commandErr *ServerCommandError
+
+	if !errors.As(event.Err, &commandErr) || commandErr.Command != ServerCommand.Subscribe {
+		t.Fatalf("TestEventServerCommandFailure: expected Subscribe ServerCommandError, received: %v", event.Err)
+	}
+
+	if event.ConnectionID != subscriber.ConnectionID() {
+		t.Fatalf("TestEventServerCommandFailure: unexpected connection ID: %s", event.ConnectionID)
+	}
+
+	if !strings.Contains(event.Message, "Received failure code in response to server command: Subscribe") {
+		t.Fatalf("TestEventServerCommandFailure: unexpected message: %s", event.Message)
+	}
+
+	// Event handler is called asynchronously
+	waitFor(t, "event handler log output", func() bool {
+		return strings.Contains(log.String(), "kind=ServerResponse")
+	})
+
+	output := log.String()
+
+	if !strings.Contains(output, "level=ERROR") || !strings.Contains(output, "kind=ServerResponse") || !strings.Contains(output, "error=") {
+		t.Fatalf("TestEventServerCommandFailure: unexpected log output: %s", output)
+	}
+
+	// Informational events should be filtered by handler level
+	if strings.Contains(output, "level=INFO") {
+		t.Fatalf("TestEventServerCommandFailure: unexpected informational log output: %s", output)
+	}
+}
+
+func TestEventMaxRetriesExceeded(t *testing.T) {
+	// Reserve a local port with no listener so that every connection attempt is refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("TestEventMaxRetriesExceeded: failed to reserve port: %s", err.Error())
+	}
+
+	port := uint16(listener.Addr().(*net.TCPAddr).Port)
+	listener.Close()
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	events := make(chan Event, 100)
+	messages := make(chan string, 100)
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.EventChannel = events
+	subscriber.EndCallbackAssignment()
+
+	connector := subscriber.Connector()
+	connector.Hostname = "127.0.0.1"
+	connector.Port = port
+	connector.MaxRetries = 2
+	connector.RetryInterval = 10
+	connector.MaxRetryInterval = 10
+
+	connector.BeginCallbackAssignment()
+	connector.ErrorMessageCallback = func(message string) {
+		messages <- message
+	}
+	connector.EndCallbackAssignment()
+
+	if status := connector.Connect(subscriber); status != ConnectStatus.Failed {
+		t.Fatalf("TestEventMaxRetriesExceeded: unexpected connect status: %d", status)
+	}
+
+	event := waitForEvent(t, events, func(event *Event) bool {
+		return errors.Is(event.Err, ErrMaxRetriesExceeded)
+	})
+
+	if event.Kind != EventKind.Connection || event.Severity != EventSeverity.Error {
+		t.Fatalf("TestEventMaxRetriesExceeded: unexpected event kind / severity: %s / %s", event.Kind.String(), event.Severity.String())
+	}
+
+	// String callback receives the same message for compatibility
+	timeout := time.After(5 * time.Second)
+
+	for {
+		select {
+		case message := <-messages:
+			if message == event.Message {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("TestEventMaxRetriesExceeded: timed out waiting for error message callback")
+		}
+	}
+}
+
+func TestEventChannelDropsWhenFull(t *testing.T) {
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	events := make(chan Event, 1)
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.EventChannel = events
+	subscriber.EndCallbackAssignment()
+
+	subscriber.dispatchEvent(EventKind.DataPacket, EventSeverity.Warning, ErrTSSCOutOfSequence, "first")
+	subscriber.dispatchEvent(EventKind.DataPacket, EventSeverity.Warning, ErrTSSCOutOfSequence, "second")
+
+	if event := <-events; event.Message != "first" {
+		t.Fatalf("TestEventChannelDropsWhenFull: unexpected event message: %s", event.Message)
+	}
+
+	if dropped := subscriber.TotalEventsDropped(); dropped != 1 {
+		t.Fatalf("TestEventChannelDropsWhenFull: expected 1 dropped event, received: %d", dropped)
+	}
+}
+
+func TestEventLegacyMessageRouting(t *testing.T) {
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	events := make(chan Event, 10)
+	statusMessages := make(chan string, 10)
+	errorMessages := make(chan string, 10)
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.EventChannel = events
+	subscriber.StatusMessageCallback = func(message string) { statusMessages <- message }
+	subscriber.ErrorMessageCallback = func(message string) { errorMessages <- message }
+	subscriber.EndCallbackAssignment()
+
+	// Missing signal index cache warning has always been delivered as a status message
+	subscriber.lastMissingCacheWarning = 1
+	subscriber.parseCompactMeasurements(subscriber.signalIndexCache[0], nil, nil)
+
+	event := waitForEvent(t, events, func(event *Event) bool { return event.Kind == EventKind.SignalIndexCache })
+
+	if event.Severity != EventSeverity.Warning || !errors.Is(event.Err, ErrSignalIndexCacheMissing) {
+		t.Fatalf("TestEventLegacyMessageRouting: unexpected event: %s", event.Message)
+	}
+
+	select {
+	case message := <-statusMessages:
+		if !strings.Contains(message, "Signal index cache has not arrived") {
+			t.Fatalf("TestEventLegacyMessageRouting: unexpected status message: %s", message)
+		}
+	case message := <-errorMessages:
+		t.Fatalf("TestEventLegacyMessageRouting: unexpected error message: %s", message)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestEventLegacyMessageRouting: timed out waiting for status message")
+	}
+
+	// Other warnings were delivered as error messages
+	subscriber.dispatchEvent(EventKind.DataPacket, EventSeverity.Warning, ErrTSSCOutOfSequence, "TSSC is out of sequence")
+
+	select {
+	case message := <-errorMessages:
+		if message != "TSSC is out of sequence" {
+			t.Fatalf("TestEventLegacyMessageRouting: unexpected error message: %s", message)
+		}
+	case message := <-statusMessages:
+		t.Fatalf("TestEventLegacyMessageRouting: unexpected status message: %s", message)
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestEventLegacyMessageRouting: timed out waiting for error message")
+	}
+}
@@ -66,14 +66,21 @@ type MeasurementMetadata struct {
 }
 
 // ParseSignalReference attempts to parse a normally formatted signal reference into a
-// signal kind and position representing original source protocol details.
+// signal kind and position representing original source protocol details. Signal kind
+// is SignalKind.Unknown when the signal reference is not normally formatted.
 func (mm *MeasurementMetadata) ParseSignalReference() (source string, signalKind SignalKindEnum, position int) {
+	signalKind = SignalKind.Unknown
 	signalReference := mm.SignalReference
 	parts := strings.Split(signalReference, "-")
 
 	if len(parts) > 1 {
 		lastIndex := len(parts) - 1
 		typeInfo := parts[lastIndex]
+
+		if len(typeInfo) < 2 {
+			return
+		}
+
 		signalKind = ParseSignalKindAcronym(typeInfo[:2])
 		position, _ = strconv.Atoi(typeInfo[2:])
 		source = strings.Join(parts[:lastIndex], "-")
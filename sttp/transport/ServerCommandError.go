@@ -0,0 +1,44 @@
+//******************************************************************************************************
+//  ServerCommandError.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+// ServerCommandError is the error returned when the DataPublisher responds to a server command
+// with ServerResponse.Failed. Use errors.As to access the command and the failure message sent
+// by the publisher.
+type ServerCommandError struct {
+	// Command is the server command that failed.
+	Command ServerCommandEnum
+
+	// Message is the failure message sent by the DataPublisher, if any.
+	Message string
+}
+
+// Error returns the string representation of the ServerCommandError.
+func (e *ServerCommandError) Error() string {
+	if len(e.Message) == 0 {
+		return "server command \"" + e.Command.String() + "\" failed"
+	}
+
+	return "server command \"" + e.Command.String() + "\" failed: " + e.Message
+}
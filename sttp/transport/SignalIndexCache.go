@@ -62,12 +62,15 @@ func (sic *SignalIndexCache) addRecord(ds *DataSubscriber, signalIndex int32, si
 	sic.idList = append(sic.idList, id)
 	sic.signalIDCache[signalID] = signalIndex
 
-	metadata := ds.LookupMetadata(signalID)
-
-	// Register measurement metadata if not defined already
-	if len(metadata.Source) == 0 {
-		metadata.Source = source
-		metadata.ID = id
+	// Publisher side caches have no associated subscriber metadata registry
+	if ds != nil {
+		metadata := ds.LookupMetadata(signalID)
+
+		// Register measurement metadata if not defined already
+		if len(metadata.Source) == 0 {
+			metadata.Source = source
+			metadata.ID = id
+		}
 	}
 
 	// Char size here helps provide a rough-estimate on binary length used to reserve
@@ -75,15 +78,22 @@ func (sic *SignalIndexCache) addRecord(ds *DataSubscriber, signalIndex int32, si
 	sic.binaryLength += 32 + uint32(len(source))*charSizeEstimate
 }
 
-// TODO: Function for use by DataPublisher
-// clear removes all records from the SignalIndexCache.
-// func (sic *SignalIndexCache) clear() {
-// 	sic.reference = map[int32]uint32{}
-// 	sic.signalIDList = nil
-// 	sic.sourceList = nil
-// 	sic.idList = nil
-// 	sic.signalIDCache = map[guid.Guid]int32{}
-// }
+// AddRecord adds a new record to the SignalIndexCache for provided key Measurement details,
+// e.g., to reconstruct a SignalIndexCache that was previously received.
+func (sic *SignalIndexCache) AddRecord(signalIndex int32, signalID guid.Guid, source string, id uint64) {
+	sic.addRecord(nil, signalIndex, signalID, source, id, 1)
+}
+
+// SignalIndexes returns the signal indexes of the SignalIndexCache in the order records were added.
+func (sic *SignalIndexCache) SignalIndexes() []int32 {
+	signalIndexes := make([]int32, len(sic.signalIDList))
+
+	for signalIndex, index := range sic.reference {
+		signalIndexes[index] = signalIndex
+	}
+
+	return signalIndexes
+}
 
 // Contains determines if the specified signalIndex exists with the SignalIndexCache.
 func (sic *SignalIndexCache) Contains(signalIndex int32) bool {
@@ -152,17 +162,16 @@ func (sic *SignalIndexCache) BinaryLength() uint32 {
 	return sic.binaryLength
 }
 
-// TODO: Function for use by DataPublisher
 // recalculateBinaryLength forces a new recalculation the cached binary length of the SignalIndexCache.
-// func (sic *SignalIndexCache) recalculateBinaryLength(connection *SubscriberConnection) {
-// 	var binaryLength uint32 = 28
+func (sic *SignalIndexCache) recalculateBinaryLength(connection *SubscriberConnection) {
+	var binaryLength uint32 = 28
 
-// 	for i := 0; i < len(sic.signalIDList); i++ {
-// 		binaryLength += 32 + uint32(len(connection.EncodeString(sic.sourceList[i])))
-// 	}
+	for i := 0; i < len(sic.signalIDList); i++ {
+		binaryLength += 32 + uint32(len(connection.EncodeString(sic.sourceList[i])))
+	}
 
-// 	sic.binaryLength = binaryLength
-// }
+	sic.binaryLength = binaryLength
+}
 
 // decode parses a SignalIndexCache from the specified byte buffer received from a DataPublisher.
 func (sic *SignalIndexCache) decode(ds *DataSubscriber, buffer []byte, subscriberID *guid.Guid) error {
@@ -182,6 +191,10 @@ func (sic *SignalIndexCache) decode(ds *DataSubscriber, buffer []byte, subscribe
 		return errors.New("not enough buffer provided to parse")
 	}
 
+	if length < offset+20 {
+		return errors.New("not enough buffer provided to parse")
+	}
+
 	var err error
 
 	// Subscriber ID
@@ -200,6 +213,10 @@ func (sic *SignalIndexCache) decode(ds *DataSubscriber, buffer []byte, subscribe
 	var i uint32
 
 	for i = 0; i < referenceCount; i++ {
+		if length-offset < 24 {
+			return errors.New("not enough buffer provided to parse")
+		}
+
 		// Signal index
 		signalIndex := int32(binary.BigEndian.Uint32(buffer[offset:]))
 		offset += 4
@@ -217,6 +234,10 @@ func (sic *SignalIndexCache) decode(ds *DataSubscriber, buffer []byte, subscribe
 		sourceSize := binary.BigEndian.Uint32(buffer[offset:])
 		offset += 4
 
+		if length-offset < sourceSize || length-offset-sourceSize < 8 {
+			return errors.New("not enough buffer provided to parse")
+		}
+
 		source := ds.DecodeString(buffer[offset : offset+sourceSize])
 		offset += sourceSize
 
@@ -233,7 +254,51 @@ func (sic *SignalIndexCache) decode(ds *DataSubscriber, buffer []byte, subscribe
 	return nil
 }
 
-//// Encode serializes a SignalIndexCache to a byte buffer for publication to a DataSubscriber.
-//func (sic *SignalIndexCache) Encode(connection *SubscriberConnection, buffer []byte) {
-//	// TODO: This will be needed by DataPublisher implementation
-//}
+// encode serializes a SignalIndexCache to a byte buffer for publication to a DataSubscriber.
+func (sic *SignalIndexCache) encode(connection *SubscriberConnection) []byte {
+	sic.recalculateBinaryLength(connection)
+
+	buffer := make([]byte, sic.binaryLength)
+	var offset uint32 = 0
+
+	// Byte size of cache
+	binary.BigEndian.PutUint32(buffer, sic.binaryLength)
+	offset += 4
+
+	// Subscriber ID
+	subscriberID := connection.SubscriberID().ToBytes(connection.parent.SwapGuidEndianness)
+	copy(buffer[offset:], subscriberID)
+	offset += 16
+
+	// Number of references
+	binary.BigEndian.PutUint32(buffer[offset:], uint32(len(sic.signalIDList)))
+	offset += 4
+
+	for signalIndex, index := range sic.reference {
+		// Signal index
+		binary.BigEndian.PutUint32(buffer[offset:], uint32(signalIndex))
+		offset += 4
+
+		// Signal ID
+		signalID := sic.signalIDList[index].ToBytes(connection.parent.SwapGuidEndianness)
+		copy(buffer[offset:], signalID)
+		offset += 16
+
+		// Source
+		source := connection.EncodeString(sic.sourceList[index])
+		binary.BigEndian.PutUint32(buffer[offset:], uint32(len(source)))
+		offset += 4
+
+		copy(buffer[offset:], source)
+		offset += uint32(len(source))
+
+		// ID
+		binary.BigEndian.PutUint64(buffer[offset:], sic.idList[index])
+		offset += 8
+	}
+
+	// Number of unauthorized signal IDs, publisher currently does not report these
+	binary.BigEndian.PutUint32(buffer[offset:], 0)
+
+	return buffer
+}
@@ -23,9 +23,200 @@
 
 package transport
 
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/thread"
+	"github.com/sttp/goapi/sttp/ticks"
+	"github.com/sttp/goapi/sttp/transport/tssc"
+	"github.com/tevino/abool/v2"
+)
+
 // SubscriberConnection represents a connection from a DataPublisher to DataSubscriber.
 type SubscriberConnection struct {
-	encoding OperationalEncodingEnum
+	parent       *DataPublisher
+	subscriberID guid.Guid
+	connectionID string
+	ipAddress    net.IP
+
+	encoding                 OperationalEncodingEnum
+	version                  byte
+	operationalModes         OperationalModesEnum
+	compressPayloadData      bool
+	compressMetadata         bool
+	compressSignalIndexCache bool
+
+	connected  abool.AtomicBool
+	validated  abool.AtomicBool
+	subscribed abool.AtomicBool
+
+	commandChannelSocket         net.Conn
+	commandChannelResponseThread *thread.Thread
+	readBuffer                   []byte
+	reader                       *bufio.Reader
+	writeMutex                   sync.Mutex
+	dataChannelSocket            net.Conn
+	dataChannelMutex             sync.Mutex
+
+	// Measurements queued for publication, sent by publishThread
+	publishQueue     chan []Measurement
+	publishThread    *thread.Thread
+	publishStopped   chan struct{}
+	stopPublishing   sync.Once
+	publishQueueFull abool.AtomicBool
+
+	// Subscription state, synchronized by publishMutex
+	publishMutex            sync.Mutex
+	subscription            SubscriptionInfo
+	signalIndexCache        [2]*SignalIndexCache
+	pendingSignalIndexCache *SignalIndexCache
+	cacheIndex              int32
+	nextCacheIndex          int32
+	timeIndex               int32
+	baseTimeOffsets         [2]int64
+	startTimeSent           bool
+	latestTimestamp         ticks.Ticks
+	tsscEncoder             *tssc.Encoder
+
+	// Statistics counters
+	totalCommandChannelBytesSent uint64
+	totalDataChannelBytesSent    uint64
+	totalMeasurementsSent        uint64
+}
+
+func newSubscriberConnection(parent *DataPublisher, connection net.Conn) *SubscriberConnection {
+	sc := &SubscriberConnection{
+		parent:               parent,
+		subscriberID:         guid.New(),
+		connectionID:         "<unknown>",
+		encoding:             OperationalEncoding.UTF8,
+		commandChannelSocket: connection,
+		readBuffer:           make([]byte, maxPacketSize),
+		publishQueue:         make(chan []Measurement, publishQueueSize),
+		publishStopped:       make(chan struct{}),
+	}
+
+	if addr := connection.RemoteAddr(); addr != nil {
+		sc.connectionID = resolveDNSName(addr.String())
+
+		if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+			sc.ipAddress = tcpAddr.IP
+		}
+	}
+
+	sc.commandChannelResponseThread = thread.NewThread(sc.runCommandChannelResponseThread)
+	sc.publishThread = thread.NewThread(sc.runPublishThread)
+
+	return sc
+}
+
+func (sc *SubscriberConnection) start() {
+	sc.connected.Set()
+	sc.publishThread.Start()
+	sc.commandChannelResponseThread.Start()
+}
+
+// Stop terminates the SubscriberConnection.
+func (sc *SubscriberConnection) Stop() {
+	sc.connected.UnSet()
+
+	if err := sc.commandChannelSocket.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		sc.parent.dispatchErrorMessage("Exception while disconnecting subscriber TCP command channel \"" + sc.connectionID + "\": " + err.Error())
+	}
+
+	sc.commandChannelResponseThread.Join()
+	sc.publishThread.Join()
+}
+
+// SubscriberID gets the unique Guid assigned to the subscriber connection by the DataPublisher.
+func (sc *SubscriberConnection) SubscriberID() guid.Guid {
+	return sc.subscriberID
+}
+
+// ConnectionID returns the IP address and DNS host name, if resolvable, of the subscriber connection.
+func (sc *SubscriberConnection) ConnectionID() string {
+	return sc.connectionID
+}
+
+// IPAddress gets the remote IP address of the subscriber connection.
+func (sc *SubscriberConnection) IPAddress() net.IP {
+	return sc.ipAddress
+}
+
+// SecurityMode gets the SecurityMode of the subscriber command channel connection.
+func (sc *SubscriberConnection) SecurityMode() SecurityModeEnum {
+	if _, ok := sc.commandChannelSocket.(*tls.Conn); ok {
+		return SecurityMode.TLS
+	}
+
+	return SecurityMode.Off
+}
+
+// IsConnected determines if the SubscriberConnection is currently connected.
+func (sc *SubscriberConnection) IsConnected() bool {
+	return sc.connected.IsSet()
+}
+
+// IsValidated determines if the SubscriberConnection has been validated as an STTP connection.
+func (sc *SubscriberConnection) IsValidated() bool {
+	return sc.validated.IsSet()
+}
+
+// IsSubscribed determines if the SubscriberConnection currently has an active subscription.
+func (sc *SubscriberConnection) IsSubscribed() bool {
+	return sc.subscribed.IsSet()
+}
+
+// Version gets the STTP protocol version requested by the subscriber.
+func (sc *SubscriberConnection) Version() byte {
+	return sc.version
+}
+
+// OperationalModes gets the operational modes requested by the subscriber.
+func (sc *SubscriberConnection) OperationalModes() OperationalModesEnum {
+	return sc.operationalModes
+}
+
+// Subscription gets a copy of the SubscriptionInfo currently requested by the subscriber.
+func (sc *SubscriberConnection) Subscription() SubscriptionInfo {
+	sc.publishMutex.Lock()
+	defer sc.publishMutex.Unlock()
+
+	return sc.subscription
+}
+
+// ActiveSignalIndexCache gets the signal index cache currently used for publication, if any.
+func (sc *SubscriberConnection) ActiveSignalIndexCache() *SignalIndexCache {
+	sc.publishMutex.Lock()
+	defer sc.publishMutex.Unlock()
+
+	return sc.signalIndexCache[sc.cacheIndex]
+}
+
+// TotalCommandChannelBytesSent gets the total number of bytes sent via the command channel.
+func (sc *SubscriberConnection) TotalCommandChannelBytesSent() uint64 {
+	return atomic.LoadUint64(&sc.totalCommandChannelBytesSent)
+}
+
+// TotalDataChannelBytesSent gets the total number of bytes sent via the data channel.
+func (sc *SubscriberConnection) TotalDataChannelBytesSent() uint64 {
+	return atomic.LoadUint64(&sc.totalDataChannelBytesSent)
+}
+
+// TotalMeasurementsSent gets the total number of measurements sent to the subscriber.
+func (sc *SubscriberConnection) TotalMeasurementsSent() uint64 {
+	return atomic.LoadUint64(&sc.totalMeasurementsSent)
 }
 
 // EncodeString encodes an STTP string according to the defined operational modes.
@@ -38,4 +229,773 @@ func (sc *SubscriberConnection) EncodeString(value string) []byte {
 	return []byte(value)
 }
 
-// TODO: Flesh this for out the DataPublisher implementation
+// DecodeString decodes an STTP string according to the defined operational modes.
+func (sc *SubscriberConnection) DecodeString(data []byte) string {
+	// Latest version of STTP only encodes to UTF8, the default for Go
+	if sc.encoding != OperationalEncoding.UTF8 {
+		panic("Go implementation of STTP only supports UTF8 string encoding")
+	}
+
+	return string(data)
+}
+
+func (sc *SubscriberConnection) runCommandChannelResponseThread() {
+	sc.reader = bufio.NewReader(sc.commandChannelSocket)
+
+	for sc.connected.IsSet() {
+		sc.readPayloadHeader(io.ReadFull(sc.reader, sc.readBuffer[:payloadHeaderSize]))
+	}
+
+	// Connection has terminated, release sockets and notify publisher
+	sc.subscribed.UnSet()
+	sc.stopPublishing.Do(func() { close(sc.publishStopped) })
+	sc.commandChannelSocket.Close()
+	sc.closeDataChannel()
+	sc.parent.connectionTerminated(sc)
+}
+
+func (sc *SubscriberConnection) readPayloadHeader(bytesTransferred int, err error) {
+	if err != nil {
+		// Read error, connection may have been closed by peer; terminate connection
+		sc.connected.UnSet()
+		return
+	}
+
+	packetSize := binary.BigEndian.Uint32(sc.readBuffer)
+
+	if sc.validated.IsNotSet() {
+		// The very first command received from the subscriber should be the define operational modes
+		// command, the payload size for this command is small. Longer message sizes would be considered
+		// suspect data, likely from a non-STTP based client connection. In context of this initial
+		// command, anything larger than 8KB of payload is considered suspect.
+		const maxInitialPacketSize = 8192
+
+		if packetSize > maxInitialPacketSize {
+			sc.parent.dispatchErrorMessage("Possible invalid protocol detected from \"" + sc.connectionID + "\": encountered request for " + strconv.Itoa(int(packetSize)) + " byte initial packet size -- connection likely from non-STTP client, disconnecting.")
+			sc.connected.UnSet()
+			return
+		}
+	}
+
+	if packetSize == 0 {
+		return
+	}
+
+	if int(packetSize) > cap(sc.readBuffer) {
+		sc.readBuffer = make([]byte, packetSize)
+	}
+
+	// Read packet (payload body)
+	// This read method is guaranteed not to return until the
+	// requested size has been read or an error has occurred.
+	if _, err = io.ReadFull(sc.reader, sc.readBuffer[:packetSize]); err != nil {
+		sc.connected.UnSet()
+		return
+	}
+
+	sc.processServerCommand(sc.readBuffer[:packetSize])
+}
+
+//gocyclo:ignore
+func (sc *SubscriberConnection) processServerCommand(buffer []byte) {
+	commandCode := ServerCommandEnum(buffer[0])
+	data := buffer[1:]
+
+	if sc.validated.IsNotSet() && commandCode != ServerCommand.DefineOperationalModes {
+		sc.parent.dispatchErrorMessage("Possible invalid protocol detected from \"" + sc.connectionID + "\": encountered unexpected initial command: " + commandCode.String() + " -- connection likely from non-STTP client, disconnecting.")
+		sc.connected.UnSet()
+		return
+	}
+
+	switch commandCode {
+	case ServerCommand.DefineOperationalModes:
+		sc.handleDefineOperationalModes(data)
+	case ServerCommand.MetadataRefresh:
+		sc.handleMetadataRefresh(data)
+	case ServerCommand.Subscribe:
+		sc.handleSubscribe(data)
+	case ServerCommand.Unsubscribe:
+		sc.handleUnsubscribe()
+	case ServerCommand.UpdateProcessingInterval:
+		sc.handleUpdateProcessingInterval(data)
+	case ServerCommand.ConfirmUpdateSignalIndexCache:
+		sc.handleConfirmUpdateSignalIndexCache()
+	case ServerCommand.ConfirmNotification, ServerCommand.ConfirmBufferBlock, ServerCommand.ConfirmUpdateBaseTimes, ServerCommand.ConfirmUpdateCipherKeys:
+		// Publisher does not currently send notifications, buffer blocks or cipher keys
+		// and base time updates do not require confirmation tracking
+	default:
+		sc.sendFailure(commandCode, "Command \""+commandCode.String()+"\" is not supported by the DataPublisher.")
+	}
+}
+
+func (sc *SubscriberConnection) handleDefineOperationalModes(data []byte) {
+	if len(data) < 4 {
+		sc.sendFailure(ServerCommand.DefineOperationalModes, "Not enough buffer was provided to parse operational modes.")
+		sc.connected.UnSet()
+		return
+	}
+
+	operationalModes := OperationalModesEnum(binary.BigEndian.Uint32(data))
+	version := byte(operationalModes & OperationalModes.VersionMask)
+	encoding := OperationalEncodingEnum(operationalModes & OperationalModes.EncodingMask)
+
+	// Pre-standard versions of STTP, i.e., less than 10, include compression modes within the version mask
+	if version&legacyVersionMask < 10 {
+		version &= legacyVersionMask
+	}
+
+	if version < 1 || version > maxSupportedVersion {
+		sc.sendFailure(ServerCommand.DefineOperationalModes, "Requested STTP protocol version "+strconv.Itoa(int(version))+" is not supported by the DataPublisher.")
+		sc.connected.UnSet()
+		return
+	}
+
+	if encoding != OperationalEncoding.UTF8 {
+		sc.sendFailure(ServerCommand.DefineOperationalModes, "Go implementation of STTP only supports UTF8 string encoding.")
+		sc.connected.UnSet()
+		return
+	}
+
+	sc.version = version
+	sc.encoding = encoding
+	sc.operationalModes = operationalModes
+	sc.compressPayloadData = operationalModes&OperationalModes.CompressPayloadData > 0 && sc.parent.CompressPayloadData
+
+	// Pre-standard versions of STTP must also specify TSSC as the requested compression mode
+	if version < 10 && operationalModes&OperationalModesEnum(CompressionModes.TSSC) == 0 {
+		sc.compressPayloadData = false
+	}
+
+	sc.compressMetadata = operationalModes&OperationalModes.CompressMetadata > 0 && sc.parent.CompressMetadata
+	sc.compressSignalIndexCache = operationalModes&OperationalModes.CompressSignalIndexCache > 0 && sc.parent.CompressSignalIndexCache
+
+	sc.validated.Set()
+
+	// Older versions of STTP do not expect a response to define operational modes
+	if version > 2 {
+		sc.sendSuccess(ServerCommand.DefineOperationalModes, "STTP v"+strconv.Itoa(int(version))+" operational modes established.")
+	}
+}
+
+func (sc *SubscriberConnection) handleMetadataRefresh(data []byte) {
+	if !sc.parent.MetadataRefreshAllowed {
+		sc.sendFailure(ServerCommand.MetadataRefresh, "Metadata refresh has been disallowed by the DataPublisher.")
+		return
+	}
+
+	// Note that any metadata filters included in the payload are not currently applied
+	metadata := sc.parent.metadataXml()
+
+	if metadata == nil {
+		sc.sendFailure(ServerCommand.MetadataRefresh, "No metadata has been defined by the DataPublisher.")
+		return
+	}
+
+	if sc.compressMetadata {
+		var err error
+
+		if metadata, err = compressGZip(metadata); err != nil {
+			sc.sendFailure(ServerCommand.MetadataRefresh, "Failed to compress metadata: "+err.Error())
+			return
+		}
+	}
+
+	if sc.SendResponse(ServerResponse.Succeeded, ServerCommand.MetadataRefresh, metadata) == nil {
+		sc.parent.dispatchStatusMessage("Sent " + strconv.Itoa(len(metadata)) + " bytes of metadata to \"" + sc.connectionID + "\"")
+	}
+}
+
+func (sc *SubscriberConnection) handleSubscribe(data []byte) {
+	if len(data) < 5 {
+		sc.sendFailure(ServerCommand.Subscribe, "Not enough buffer was provided to parse client data subscription.")
+		return
+	}
+
+	if DataPacketFlagsEnum(data[0])&DataPacketFlags.Compact == 0 {
+		sc.sendFailure(ServerCommand.Subscribe, "Go implementation of STTP only supports compact measurement format.")
+		return
+	}
+
+	length := binary.BigEndian.Uint32(data[1:])
+
+	if uint32(len(data)-5) < length {
+		sc.sendFailure(ServerCommand.Subscribe, "Not enough buffer was provided to parse client data subscription.")
+		return
+	}
+
+	settings := parseKeyValuePairs(sc.DecodeString(data[5 : 5+length]))
+	subscription, err := parseSubscriptionInfo(settings)
+
+	if err != nil {
+		sc.sendFailure(ServerCommand.Subscribe, "Failed to parse client data subscription: "+err.Error())
+		return
+	}
+
+	if len(subscription.StartTime) > 0 || len(subscription.StopTime) > 0 {
+		sc.sendFailure(ServerCommand.Subscribe, "Temporal subscriptions are not supported by the DataPublisher.")
+		return
+	}
+
+	signalIndexCache, err := sc.parent.createSignalIndexCache(subscription.FilterExpression)
+
+	if err != nil {
+		sc.sendFailure(ServerCommand.Subscribe, "Failed to parse subscription filter expression: "+err.Error())
+		return
+	}
+
+	// Establish UDP data channel, if requested
+	sc.closeDataChannel()
+
+	if subscription.UdpDataChannel {
+		if err = sc.openDataChannel(subscription.DataChannelLocalPort); err != nil {
+			sc.sendFailure(ServerCommand.Subscribe, "Failed to open UDP data channel: "+err.Error())
+			return
+		}
+	}
+
+	sc.publishMutex.Lock()
+	sc.subscription = subscription
+	sc.startTimeSent = false
+	sc.latestTimestamp = 0
+	sc.timeIndex = 0
+	sc.baseTimeOffsets = [2]int64{}
+	sc.pendingSignalIndexCache = signalIndexCache
+	cacheIndex := sc.nextCacheIndex
+
+	// STTP version 1 does not confirm signal index cache updates
+	if sc.version < 2 {
+		sc.activateSignalIndexCache()
+	}
+
+	sc.publishMutex.Unlock()
+
+	if err = sc.sendSignalIndexCache(signalIndexCache, cacheIndex); err != nil {
+		return
+	}
+
+	if subscription.IncludeTime && sc.parent.UseBaseTimeOffsets {
+		sc.publishMutex.Lock()
+		err = sc.rotateBaseTimes(ticks.UtcNow())
+		sc.publishMutex.Unlock()
+
+		if err != nil {
+			return
+		}
+	}
+
+	sc.subscribed.Set()
+
+	message := "Client subscribed as compact with " + strconv.Itoa(int(signalIndexCache.Count())) + " signals."
+
+	if sc.sendSuccess(ServerCommand.Subscribe, message) == nil {
+		sc.parent.dispatchStatusMessage("\"" + sc.connectionID + "\": " + message)
+	}
+}
+
+func (sc *SubscriberConnection) handleUnsubscribe() {
+	sc.subscribed.UnSet()
+	sc.closeDataChannel()
+
+	if sc.sendSuccess(ServerCommand.Unsubscribe, "Client unsubscribed.") == nil {
+		sc.parent.dispatchStatusMessage("\"" + sc.connectionID + "\": Client unsubscribed.")
+	}
+}
+
+func (sc *SubscriberConnection) handleUpdateProcessingInterval(data []byte) {
+	if len(data) < 4 {
+		sc.sendFailure(ServerCommand.UpdateProcessingInterval, "Not enough buffer was provided to update client processing interval.")
+		return
+	}
+
+	processingInterval := int32(binary.BigEndian.Uint32(data))
+
+	sc.publishMutex.Lock()
+	sc.subscription.ProcessingInterval = processingInterval
+	sc.publishMutex.Unlock()
+
+	sc.sendSuccess(ServerCommand.UpdateProcessingInterval, "New processing interval of "+strconv.Itoa(int(processingInterval))+" assigned.")
+}
+
+func (sc *SubscriberConnection) handleConfirmUpdateSignalIndexCache() {
+	sc.publishMutex.Lock()
+	defer sc.publishMutex.Unlock()
+
+	if sc.pendingSignalIndexCache != nil {
+		sc.activateSignalIndexCache()
+	}
+}
+
+// activateSignalIndexCache makes the pending signal index cache active, publishMutex is expected to be locked.
+func (sc *SubscriberConnection) activateSignalIndexCache() {
+	sc.signalIndexCache[sc.nextCacheIndex] = sc.pendingSignalIndexCache
+	sc.cacheIndex = sc.nextCacheIndex
+	sc.nextCacheIndex ^= 1
+	sc.pendingSignalIndexCache = nil
+	sc.tsscEncoder = nil
+}
+
+func (sc *SubscriberConnection) sendSignalIndexCache(signalIndexCache *SignalIndexCache, cacheIndex int32) error {
+	buffer := signalIndexCache.encode(sc)
+
+	if sc.compressSignalIndexCache {
+		var err error
+
+		if buffer, err = compressGZip(buffer); err != nil {
+			sc.parent.dispatchErrorMessage("Failed to compress signal index cache for \"" + sc.connectionID + "\": " + err.Error())
+			return err
+		}
+	}
+
+	if sc.version > 1 {
+		buffer = append([]byte{byte(cacheIndex)}, buffer...)
+	}
+
+	return sc.SendResponse(ServerResponse.UpdateSignalIndexCache, ServerCommand.Subscribe, buffer)
+}
+
+// rotateBaseTimes establishes new base time offsets, publishMutex is expected to be locked.
+func (sc *SubscriberConnection) rotateBaseTimes(realTime ticks.Ticks) error {
+	interval := int64(baseTimeRotationInterval)
+
+	if sc.subscription.UseMillisecondResolution {
+		interval = int64(millisecondBaseTimeRotationInterval)
+	}
+
+	if sc.baseTimeOffsets[0] == 0 {
+		sc.baseTimeOffsets[0] = int64(realTime)
+		sc.baseTimeOffsets[1] = int64(realTime) + interval
+		sc.timeIndex = 0
+	} else {
+		oldIndex := sc.timeIndex
+		sc.timeIndex ^= 1
+		sc.baseTimeOffsets[oldIndex] = sc.baseTimeOffsets[sc.timeIndex] + interval
+	}
+
+	buffer := make([]byte, 20)
+	binary.BigEndian.PutUint32(buffer, uint32(sc.timeIndex))
+	binary.BigEndian.PutUint64(buffer[4:], uint64(sc.baseTimeOffsets[0]))
+	binary.BigEndian.PutUint64(buffer[12:], uint64(sc.baseTimeOffsets[1]))
+
+	return sc.SendResponse(ServerResponse.UpdateBaseTimes, ServerCommand.Subscribe, buffer)
+}
+
+func (sc *SubscriberConnection) openDataChannel(port uint16) error {
+	if sc.ipAddress == nil {
+		return errors.New("subscriber IP address is unknown")
+	}
+
+	conn, err := net.Dial("udp", net.JoinHostPort(sc.ipAddress.String(), strconv.Itoa(int(port))))
+
+	if err != nil {
+		return err
+	}
+
+	sc.dataChannelMutex.Lock()
+	sc.dataChannelSocket = conn
+	sc.dataChannelMutex.Unlock()
+
+	return nil
+}
+
+func (sc *SubscriberConnection) closeDataChannel() {
+	sc.dataChannelMutex.Lock()
+	defer sc.dataChannelMutex.Unlock()
+
+	if sc.dataChannelSocket == nil {
+		return
+	}
+
+	if err := sc.dataChannelSocket.Close(); err != nil {
+		sc.parent.dispatchErrorMessage("Exception while disconnecting subscriber UDP data channel \"" + sc.connectionID + "\": " + err.Error())
+	}
+
+	sc.dataChannelSocket = nil
+}
+
+// queueMeasurements queues the provided measurements for publication to the subscriber. Measurements are
+// dropped when the subscriber is not keeping up with publication and the queue is full.
+func (sc *SubscriberConnection) queueMeasurements(measurements []Measurement) {
+	if sc.subscribed.IsNotSet() {
+		return
+	}
+
+	select {
+	case sc.publishQueue <- measurements:
+		sc.publishQueueFull.UnSet()
+	default:
+		// Report once until queue has capacity again
+		if sc.publishQueueFull.SetToIf(false, true) {
+			sc.parent.dispatchErrorMessage("Publication queue for \"" + sc.connectionID + "\" is full, measurements are being dropped")
+		}
+	}
+}
+
+func (sc *SubscriberConnection) runPublishThread() {
+	for {
+		select {
+		case measurements := <-sc.publishQueue:
+			sc.publishMeasurements(measurements)
+		case <-sc.publishStopped:
+			return
+		}
+	}
+}
+
+// publishMeasurements sends the subset of the provided measurements that are defined in the active signal index cache.
+func (sc *SubscriberConnection) publishMeasurements(measurements []Measurement) {
+	if sc.subscribed.IsNotSet() {
+		return
+	}
+
+	sc.publishMutex.Lock()
+	defer sc.publishMutex.Unlock()
+
+	signalIndexCache := sc.signalIndexCache[sc.cacheIndex]
+
+	if signalIndexCache == nil || signalIndexCache.Count() == 0 {
+		return
+	}
+
+	subscription := &sc.subscription
+	filterNaN := sc.parent.NaNValueFilterForced || (subscription.RequestNaNValueFilter && sc.parent.NaNValueFilterAllowed)
+	includeTime := subscription.IncludeTime
+	useMillisecondResolution := subscription.UseMillisecondResolution
+	var lagTime, leadTime, realTime ticks.Ticks
+
+	if subscription.EnableTimeReasonabilityCheck {
+		lagTime = ticks.Ticks(subscription.LagTime * float64(ticks.PerSecond))
+		leadTime = ticks.Ticks(subscription.LeadTime * float64(ticks.PerSecond))
+
+		if subscription.UseLocalClockAsRealTime {
+			realTime = ticks.UtcNow()
+		} else {
+			for i := range measurements {
+				if timestamp := measurements[i].Timestamp.TimestampValue(); timestamp > int64(sc.latestTimestamp) {
+					sc.latestTimestamp = ticks.Ticks(timestamp)
+				}
+			}
+
+			realTime = sc.latestTimestamp
+		}
+	}
+
+	if includeTime && sc.parent.UseBaseTimeOffsets && sc.baseTimeOffsets[0] > 0 {
+		// Rotate base time offsets once real-time has moved past next offset
+		if now := ticks.UtcNow(); int64(now) >= sc.baseTimeOffsets[sc.timeIndex^1] {
+			if sc.rotateBaseTimes(now) != nil {
+				return
+			}
+		}
+	}
+
+	// Select measurements defined in subscription
+	selected := make([]publishedMeasurement, 0, len(measurements))
+
+	for i := range measurements {
+		measurement := &measurements[i]
+		signalIndex := signalIndexCache.SignalIndex(measurement.SignalID)
+
+		if signalIndex < 0 {
+			continue
+		}
+
+		if filterNaN && math.IsNaN(measurement.Value) {
+			continue
+		}
+
+		if subscription.EnableTimeReasonabilityCheck {
+			timestamp := ticks.Ticks(measurement.Timestamp.TimestampValue())
+
+			if timestamp < realTime-lagTime || timestamp > realTime+leadTime {
+				continue
+			}
+		}
+
+		selected = append(selected, publishedMeasurement{measurement, signalIndex})
+	}
+
+	if len(selected) == 0 {
+		return
+	}
+
+	if !sc.startTimeSent {
+		sc.startTimeSent = true
+		buffer := make([]byte, 8)
+		binary.BigEndian.PutUint64(buffer, uint64(selected[0].measurement.Timestamp))
+
+		if sc.SendResponse(ServerResponse.DataStartTime, ServerCommand.Subscribe, buffer) != nil {
+			return
+		}
+	}
+
+	// TSSC compression only works with stateful connections
+	if sc.compressPayloadData && !subscription.UdpDataChannel {
+		sc.publishTSSCMeasurements(selected)
+	} else {
+		sc.publishCompactMeasurements(selected, includeTime, useMillisecondResolution)
+	}
+}
+
+// publishedMeasurement pairs a Measurement with its signal index in the active signal index cache.
+type publishedMeasurement struct {
+	measurement *Measurement
+	signalIndex int32
+}
+
+// publishCompactMeasurements sends measurements as compact data packets, publishMutex is expected to be locked.
+func (sc *SubscriberConnection) publishCompactMeasurements(measurements []publishedMeasurement, includeTime, useMillisecondResolution bool) {
+	const maxMeasurementSize = 17
+
+	packet := make([]byte, maxDataPacketPayloadSize)
+	length := dataPacketHeaderSize
+	count := 0
+
+	for _, selected := range measurements {
+		if length+maxMeasurementSize > len(packet) {
+			if sc.sendDataPacket(DataPacketFlags.Compact, packet[:length], count) != nil {
+				return
+			}
+
+			length = dataPacketHeaderSize
+			count = 0
+		}
+
+		compactMeasurement := newCompactMeasurementFrom(selected.measurement, selected.signalIndex)
+		length += compactMeasurement.encode(includeTime, useMillisecondResolution, &sc.baseTimeOffsets, sc.timeIndex, packet[length:])
+		count++
+	}
+
+	if count > 0 {
+		sc.sendDataPacket(DataPacketFlags.Compact, packet[:length], count)
+	}
+}
+
+// publishTSSCMeasurements sends measurements as TSSC compressed data packets, publishMutex is expected to be locked.
+func (sc *SubscriberConnection) publishTSSCMeasurements(measurements []publishedMeasurement) {
+	flags := DataPacketFlags.Compact | DataPacketFlags.Compressed
+
+	// Encoder state is reset with each new signal index cache
+	if sc.tsscEncoder == nil {
+		sc.tsscEncoder = tssc.NewEncoder()
+	}
+
+	encoder := sc.tsscEncoder
+	packet := make([]byte, maxDataPacketPayloadSize)
+	encoder.SetBuffer(packet[dataPacketHeaderSize:])
+	count := 0
+
+	finishBlock := func() error {
+		length, err := encoder.FinishBlock()
+
+		if err != nil {
+			sc.parent.dispatchErrorMessage("Failed to encode TSSC data packet for \"" + sc.connectionID + "\": " + err.Error())
+			sc.tsscEncoder = nil
+			return err
+		}
+
+		return sc.sendDataPacket(flags, packet[:dataPacketHeaderSize+length], count)
+	}
+
+	for i := 0; i < len(measurements); i++ {
+		measurement := measurements[i].measurement
+		added, err := encoder.TryAddMeasurement(measurements[i].signalIndex, int64(measurement.Timestamp), uint32(measurement.Flags), float32(measurement.Value))
+
+		if err != nil {
+			sc.parent.dispatchErrorMessage("Failed to encode TSSC measurement for \"" + sc.connectionID + "\": " + err.Error())
+			sc.tsscEncoder = nil
+			return
+		}
+
+		if added {
+			count++
+			continue
+		}
+
+		// Packet is full, send current block and retry measurement with a new block
+		if count == 0 || finishBlock() != nil {
+			return
+		}
+
+		encoder.SetBuffer(packet[dataPacketHeaderSize:])
+		count = 0
+		i--
+	}
+
+	if count > 0 {
+		finishBlock()
+	}
+}
+
+// sendDataPacket sends a data packet with the specified flags, publishMutex is expected to be locked.
+func (sc *SubscriberConnection) sendDataPacket(flags DataPacketFlagsEnum, packet []byte, count int) error {
+	if sc.cacheIndex > 0 {
+		flags |= DataPacketFlags.CacheIndex
+	}
+
+	packet[0] = byte(flags)
+	binary.BigEndian.PutUint32(packet[1:], uint32(count))
+
+	if err := sc.sendDataResponse(packet); err != nil {
+		return err
+	}
+
+	atomic.AddUint64(&sc.totalMeasurementsSent, uint64(count))
+	return nil
+}
+
+func (sc *SubscriberConnection) sendDataResponse(data []byte) error {
+	sc.dataChannelMutex.Lock()
+	dataChannelSocket := sc.dataChannelSocket
+	sc.dataChannelMutex.Unlock()
+
+	if dataChannelSocket == nil {
+		return sc.SendResponse(ServerResponse.DataPacket, ServerCommand.Subscribe, data)
+	}
+
+	// UDP data packets are sent without the leading packet size
+	buffer := make([]byte, responseHeaderSize+len(data))
+	buffer[0] = byte(ServerResponse.DataPacket)
+	buffer[1] = byte(ServerCommand.Subscribe)
+	binary.BigEndian.PutUint32(buffer[2:], uint32(len(data)))
+	copy(buffer[responseHeaderSize:], data)
+
+	if _, err := dataChannelSocket.Write(buffer); err != nil {
+		sc.parent.dispatchErrorMessage("Failed to send data packet to \"" + sc.connectionID + "\" over UDP data channel: " + err.Error())
+		return err
+	}
+
+	atomic.AddUint64(&sc.totalDataChannelBytesSent, uint64(len(buffer)))
+	return nil
+}
+
+func (sc *SubscriberConnection) sendSuccess(commandCode ServerCommandEnum, message string) error {
+	return sc.SendResponseWithMessage(ServerResponse.Succeeded, commandCode, message)
+}
+
+func (sc *SubscriberConnection) sendFailure(commandCode ServerCommandEnum, message string) error {
+	err := sc.SendResponseWithMessage(ServerResponse.Failed, commandCode, message)
+
+	if err == nil {
+		sc.parent.dispatchErrorMessage("\"" + sc.connectionID + "\": " + message)
+	}
+
+	return err
+}
+
+// SendResponseWithMessage sends a server response code to the DataSubscriber along with the specified string message as payload.
+func (sc *SubscriberConnection) SendResponseWithMessage(responseCode ServerResponseEnum, commandCode ServerCommandEnum, message string) error {
+	return sc.SendResponse(responseCode, commandCode, sc.EncodeString(message))
+}
+
+// SendResponse sends a server response code to the DataSubscriber, in reply to the specified command code,
+// along with the specified data payload.
+func (sc *SubscriberConnection) SendResponse(responseCode ServerResponseEnum, commandCode ServerCommandEnum, data []byte) error {
+	if sc.connected.IsNotSet() {
+		return errors.New("subscriber connection is not connected")
+	}
+
+	packetSize := uint32(responseHeaderSize + len(data))
+	buffer := make([]byte, payloadHeaderSize+packetSize)
+
+	// Insert packet size
+	binary.BigEndian.PutUint32(buffer, packetSize)
+
+	// Insert response header, note that internal payload size is
+	// retained for compatibility with existing STTP implementations
+	buffer[4] = byte(responseCode)
+	buffer[5] = byte(commandCode)
+	binary.BigEndian.PutUint32(buffer[6:], uint32(len(data)))
+	copy(buffer[payloadHeaderSize+responseHeaderSize:], data)
+
+	sc.writeMutex.Lock()
+	_, err := sc.commandChannelSocket.Write(buffer)
+	sc.writeMutex.Unlock()
+
+	if err != nil {
+		// Write error, connection may have been closed by peer; terminate connection
+		if sc.connected.IsSet() {
+			sc.parent.dispatchErrorMessage("Failed to send response to \"" + sc.connectionID + "\" - disconnecting: " + err.Error())
+			sc.connected.UnSet()
+			sc.commandChannelSocket.Close()
+		}
+
+		return err
+	}
+
+	atomic.AddUint64(&sc.totalCommandChannelBytesSent, uint64(len(buffer)))
+	return nil
+}
+
+// parseSubscriptionInfo parses the connection string settings of a subscribe command into a SubscriptionInfo.
+//
+//gocyclo:ignore
+func parseSubscriptionInfo(settings map[string]string) (SubscriptionInfo, error) {
+	subscription := SubscriptionInfo{
+		PublishInterval:    defaultPublishInterval,
+		IncludeTime:        true,
+		LagTime:            defaultLagTime,
+		LeadTime:           defaultLeadTime,
+		ProcessingInterval: -1,
+	}
+
+	var err error
+
+	parseBool := func(key string, target *bool) {
+		if value, ok := settings[key]; ok && err == nil {
+			if *target, err = strconv.ParseBool(value); err != nil {
+				err = errors.New("invalid \"" + key + "\" value: " + value)
+			}
+		}
+	}
+
+	parseFloat := func(key string, target *float64) {
+		if value, ok := settings[key]; ok && err == nil {
+			if *target, err = strconv.ParseFloat(value, 64); err != nil {
+				err = errors.New("invalid \"" + key + "\" value: " + value)
+			}
+		}
+	}
+
+	parseBool("throttled", &subscription.Throttled)
+	parseFloat("publishinterval", &subscription.PublishInterval)
+	parseBool("includetime", &subscription.IncludeTime)
+	parseBool("enabletimereasonabilitycheck", &subscription.EnableTimeReasonabilityCheck)
+	parseFloat("lagtime", &subscription.LagTime)
+	parseFloat("leadtime", &subscription.LeadTime)
+	parseBool("uselocalclockasrealtime", &subscription.UseLocalClockAsRealTime)
+	parseBool("usemillisecondresolution", &subscription.UseMillisecondResolution)
+	parseBool("requestnanvaluefilter", &subscription.RequestNaNValueFilter)
+
+	if err != nil {
+		return subscription, err
+	}
+
+	if value, ok := settings["processinginterval"]; ok {
+		processingInterval, err := strconv.ParseInt(value, 10, 32)
+
+		if err != nil {
+			return subscription, errors.New("invalid \"processinginterval\" value: " + value)
+		}
+
+		subscription.ProcessingInterval = int32(processingInterval)
+	}
+
+	if value, ok := settings["datachannel"]; ok {
+		dataChannel := parseKeyValuePairs(value)
+		localPort, err := strconv.ParseUint(strings.TrimSpace(dataChannel["localport"]), 10, 16)
+
+		if err != nil {
+			return subscription, errors.New("invalid \"datachannel\" local port value: " + value)
+		}
+
+		subscription.UdpDataChannel = true
+		subscription.DataChannelLocalPort = uint16(localPort)
+	}
+
+	subscription.FilterExpression = settings["filterexpression"]
+	subscription.StartTime = settings["starttimeconstraint"]
+	subscription.StopTime = settings["stoptimeconstraint"]
+	subscription.ConstraintParameters = settings["timeconstraintparameters"]
+
+	return subscription, nil
+}
@@ -24,8 +24,15 @@
 package transport
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"math"
+	"net"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -44,12 +51,34 @@ type SubscriberConnector struct {
 	// ReconnectCallback is called when SubscriberConnector attempts to reconnect.
 	ReconnectCallback func(*DataSubscriber)
 
+	// ActiveEndpointChangedCallback is called when a connection is established to a different
+	// DataPublisher endpoint than the one used by the previous connection.
+	ActiveEndpointChangedCallback func(Endpoint)
+
 	// Hostname is the DataPublisher DNS name or IP.
 	Hostname string
 
 	// Port it the TCP/IP listening port of the DataPublisher.
 	Port uint16
 
+	// Endpoints defines an ordered list of DataPublisher endpoints where the first endpoint is the
+	// primary and the remaining endpoints are failovers in priority order. Each connection attempt
+	// tries the endpoints in order until one connects, so a retry, along with its back-off and count
+	// toward MaxRetries, only occurs after all endpoints have failed. When defined, Hostname and Port
+	// are updated to the endpoint of the current connection attempt. Set value to nil to connect to
+	// the single endpoint defined by Hostname and Port.
+	Endpoints []Endpoint
+
+	// FailbackInterval defines the interval, in milliseconds, at which a connection to a failover
+	// endpoint checks if a higher priority endpoint is available again. An endpoint is available when
+	// a connection, including any TLS handshake, succeeds and, for STTP versions above 2, the endpoint
+	// accepts the operational modes of the DataSubscriber. When one is, the connection is terminated so
+	// that auto-reconnect can fail back to the higher priority endpoint. If auto-reconnect does not then
+	// connect to that endpoint, the interval is doubled for each consecutive failed fail-back, up to 32
+	// times the defined interval. Fail-back requires AutoReconnect to be true. Set value to zero to
+	// disable fail-back.
+	FailbackInterval int32
+
 	// MaxRetries defines the maximum number of times to retry a connection.
 	// Set value to -1 to retry infinitely.
 	MaxRetries int32
@@ -65,13 +94,32 @@ type SubscriberConnector struct {
 	// automatically reattempted.
 	AutoReconnect bool
 
+	// TLSConfig defines the Transport Layer Security configuration for the command
+	// channel. Set value to nil for an unencrypted connection. For connections to a
+	// DataPublisher, RootCAs pins the trusted certificate authorities, Certificates
+	// provides a client certificate for mutual TLS and ServerName overrides the name
+	// used to verify the server certificate, which otherwise defaults to Hostname.
+	// Configuration is also applied to listening, i.e., reverse, connections where
+	// Certificates must define the server certificate, and ClientCAs and ClientAuth
+	// control verification of the connecting DataPublisher certificate.
+	TLSConfig *tls.Config
+
 	connectAttempt       int32
+	lastError            error
+	lastErrorMutex       sync.Mutex
 	connectionRefused    abool.AtomicBool
 	cancel               abool.AtomicBool
 	reconnectThread      *thread.Thread
 	reconnectThreadMutex sync.Mutex
 	waitTimer            *time.Timer
 	waitTimerMutex       sync.Mutex
+	activeEndpoint       Endpoint
+	activeEndpointMutex  sync.Mutex
+	failbackStop         chan struct{}
+	failbackDelay        time.Duration
+	failbackEndpoint     Endpoint
+	failingBack          bool
+	failbackMutex        sync.Mutex
 
 	assigningHandlerMutex sync.RWMutex
 }
@@ -99,17 +147,17 @@ func (ds *DataSubscriber) autoReconnect() {
 		}
 
 		if sc.MaxRetries != -1 && sc.connectAttempt >= sc.MaxRetries {
-			sc.dispatchErrorMessage("Maximum connection retries attempted. Auto-reconnect canceled.")
+			sc.dispatchEvent(ds, EventSeverity.Error, ErrMaxRetriesExceeded, "Maximum connection retries attempted. Auto-reconnect canceled.")
 			return
 		}
 
-		sc.waitForRetry()
+		sc.waitForRetry(ds)
 
 		if sc.cancel.IsSet() || ds.disposing.IsSet() {
 			return
 		}
 
-		if sc.connect(ds, true) == ConnectStatus.Canceled {
+		if sc.connect(context.Background(), ds, true) == ConnectStatus.Canceled {
 			return
 		}
 
@@ -130,7 +178,7 @@ func (ds *DataSubscriber) autoReconnect() {
 	reconnectThread.Start()
 }
 
-func (sc *SubscriberConnector) waitForRetry() {
+func (sc *SubscriberConnector) waitForRetry(ds *DataSubscriber) {
 	// Apply exponential back-off algorithm for retry attempt delays
 	var exponent float64
 
@@ -175,7 +223,7 @@ func (sc *SubscriberConnector) waitForRetry() {
 		message.WriteString("Attempting to reconnect...")
 	}
 
-	sc.dispatchErrorMessage(message.String())
+	sc.dispatchEvent(ds, EventSeverity.Warning, sc.LastError(), message.String())
 
 	waitTimer := time.NewTimer(time.Duration(retryInterval) * time.Millisecond)
 
@@ -192,10 +240,20 @@ func (sc *SubscriberConnector) Connect(ds *DataSubscriber) ConnectStatusEnum {
 		return ConnectStatus.Canceled
 	}
 
-	return sc.connect(ds, false)
+	return sc.connect(context.Background(), ds, false)
 }
 
-func (sc *SubscriberConnector) connect(ds *DataSubscriber, autoReconnecting bool) ConnectStatusEnum {
+// ConnectContext initiates a connection sequence for a DataSubscriber that is canceled,
+// including any pending connection attempt or retry delay, when the context expires.
+func (sc *SubscriberConnector) ConnectContext(ctx context.Context, ds *DataSubscriber) ConnectStatusEnum {
+	if sc.cancel.IsSet() || ctx.Err() != nil {
+		return ConnectStatus.Canceled
+	}
+
+	return sc.connect(ctx, ds, false)
+}
+
+func (sc *SubscriberConnector) connect(ctx context.Context, ds *DataSubscriber, autoReconnecting bool) ConnectStatusEnum {
 	if sc.AutoReconnect {
 		ds.BeginCallbackAssignment()
 		ds.AutoReconnectCallback = ds.autoReconnect
@@ -204,9 +262,16 @@ func (sc *SubscriberConnector) connect(ds *DataSubscriber, autoReconnecting bool
 
 	sc.cancel.UnSet()
 
+	stopCancel := context.AfterFunc(ctx, sc.cancelConnect)
+	defer stopCancel()
+
 	for ds.disposing.IsNotSet() {
+		if sc.cancel.IsSet() || ctx.Err() != nil {
+			return ConnectStatus.Canceled
+		}
+
 		if sc.MaxRetries != -1 && sc.connectAttempt >= sc.MaxRetries {
-			sc.dispatchErrorMessage("Maximum connection retries attempted. Auto-reconnect canceled.")
+			sc.dispatchEvent(ds, EventSeverity.Error, ErrMaxRetriesExceeded, "Maximum connection retries attempted. Auto-reconnect canceled.")
 			break
 		}
 
@@ -216,15 +281,19 @@ func (sc *SubscriberConnector) connect(ds *DataSubscriber, autoReconnecting bool
 			return ConnectStatus.Canceled
 		}
 
-		if ds.connect(sc.Hostname, sc.Port, autoReconnecting) == nil {
+		if err := sc.connectEndpoints(ctx, ds, autoReconnecting); err == nil {
 			break
 		}
 
+		if ctx.Err() != nil {
+			return ConnectStatus.Canceled
+		}
+
 		if ds.disposing.IsNotSet() && sc.RetryInterval > 0 {
 			autoReconnecting = true
-			sc.waitForRetry()
+			sc.waitForRetry(ds)
 
-			if sc.cancel.IsSet() {
+			if sc.cancel.IsSet() || ctx.Err() != nil {
 				return ConnectStatus.Canceled
 			}
 		}
@@ -241,18 +310,226 @@ func (sc *SubscriberConnector) connect(ds *DataSubscriber, autoReconnecting bool
 	return ConnectStatus.Failed
 }
 
-// Cancel stops all current and future connection sequences.
-func (sc *SubscriberConnector) Cancel() {
-	sc.cancel.Set()
+// connectEndpoints attempts a connection to each endpoint, in priority order, until one connects.
+func (sc *SubscriberConnector) connectEndpoints(ctx context.Context, ds *DataSubscriber, autoReconnecting bool) error {
+	sc.stopFailbackMonitor()
 
-	sc.waitTimerMutex.Lock()
-	waitTimer := sc.waitTimer
-	sc.waitTimerMutex.Unlock()
+	endpoints := sc.Endpoints
 
-	if waitTimer != nil {
-		waitTimer.Stop()
+	if len(endpoints) == 0 {
+		endpoints = []Endpoint{{Hostname: sc.Hostname, Port: sc.Port}}
 	}
 
+	var err error
+
+	for index, endpoint := range endpoints {
+		if index > 0 {
+			if sc.cancel.IsSet() || ctx.Err() != nil || ds.disposing.IsSet() {
+				break
+			}
+
+			sc.dispatchEvent(ds, EventSeverity.Warning, err, "Connection to \""+endpoints[index-1].String()+"\" failed. Attempting connection to failover endpoint \""+endpoint.String()+"\"...")
+		}
+
+		sc.Hostname = endpoint.Hostname
+		sc.Port = endpoint.Port
+
+		// Only first endpoint of a connection sequence resets connection state
+		err = ds.connect(ctx, endpoint.Hostname, endpoint.Port, autoReconnecting || index > 0)
+		sc.setLastError(err)
+
+		if err == nil {
+			sc.setActiveEndpoint(ds, endpoint)
+			sc.startFailbackMonitor(ds, endpoints[:index])
+			return nil
+		}
+
+		var handshakeErr *TLSHandshakeError
+
+		if errors.As(err, &handshakeErr) {
+			sc.dispatchEvent(ds, EventSeverity.Error, handshakeErr, handshakeErr.Error())
+		}
+	}
+
+	return err
+}
+
+func (sc *SubscriberConnector) setActiveEndpoint(ds *DataSubscriber, endpoint Endpoint) {
+	sc.activeEndpointMutex.Lock()
+	changed := sc.activeEndpoint != endpoint
+	sc.activeEndpoint = endpoint
+	sc.activeEndpointMutex.Unlock()
+
+	if !changed {
+		return
+	}
+
+	sc.BeginCallbackSync()
+
+	if sc.ActiveEndpointChangedCallback != nil {
+		go sc.ActiveEndpointChangedCallback(endpoint)
+	}
+
+	sc.EndCallbackSync()
+}
+
+// ActiveEndpoint gets the DataPublisher endpoint of the most recently established connection.
+func (sc *SubscriberConnector) ActiveEndpoint() Endpoint {
+	sc.activeEndpointMutex.Lock()
+	defer sc.activeEndpointMutex.Unlock()
+
+	return sc.activeEndpoint
+}
+
+// startFailbackMonitor starts monitoring the higher priority endpoints when connected to a failover endpoint.
+func (sc *SubscriberConnector) startFailbackMonitor(ds *DataSubscriber, preferred []Endpoint) {
+	sc.failbackMutex.Lock()
+	defer sc.failbackMutex.Unlock()
+
+	interval := time.Duration(sc.FailbackInterval) * time.Millisecond
+
+	// Back off when fail-back did not connect to the endpoint it was initiated for
+	if sc.failingBack && slices.Contains(preferred, sc.failbackEndpoint) {
+		sc.failbackDelay = min(sc.failbackDelay*2, interval*maximumFailbackBackoff)
+	} else {
+		sc.failbackDelay = interval
+	}
+
+	sc.failingBack = false
+
+	if len(preferred) == 0 || sc.FailbackInterval <= 0 || !sc.AutoReconnect {
+		return
+	}
+
+	stop := make(chan struct{})
+	sc.failbackStop = stop
+
+	go sc.runFailbackMonitor(ds, preferred, sc.failbackDelay, stop)
+}
+
+func (sc *SubscriberConnector) stopFailbackMonitor() {
+	sc.failbackMutex.Lock()
+	defer sc.failbackMutex.Unlock()
+
+	if sc.failbackStop != nil {
+		close(sc.failbackStop)
+		sc.failbackStop = nil
+	}
+}
+
+func (sc *SubscriberConnector) runFailbackMonitor(ds *DataSubscriber, preferred []Endpoint, interval time.Duration, stop chan struct{}) {
+	timeout := time.Duration(sc.FailbackInterval) * time.Millisecond
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-timer.C:
+		}
+
+		if !ds.IsConnected() || sc.cancel.IsSet() || ds.disposing.IsSet() {
+			return
+		}
+
+		for _, endpoint := range preferred {
+			if !sc.probeEndpoint(ds, endpoint, timeout) {
+				continue
+			}
+
+			// Make sure monitor was not stopped while probing
+			select {
+			case <-stop:
+				return
+			default:
+			}
+
+			sc.dispatchEvent(ds, EventSeverity.Information, nil, "Higher priority endpoint \""+endpoint.String()+"\" is available. Failing back...")
+
+			sc.failbackMutex.Lock()
+			sc.failbackEndpoint = endpoint
+			sc.failingBack = true
+			sc.failbackMutex.Unlock()
+
+			// Terminating connection initiates auto-reconnect which starts with the primary endpoint
+			ds.dispatchConnectionTerminated()
+			return
+		}
+
+		timer.Reset(interval)
+	}
+}
+
+// probeEndpoint determines if a connection to the endpoint can be established, including any TLS handshake.
+// Since older versions of STTP do not respond to operational modes, the endpoint must also accept the
+// operational modes of the DataSubscriber only when the STTP version is above 2.
+func (sc *SubscriberConnector) probeEndpoint(ds *DataSubscriber, endpoint Endpoint, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	address := endpoint.String()
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+
+	if err != nil {
+		return false
+	}
+
+	if sc.TLSConfig != nil {
+		// Connection is closed when handshake fails
+		if conn, err = tlsClientHandshake(ctx, conn, sc.TLSConfig, endpoint.Hostname, address); err != nil {
+			return false
+		}
+	}
+
+	defer conn.Close()
+
+	if ds.Version <= 2 {
+		return true
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	command := make([]byte, payloadHeaderSize+5)
+	binary.BigEndian.PutUint32(command, 5)
+	command[payloadHeaderSize] = byte(ServerCommand.DefineOperationalModes)
+	binary.BigEndian.PutUint32(command[payloadHeaderSize+1:], uint32(ds.operationalModes()))
+
+	if _, err = conn.Write(command); err != nil {
+		return false
+	}
+
+	buffer := make([]byte, maxPacketSize)
+
+	for {
+		if _, err = io.ReadFull(conn, buffer[:payloadHeaderSize]); err != nil {
+			return false
+		}
+
+		packetSize := binary.BigEndian.Uint32(buffer)
+
+		if packetSize < responseHeaderSize || packetSize > maxPacketSize {
+			return false
+		}
+
+		if _, err = io.ReadFull(conn, buffer[:packetSize]); err != nil {
+			return false
+		}
+
+		if responseCode := ServerResponseEnum(buffer[0]); responseCode != ServerResponse.NoOP {
+			return responseCode == ServerResponse.Succeeded && ServerCommandEnum(buffer[1]) == ServerCommand.DefineOperationalModes
+		}
+	}
+}
+
+// Cancel stops all current and future connection sequences.
+func (sc *SubscriberConnector) Cancel() {
+	sc.cancelConnect()
+	sc.stopFailbackMonitor()
+
 	sc.reconnectThreadMutex.Lock()
 	reconnectThread := sc.reconnectThread
 	sc.reconnectThreadMutex.Unlock()
@@ -262,13 +539,51 @@ func (sc *SubscriberConnector) Cancel() {
 	}
 }
 
+func (sc *SubscriberConnector) cancelConnect() {
+	sc.cancel.Set()
+
+	sc.waitTimerMutex.Lock()
+	waitTimer := sc.waitTimer
+	sc.waitTimerMutex.Unlock()
+
+	// Expire any pending retry delay immediately so waiting connection sequence can observe cancel
+	if waitTimer != nil {
+		waitTimer.Reset(0)
+	}
+}
+
 // ResetConnection resets SubscriberConnector for a new connection.
 func (sc *SubscriberConnector) ResetConnection() {
 	sc.connectAttempt = 0
 	sc.cancel.UnSet()
 }
 
-func (sc *SubscriberConnector) dispatchErrorMessage(message string) {
+// LastError gets the error from the most recent connection attempt, if any. When a TLS
+// handshake caused the attempt to fail, the error will be a *TLSHandshakeError.
+func (sc *SubscriberConnector) LastError() error {
+	sc.lastErrorMutex.Lock()
+	defer sc.lastErrorMutex.Unlock()
+
+	return sc.lastError
+}
+
+func (sc *SubscriberConnector) setLastError(err error) {
+	sc.lastErrorMutex.Lock()
+	sc.lastError = err
+	sc.lastErrorMutex.Unlock()
+}
+
+func (sc *SubscriberConnector) dispatchEvent(ds *DataSubscriber, severity EventSeverityEnum, err error, message string) {
+	ds.publishEvent(&Event{
+		Time:         time.Now(),
+		Kind:         EventKind.Connection,
+		Severity:     severity,
+		ConnectionID: Endpoint{Hostname: sc.Hostname, Port: sc.Port}.String(),
+		Message:      message,
+		Err:          err,
+	})
+
+	// Connector messages are delivered to its own error message callback for compatibility
 	sc.BeginCallbackSync()
 
 	if sc.ErrorMessageCallback != nil {
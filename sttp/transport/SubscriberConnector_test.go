@@ -0,0 +1,544 @@
+//******************************************************************************************************
+//  SubscriberConnector_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/internal/transporttest"
+)
+
+type testCertificateAuthority struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	pool        *x509.CertPool
+}
+
+func newTestCertificateAuthority(t *testing.T) *testCertificateAuthority {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("Failed to generate CA key: %s", err.Error())
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "STTP Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatalf("Failed to create CA certificate: %s", err.Error())
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+
+	if err != nil {
+		t.Fatalf("Failed to parse CA certificate: %s", err.Error())
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+
+	return &testCertificateAuthority{certificate: certificate, key: key, pool: pool}
+}
+
+func (ca *testCertificateAuthority) issue(t *testing.T, commonName string, usage x509.ExtKeyUsage) tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("Failed to generate key for \"%s\": %s", commonName, err.Error())
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.certificate, &key.PublicKey, ca.key)
+
+	if err != nil {
+		t.Fatalf("Failed to create certificate for \"%s\": %s", commonName, err.Error())
+	}
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
+
+func TestConnectMutualTLS(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+
+	publisher, _ := startTestTLSPublisher(t, &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "publisher", x509.ExtKeyUsageServerAuth)},
+		ClientCAs:    ca.pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	})
+
+	defer publisher.Dispose()
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	subscriber.Connector().TLSConfig = &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "subscriber", x509.ExtKeyUsageClientAuth)},
+		RootCAs:      ca.pool,
+	}
+
+	connection := connectTestSubscriber(t, publisher, subscriber)
+
+	if subscriber.SecurityMode() != SecurityMode.TLS {
+		t.Fatal("TestConnectMutualTLS: subscriber command channel is not secured with TLS")
+	}
+
+	if connection.SecurityMode() != SecurityMode.TLS {
+		t.Fatal("TestConnectMutualTLS: publisher connection is not secured with TLS")
+	}
+}
+
+func TestConnectTLSHandshakeFailure(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+
+	publisher, _ := startTestTLSPublisher(t, &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "publisher", x509.ExtKeyUsageServerAuth)},
+	})
+
+	defer publisher.Dispose()
+
+	tests := []struct {
+		name   string
+		config *tls.Config
+	}{
+		{"server name mismatch", &tls.Config{RootCAs: ca.pool, ServerName: "publisher.example.com"}},
+		{"untrusted CA", &tls.Config{RootCAs: newTestCertificateAuthority(t).pool}},
+	}
+
+	for _, test := range tests {
+		subscriber := NewDataSubscriber()
+
+		connector := subscriber.Connector()
+		connector.Hostname = "127.0.0.1"
+		connector.Port = publisher.Port()
+		connector.MaxRetries = 1
+		connector.RetryInterval = 10
+		connector.MaxRetryInterval = 10
+		connector.TLSConfig = test.config
+
+		if status := connector.Connect(subscriber); status != ConnectStatus.Failed {
+			t.Fatalf("TestConnectTLSHandshakeFailure: %s: unexpected connect status: %d", test.name, status)
+		}
+
+		var handshakeErr *TLSHandshakeError
+
+		if !errors.As(connector.LastError(), &handshakeErr) {
+			t.Fatalf("TestConnectTLSHandshakeFailure: %s: expected TLS handshake error, received: %v", test.name, connector.LastError())
+		}
+
+		if subscriber.IsConnected() {
+			t.Fatalf("TestConnectTLSHandshakeFailure: %s: subscriber connected after failed handshake", test.name)
+		}
+
+		subscriber.Dispose()
+	}
+}
+
+func TestConnectTLSWithStalledClient(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+
+	publisher, _ := startTestTLSPublisher(t, &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "publisher", x509.ExtKeyUsageServerAuth)},
+	})
+
+	defer publisher.Dispose()
+
+	// Client connects but never starts its TLS handshake
+	stalled, err := net.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(publisher.Port()))))
+
+	if err != nil {
+		t.Fatalf("TestConnectTLSWithStalledClient: failed to connect stalled client: %s", err.Error())
+	}
+
+	defer stalled.Close()
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	subscriber.Connector().TLSConfig = &tls.Config{RootCAs: ca.pool}
+	started := time.Now()
+
+	connectTestSubscriber(t, publisher, subscriber)
+
+	if elapsed := time.Since(started); elapsed >= tlsHandshakeTimeout/2 {
+		t.Fatalf("TestConnectTLSWithStalledClient: connection delayed by stalled client for %s", elapsed)
+	}
+}
+
+func TestConnectContextCancelsTLSHandshake(t *testing.T) {
+	// Listener accepts connections but never responds to TLS handshake
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("TestConnectContextCancelsTLSHandshake: failed to listen: %s", err.Error())
+	}
+
+	defer listener.Close()
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+
+			if err != nil {
+				return
+			}
+
+			defer conn.Close()
+		}
+	}()
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	subscriber.Connector().TLSConfig = &tls.Config{RootCAs: newTestCertificateAuthority(t).pool}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	err = subscriber.connect(ctx, "127.0.0.1", uint16(listener.Addr().(*net.TCPAddr).Port), false)
+
+	var handshakeErr *TLSHandshakeError
+
+	if !errors.As(err, &handshakeErr) {
+		t.Fatalf("TestConnectContextCancelsTLSHandshake: expected TLS handshake error, received: %v", err)
+	}
+
+	if elapsed := time.Since(started); elapsed >= tlsHandshakeTimeout/2 {
+		t.Fatalf("TestConnectContextCancelsTLSHandshake: handshake not canceled with context, elapsed %s", elapsed)
+	}
+}
+
+func TestListenTLS(t *testing.T) {
+	ca := newTestCertificateAuthority(t)
+
+	// Reserve a free port for the reverse connection listener
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("TestListenTLS: failed to reserve port: %s", err.Error())
+	}
+
+	port := uint16(listener.Addr().(*net.TCPAddr).Port)
+	listener.Close()
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	subscriber.Connector().TLSConfig = &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "subscriber", x509.ExtKeyUsageServerAuth)},
+		ClientCAs:    ca.pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+	}
+
+	if err = subscriber.Listen(port, "127.0.0.1"); err != nil {
+		t.Fatalf("TestListenTLS: failed to listen: %s", err.Error())
+	}
+
+	conn, err := tls.Dial("tcp", net.JoinHostPort("127.0.0.1", strconv.Itoa(int(port))), &tls.Config{
+		Certificates: []tls.Certificate{ca.issue(t, "publisher", x509.ExtKeyUsageClientAuth)},
+		RootCAs:      ca.pool,
+	})
+
+	if err != nil {
+		t.Fatalf("TestListenTLS: failed to connect to listening subscriber: %s", err.Error())
+	}
+
+	defer conn.Close()
+
+	// Subscriber sends its operational modes once the reverse connection is established
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	header := make([]byte, payloadHeaderSize+1)
+
+	if _, err = io.ReadFull(conn, header); err != nil {
+		t.Fatalf("TestListenTLS: failed to read subscriber command: %s", err.Error())
+	}
+
+	if command := ServerCommandEnum(header[payloadHeaderSize]); command != ServerCommand.DefineOperationalModes {
+		t.Fatalf("TestListenTLS: unexpected command received: 0x%02X", byte(command))
+	}
+
+	if size := binary.BigEndian.Uint32(header); size != 5 {
+		t.Fatalf("TestListenTLS: unexpected command size: %d", size)
+	}
+
+	if subscriber.SecurityMode() != SecurityMode.TLS {
+		t.Fatal("TestListenTLS: subscriber command channel is not secured with TLS")
+	}
+}
+
+func TestListenTLSWithoutCertificate(t *testing.T) {
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	subscriber.Connector().TLSConfig = &tls.Config{}
+
+	if err := subscriber.Listen(0, "127.0.0.1"); err == nil {
+		t.Fatal("TestListenTLSWithoutCertificate: expected error for TLS listener without certificate")
+	}
+}
+
+func reserveTestPort(t *testing.T) uint16 {
+	// Reserve a local port with no listener so that connection attempts are refused
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+
+	if err != nil {
+		t.Fatalf("Failed to reserve port: %s", err.Error())
+	}
+
+	port := uint16(listener.Addr().(*net.TCPAddr).Port)
+	listener.Close()
+
+	return port
+}
+
+func TestConnectFailover(t *testing.T) {
+	publisher := NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Dispose()
+
+	primary := Endpoint{Hostname: "127.0.0.1", Port: reserveTestPort(t)}
+	backup := Endpoint{Hostname: "127.0.0.1", Port: publisher.Port()}
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	changed := make(chan Endpoint, 10)
+
+	connector := subscriber.Connector()
+	connector.Endpoints = []Endpoint{primary, backup}
+	connector.MaxRetries = 1
+	connector.RetryInterval = 10
+	connector.MaxRetryInterval = 10
+
+	connector.BeginCallbackAssignment()
+	connector.ActiveEndpointChangedCallback = func(endpoint Endpoint) {
+		changed <- endpoint
+	}
+	connector.EndCallbackAssignment()
+
+	if status := connector.Connect(subscriber); status != ConnectStatus.Success {
+		t.Fatalf("TestConnectFailover: unexpected connect status: %d", status)
+	}
+
+	if subscriber.ConnectionID() != backup.String() {
+		t.Fatalf("TestConnectFailover: expected connection ID %s, received: %s", backup.String(), subscriber.ConnectionID())
+	}
+
+	if connector.ActiveEndpoint() != backup {
+		t.Fatalf("TestConnectFailover: unexpected active endpoint: %s", connector.ActiveEndpoint().String())
+	}
+
+	select {
+	case endpoint := <-changed:
+		if endpoint != backup {
+			t.Fatalf("TestConnectFailover: unexpected active endpoint changed notification: %s", endpoint.String())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestConnectFailover: timed out waiting for active endpoint changed notification")
+	}
+}
+
+func TestConnectFailback(t *testing.T) {
+	backupPublisher := NewDataPublisher()
+	transporttest.StartPublisher(t, backupPublisher)
+	defer backupPublisher.Dispose()
+
+	primary := Endpoint{Hostname: "127.0.0.1", Port: reserveTestPort(t)}
+	backup := Endpoint{Hostname: "127.0.0.1", Port: backupPublisher.Port()}
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	connector := subscriber.Connector()
+	connector.Endpoints = []Endpoint{primary, backup}
+	connector.MaxRetries = -1
+	connector.RetryInterval = 10
+	connector.MaxRetryInterval = 10
+	connector.AutoReconnect = true
+	connector.FailbackInterval = 20
+
+	if status := connector.Connect(subscriber); status != ConnectStatus.Success {
+		t.Fatalf("TestConnectFailback: unexpected connect status: %d", status)
+	}
+
+	if connector.ActiveEndpoint() != backup {
+		t.Fatalf("TestConnectFailback: expected connection to backup endpoint, received: %s", connector.ActiveEndpoint().String())
+	}
+
+	// Bring primary endpoint online
+	primaryPublisher := NewDataPublisher()
+	defer primaryPublisher.Dispose()
+
+	if err := primaryPublisher.DefineMetadataXml(transporttest.SampleMetadata(t)); err != nil {
+		t.Fatalf("TestConnectFailback: failed to define primary metadata: %s", err.Error())
+	}
+
+	if err := primaryPublisher.Start(primary.Port, primary.Hostname); err != nil {
+		t.Fatalf("TestConnectFailback: failed to start primary publisher: %s", err.Error())
+	}
+
+	waitFor(t, "fail-back to primary endpoint", func() bool {
+		return connector.ActiveEndpoint() == primary && subscriber.IsConnected() && subscriber.ConnectionID() == primary.String()
+	})
+}
+
+func TestConnectFailbackRequiresHandshake(t *testing.T) {
+	backupPublisher := NewDataPublisher()
+	transporttest.StartPublisher(t, backupPublisher)
+	defer backupPublisher.Dispose()
+
+	primary := Endpoint{Hostname: "127.0.0.1", Port: reserveTestPort(t)}
+	backup := Endpoint{Hostname: "127.0.0.1", Port: backupPublisher.Port()}
+
+	subscriber := NewDataSubscriber()
+	subscriber.Version = 3
+	defer subscriber.Dispose()
+
+	connector := subscriber.Connector()
+	connector.Endpoints = []Endpoint{primary, backup}
+	connector.MaxRetries = -1
+	connector.RetryInterval = 10
+	connector.MaxRetryInterval = 10
+	connector.AutoReconnect = true
+	connector.FailbackInterval = 20
+
+	if status := connector.Connect(subscriber); status != ConnectStatus.Success {
+		t.Fatalf("TestConnectFailbackRequiresHandshake: unexpected connect status: %d", status)
+	}
+
+	if connector.ActiveEndpoint() != backup {
+		t.Fatalf("TestConnectFailbackRequiresHandshake: expected connection to backup endpoint, received: %s", connector.ActiveEndpoint().String())
+	}
+
+	reconnects := atomic.LoadUint64(&subscriber.totalReconnects)
+
+	// Bring primary endpoint online as a listener that accepts TCP connections
+	// but closes them without an STTP response
+	listener, err := net.Listen("tcp", primary.String())
+
+	if err != nil {
+		t.Fatalf("TestConnectFailbackRequiresHandshake: failed to listen on primary endpoint: %s", err.Error())
+	}
+
+	defer listener.Close()
+
+	var accepted int32
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+
+			if err != nil {
+				return
+			}
+
+			atomic.AddInt32(&accepted, 1)
+			conn.Close()
+		}
+	}()
+
+	waitFor(t, "fail-back probes of primary endpoint", func() bool {
+		return atomic.LoadInt32(&accepted) >= 3
+	})
+
+	if atomic.LoadUint64(&subscriber.totalReconnects) != reconnects || connector.ActiveEndpoint() != backup || !subscriber.IsConnected() {
+		t.Fatal("TestConnectFailbackRequiresHandshake: expected connection to remain on backup endpoint")
+	}
+}
+
+func TestFailbackBackoff(t *testing.T) {
+	primary := Endpoint{Hostname: "127.0.0.1", Port: 7165}
+	connector := &SubscriberConnector{FailbackInterval: 20}
+	interval := 20 * time.Millisecond
+
+	connector.startFailbackMonitor(nil, []Endpoint{primary})
+
+	if connector.failbackDelay != interval {
+		t.Fatalf("TestFailbackBackoff: unexpected initial fail-back delay: %s", connector.failbackDelay)
+	}
+
+	// Each fail-back that ends on a lower priority endpoint doubles the delay
+	for _, expected := range []time.Duration{2 * interval, 4 * interval, 8 * interval, 16 * interval, 32 * interval, 32 * interval} {
+		connector.failbackEndpoint = primary
+		connector.failingBack = true
+		connector.startFailbackMonitor(nil, []Endpoint{primary})
+
+		if connector.failbackDelay != expected {
+			t.Fatalf("TestFailbackBackoff: expected fail-back delay %s, received: %s", expected, connector.failbackDelay)
+		}
+	}
+
+	// Successful fail-back resets the delay
+	connector.failbackEndpoint = primary
+	connector.failingBack = true
+	connector.startFailbackMonitor(nil, nil)
+
+	if connector.failbackDelay != interval || connector.failingBack {
+		t.Fatalf("TestFailbackBackoff: expected fail-back delay to reset, received: %s", connector.failbackDelay)
+	}
+}
+
+func TestEndpointString(t *testing.T) {
+	if endpoint := (Endpoint{Hostname: "127.0.0.1", Port: 7165}); endpoint.String() != "127.0.0.1:7165" {
+		t.Fatalf("TestEndpointString: unexpected IPv4 endpoint: %s", endpoint.String())
+	}
+
+	if endpoint := (Endpoint{Hostname: "::1", Port: 7165}); endpoint.String() != "[::1]:7165" {
+		t.Fatalf("TestEndpointString: unexpected IPv6 endpoint: %s", endpoint.String())
+	}
+}
@@ -0,0 +1,157 @@
+//******************************************************************************************************
+//  SubscriberStatistics.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// SubscriberStatistics defines a snapshot of the operational statistics of a DataSubscriber.
+// Unless noted otherwise, counters accumulate over the lifetime of the DataSubscriber.
+type SubscriberStatistics struct {
+	// Connected determines if the DataSubscriber was connected when the snapshot was taken.
+	Connected bool
+
+	// Subscribed determines if the DataSubscriber was subscribed when the snapshot was taken.
+	Subscribed bool
+
+	// Connections is the number of connections established to a DataPublisher.
+	Connections uint64
+
+	// Reconnects is the number of connections established by automatic reconnection or failover.
+	Reconnects uint64
+
+	// MeasurementsReceived is the number of measurements received.
+	MeasurementsReceived uint64
+
+	// MetadataRefreshes is the number of metadata responses received.
+	MetadataRefreshes uint64
+
+	// MetadataRefreshLatency is the accumulated time between metadata requests and their responses.
+	MetadataRefreshLatency time.Duration
+
+	// LastMetadataRefreshLatency is the time between the last metadata request and its response.
+	LastMetadataRefreshLatency time.Duration
+
+	// TSSCResets is the number of times the TSSC decompressor was reset by the DataPublisher.
+	TSSCResets uint64
+
+	// TSSCOutOfSequence is the number of TSSC data packets ignored because they were out of sequence.
+	TSSCOutOfSequence uint64
+
+	// SignalIndexCacheSwaps is the number of signal index caches received and made active.
+	SignalIndexCacheSwaps uint64
+
+	// DecryptionFailures is the number of data packets that could not be decrypted.
+	DecryptionFailures uint64
+
+	// EventsDropped is the number of events that could not be sent to the EventChannel.
+	EventsDropped uint64
+
+	// Callbacks defines the execution time statistics of each callback that has been
+	// invoked, keyed by callback field name, e.g., "NewMeasurementsCallback".
+	Callbacks map[string]CallbackStatistics
+}
+
+// CallbackStatistics defines the execution time statistics for a DataSubscriber callback.
+type CallbackStatistics struct {
+	// Invocations is the number of times the callback completed.
+	Invocations uint64
+
+	// TotalDuration is the accumulated execution time of the callback.
+	TotalDuration time.Duration
+
+	// MaxDuration is the longest execution time of the callback.
+	MaxDuration time.Duration
+}
+
+// callbackKind identifies a DataSubscriber callback for execution time tracking.
+type callbackKind int
+
+const (
+	callbackStatusMessage callbackKind = iota
+	callbackErrorMessage
+	callbackConnectionEstablished
+	callbackConnectionTerminated
+	callbackAutoReconnect
+	callbackMetadataReceived
+	callbackMetadataReader
+	callbackSubscriptionUpdated
+	callbackDataStartTime
+	callbackConfigurationChanged
+	callbackNewMeasurements
+	callbackNewBufferBlocks
+	callbackProcessingComplete
+	callbackNotificationReceived
+	callbackKindCount
+)
+
+var callbackNames = [callbackKindCount]string{
+	"StatusMessageCallback",
+	"ErrorMessageCallback",
+	"ConnectionEstablishedCallback",
+	"ConnectionTerminatedCallback",
+	"AutoReconnectCallback",
+	"MetadataReceivedCallback",
+	"MetadataReaderCallback",
+	"SubscriptionUpdatedCallback",
+	"DataStartTimeCallback",
+	"ConfigurationChangedCallback",
+	"NewMeasurementsCallback",
+	"NewBufferBlocksCallback",
+	"ProcessingCompleteCallback",
+	"NotificationReceivedCallback",
+}
+
+// callbackTimer accumulates callback execution times using atomic operations so that
+// callbacks on the socket processing threads are not serialized by a lock.
+type callbackTimer struct {
+	invocations   uint64
+	totalDuration int64
+	maxDuration   int64
+}
+
+func (ct *callbackTimer) record(duration time.Duration) {
+	atomic.AddInt64(&ct.totalDuration, int64(duration))
+
+	for {
+		maxDuration := atomic.LoadInt64(&ct.maxDuration)
+
+		if int64(duration) <= maxDuration || atomic.CompareAndSwapInt64(&ct.maxDuration, maxDuration, int64(duration)) {
+			break
+		}
+	}
+
+	// Invocations updated last so a snapshot never reports an invocation without its duration
+	atomic.AddUint64(&ct.invocations, 1)
+}
+
+func (ct *callbackTimer) statistics() CallbackStatistics {
+	return CallbackStatistics{
+		Invocations:   atomic.LoadUint64(&ct.invocations),
+		TotalDuration: time.Duration(atomic.LoadInt64(&ct.totalDuration)),
+		MaxDuration:   time.Duration(atomic.LoadInt64(&ct.maxDuration)),
+	}
+}
@@ -0,0 +1,117 @@
+//******************************************************************************************************
+//  SubscriberStatistics_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sttp/goapi/internal/transporttest"
+	"github.com/sttp/goapi/sttp/guid"
+	"github.com/sttp/goapi/sttp/ticks"
+)
+
+func TestSubscriberStatistics(t *testing.T) {
+	publisher := NewDataPublisher()
+	transporttest.StartPublisher(t, publisher)
+	defer publisher.Dispose()
+
+	subscriber := NewDataSubscriber()
+	defer subscriber.Dispose()
+
+	receivedMetadata := make(chan struct{}, 1)
+
+	subscriber.BeginCallbackAssignment()
+	subscriber.MetadataReceivedCallback = func([]byte) {
+		receivedMetadata <- struct{}{}
+	}
+	subscriber.NewMeasurementsCallback = func(*[]Measurement) {
+		time.Sleep(time.Millisecond)
+	}
+	subscriber.EndCallbackAssignment()
+
+	connection := connectTestSubscriber(t, publisher, subscriber)
+	subscriber.SendServerCommand(ServerCommand.MetadataRefresh)
+
+	select {
+	case <-receivedMetadata:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("TestSubscriberStatistics: timed out waiting for metadata")
+	}
+
+	subscriber.Subscription().FilterExpression = "FILTER ActiveMeasurements WHERE SignalType = 'FREQ'"
+
+	if err := subscriber.Subscribe(); err != nil {
+		t.Fatalf("TestSubscriberStatistics: failed to subscribe: %s", err.Error())
+	}
+
+	waitFor(t, "signal index cache confirmation", func() bool {
+		return connection.ActiveSignalIndexCache() != nil && subscriber.IsSubscribed()
+	})
+
+	freqID, _ := guid.Parse(freqSignalID)
+	publisher.PublishMeasurements([]Measurement{{SignalID: freqID, Value: 60.0, Timestamp: ticks.UtcNow()}})
+
+	waitFor(t, "measurements", func() bool {
+		return subscriber.Statistics().MeasurementsReceived == 1
+	})
+
+	statistics := subscriber.Statistics()
+
+	if !statistics.Connected || !statistics.Subscribed || statistics.Connections != 1 || statistics.Reconnects != 0 {
+		t.Fatalf("TestSubscriberStatistics: unexpected connection statistics: %+v", statistics)
+	}
+
+	if statistics.MetadataRefreshes != 1 || statistics.LastMetadataRefreshLatency <= 0 || statistics.MetadataRefreshLatency != statistics.LastMetadataRefreshLatency {
+		t.Fatalf("TestSubscriberStatistics: unexpected metadata statistics: %+v", statistics)
+	}
+
+	if statistics.SignalIndexCacheSwaps != 1 {
+		t.Fatalf("TestSubscriberStatistics: expected 1 signal index cache swap, received: %d", statistics.SignalIndexCacheSwaps)
+	}
+
+	callback, found := statistics.Callbacks["NewMeasurementsCallback"]
+
+	if !found || callback.Invocations != 1 || callback.TotalDuration < time.Millisecond || callback.MaxDuration != callback.TotalDuration {
+		t.Fatalf("TestSubscriberStatistics: unexpected NewMeasurementsCallback statistics: %+v", callback)
+	}
+
+	if _, found := statistics.Callbacks["DataStartTimeCallback"]; found {
+		t.Fatalf("TestSubscriberStatistics: expected no statistics for undefined callback")
+	}
+}
+
+func TestCallbackTimer(t *testing.T) {
+	var timer callbackTimer
+
+	timer.record(3 * time.Millisecond)
+	timer.record(5 * time.Millisecond)
+	timer.record(time.Millisecond)
+
+	statistics := timer.statistics()
+
+	if statistics.Invocations != 3 || statistics.TotalDuration != 9*time.Millisecond || statistics.MaxDuration != 5*time.Millisecond {
+		t.Fatalf("TestCallbackTimer: unexpected statistics: %+v", statistics)
+	}
+}
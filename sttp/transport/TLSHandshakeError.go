@@ -0,0 +1,46 @@
+//******************************************************************************************************
+//  TLSHandshakeError.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package transport
+
+// TLSHandshakeError is the error returned when a TCP connection for the STTP command channel
+// was established but the Transport Layer Security handshake failed, e.g., due to an untrusted
+// certificate, a server name mismatch or a missing client certificate. Use errors.As to
+// distinguish handshake failures from other connection errors.
+type TLSHandshakeError struct {
+	// Address is the remote endpoint of the failed connection.
+	Address string
+
+	// Err is the underlying handshake error.
+	Err error
+}
+
+// Error returns the string representation of the TLSHandshakeError.
+func (e *TLSHandshakeError) Error() string {
+	return "TLS handshake with \"" + e.Address + "\" failed: " + e.Err.Error()
+}
+
+// Unwrap returns the underlying handshake error.
+func (e *TLSHandshakeError) Unwrap() error {
+	return e.Err
+}
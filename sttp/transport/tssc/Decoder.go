@@ -36,6 +36,9 @@ type Decoder struct {
 	position     int
 	lastPosition int
 
+	// overrun is set when a read is attempted past the end of the working buffer
+	overrun bool
+
 	prevTimestamp1 int64
 	prevTimestamp2 int64
 
@@ -91,6 +94,7 @@ func (td *Decoder) SetBuffer(data []byte) {
 	td.data = data
 	td.position = 0
 	td.lastPosition = len(data)
+	td.overrun = false
 }
 
 // TryGetMeasurement attempts to get the next decoded measurement from the working buffer.
@@ -219,26 +223,19 @@ func (td *Decoder) TryGetMeasurement(id *int32, timestamp *int64, stateFlags *ui
 		case codeWords.ValueXor4:
 			valueRaw = uint32(td.readBits4()) ^ nextPoint.PrevValue1
 		case codeWords.ValueXor8:
-			valueRaw = uint32(td.data[td.position]) ^ nextPoint.PrevValue1
-			td.position++
+			valueRaw = uint32(td.readByte()) ^ nextPoint.PrevValue1
 		case codeWords.ValueXor12:
-			valueRaw = uint32(td.readBits4()) ^ uint32(td.data[td.position])<<4 ^ nextPoint.PrevValue1
-			td.position++
+			valueRaw = uint32(td.readBits4()) ^ uint32(td.readByte())<<4 ^ nextPoint.PrevValue1
 		case codeWords.ValueXor16:
-			valueRaw = uint32(td.data[td.position]) ^ uint32(td.data[td.position+1])<<8 ^ nextPoint.PrevValue1
-			td.position += 2
+			valueRaw = uint32(td.readByte()) ^ uint32(td.readByte())<<8 ^ nextPoint.PrevValue1
 		case codeWords.ValueXor20:
-			valueRaw = uint32(td.readBits4()) ^ uint32(td.data[td.position])<<4 ^ uint32(td.data[td.position+1])<<12 ^ nextPoint.PrevValue1
-			td.position += 2
+			valueRaw = uint32(td.readBits4()) ^ uint32(td.readByte())<<4 ^ uint32(td.readByte())<<12 ^ nextPoint.PrevValue1
 		case codeWords.ValueXor24:
-			valueRaw = uint32(td.data[td.position]) ^ uint32(td.data[td.position+1])<<8 ^ uint32(td.data[td.position+2])<<16 ^ nextPoint.PrevValue1
-			td.position += 3
+			valueRaw = uint32(td.readByte()) ^ uint32(td.readByte())<<8 ^ uint32(td.readByte())<<16 ^ nextPoint.PrevValue1
 		case codeWords.ValueXor28:
-			valueRaw = uint32(td.readBits4()) ^ uint32(td.data[td.position])<<4 ^ uint32(td.data[td.position+1])<<12 ^ uint32(td.data[td.position+2])<<20 ^ nextPoint.PrevValue1
-			td.position += 3
+			valueRaw = uint32(td.readBits4()) ^ uint32(td.readByte())<<4 ^ uint32(td.readByte())<<12 ^ uint32(td.readByte())<<20 ^ nextPoint.PrevValue1
 		case codeWords.ValueXor32:
-			valueRaw = uint32(td.data[td.position]) ^ uint32(td.data[td.position+1])<<8 ^ uint32(td.data[td.position+2])<<16 ^ uint32(td.data[td.position+3])<<24 ^ nextPoint.PrevValue1
-			td.position += 4
+			valueRaw = uint32(td.readByte()) ^ uint32(td.readByte())<<8 ^ uint32(td.readByte())<<16 ^ uint32(td.readByte())<<24 ^ nextPoint.PrevValue1
 		default:
 			return false, fmt.Errorf("invalid code received %d at position %d with last position %d", code, td.position, td.lastPosition)
 		}
@@ -252,6 +249,10 @@ func (td *Decoder) TryGetMeasurement(id *int32, timestamp *int64, stateFlags *ui
 	*value = math.Float32frombits(valueRaw)
 	td.lastPoint = nextPoint
 
+	if td.overrun {
+		return false, fmt.Errorf("buffer overrun decoding measurement with last position %d", td.lastPosition)
+	}
+
 	return true, nil
 }
 
@@ -260,23 +261,17 @@ func (td *Decoder) decodePointID(code byte) error {
 	case codeWords.PointIDXor4:
 		td.lastPoint.PrevNextPointID1 = td.readBits4() ^ td.lastPoint.PrevNextPointID1
 	case codeWords.PointIDXor8:
-		td.lastPoint.PrevNextPointID1 = int32(td.data[td.position]) ^ td.lastPoint.PrevNextPointID1
-		td.position += 1
+		td.lastPoint.PrevNextPointID1 = int32(td.readByte()) ^ td.lastPoint.PrevNextPointID1
 	case codeWords.PointIDXor12:
-		td.lastPoint.PrevNextPointID1 = td.readBits4() ^ int32(td.data[td.position])<<4 ^ td.lastPoint.PrevNextPointID1
-		td.position += 1
+		td.lastPoint.PrevNextPointID1 = td.readBits4() ^ int32(td.readByte())<<4 ^ td.lastPoint.PrevNextPointID1
 	case codeWords.PointIDXor16:
-		td.lastPoint.PrevNextPointID1 = int32(td.data[td.position]) ^ int32(td.data[td.position+1])<<8 ^ td.lastPoint.PrevNextPointID1
-		td.position += 2
+		td.lastPoint.PrevNextPointID1 = int32(td.readByte()) ^ int32(td.readByte())<<8 ^ td.lastPoint.PrevNextPointID1
 	case codeWords.PointIDXor20:
-		td.lastPoint.PrevNextPointID1 = td.readBits4() ^ int32(td.data[td.position])<<4 ^ int32(td.data[td.position+1])<<12 ^ td.lastPoint.PrevNextPointID1
-		td.position += 2
+		td.lastPoint.PrevNextPointID1 = td.readBits4() ^ int32(td.readByte())<<4 ^ int32(td.readByte())<<12 ^ td.lastPoint.PrevNextPointID1
 	case codeWords.PointIDXor24:
-		td.lastPoint.PrevNextPointID1 = int32(td.data[td.position]) ^ int32(td.data[td.position+1])<<8 ^ int32(td.data[td.position+2])<<16 ^ td.lastPoint.PrevNextPointID1
-		td.position += 3
+		td.lastPoint.PrevNextPointID1 = int32(td.readByte()) ^ int32(td.readByte())<<8 ^ int32(td.readByte())<<16 ^ td.lastPoint.PrevNextPointID1
 	case codeWords.PointIDXor32:
-		td.lastPoint.PrevNextPointID1 = int32(td.data[td.position]) ^ int32(td.data[td.position+1])<<8 ^ int32(td.data[td.position+2])<<16 ^ int32(td.data[td.position+3])<<24 ^ td.lastPoint.PrevNextPointID1
-		td.position += 4
+		td.lastPoint.PrevNextPointID1 = int32(td.readByte()) ^ int32(td.readByte())<<8 ^ int32(td.readByte())<<16 ^ int32(td.readByte())<<24 ^ td.lastPoint.PrevNextPointID1
 	default:
 		return fmt.Errorf("invalid code received %d at position %d with last position %d", code, td.position, td.lastPosition)
 	}
@@ -308,7 +303,7 @@ func (td *Decoder) decodeTimestamp(code byte) int64 {
 	case codeWords.Timestamp2:
 		timestamp = td.prevTimestamp2
 	default:
-		timestamp = td.prevTimestamp1 ^ int64(decode7BitUInt64(td.data, &td.position))
+		timestamp = td.prevTimestamp1 ^ int64(td.decode7BitUInt64())
 	}
 
 	// Save the smallest delta time
@@ -344,7 +339,7 @@ func (td *Decoder) decodeStateFlags(code byte, nextPoint *pointMetadata) uint32
 	if code == codeWords.StateFlags2 {
 		stateFlags = nextPoint.PrevStateFlags2
 	} else {
-		stateFlags = decode7BitUInt32(td.data, &td.position)
+		stateFlags = td.decode7BitUInt32()
 	}
 
 	nextPoint.PrevStateFlags2 = nextPoint.PrevStateFlags1
@@ -356,8 +351,7 @@ func (td *Decoder) decodeStateFlags(code byte, nextPoint *pointMetadata) uint32
 func (td *Decoder) readBit() int32 {
 	if td.bitStreamCount == 0 {
 		td.bitStreamCount = 8
-		td.bitStreamCache = int32(td.data[td.position])
-		td.position++
+		td.bitStreamCache = int32(td.readByte())
 	}
 
 	td.bitStreamCount--
@@ -365,6 +359,20 @@ func (td *Decoder) readBit() int32 {
 	return td.bitStreamCache >> td.bitStreamCount & 1
 }
 
+// readByte reads the next byte from the working buffer. Reads past the end of the buffer return
+// zero and are reported as an error once the current measurement is decoded.
+func (td *Decoder) readByte() byte {
+	if td.position >= td.lastPosition {
+		td.overrun = true
+		return 0
+	}
+
+	value := td.data[td.position]
+	td.position++
+
+	return value
+}
+
 func (td *Decoder) readBits4() int32 {
 	return td.readBit()<<3 | td.readBit()<<2 | td.readBit()<<1 | td.readBit()
 }
@@ -373,105 +381,88 @@ func (td *Decoder) readBits5() int32 {
 	return td.readBit()<<4 | td.readBit()<<3 | td.readBit()<<2 | td.readBit()<<1 | td.readBit()
 }
 
-func decode7BitUInt32(stream []byte, position *int) uint32 {
-	stream = stream[*position:]
-	value := uint32(stream[0])
+func (td *Decoder) decode7BitUInt32() uint32 {
+	value := uint32(td.readByte())
 
 	if value < 128 {
-		*position++
 		return value
 	}
 
-	value ^= uint32(stream[1]) << 7
+	value ^= uint32(td.readByte()) << 7
 
 	if value < 16384 {
-		*position += 2
 		return value ^ 0x80
 	}
 
-	value ^= uint32(stream[2]) << 14
+	value ^= uint32(td.readByte()) << 14
 
 	if value < 2097152 {
-		*position += 3
 		return value ^ 0x4080
 	}
 
-	value ^= uint32(stream[3]) << 21
+	value ^= uint32(td.readByte()) << 21
 
 	if value < 268435456 {
-		*position += 4
 		return value ^ 0x204080
 	}
 
-	value ^= uint32(stream[4]) << 28
-	*position += 5
+	value ^= uint32(td.readByte()) << 28
 
 	return value ^ 0x10204080
 }
 
-func decode7BitUInt64(stream []byte, position *int) uint64 {
-	stream = stream[*position:]
-	value := uint64(stream[0])
+func (td *Decoder) decode7BitUInt64() uint64 {
+	value := uint64(td.readByte())
 
 	if value < 128 {
-		*position++
 		return value
 	}
 
-	value ^= uint64(stream[1]) << 7
+	value ^= uint64(td.readByte()) << 7
 
 	if value < 16384 {
-		*position += 2
 		return value ^ 0x80
 	}
 
-	value ^= uint64(stream[2]) << 14
+	value ^= uint64(td.readByte()) << 14
 
 	if value < 2097152 {
-		*position += 3
 		return value ^ 0x4080
 	}
 
-	value ^= uint64(stream[3]) << 21
+	value ^= uint64(td.readByte()) << 21
 
 	if value < 268435456 {
-		*position += 4
 		return value ^ 0x204080
 	}
 
-	value ^= uint64(stream[4]) << 28
+	value ^= uint64(td.readByte()) << 28
 
 	if value < 34359738368 {
-		*position += 5
 		return value ^ 0x10204080
 	}
 
-	value ^= uint64(stream[5]) << 35
+	value ^= uint64(td.readByte()) << 35
 
 	if value < 4398046511104 {
-		*position += 6
 		return value ^ 0x810204080
 	}
 
-	value ^= uint64(stream[6]) << 42
+	value ^= uint64(td.readByte()) << 42
 
 	if value < 562949953421312 {
-		*position += 7
 		return value ^ 0x40810204080
 	}
 
-	value ^= uint64(stream[7]) << 49
+	value ^= uint64(td.readByte()) << 49
 
 	if value < 72057594037927936 {
-		*position += 8
 		return value ^ 0x2040810204080
 	}
 
-	value ^= uint64(stream[8]) << 56
-	*position += 9
+	value ^= uint64(td.readByte()) << 56
 
 	return value ^ 0x102040810204080
-
 }
 
 func abs(value int64) int64 {
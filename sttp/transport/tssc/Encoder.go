@@ -0,0 +1,504 @@
+//******************************************************************************************************
+//  Encoder.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package tssc
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+const (
+	// Version defines the TSSC algorithm version written to the header of each encoded block.
+	Version byte = 85
+
+	// HeaderSize defines the size, in bytes, of the TSSC block header, i.e., version and sequence number.
+	HeaderSize = 3
+
+	// Minimum number of bytes that must remain in the working buffer to add another measurement
+	minimumAvailableBytes = 100
+
+	bits4  uint32 = 0xF
+	bits8  uint32 = 0xFF
+	bits12 uint32 = 0xFFF
+	bits16 uint32 = 0xFFFF
+	bits20 uint32 = 0xFFFFF
+	bits24 uint32 = 0xFFFFFF
+	bits28 uint32 = 0xFFFFFFF
+)
+
+// Encoder is the encoder for the Time-Series Special Compression (TSSC) algorithm of STTP.
+type Encoder struct {
+	data         []byte
+	position     int
+	lastPosition int
+
+	prevTimestamp1 int64
+	prevTimestamp2 int64
+
+	prevTimeDelta1 int64
+	prevTimeDelta2 int64
+	prevTimeDelta3 int64
+	prevTimeDelta4 int64
+
+	lastPoint *pointMetadata
+	points    map[int32]*pointMetadata
+
+	// The position in the buffer where the current bit stream byte will be written, -1 means no byte is reserved
+	bitStreamBufferIndex int
+
+	// The number of bits in bitStreamCache that are valid. 0 Means the bitstream is empty
+	bitStreamCount int32
+
+	// A cache of bits that need to be flushed to the buffer when full. Bits filled starting from the right moving left
+	bitStreamCache int32
+
+	// SequenceNumber is the sequence used to synchronize encoding and decoding. Sequence number
+	// is written to the header of each block and is incremented when the block is finished.
+	SequenceNumber uint16
+}
+
+// NewEncoder creates a new TSSC encoder.
+func NewEncoder() *Encoder {
+	te := &Encoder{
+		prevTimeDelta1:       math.MaxInt64,
+		prevTimeDelta2:       math.MaxInt64,
+		prevTimeDelta3:       math.MaxInt64,
+		prevTimeDelta4:       math.MaxInt64,
+		points:               make(map[int32]*pointMetadata),
+		bitStreamBufferIndex: -1,
+	}
+
+	te.lastPoint = te.newPointMetadata()
+
+	return te
+}
+
+func (te *Encoder) newPointMetadata() *pointMetadata {
+	return newPointMetadata(te.writeBits, nil, nil)
+}
+
+func (te *Encoder) clearBitStream() {
+	te.bitStreamBufferIndex = -1
+	te.bitStreamCount = 0
+	te.bitStreamCache = 0
+}
+
+// SetBuffer assigns the working buffer to use for encoding a block of measurements. The block header, i.e.,
+// the TSSC version and current sequence number, is written to the start of the buffer.
+func (te *Encoder) SetBuffer(data []byte) {
+	te.clearBitStream()
+	te.data = data
+	te.position = 0
+	te.lastPosition = len(data)
+
+	if len(data) < HeaderSize {
+		// Not enough space for header, TryAddMeasurement will reject all measurements
+		te.position = te.lastPosition
+		return
+	}
+
+	data[0] = Version
+	binary.BigEndian.PutUint16(data[1:], te.SequenceNumber)
+	te.position = HeaderSize
+}
+
+// FinishBlock completes the current block of encoded measurements and returns the total number of bytes,
+// including the header, written to the working buffer. The sequence number is advanced for the next block.
+func (te *Encoder) FinishBlock() (int, error) {
+	if err := te.bitStreamFlush(); err != nil {
+		return 0, err
+	}
+
+	te.SequenceNumber++
+
+	// Do not increment to 0 on roll-over
+	if te.SequenceNumber == 0 {
+		te.SequenceNumber = 1
+	}
+
+	return te.position, nil
+}
+
+// TryAddMeasurement attempts to add a measurement to the working buffer. Returns false when there is not
+// enough space in the buffer to add the measurement, in which case the block should be finished and a new
+// buffer assigned.
+//
+//gocyclo:ignore
+func (te *Encoder) TryAddMeasurement(id int32, timestamp int64, stateFlags uint32, value float32) (bool, error) {
+	// If there are fewer than 100 bytes available on the buffer assume that we cannot add any more
+	if te.lastPosition-te.position < minimumAvailableBytes {
+		return false, nil
+	}
+
+	point, ok := te.points[id]
+
+	if !ok || point == nil {
+		point = te.newPointMetadata()
+		point.PrevNextPointID1 = id + 1
+		te.points[id] = point
+	}
+
+	// Note: since measurement ID, timestamp, and state flags are known
+	// before value, these are encoded first in the sequence
+
+	if te.lastPoint.PrevNextPointID1 != id {
+		if err := te.writePointIDChange(id); err != nil {
+			return false, err
+		}
+	}
+
+	if te.prevTimestamp1 != timestamp {
+		if err := te.writeTimestampChange(timestamp); err != nil {
+			return false, err
+		}
+	}
+
+	if point.PrevStateFlags1 != stateFlags {
+		if err := te.writeStateFlagsChange(stateFlags, point); err != nil {
+			return false, err
+		}
+	}
+
+	// Since measurement value will almost always change, this is not put inside a function call
+	valueRaw := math.Float32bits(value)
+	var err error
+
+	if point.PrevValue1 == valueRaw {
+		err = te.lastPoint.WriteCode(int32(codeWords.Value1))
+	} else if point.PrevValue2 == valueRaw {
+		err = te.lastPoint.WriteCode(int32(codeWords.Value2))
+		point.PrevValue2 = point.PrevValue1
+		point.PrevValue1 = valueRaw
+	} else if point.PrevValue3 == valueRaw {
+		err = te.lastPoint.WriteCode(int32(codeWords.Value3))
+		point.PrevValue3 = point.PrevValue2
+		point.PrevValue2 = point.PrevValue1
+		point.PrevValue1 = valueRaw
+	} else if valueRaw == 0 {
+		err = te.lastPoint.WriteCode(int32(codeWords.ValueZero))
+		point.PrevValue3 = point.PrevValue2
+		point.PrevValue2 = point.PrevValue1
+		point.PrevValue1 = valueRaw
+	} else {
+		bitsChanged := valueRaw ^ point.PrevValue1
+
+		switch {
+		case bitsChanged <= bits4:
+			if err = te.lastPoint.WriteCode(int32(codeWords.ValueXor4)); err == nil {
+				te.writeBits(int32(bitsChanged&0xF), 4)
+			}
+		case bitsChanged <= bits8:
+			if err = te.lastPoint.WriteCode(int32(codeWords.ValueXor8)); err == nil {
+				te.writeBytes(bitsChanged, 1)
+			}
+		case bitsChanged <= bits12:
+			if err = te.lastPoint.WriteCode(int32(codeWords.ValueXor12)); err == nil {
+				te.writeBits(int32(bitsChanged&0xF), 4)
+				te.writeBytes(bitsChanged>>4, 1)
+			}
+		case bitsChanged <= bits16:
+			if err = te.lastPoint.WriteCode(int32(codeWords.ValueXor16)); err == nil {
+				te.writeBytes(bitsChanged, 2)
+			}
+		case bitsChanged <= bits20:
+			if err = te.lastPoint.WriteCode(int32(codeWords.ValueXor20)); err == nil {
+				te.writeBits(int32(bitsChanged&0xF), 4)
+				te.writeBytes(bitsChanged>>4, 2)
+			}
+		case bitsChanged <= bits24:
+			if err = te.lastPoint.WriteCode(int32(codeWords.ValueXor24)); err == nil {
+				te.writeBytes(bitsChanged, 3)
+			}
+		case bitsChanged <= bits28:
+			if err = te.lastPoint.WriteCode(int32(codeWords.ValueXor28)); err == nil {
+				te.writeBits(int32(bitsChanged&0xF), 4)
+				te.writeBytes(bitsChanged>>4, 3)
+			}
+		default:
+			if err = te.lastPoint.WriteCode(int32(codeWords.ValueXor32)); err == nil {
+				te.writeBytes(bitsChanged, 4)
+			}
+		}
+
+		point.PrevValue3 = point.PrevValue2
+		point.PrevValue2 = point.PrevValue1
+		point.PrevValue1 = valueRaw
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	te.lastPoint = point
+
+	return true, nil
+}
+
+func (te *Encoder) writePointIDChange(id int32) error {
+	bitsChanged := uint32(id ^ te.lastPoint.PrevNextPointID1)
+	var err error
+
+	switch {
+	case bitsChanged <= bits4:
+		if err = te.lastPoint.WriteCode(int32(codeWords.PointIDXor4)); err == nil {
+			te.writeBits(int32(bitsChanged&0xF), 4)
+		}
+	case bitsChanged <= bits8:
+		if err = te.lastPoint.WriteCode(int32(codeWords.PointIDXor8)); err == nil {
+			te.writeBytes(bitsChanged, 1)
+		}
+	case bitsChanged <= bits12:
+		if err = te.lastPoint.WriteCode(int32(codeWords.PointIDXor12)); err == nil {
+			te.writeBits(int32(bitsChanged&0xF), 4)
+			te.writeBytes(bitsChanged>>4, 1)
+		}
+	case bitsChanged <= bits16:
+		if err = te.lastPoint.WriteCode(int32(codeWords.PointIDXor16)); err == nil {
+			te.writeBytes(bitsChanged, 2)
+		}
+	case bitsChanged <= bits20:
+		if err = te.lastPoint.WriteCode(int32(codeWords.PointIDXor20)); err == nil {
+			te.writeBits(int32(bitsChanged&0xF), 4)
+			te.writeBytes(bitsChanged>>4, 2)
+		}
+	case bitsChanged <= bits24:
+		if err = te.lastPoint.WriteCode(int32(codeWords.PointIDXor24)); err == nil {
+			te.writeBytes(bitsChanged, 3)
+		}
+	default:
+		if err = te.lastPoint.WriteCode(int32(codeWords.PointIDXor32)); err == nil {
+			te.writeBytes(bitsChanged, 4)
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	te.lastPoint.PrevNextPointID1 = id
+
+	return nil
+}
+
+//gocyclo:ignore
+func (te *Encoder) writeTimestampChange(timestamp int64) error {
+	var err error
+
+	switch timestamp {
+	case te.prevTimestamp1 + te.prevTimeDelta1:
+		err = te.lastPoint.WriteCode(int32(codeWords.TimeDelta1Forward))
+	case te.prevTimestamp1 + te.prevTimeDelta2:
+		err = te.lastPoint.WriteCode(int32(codeWords.TimeDelta2Forward))
+	case te.prevTimestamp1 + te.prevTimeDelta3:
+		err = te.lastPoint.WriteCode(int32(codeWords.TimeDelta3Forward))
+	case te.prevTimestamp1 + te.prevTimeDelta4:
+		err = te.lastPoint.WriteCode(int32(codeWords.TimeDelta4Forward))
+	case te.prevTimestamp1 - te.prevTimeDelta1:
+		err = te.lastPoint.WriteCode(int32(codeWords.TimeDelta1Reverse))
+	case te.prevTimestamp1 - te.prevTimeDelta2:
+		err = te.lastPoint.WriteCode(int32(codeWords.TimeDelta2Reverse))
+	case te.prevTimestamp1 - te.prevTimeDelta3:
+		err = te.lastPoint.WriteCode(int32(codeWords.TimeDelta3Reverse))
+	case te.prevTimestamp1 - te.prevTimeDelta4:
+		err = te.lastPoint.WriteCode(int32(codeWords.TimeDelta4Reverse))
+	case te.prevTimestamp2:
+		err = te.lastPoint.WriteCode(int32(codeWords.Timestamp2))
+	default:
+		if err = te.lastPoint.WriteCode(int32(codeWords.TimeXor7Bit)); err == nil {
+			encode7BitUInt64(te.data, &te.position, uint64(timestamp^te.prevTimestamp1))
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// Save the smallest delta time
+	minDelta := abs(te.prevTimestamp1 - timestamp)
+
+	if minDelta < te.prevTimeDelta4 && minDelta != te.prevTimeDelta1 && minDelta != te.prevTimeDelta2 && minDelta != te.prevTimeDelta3 {
+		if minDelta < te.prevTimeDelta1 {
+			te.prevTimeDelta4 = te.prevTimeDelta3
+			te.prevTimeDelta3 = te.prevTimeDelta2
+			te.prevTimeDelta2 = te.prevTimeDelta1
+			te.prevTimeDelta1 = minDelta
+		} else if minDelta < te.prevTimeDelta2 {
+			te.prevTimeDelta4 = te.prevTimeDelta3
+			te.prevTimeDelta3 = te.prevTimeDelta2
+			te.prevTimeDelta2 = minDelta
+		} else if minDelta < te.prevTimeDelta3 {
+			te.prevTimeDelta4 = te.prevTimeDelta3
+			te.prevTimeDelta3 = minDelta
+		} else {
+			te.prevTimeDelta4 = minDelta
+		}
+	}
+
+	te.prevTimestamp2 = te.prevTimestamp1
+	te.prevTimestamp1 = timestamp
+
+	return nil
+}
+
+func (te *Encoder) writeStateFlagsChange(stateFlags uint32, point *pointMetadata) error {
+	var err error
+
+	if point.PrevStateFlags2 == stateFlags {
+		err = te.lastPoint.WriteCode(int32(codeWords.StateFlags2))
+	} else if err = te.lastPoint.WriteCode(int32(codeWords.StateFlags7Bit32)); err == nil {
+		encode7BitUInt32(te.data, &te.position, stateFlags)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	point.PrevStateFlags2 = point.PrevStateFlags1
+	point.PrevStateFlags1 = stateFlags
+
+	return nil
+}
+
+// writeBytes writes the specified number of low-order bytes of value to the buffer, least significant byte first.
+func (te *Encoder) writeBytes(value uint32, count int) {
+	for i := 0; i < count; i++ {
+		te.data[te.position] = byte(value >> (8 * i))
+		te.position++
+	}
+}
+
+func (te *Encoder) writeBits(code int32, length int32) {
+	// Reserve a byte in the buffer for the bit stream, the decoder reads the
+	// bit stream byte before any raw bytes that follow it in the buffer
+	if te.bitStreamBufferIndex < 0 {
+		te.bitStreamBufferIndex = te.position
+		te.position++
+	}
+
+	te.bitStreamCache = te.bitStreamCache<<length | code
+	te.bitStreamCount += length
+
+	if te.bitStreamCount > 7 {
+		te.bitStreamEnd()
+	}
+}
+
+func (te *Encoder) bitStreamEnd() {
+	for te.bitStreamCount > 7 {
+		te.data[te.bitStreamBufferIndex] = byte(te.bitStreamCache >> (te.bitStreamCount - 8))
+		te.bitStreamCount -= 8
+
+		if te.bitStreamCount > 0 {
+			te.bitStreamBufferIndex = te.position
+			te.position++
+		} else {
+			te.bitStreamBufferIndex = -1
+		}
+	}
+}
+
+func (te *Encoder) bitStreamFlush() error {
+	if te.bitStreamCount > 0 {
+		if te.bitStreamBufferIndex < 0 {
+			te.bitStreamBufferIndex = te.position
+			te.position++
+		}
+
+		if err := te.lastPoint.WriteCode(int32(codeWords.EndOfStream)); err != nil {
+			return err
+		}
+
+		if te.bitStreamCount > 7 {
+			te.bitStreamEnd()
+		}
+
+		if te.bitStreamCount > 0 {
+			// Make up 8 bits by padding
+			te.bitStreamCache <<= 8 - te.bitStreamCount
+			te.data[te.bitStreamBufferIndex] = byte(te.bitStreamCache)
+		}
+	}
+
+	te.clearBitStream()
+
+	return nil
+}
+
+func encode7BitUInt32(stream []byte, position *int, value uint32) {
+	stream = stream[*position:]
+
+	if value < 128 {
+		stream[0] = byte(value)
+		*position++
+		return
+	}
+
+	stream[0] = byte(value | 128)
+
+	if value < 16384 {
+		stream[1] = byte(value >> 7)
+		*position += 2
+		return
+	}
+
+	stream[1] = byte(value>>7 | 128)
+
+	if value < 2097152 {
+		stream[2] = byte(value >> 14)
+		*position += 3
+		return
+	}
+
+	stream[2] = byte(value>>14 | 128)
+
+	if value < 268435456 {
+		stream[3] = byte(value >> 21)
+		*position += 4
+		return
+	}
+
+	stream[3] = byte(value>>21 | 128)
+	stream[4] = byte(value >> 28)
+	*position += 5
+}
+
+func encode7BitUInt64(stream []byte, position *int, value uint64) {
+	stream = stream[*position:]
+
+	// Each of the first eight bytes carries 7 bits with a continuation
+	// flag, the ninth byte, if needed, carries the final 8 bits
+	for i := 0; i < 8; i++ {
+		if value < 128 {
+			stream[i] = byte(value)
+			*position += i + 1
+			return
+		}
+
+		stream[i] = byte(value | 128)
+		value >>= 7
+	}
+
+	stream[8] = byte(value)
+	*position += 9
+}
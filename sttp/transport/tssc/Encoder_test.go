@@ -0,0 +1,260 @@
+//******************************************************************************************************
+//  Encoder_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package tssc
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+type testMeasurement struct {
+	id         int32
+	timestamp  int64
+	stateFlags uint32
+	value      float32
+}
+
+func createTestMeasurements(count int) []testMeasurement {
+	random := rand.New(rand.NewSource(2664))
+	measurements := make([]testMeasurement, 0, count)
+	timestamp := int64(637680000000000000)
+	values := make(map[int32]float32)
+
+	for len(measurements) < count {
+		// Simulate frames of 30 samples per second with occasional time jitter
+		timestamp += 333333
+
+		if random.Intn(20) == 0 {
+			timestamp += random.Int63n(1000000) - 500000
+		}
+
+		for id := int32(0); id < 50 && len(measurements) < count; id++ {
+			var stateFlags uint32
+			value := values[id]
+
+			switch random.Intn(10) {
+			case 0:
+				value = 0
+			case 1:
+				value = float32(math.NaN())
+			case 2:
+				// Repeat value
+			default:
+				value += float32(random.NormFloat64())
+			}
+
+			if random.Intn(25) == 0 {
+				stateFlags = random.Uint32()
+			}
+
+			values[id] = value
+			measurements = append(measurements, testMeasurement{id, timestamp, stateFlags, value})
+		}
+
+		// Include sparse, high-valued IDs to exercise larger ID deltas
+		if random.Intn(3) == 0 && len(measurements) < count {
+			measurements = append(measurements, testMeasurement{random.Int31(), timestamp - random.Int63(), random.Uint32(), random.Float32()})
+		}
+	}
+
+	return measurements
+}
+
+func equalMeasurement(expected testMeasurement, id int32, timestamp int64, stateFlags uint32, value float32) bool {
+	return expected.id == id && expected.timestamp == timestamp && expected.stateFlags == stateFlags && math.Float32bits(expected.value) == math.Float32bits(value)
+}
+
+func TestEncoderRoundTrip(t *testing.T) {
+	measurements := createTestMeasurements(20000)
+	encoder := NewEncoder()
+	decoder := NewDecoder()
+	buffer := make([]byte, 32000)
+
+	var id int32
+	var timestamp int64
+	var stateFlags uint32
+	var value float32
+
+	index := 0
+	blocks := 0
+
+	for index < len(measurements) {
+		encoder.SetBuffer(buffer)
+		start := index
+
+		for index < len(measurements) {
+			m := measurements[index]
+			added, err := encoder.TryAddMeasurement(m.id, m.timestamp, m.stateFlags, m.value)
+
+			if err != nil {
+				t.Fatalf("TestEncoderRoundTrip: failed to encode measurement %d: %s", index, err.Error())
+			}
+
+			if !added {
+				break
+			}
+
+			index++
+		}
+
+		length, err := encoder.FinishBlock()
+
+		if err != nil {
+			t.Fatalf("TestEncoderRoundTrip: failed to finish block: %s", err.Error())
+		}
+
+		block := buffer[:length]
+
+		if block[0] != Version {
+			t.Fatalf("TestEncoderRoundTrip: expected version %d, received: %d", Version, block[0])
+		}
+
+		if sequenceNumber := binary.BigEndian.Uint16(block[1:]); sequenceNumber != decoder.SequenceNumber {
+			t.Fatalf("TestEncoderRoundTrip: expected sequence number %d, received: %d", decoder.SequenceNumber, sequenceNumber)
+		}
+
+		decoder.SetBuffer(block[HeaderSize:])
+
+		for i := start; i < index; i++ {
+			ok, err := decoder.TryGetMeasurement(&id, &timestamp, &stateFlags, &value)
+
+			if err != nil {
+				t.Fatalf("TestEncoderRoundTrip: failed to decode measurement %d: %s", i, err.Error())
+			}
+
+			if !ok {
+				t.Fatalf("TestEncoderRoundTrip: expected measurement %d, block ended", i)
+			}
+
+			if !equalMeasurement(measurements[i], id, timestamp, stateFlags, value) {
+				t.Fatalf("TestEncoderRoundTrip: measurement %d mismatch: expected %v, received {%d %d %d %v}", i, measurements[i], id, timestamp, stateFlags, value)
+			}
+		}
+
+		if ok, err := decoder.TryGetMeasurement(&id, &timestamp, &stateFlags, &value); ok || err != nil {
+			t.Fatalf("TestEncoderRoundTrip: expected end of block %d", blocks)
+		}
+
+		decoder.SequenceNumber++
+		blocks++
+	}
+
+	if blocks < 2 {
+		t.Fatalf("TestEncoderRoundTrip: expected multiple blocks, received: %d", blocks)
+	}
+}
+
+func TestDecoderTruncatedBlock(t *testing.T) {
+	measurements := createTestMeasurements(200)
+	encoder := NewEncoder()
+	buffer := make([]byte, 32000)
+	encoder.SetBuffer(buffer)
+
+	for _, m := range measurements {
+		encoder.TryAddMeasurement(m.id, m.timestamp, m.stateFlags, m.value)
+	}
+
+	length, err := encoder.FinishBlock()
+
+	if err != nil {
+		t.Fatalf("TestDecoderTruncatedBlock: failed to finish block: %s", err.Error())
+	}
+
+	var id int32
+	var timestamp int64
+	var stateFlags uint32
+	var value float32
+	var failures int
+
+	// Decoding a truncated block must report an error, or end early, rather than read past the buffer
+	for truncated := HeaderSize; truncated < length; truncated++ {
+		decoder := NewDecoder()
+		decoder.SetBuffer(buffer[HeaderSize:truncated])
+		count := 0
+
+		for {
+			ok, err := decoder.TryGetMeasurement(&id, &timestamp, &stateFlags, &value)
+
+			if err != nil {
+				failures++
+				break
+			}
+
+			if !ok {
+				break
+			}
+
+			count++
+		}
+
+		if count > len(measurements) {
+			t.Fatalf("TestDecoderTruncatedBlock: decoded %d measurements from %d byte block", count, truncated)
+		}
+	}
+
+	if failures == 0 {
+		t.Fatalf("TestDecoderTruncatedBlock: expected errors decoding truncated blocks")
+	}
+}
+
+func TestEncoderSequenceNumber(t *testing.T) {
+	encoder := NewEncoder()
+	buffer := make([]byte, 1024)
+
+	for i := 0; i < 3; i++ {
+		encoder.SetBuffer(buffer)
+		encoder.TryAddMeasurement(1, int64(i), 0, 1.0)
+
+		if _, err := encoder.FinishBlock(); err != nil {
+			t.Fatalf("TestEncoderSequenceNumber: failed to finish block: %s", err.Error())
+		}
+
+		if sequenceNumber := binary.BigEndian.Uint16(buffer[1:]); sequenceNumber != uint16(i) {
+			t.Fatalf("TestEncoderSequenceNumber: expected sequence number %d, received: %d", i, sequenceNumber)
+		}
+	}
+
+	encoder.SequenceNumber = math.MaxUint16
+	encoder.SetBuffer(buffer)
+	encoder.FinishBlock()
+
+	if encoder.SequenceNumber != 1 {
+		t.Fatalf("TestEncoderSequenceNumber: expected roll-over to skip 0, received: %d", encoder.SequenceNumber)
+	}
+}
+
+func TestEncoderBufferFull(t *testing.T) {
+	encoder := NewEncoder()
+	encoder.SetBuffer(make([]byte, minimumAvailableBytes+HeaderSize-1))
+
+	if added, _ := encoder.TryAddMeasurement(1, 1, 0, 1.0); added {
+		t.Fatal("TestEncoderBufferFull: expected measurement to be rejected for insufficient buffer space")
+	}
+
+	if length, _ := encoder.FinishBlock(); length != HeaderSize {
+		t.Fatalf("TestEncoderBufferFull: expected empty block of %d bytes, received: %d", HeaderSize, length)
+	}
+}
@@ -0,0 +1,1067 @@
+//******************************************************************************************************
+//  XPath.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package xml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"math"
+	"sort"
+	"strings"
+)
+
+// XPathExpression represents a compiled XPath 1.0 expression, see https://www.w3.org/TR/xpath-10/.
+// Expressions support all axes, node tests, predicates, operators and core library functions, with
+// the following limitations of the XmlNode model: name tests without a prefix match on local name
+// regardless of namespace, prefixed name tests resolve the prefix against the namespace declarations
+// in scope of the tested node, the text content of an element is exposed as a single text node,
+// comments and processing instructions are not represented, the namespace axis is always empty and
+// variable references are not supported.
+type XPathExpression struct {
+	xpath string
+	root  xpathExpr
+}
+
+// CompileXPath parses the XPath 1.0 xpath expression into an XPathExpression.
+func CompileXPath(xpath string) (*XPathExpression, error) {
+	tokens, err := tokenizeXPath(xpath)
+
+	if err != nil {
+		return nil, errors.New("failed to parse XPath expression \"" + xpath + "\": " + err.Error())
+	}
+
+	parser := &xpathParser{tokens: tokens}
+	root, err := parser.parseOrExpr()
+
+	if err == nil {
+		if token := parser.peek(); token.kind != tokenEOF {
+			err = unexpectedToken(token, "end of expression")
+		}
+	}
+
+	if err != nil {
+		return nil, errors.New("failed to parse XPath expression \"" + xpath + "\": " + err.Error())
+	}
+
+	return &XPathExpression{xpath: xpath, root: root}, nil
+}
+
+// String gets the source text of the XPathExpression.
+func (xe *XPathExpression) String() string {
+	return xe.xpath
+}
+
+// Evaluate evaluates the XPathExpression with the specified node as the context node. The result is
+// one of the XPath 1.0 data types: a node-set as a []*XmlNode in document order, a string, a number
+// as a float64 or a bool. Attribute and text nodes in a node-set are created by the evaluation and
+// have the XmlNode for their element as the Parent; use InnerText to get their decoded value.
+func (xe *XPathExpression) Evaluate(node *XmlNode) (interface{}, error) {
+	if node == nil {
+		return nil, errors.New("cannot evaluate XPath expression: context node is nil")
+	}
+
+	context := &xpathContext{
+		node:     node,
+		position: 1,
+		size:     1,
+		state:    newXPathState(),
+	}
+
+	return xe.root.evaluate(context)
+}
+
+// Select evaluates the XPathExpression with the specified node as the context node and returns the
+// resulting node-set in document order. An error is returned if the result is not a node-set.
+func (xe *XPathExpression) Select(node *XmlNode) ([]*XmlNode, error) {
+	result, err := xe.Evaluate(node)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, ok := result.([]*XmlNode)
+
+	if !ok {
+		return nil, errors.New("XPath expression \"" + xe.xpath + "\" does not evaluate to a node-set")
+	}
+
+	return nodes, nil
+}
+
+// EvaluateString evaluates the XPathExpression with the specified node as the context node and
+// converts the result to a string as if by a call to the XPath string() function.
+func (xe *XPathExpression) EvaluateString(node *XmlNode) (string, error) {
+	result, err := xe.Evaluate(node)
+
+	if err != nil {
+		return "", err
+	}
+
+	return toXPathString(result), nil
+}
+
+// EvaluateNumber evaluates the XPathExpression with the specified node as the context node and
+// converts the result to a number as if by a call to the XPath number() function.
+func (xe *XPathExpression) EvaluateNumber(node *XmlNode) (float64, error) {
+	result, err := xe.Evaluate(node)
+
+	if err != nil {
+		return math.NaN(), err
+	}
+
+	return toXPathNumber(result), nil
+}
+
+// EvaluateBoolean evaluates the XPathExpression with the specified node as the context node and
+// converts the result to a boolean as if by a call to the XPath boolean() function.
+func (xe *XPathExpression) EvaluateBoolean(node *XmlNode) (bool, error) {
+	result, err := xe.Evaluate(node)
+
+	if err != nil {
+		return false, err
+	}
+
+	return toXPathBoolean(result), nil
+}
+
+// xpathContext defines the evaluation context of an XPath expression.
+type xpathContext struct {
+	node     *XmlNode
+	position int
+	size     int
+	state    *xpathState
+}
+
+type xpathExpr interface {
+	evaluate(context *xpathContext) (interface{}, error)
+}
+
+type literalExpr struct {
+	value string
+}
+
+func (e *literalExpr) evaluate(*xpathContext) (interface{}, error) {
+	return e.value, nil
+}
+
+type numberExpr struct {
+	value float64
+}
+
+func (e *numberExpr) evaluate(*xpathContext) (interface{}, error) {
+	return e.value, nil
+}
+
+type negateExpr struct {
+	operand xpathExpr
+}
+
+func (e *negateExpr) evaluate(context *xpathContext) (interface{}, error) {
+	value, err := e.operand.evaluate(context)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return -toXPathNumber(value), nil
+}
+
+type binaryExpr struct {
+	operator string
+	left     xpathExpr
+	right    xpathExpr
+}
+
+//gocyclo:ignore
+func (e *binaryExpr) evaluate(context *xpathContext) (interface{}, error) {
+	left, err := e.left.evaluate(context)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Boolean operators short-circuit evaluation of the right operand
+	switch e.operator {
+	case "or":
+		if toXPathBoolean(left) {
+			return true, nil
+		}
+	case "and":
+		if !toXPathBoolean(left) {
+			return false, nil
+		}
+	}
+
+	right, err := e.right.evaluate(context)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch e.operator {
+	case "or", "and":
+		return toXPathBoolean(right), nil
+	case "=", "!=", "<", "<=", ">", ">=":
+		return compareXPathValues(e.operator, left, right), nil
+	case "+":
+		return toXPathNumber(left) + toXPathNumber(right), nil
+	case "-":
+		return toXPathNumber(left) - toXPathNumber(right), nil
+	case "*":
+		return toXPathNumber(left) * toXPathNumber(right), nil
+	case "div":
+		return toXPathNumber(left) / toXPathNumber(right), nil
+	case "mod":
+		return math.Mod(toXPathNumber(left), toXPathNumber(right)), nil
+	}
+
+	return nil, errors.New("unexpected XPath operator \"" + e.operator + "\"")
+}
+
+type unionExpr struct {
+	left  xpathExpr
+	right xpathExpr
+}
+
+func (e *unionExpr) evaluate(context *xpathContext) (interface{}, error) {
+	left, err := evaluateNodeSet(context, e.left, "union")
+
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := evaluateNodeSet(context, e.right, "union")
+
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*XmlNode, 0, len(left)+len(right))
+	nodes = append(nodes, left...)
+	nodes = append(nodes, right...)
+
+	return context.state.documentOrder(nodes), nil
+}
+
+func evaluateNodeSet(context *xpathContext, expr xpathExpr, operation string) ([]*XmlNode, error) {
+	value, err := expr.evaluate(context)
+
+	if err != nil {
+		return nil, err
+	}
+
+	nodes, ok := value.([]*XmlNode)
+
+	if !ok {
+		return nil, errors.New(operation + " requires a node-set operand")
+	}
+
+	return nodes, nil
+}
+
+type functionExpr struct {
+	name      string
+	function  xpathFunction
+	arguments []xpathExpr
+}
+
+func (e *functionExpr) evaluate(context *xpathContext) (interface{}, error) {
+	arguments := make([]interface{}, len(e.arguments))
+
+	for i, argument := range e.arguments {
+		value, err := argument.evaluate(context)
+
+		if err != nil {
+			return nil, err
+		}
+
+		arguments[i] = value
+	}
+
+	result, err := e.function.call(context, arguments)
+
+	if err != nil {
+		return nil, errors.New("failed to evaluate function \"" + e.name + "()\": " + err.Error())
+	}
+
+	return result, nil
+}
+
+type filterExpr struct {
+	primary    xpathExpr
+	predicates []xpathExpr
+}
+
+func (e *filterExpr) evaluate(context *xpathContext) (interface{}, error) {
+	nodes, err := evaluateNodeSet(context, e.primary, "predicate")
+
+	if err != nil {
+		return nil, err
+	}
+
+	return applyPredicates(context, nodes, e.predicates)
+}
+
+type pathExpr struct {
+	filter   xpathExpr
+	absolute bool
+	steps    []*xpathStep
+}
+
+func (e *pathExpr) evaluate(context *xpathContext) (interface{}, error) {
+	var nodes []*XmlNode
+	var err error
+
+	switch {
+	case e.filter != nil:
+		if nodes, err = evaluateNodeSet(context, e.filter, "location path"); err != nil {
+			return nil, err
+		}
+	case e.absolute:
+		nodes = []*XmlNode{context.state.documentNode(context.node)}
+	default:
+		nodes = []*XmlNode{context.node}
+	}
+
+	for _, step := range e.steps {
+		results := make([]*XmlNode, 0)
+
+		for _, node := range nodes {
+			selected, err := step.evaluate(context, node)
+
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, selected...)
+		}
+
+		// A single forward axis step from one node is already in document order
+		if len(nodes) > 1 || step.axis.reverse() {
+			results = context.state.documentOrder(results)
+		}
+
+		nodes = results
+	}
+
+	return nodes, nil
+}
+
+type xpathStep struct {
+	axis       xpathAxisEnum
+	test       xpathNodeTest
+	predicates []xpathExpr
+}
+
+func (s *xpathStep) evaluate(context *xpathContext, node *XmlNode) ([]*XmlNode, error) {
+	candidates := context.state.axisNodes(node, s.axis)
+	selected := make([]*XmlNode, 0, len(candidates))
+
+	for _, candidate := range candidates {
+		if s.test.matches(candidate, s.axis) {
+			selected = append(selected, candidate)
+		}
+	}
+
+	// Predicate positions are in axis order, i.e., reverse document order for reverse axes
+	return applyPredicates(context, selected, s.predicates)
+}
+
+func applyPredicates(context *xpathContext, nodes []*XmlNode, predicates []xpathExpr) ([]*XmlNode, error) {
+	for _, predicate := range predicates {
+		filtered := make([]*XmlNode, 0, len(nodes))
+
+		for i, node := range nodes {
+			result, err := predicate.evaluate(&xpathContext{
+				node:     node,
+				position: i + 1,
+				size:     len(nodes),
+				state:    context.state,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			// Numeric predicate results are shorthand for position() = result
+			if number, ok := result.(float64); ok {
+				if number == float64(i+1) {
+					filtered = append(filtered, node)
+				}
+			} else if toXPathBoolean(result) {
+				filtered = append(filtered, node)
+			}
+		}
+
+		nodes = filtered
+	}
+
+	return nodes, nil
+}
+
+// xpathAxisEnum defines the type of the xpathAxis enumeration.
+type xpathAxisEnum int
+
+// xpathAxis is an enumeration of the XPath 1.0 axes.
+var xpathAxis = struct {
+	child            xpathAxisEnum
+	descendant       xpathAxisEnum
+	parent           xpathAxisEnum
+	ancestor         xpathAxisEnum
+	followingSibling xpathAxisEnum
+	precedingSibling xpathAxisEnum
+	following        xpathAxisEnum
+	preceding        xpathAxisEnum
+	attribute        xpathAxisEnum
+	namespace        xpathAxisEnum
+	self             xpathAxisEnum
+	descendantOrSelf xpathAxisEnum
+	ancestorOrSelf   xpathAxisEnum
+}{
+	child:            0,
+	descendant:       1,
+	parent:           2,
+	ancestor:         3,
+	followingSibling: 4,
+	precedingSibling: 5,
+	following:        6,
+	preceding:        7,
+	attribute:        8,
+	namespace:        9,
+	self:             10,
+	descendantOrSelf: 11,
+	ancestorOrSelf:   12,
+}
+
+var xpathAxisNames = map[string]xpathAxisEnum{
+	"child":              xpathAxis.child,
+	"descendant":         xpathAxis.descendant,
+	"parent":             xpathAxis.parent,
+	"ancestor":           xpathAxis.ancestor,
+	"following-sibling":  xpathAxis.followingSibling,
+	"preceding-sibling":  xpathAxis.precedingSibling,
+	"following":          xpathAxis.following,
+	"preceding":          xpathAxis.preceding,
+	"attribute":          xpathAxis.attribute,
+	"namespace":          xpathAxis.namespace,
+	"self":               xpathAxis.self,
+	"descendant-or-self": xpathAxis.descendantOrSelf,
+	"ancestor-or-self":   xpathAxis.ancestorOrSelf,
+}
+
+// reverse determines if the axis selects nodes in reverse document order.
+func (axis xpathAxisEnum) reverse() bool {
+	switch axis {
+	case xpathAxis.ancestor, xpathAxis.ancestorOrSelf, xpathAxis.preceding, xpathAxis.precedingSibling:
+		return true
+	}
+
+	return false
+}
+
+type xpathNodeTestKind int
+
+const (
+	nodeTestName xpathNodeTestKind = iota
+	nodeTestAnyNode
+	nodeTestText
+	nodeTestComment
+	nodeTestProcessingInstruction
+)
+
+type xpathNodeTest struct {
+	kind   xpathNodeTestKind
+	prefix string
+	local  string
+}
+
+func (t *xpathNodeTest) matches(node *XmlNode, axis xpathAxisEnum) bool {
+	switch t.kind {
+	case nodeTestAnyNode:
+		return true
+	case nodeTestText:
+		return node.nodeType == XmlNodeType.Text
+	case nodeTestName:
+		principalType := XmlNodeType.Element
+
+		if axis == xpathAxis.attribute {
+			principalType = XmlNodeType.Attribute
+		}
+
+		if node.nodeType != principalType || t.local != "*" && node.Name != t.local {
+			return false
+		}
+
+		if len(t.prefix) > 0 {
+			if namespace, found := lookupNamespace(node, t.prefix); found {
+				return node.Namespace == namespace
+			}
+		}
+
+		return true
+	}
+
+	// Comments and processing instructions are not represented
+	return false
+}
+
+const xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+
+// lookupNamespace finds the namespace URI for the prefix declared in scope of the node.
+func lookupNamespace(node *XmlNode, prefix string) (string, bool) {
+	if prefix == "xml" {
+		return xmlNamespace, true
+	}
+
+	for element := node; element != nil; element = element.Parent {
+		if element.AttributeNamespaces[prefix] == "xmlns" {
+			return element.Attributes[prefix], true
+		}
+	}
+
+	return "", false
+}
+
+// lookupPrefix finds the namespace prefix, if any, declared in scope of the node for the namespace URI.
+func lookupPrefix(node *XmlNode, namespace string) string {
+	for element := node; element != nil; element = element.Parent {
+		var prefixes []string
+
+		for name, value := range element.Attributes {
+			if value == namespace && element.AttributeNamespaces[name] == "xmlns" {
+				prefixes = append(prefixes, name)
+			}
+		}
+
+		if len(prefixes) > 0 {
+			sort.Strings(prefixes)
+			return prefixes[0]
+		}
+	}
+
+	return ""
+}
+
+// xpathState caches the nodes synthesized during the evaluation of an expression so that node
+// identity is stable, e.g., for union and comparison operations, along with document order data.
+type xpathState struct {
+	documents       map[*XmlNode]*XmlNode
+	attributes      map[*XmlNode][]*XmlNode
+	texts           map[*XmlNode]*XmlNode
+	siblingIndexes  map[*XmlNode]int
+	documentIndexes map[*XmlNode]*xpathDocumentIndex
+	treeOrder       map[*XmlNode]int
+}
+
+// xpathDocumentIndex defines the document order of the element and text nodes of a document.
+type xpathDocumentIndex struct {
+	nodes     []*XmlNode
+	positions map[*XmlNode]int
+	ends      map[*XmlNode]int
+}
+
+func newXPathState() *xpathState {
+	return &xpathState{
+		documents:      make(map[*XmlNode]*XmlNode),
+		attributes:     make(map[*XmlNode][]*XmlNode),
+		texts:          make(map[*XmlNode]*XmlNode),
+		siblingIndexes: make(map[*XmlNode]int),
+		treeOrder:      make(map[*XmlNode]int),
+	}
+}
+
+// rootElement gets the top-level element of the tree containing the node.
+func (s *xpathState) rootElement(node *XmlNode) *XmlNode {
+	if node.nodeType == XmlNodeType.Document {
+		return documentElement(node)
+	}
+
+	for node.Parent != nil {
+		node = node.Parent
+	}
+
+	return node
+}
+
+// documentNode gets the virtual document node, i.e., the parent of the root element, for the node.
+func (s *xpathState) documentNode(node *XmlNode) *XmlNode {
+	if node.nodeType == XmlNodeType.Document {
+		return node
+	}
+
+	root := s.rootElement(node)
+
+	if document, found := s.documents[root]; found {
+		return document
+	}
+
+	document := &XmlNode{
+		Item:     map[string]*XmlNode{root.Name: root},
+		Items:    map[string][]*XmlNode{root.Name: {root}},
+		Level:    -1,
+		Owner:    root.Owner,
+		nodeType: XmlNodeType.Document,
+	}
+
+	s.documents[root] = document
+
+	return document
+}
+
+// documentElement gets the root element of a virtual document node.
+func documentElement(document *XmlNode) *XmlNode {
+	for _, root := range document.Item {
+		return root
+	}
+
+	return nil
+}
+
+func (s *xpathState) parent(node *XmlNode) *XmlNode {
+	switch node.nodeType {
+	case XmlNodeType.Document:
+		return nil
+	case XmlNodeType.Element:
+		if node.Parent == nil {
+			return s.documentNode(node)
+		}
+	}
+
+	return node.Parent
+}
+
+func (s *xpathState) children(node *XmlNode) []*XmlNode {
+	switch node.nodeType {
+	case XmlNodeType.Document:
+		return []*XmlNode{documentElement(node)}
+	case XmlNodeType.Element:
+		if text := s.text(node); text != nil {
+			return append([]*XmlNode{text}, node.GetChildNodes()...)
+		}
+
+		return node.GetChildNodes()
+	}
+
+	return nil
+}
+
+// attributeNodes gets the attribute nodes of an element, sorted by name, excluding namespace declarations.
+func (s *xpathState) attributeNodes(node *XmlNode) []*XmlNode {
+	if node.nodeType != XmlNodeType.Element {
+		return nil
+	}
+
+	if attributes, found := s.attributes[node]; found {
+		return attributes
+	}
+
+	names := make([]string, 0, len(node.Attributes))
+
+	for name := range node.Attributes {
+		namespace := node.AttributeNamespaces[name]
+
+		if namespace == "xmlns" || name == "xmlns" && len(namespace) == 0 {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	attributes := make([]*XmlNode, len(names))
+
+	for i, name := range names {
+		attributes[i] = &XmlNode{
+			Name:      name,
+			Namespace: node.AttributeNamespaces[name],
+			InnerXml:  escapeText(node.Attributes[name]),
+			Parent:    node,
+			Level:     node.Level + 1,
+			Owner:     node.Owner,
+			nodeType:  XmlNodeType.Attribute,
+		}
+	}
+
+	s.attributes[node] = attributes
+
+	return attributes
+}
+
+// text gets the text node of an element, or nil if the element has no text content. Text content
+// consisting only of whitespace between child elements is not considered a text node.
+func (s *xpathState) text(node *XmlNode) *XmlNode {
+	if text, found := s.texts[node]; found {
+		return text
+	}
+
+	var text *XmlNode
+	value, hasChildElements := directText(node.InnerXml)
+
+	if len(value) > 0 && (!hasChildElements || len(strings.TrimSpace(value)) > 0) {
+		text = &XmlNode{
+			InnerXml: escapeText(value),
+			Parent:   node,
+			Level:    node.Level + 1,
+			Owner:    node.Owner,
+			nodeType: XmlNodeType.Text,
+		}
+	}
+
+	s.texts[node] = text
+
+	return text
+}
+
+// directText gets the decoded character data of the inner XML that is not within a child element.
+func directText(innerXml []byte) (string, bool) {
+	if !bytes.ContainsAny(innerXml, "&<\r") {
+		return string(innerXml), false
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(innerXml))
+	var text strings.Builder
+	hasChildElements := false
+	depth := 0
+
+	for {
+		token, err := decoder.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return string(innerXml), hasChildElements
+		}
+
+		switch token := token.(type) {
+		case xml.StartElement:
+			hasChildElements = true
+			depth++
+		case xml.EndElement:
+			depth--
+		case xml.CharData:
+			if depth == 0 {
+				text.Write(token)
+			}
+		}
+	}
+
+	return text.String(), hasChildElements
+}
+
+func escapeText(value string) []byte {
+	var buffer bytes.Buffer
+	xml.EscapeText(&buffer, []byte(value))
+	return buffer.Bytes()
+}
+
+// siblingIndex gets the index of an element within the child elements of its parent.
+func (s *xpathState) siblingIndex(node *XmlNode) int {
+	if index, found := s.siblingIndexes[node]; found {
+		return index
+	}
+
+	parent := node.Parent
+
+	if parent == nil {
+		return 0
+	}
+
+	for i := range parent.ChildNodes {
+		s.siblingIndexes[&parent.ChildNodes[i]] = i
+	}
+
+	return s.siblingIndexes[node]
+}
+
+// documentIndex gets the document order index of the element and text nodes of the tree containing the node.
+func (s *xpathState) documentIndex(node *XmlNode) *xpathDocumentIndex {
+	if s.documentIndexes == nil {
+		s.documentIndexes = make(map[*XmlNode]*xpathDocumentIndex)
+	}
+
+	root := s.rootElement(node)
+
+	if index, found := s.documentIndexes[root]; found {
+		return index
+	}
+
+	index := &xpathDocumentIndex{
+		positions: make(map[*XmlNode]int),
+		ends:      make(map[*XmlNode]int),
+	}
+
+	var walk func(node *XmlNode)
+
+	walk = func(node *XmlNode) {
+		index.positions[node] = len(index.nodes)
+		index.nodes = append(index.nodes, node)
+
+		for _, child := range s.children(node) {
+			walk(child)
+		}
+
+		index.ends[node] = len(index.nodes) - 1
+	}
+
+	walk(root)
+	s.documentIndexes[root] = index
+
+	return index
+}
+
+// axisNodes gets the nodes of the axis for the node in axis order.
+//
+//gocyclo:ignore
+func (s *xpathState) axisNodes(node *XmlNode, axis xpathAxisEnum) []*XmlNode {
+	switch axis {
+	case xpathAxis.child:
+		return s.children(node)
+	case xpathAxis.descendant:
+		return s.descendants(node, nil)
+	case xpathAxis.descendantOrSelf:
+		return s.descendants(node, []*XmlNode{node})
+	case xpathAxis.parent:
+		if parent := s.parent(node); parent != nil {
+			return []*XmlNode{parent}
+		}
+	case xpathAxis.ancestor:
+		return s.ancestors(s.parent(node), nil)
+	case xpathAxis.ancestorOrSelf:
+		return s.ancestors(node, nil)
+	case xpathAxis.followingSibling, xpathAxis.precedingSibling:
+		if node.nodeType != XmlNodeType.Element && node.nodeType != XmlNodeType.Text {
+			return nil
+		}
+
+		siblings := s.children(s.parent(node))
+		index := 0
+
+		if node.nodeType == XmlNodeType.Element && node.Parent != nil {
+			index = s.siblingIndex(node)
+
+			if len(siblings) > len(node.Parent.ChildNodes) {
+				index++
+			}
+		}
+
+		if axis == xpathAxis.followingSibling {
+			return siblings[index+1:]
+		}
+
+		return reverseNodes(siblings[:index])
+	case xpathAxis.following:
+		if node.nodeType == XmlNodeType.Document {
+			return nil
+		}
+
+		index := s.documentIndex(node)
+		var start int
+
+		if node.nodeType == XmlNodeType.Attribute {
+			start = index.positions[node.Parent] + 1
+		} else {
+			start = index.ends[node] + 1
+		}
+
+		return index.nodes[start:]
+	case xpathAxis.preceding:
+		if node.nodeType == XmlNodeType.Attribute {
+			node = node.Parent
+		}
+
+		if node.nodeType == XmlNodeType.Document {
+			return nil
+		}
+
+		index := s.documentIndex(node)
+		position := index.positions[node]
+		var preceding []*XmlNode
+
+		// Ancestors end at or after the node position and are excluded
+		for i := position - 1; i >= 0; i-- {
+			if candidate := index.nodes[i]; index.ends[candidate] < position {
+				preceding = append(preceding, candidate)
+			}
+		}
+
+		return preceding
+	case xpathAxis.attribute:
+		return s.attributeNodes(node)
+	case xpathAxis.self:
+		return []*XmlNode{node}
+	}
+
+	return nil
+}
+
+func (s *xpathState) descendants(node *XmlNode, nodes []*XmlNode) []*XmlNode {
+	for _, child := range s.children(node) {
+		nodes = append(nodes, child)
+		nodes = s.descendants(child, nodes)
+	}
+
+	return nodes
+}
+
+func (s *xpathState) ancestors(node *XmlNode, nodes []*XmlNode) []*XmlNode {
+	for node != nil {
+		nodes = append(nodes, node)
+		node = s.parent(node)
+	}
+
+	return nodes
+}
+
+func reverseNodes(nodes []*XmlNode) []*XmlNode {
+	reversed := make([]*XmlNode, len(nodes))
+
+	for i, node := range nodes {
+		reversed[len(nodes)-1-i] = node
+	}
+
+	return reversed
+}
+
+// documentOrder removes duplicate nodes and sorts the nodes in document order.
+func (s *xpathState) documentOrder(nodes []*XmlNode) []*XmlNode {
+	if len(nodes) < 2 {
+		return nodes
+	}
+
+	unique := make([]*XmlNode, 0, len(nodes))
+	found := make(map[*XmlNode]bool, len(nodes))
+	sorted := true
+
+	for _, node := range nodes {
+		if found[node] {
+			continue
+		}
+
+		found[node] = true
+
+		if sorted && len(unique) > 0 && s.compareOrder(unique[len(unique)-1], node) > 0 {
+			sorted = false
+		}
+
+		unique = append(unique, node)
+	}
+
+	if !sorted {
+		sort.SliceStable(unique, func(i, j int) bool {
+			return s.compareOrder(unique[i], unique[j]) < 0
+		})
+	}
+
+	return unique
+}
+
+// compareOrder compares the document order of two nodes, returning a negative number when
+// left precedes right, a positive number when left follows right and zero when equal.
+func (s *xpathState) compareOrder(left, right *XmlNode) int {
+	if left == right {
+		return 0
+	}
+
+	leftRoot, rightRoot := s.rootElement(left), s.rootElement(right)
+
+	// Nodes from different trees are ordered consistently by first encounter
+	if leftRoot != rightRoot {
+		return s.treeIndex(leftRoot) - s.treeIndex(rightRoot)
+	}
+
+	if left.nodeType == XmlNodeType.Document {
+		return -1
+	}
+
+	if right.nodeType == XmlNodeType.Document {
+		return 1
+	}
+
+	leftPath, rightPath := nodePath(left), nodePath(right)
+
+	for i := 0; i < len(leftPath) && i < len(rightPath); i++ {
+		if leftPath[i] != rightPath[i] {
+			return s.compareSiblings(leftPath[i], rightPath[i])
+		}
+	}
+
+	// Ancestors precede their descendants
+	return len(leftPath) - len(rightPath)
+}
+
+func (s *xpathState) treeIndex(root *XmlNode) int {
+	if index, found := s.treeOrder[root]; found {
+		return index
+	}
+
+	index := len(s.treeOrder)
+	s.treeOrder[root] = index
+
+	return index
+}
+
+// nodePath gets the ancestor-or-self nodes of a node, starting from the root element.
+func nodePath(node *XmlNode) []*XmlNode {
+	var path []*XmlNode
+
+	for ; node != nil; node = node.Parent {
+		path = append(path, node)
+	}
+
+	return reverseNodes(path)
+}
+
+// compareSiblings compares the document order of two nodes having the same parent element:
+// attributes precede the text node which precedes child elements.
+func (s *xpathState) compareSiblings(left, right *XmlNode) int {
+	leftRank, leftIndex := s.siblingRank(left)
+	rightRank, rightIndex := s.siblingRank(right)
+
+	if leftRank != rightRank {
+		return leftRank - rightRank
+	}
+
+	return leftIndex - rightIndex
+}
+
+func (s *xpathState) siblingRank(node *XmlNode) (int, int) {
+	switch node.nodeType {
+	case XmlNodeType.Attribute:
+		for i, attribute := range s.attributeNodes(node.Parent) {
+			if attribute == node {
+				return 0, i
+			}
+		}
+
+		return 0, 0
+	case XmlNodeType.Text:
+		return 1, 0
+	}
+
+	return 2, s.siblingIndex(node)
+}
@@ -0,0 +1,580 @@
+//******************************************************************************************************
+//  XPathFunctions.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package xml
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// xpathFunction defines an XPath core library function with its allowed argument count,
+// a maxArgs value of -1 allows any number of arguments.
+type xpathFunction struct {
+	minArgs int
+	maxArgs int
+	call    func(context *xpathContext, args []interface{}) (interface{}, error)
+}
+
+var xpathFunctions = map[string]xpathFunction{
+	// Node-set functions
+	"last":          {0, 0, xpathLast},
+	"position":      {0, 0, xpathPosition},
+	"count":         {1, 1, xpathCount},
+	"id":            {1, 1, xpathID},
+	"local-name":    {0, 1, xpathLocalName},
+	"namespace-uri": {0, 1, xpathNamespaceURI},
+	"name":          {0, 1, xpathName},
+
+	// String functions
+	"string":           {0, 1, xpathString},
+	"concat":           {2, -1, xpathConcat},
+	"starts-with":      {2, 2, xpathStartsWith},
+	"contains":         {2, 2, xpathContains},
+	"substring-before": {2, 2, xpathSubstringBefore},
+	"substring-after":  {2, 2, xpathSubstringAfter},
+	"substring":        {2, 3, xpathSubstring},
+	"string-length":    {0, 1, xpathStringLength},
+	"normalize-space":  {0, 1, xpathNormalizeSpace},
+	"translate":        {3, 3, xpathTranslate},
+
+	// Boolean functions
+	"boolean": {1, 1, xpathBoolean},
+	"not":     {1, 1, xpathNot},
+	"true":    {0, 0, xpathTrue},
+	"false":   {0, 0, xpathFalse},
+	"lang":    {1, 1, xpathLang},
+
+	// Number functions
+	"number":  {0, 1, xpathNumber},
+	"sum":     {1, 1, xpathSum},
+	"floor":   {1, 1, xpathFloor},
+	"ceiling": {1, 1, xpathCeiling},
+	"round":   {1, 1, xpathRound},
+}
+
+func xpathLast(context *xpathContext, _ []interface{}) (interface{}, error) {
+	return float64(context.size), nil
+}
+
+func xpathPosition(context *xpathContext, _ []interface{}) (interface{}, error) {
+	return float64(context.position), nil
+}
+
+func nodeSetArgument(args []interface{}, index int) ([]*XmlNode, error) {
+	nodes, ok := args[index].([]*XmlNode)
+
+	if !ok {
+		return nil, errors.New("argument " + strconv.Itoa(index+1) + " must be a node-set")
+	}
+
+	return nodes, nil
+}
+
+// optionalNodeArgument gets the first node of an optional node-set argument, defaulting to the context node.
+func optionalNodeArgument(context *xpathContext, args []interface{}) (*XmlNode, error) {
+	if len(args) == 0 {
+		return context.node, nil
+	}
+
+	nodes, err := nodeSetArgument(args, 0)
+
+	if err != nil || len(nodes) == 0 {
+		return nil, err
+	}
+
+	return nodes[0], nil
+}
+
+// optionalStringArgument gets an optional string argument, defaulting to the string-value of the context node.
+func optionalStringArgument(context *xpathContext, args []interface{}) string {
+	if len(args) == 0 {
+		return stringValue(context.node)
+	}
+
+	return toXPathString(args[0])
+}
+
+func xpathCount(_ *xpathContext, args []interface{}) (interface{}, error) {
+	nodes, err := nodeSetArgument(args, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return float64(len(nodes)), nil
+}
+
+// xpathID always returns an empty node-set since element IDs require a DTD
+func xpathID(_ *xpathContext, _ []interface{}) (interface{}, error) {
+	return make([]*XmlNode, 0), nil
+}
+
+func xpathLocalName(context *xpathContext, args []interface{}) (interface{}, error) {
+	node, err := optionalNodeArgument(context, args)
+
+	if err != nil || node == nil {
+		return "", err
+	}
+
+	return node.Name, nil
+}
+
+func xpathNamespaceURI(context *xpathContext, args []interface{}) (interface{}, error) {
+	node, err := optionalNodeArgument(context, args)
+
+	if err != nil || node == nil {
+		return "", err
+	}
+
+	return node.Namespace, nil
+}
+
+func xpathName(context *xpathContext, args []interface{}) (interface{}, error) {
+	node, err := optionalNodeArgument(context, args)
+
+	if err != nil || node == nil {
+		return "", err
+	}
+
+	if len(node.Namespace) > 0 {
+		if prefix := lookupPrefix(node, node.Namespace); len(prefix) > 0 {
+			return prefix + ":" + node.Name, nil
+		}
+	}
+
+	return node.Name, nil
+}
+
+func xpathString(context *xpathContext, args []interface{}) (interface{}, error) {
+	return optionalStringArgument(context, args), nil
+}
+
+func xpathConcat(_ *xpathContext, args []interface{}) (interface{}, error) {
+	var result strings.Builder
+
+	for _, arg := range args {
+		result.WriteString(toXPathString(arg))
+	}
+
+	return result.String(), nil
+}
+
+func xpathStartsWith(_ *xpathContext, args []interface{}) (interface{}, error) {
+	return strings.HasPrefix(toXPathString(args[0]), toXPathString(args[1])), nil
+}
+
+func xpathContains(_ *xpathContext, args []interface{}) (interface{}, error) {
+	return strings.Contains(toXPathString(args[0]), toXPathString(args[1])), nil
+}
+
+func xpathSubstringBefore(_ *xpathContext, args []interface{}) (interface{}, error) {
+	value := toXPathString(args[0])
+
+	if index := strings.Index(value, toXPathString(args[1])); index > -1 {
+		return value[:index], nil
+	}
+
+	return "", nil
+}
+
+func xpathSubstringAfter(_ *xpathContext, args []interface{}) (interface{}, error) {
+	value := toXPathString(args[0])
+	search := toXPathString(args[1])
+
+	if index := strings.Index(value, search); index > -1 {
+		return value[index+len(search):], nil
+	}
+
+	return "", nil
+}
+
+// xpathSubstring returns the characters at positions p where round(start) <= p < round(start) + round(length),
+// positions are one-based and comparisons involving NaN exclude the character.
+func xpathSubstring(_ *xpathContext, args []interface{}) (interface{}, error) {
+	value := toXPathString(args[0])
+	start := roundXPathNumber(toXPathNumber(args[1]))
+	end := math.Inf(1)
+
+	if len(args) > 2 {
+		end = start + roundXPathNumber(toXPathNumber(args[2]))
+	}
+
+	var result strings.Builder
+	position := 1.0
+
+	for _, r := range value {
+		if position >= start && position < end {
+			result.WriteRune(r)
+		}
+
+		position++
+	}
+
+	return result.String(), nil
+}
+
+func xpathStringLength(context *xpathContext, args []interface{}) (interface{}, error) {
+	return float64(utf8.RuneCountInString(optionalStringArgument(context, args))), nil
+}
+
+func xpathNormalizeSpace(context *xpathContext, args []interface{}) (interface{}, error) {
+	fields := strings.FieldsFunc(optionalStringArgument(context, args), func(r rune) bool {
+		return r < utf8.RuneSelf && isXPathWhitespace(byte(r))
+	})
+
+	return strings.Join(fields, " "), nil
+}
+
+func xpathTranslate(_ *xpathContext, args []interface{}) (interface{}, error) {
+	from := []rune(toXPathString(args[1]))
+	to := []rune(toXPathString(args[2]))
+	mapping := make(map[rune]int, len(from))
+
+	// First occurrence of a character in the from string defines its translation
+	for i, r := range from {
+		if _, found := mapping[r]; !found {
+			mapping[r] = i
+		}
+	}
+
+	var result strings.Builder
+
+	for _, r := range toXPathString(args[0]) {
+		index, found := mapping[r]
+
+		switch {
+		case !found:
+			result.WriteRune(r)
+		case index < len(to):
+			result.WriteRune(to[index])
+		}
+	}
+
+	return result.String(), nil
+}
+
+func xpathBoolean(_ *xpathContext, args []interface{}) (interface{}, error) {
+	return toXPathBoolean(args[0]), nil
+}
+
+func xpathNot(_ *xpathContext, args []interface{}) (interface{}, error) {
+	return !toXPathBoolean(args[0]), nil
+}
+
+func xpathTrue(*xpathContext, []interface{}) (interface{}, error) {
+	return true, nil
+}
+
+func xpathFalse(*xpathContext, []interface{}) (interface{}, error) {
+	return false, nil
+}
+
+// xpathLang determines if the xml:lang of the context node matches, or is a sub-language of, the argument.
+func xpathLang(context *xpathContext, args []interface{}) (interface{}, error) {
+	target := strings.ToLower(toXPathString(args[0]))
+
+	for node := context.node; node != nil; node = node.Parent {
+		if node.nodeType != XmlNodeType.Element {
+			continue
+		}
+
+		if lang, found := node.Attributes["lang"]; found && node.AttributeNamespaces["lang"] == xmlNamespace {
+			lang = strings.ToLower(lang)
+			return lang == target || strings.HasPrefix(lang, target+"-"), nil
+		}
+	}
+
+	return false, nil
+}
+
+func xpathNumber(context *xpathContext, args []interface{}) (interface{}, error) {
+	if len(args) == 0 {
+		return parseXPathNumber(stringValue(context.node)), nil
+	}
+
+	return toXPathNumber(args[0]), nil
+}
+
+func xpathSum(_ *xpathContext, args []interface{}) (interface{}, error) {
+	nodes, err := nodeSetArgument(args, 0)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sum := 0.0
+
+	for _, node := range nodes {
+		sum += parseXPathNumber(stringValue(node))
+	}
+
+	return sum, nil
+}
+
+func xpathFloor(_ *xpathContext, args []interface{}) (interface{}, error) {
+	return math.Floor(toXPathNumber(args[0])), nil
+}
+
+func xpathCeiling(_ *xpathContext, args []interface{}) (interface{}, error) {
+	return math.Ceil(toXPathNumber(args[0])), nil
+}
+
+func xpathRound(_ *xpathContext, args []interface{}) (interface{}, error) {
+	return roundXPathNumber(toXPathNumber(args[0])), nil
+}
+
+// roundXPathNumber rounds to the closest integer, rounding half values towards positive infinity.
+func roundXPathNumber(value float64) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value == 0 {
+		return value
+	}
+
+	if value < 0 && value >= -0.5 {
+		return math.Copysign(0, -1)
+	}
+
+	return math.Floor(value + 0.5)
+}
+
+// stringValue gets the XPath string-value of a node.
+func stringValue(node *XmlNode) string {
+	if node.nodeType == XmlNodeType.Document {
+		if root := documentElement(node); root != nil {
+			return root.InnerText()
+		}
+
+		return ""
+	}
+
+	return node.InnerText()
+}
+
+// toXPathString converts an XPath value to a string as defined by the string() function.
+func toXPathString(value interface{}) string {
+	switch value := value.(type) {
+	case string:
+		return value
+	case bool:
+		return strconv.FormatBool(value)
+	case float64:
+		return formatXPathNumber(value)
+	case []*XmlNode:
+		if len(value) == 0 {
+			return ""
+		}
+
+		return stringValue(value[0])
+	}
+
+	return ""
+}
+
+// toXPathNumber converts an XPath value to a number as defined by the number() function.
+func toXPathNumber(value interface{}) float64 {
+	switch value := value.(type) {
+	case float64:
+		return value
+	case bool:
+		if value {
+			return 1
+		}
+
+		return 0
+	case string:
+		return parseXPathNumber(value)
+	case []*XmlNode:
+		return parseXPathNumber(toXPathString(value))
+	}
+
+	return math.NaN()
+}
+
+// toXPathBoolean converts an XPath value to a boolean as defined by the boolean() function.
+func toXPathBoolean(value interface{}) bool {
+	switch value := value.(type) {
+	case bool:
+		return value
+	case float64:
+		return value != 0 && !math.IsNaN(value)
+	case string:
+		return len(value) > 0
+	case []*XmlNode:
+		return len(value) > 0
+	}
+
+	return false
+}
+
+// parseXPathNumber parses an XPath Number with optional surrounding whitespace and leading minus
+// sign, any other string, including exponent notation, is NaN.
+func parseXPathNumber(value string) float64 {
+	value = strings.TrimFunc(value, func(r rune) bool {
+		return r < utf8.RuneSelf && isXPathWhitespace(byte(r))
+	})
+
+	digits := strings.TrimPrefix(value, "-")
+
+	if len(digits) == 0 || digits == "." || strings.Trim(digits, "0123456789.") != "" || strings.Count(digits, ".") > 1 {
+		return math.NaN()
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+
+	if err != nil {
+		return math.NaN()
+	}
+
+	return number
+}
+
+// formatXPathNumber formats a number as defined by the string() function, i.e., without exponent.
+func formatXPathNumber(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	case value == 0:
+		return "0"
+	}
+
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// compareXPathValues compares two XPath values with an equality or relational operator according
+// to the XPath 1.0 rules for node-set, boolean, number and string comparisons.
+func compareXPathValues(operator string, left, right interface{}) bool {
+	leftNodes, leftIsNodes := left.([]*XmlNode)
+	rightNodes, rightIsNodes := right.([]*XmlNode)
+
+	switch {
+	case leftIsNodes && rightIsNodes:
+		rightValues := make([]string, len(rightNodes))
+
+		for i, node := range rightNodes {
+			rightValues[i] = stringValue(node)
+		}
+
+		for _, node := range leftNodes {
+			leftValue := stringValue(node)
+
+			for _, rightValue := range rightValues {
+				if compareXPathAtomics(operator, leftValue, rightValue) {
+					return true
+				}
+			}
+		}
+
+		return false
+	case leftIsNodes:
+		return compareXPathNodeSet(operator, leftNodes, right)
+	case rightIsNodes:
+		return compareXPathNodeSet(reverseXPathOperator(operator), rightNodes, left)
+	}
+
+	return compareXPathAtomics(operator, left, right)
+}
+
+// compareXPathNodeSet compares a node-set to a non-node-set value.
+func compareXPathNodeSet(operator string, nodes []*XmlNode, value interface{}) bool {
+	if _, ok := value.(bool); ok {
+		return compareXPathAtomics(operator, len(nodes) > 0, value)
+	}
+
+	for _, node := range nodes {
+		if compareXPathAtomics(operator, stringValue(node), value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reverseXPathOperator gets the operator for swapped operands.
+func reverseXPathOperator(operator string) string {
+	switch operator {
+	case "<":
+		return ">"
+	case "<=":
+		return ">="
+	case ">":
+		return "<"
+	case ">=":
+		return "<="
+	}
+
+	return operator
+}
+
+// compareXPathAtomics compares two non-node-set values.
+//
+//gocyclo:ignore
+func compareXPathAtomics(operator string, left, right interface{}) bool {
+	if operator == "=" || operator == "!=" {
+		var equal bool
+
+		_, leftIsBool := left.(bool)
+		_, rightIsBool := right.(bool)
+		_, leftIsNumber := left.(float64)
+		_, rightIsNumber := right.(float64)
+
+		switch {
+		case leftIsBool || rightIsBool:
+			equal = toXPathBoolean(left) == toXPathBoolean(right)
+		case leftIsNumber || rightIsNumber:
+			leftNumber, rightNumber := toXPathNumber(left), toXPathNumber(right)
+
+			// NaN is not equal to any value, including itself
+			if math.IsNaN(leftNumber) || math.IsNaN(rightNumber) {
+				return operator == "!="
+			}
+
+			equal = leftNumber == rightNumber
+		default:
+			equal = toXPathString(left) == toXPathString(right)
+		}
+
+		return equal == (operator == "=")
+	}
+
+	leftNumber, rightNumber := toXPathNumber(left), toXPathNumber(right)
+
+	switch operator {
+	case "<":
+		return leftNumber < rightNumber
+	case "<=":
+		return leftNumber <= rightNumber
+	case ">":
+		return leftNumber > rightNumber
+	case ">=":
+		return leftNumber >= rightNumber
+	}
+
+	return false
+}
@@ -0,0 +1,730 @@
+//******************************************************************************************************
+//  XPathParser.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package xml
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+type xpathTokenKind int
+
+const (
+	tokenEOF xpathTokenKind = iota
+	tokenSymbol
+	tokenOperator
+	tokenNameTest
+	tokenNodeType
+	tokenFunctionName
+	tokenAxisName
+	tokenLiteral
+	tokenNumber
+	tokenVariable
+)
+
+type xpathToken struct {
+	kind  xpathTokenKind
+	value string
+}
+
+func (t xpathToken) is(kind xpathTokenKind, value string) bool {
+	return t.kind == kind && t.value == value
+}
+
+var xpathNodeTypes = map[string]bool{
+	"comment":                true,
+	"text":                   true,
+	"processing-instruction": true,
+	"node":                   true,
+}
+
+var xpathOperatorNames = map[string]bool{
+	"and": true,
+	"or":  true,
+	"mod": true,
+	"div": true,
+}
+
+// tokenizeXPath splits an XPath expression into tokens applying the lexical disambiguation
+// rules of XPath 1.0, section 3.7, for the '*' character and operator names.
+//
+//gocyclo:ignore
+func tokenizeXPath(xpath string) ([]xpathToken, error) {
+	var tokens []xpathToken
+
+	// Determines if the preceding token allows the next token to be an operator
+	operatorAllowed := func() bool {
+		if len(tokens) == 0 {
+			return false
+		}
+
+		last := tokens[len(tokens)-1]
+
+		switch last.kind {
+		case tokenOperator:
+			return false
+		case tokenSymbol:
+			return last.value != "@" && last.value != "::" && last.value != "(" && last.value != "[" && last.value != ","
+		}
+
+		return true
+	}
+
+	// Finds the next non-whitespace character
+	peekNext := func(index int) string {
+		for index < len(xpath) && isXPathWhitespace(xpath[index]) {
+			index++
+		}
+
+		return xpath[index:]
+	}
+
+	i := 0
+
+	for i < len(xpath) {
+		c := xpath[i]
+
+		if isXPathWhitespace(c) {
+			i++
+			continue
+		}
+
+		switch {
+		case c == '(' || c == ')' || c == '[' || c == ']' || c == '@' || c == ',':
+			tokens = append(tokens, xpathToken{tokenSymbol, string(c)})
+			i++
+		case c == ':' && strings.HasPrefix(xpath[i:], "::"):
+			tokens = append(tokens, xpathToken{tokenSymbol, "::"})
+			i += 2
+		case c == '.' && strings.HasPrefix(xpath[i:], ".."):
+			tokens = append(tokens, xpathToken{tokenSymbol, ".."})
+			i += 2
+		case c == '.' && (i+1 >= len(xpath) || !isDigit(xpath[i+1])):
+			tokens = append(tokens, xpathToken{tokenSymbol, "."})
+			i++
+		case isDigit(c) || c == '.':
+			start := i
+
+			for i < len(xpath) && isDigit(xpath[i]) {
+				i++
+			}
+
+			if i < len(xpath) && xpath[i] == '.' {
+				i++
+
+				for i < len(xpath) && isDigit(xpath[i]) {
+					i++
+				}
+			}
+
+			tokens = append(tokens, xpathToken{tokenNumber, xpath[start:i]})
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(xpath[i+1:], c)
+
+			if end < 0 {
+				return nil, errors.New("unterminated string literal at position " + strconv.Itoa(i))
+			}
+
+			tokens = append(tokens, xpathToken{tokenLiteral, xpath[i+1 : i+1+end]})
+			i += end + 2
+		case c == '/':
+			if strings.HasPrefix(xpath[i:], "//") {
+				tokens = append(tokens, xpathToken{tokenOperator, "//"})
+				i += 2
+			} else {
+				tokens = append(tokens, xpathToken{tokenOperator, "/"})
+				i++
+			}
+		case c == '|' || c == '+' || c == '-' || c == '=':
+			tokens = append(tokens, xpathToken{tokenOperator, string(c)})
+			i++
+		case c == '!':
+			if !strings.HasPrefix(xpath[i:], "!=") {
+				return nil, errors.New("unexpected character '!' at position " + strconv.Itoa(i))
+			}
+
+			tokens = append(tokens, xpathToken{tokenOperator, "!="})
+			i += 2
+		case c == '<' || c == '>':
+			if i+1 < len(xpath) && xpath[i+1] == '=' {
+				tokens = append(tokens, xpathToken{tokenOperator, xpath[i : i+2]})
+				i += 2
+			} else {
+				tokens = append(tokens, xpathToken{tokenOperator, string(c)})
+				i++
+			}
+		case c == '*':
+			if operatorAllowed() {
+				tokens = append(tokens, xpathToken{tokenOperator, "*"})
+			} else {
+				tokens = append(tokens, xpathToken{tokenNameTest, "*"})
+			}
+
+			i++
+		case c == '$':
+			name, length := scanNCName(xpath[i+1:])
+
+			if length == 0 {
+				return nil, errors.New("expected variable name at position " + strconv.Itoa(i+1))
+			}
+
+			i += length + 1
+
+			if i < len(xpath) && xpath[i] == ':' && !strings.HasPrefix(xpath[i:], "::") {
+				local, localLength := scanNCName(xpath[i+1:])
+
+				if localLength == 0 {
+					return nil, errors.New("expected variable name at position " + strconv.Itoa(i+1))
+				}
+
+				name += ":" + local
+				i += localLength + 1
+			}
+
+			tokens = append(tokens, xpathToken{tokenVariable, name})
+		default:
+			name, length := scanNCName(xpath[i:])
+
+			if length == 0 {
+				r, _ := utf8.DecodeRuneInString(xpath[i:])
+				return nil, errors.New("unexpected character '" + string(r) + "' at position " + strconv.Itoa(i))
+			}
+
+			if operatorAllowed() {
+				if !xpathOperatorNames[name] {
+					return nil, errors.New("expected operator at position " + strconv.Itoa(i) + ", found \"" + name + "\"")
+				}
+
+				tokens = append(tokens, xpathToken{tokenOperator, name})
+				i += length
+				continue
+			}
+
+			i += length
+
+			// Check for qualified name, i.e., prefix:local or prefix:*
+			if i < len(xpath) && xpath[i] == ':' && !strings.HasPrefix(xpath[i:], "::") {
+				if i+1 < len(xpath) && xpath[i+1] == '*' {
+					tokens = append(tokens, xpathToken{tokenNameTest, name + ":*"})
+					i += 2
+					continue
+				}
+
+				local, localLength := scanNCName(xpath[i+1:])
+
+				if localLength == 0 {
+					return nil, errors.New("expected local name at position " + strconv.Itoa(i+1))
+				}
+
+				name += ":" + local
+				i += localLength + 1
+			}
+
+			next := peekNext(i)
+
+			switch {
+			case strings.HasPrefix(next, "::"):
+				tokens = append(tokens, xpathToken{tokenAxisName, name})
+			case strings.HasPrefix(next, "("):
+				if xpathNodeTypes[name] {
+					tokens = append(tokens, xpathToken{tokenNodeType, name})
+				} else {
+					tokens = append(tokens, xpathToken{tokenFunctionName, name})
+				}
+			default:
+				tokens = append(tokens, xpathToken{tokenNameTest, name})
+			}
+		}
+	}
+
+	return append(tokens, xpathToken{tokenEOF, ""}), nil
+}
+
+func isXPathWhitespace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// scanNCName scans an XML non-colonized name from the start of value, returning the
+// name and its length in bytes; length is zero if value does not start with a name.
+func scanNCName(value string) (string, int) {
+	length := 0
+
+	for length < len(value) {
+		r, size := utf8.DecodeRuneInString(value[length:])
+
+		if r == '_' || unicode.IsLetter(r) || length > 0 && (r == '-' || r == '.' || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)) {
+			length += size
+			continue
+		}
+
+		break
+	}
+
+	return value[:length], length
+}
+
+// xpathParser is a recursive descent parser for the XPath 1.0 grammar.
+type xpathParser struct {
+	tokens []xpathToken
+	index  int
+}
+
+func (p *xpathParser) peek() xpathToken {
+	return p.tokens[p.index]
+}
+
+func (p *xpathParser) next() xpathToken {
+	token := p.tokens[p.index]
+
+	if token.kind != tokenEOF {
+		p.index++
+	}
+
+	return token
+}
+
+func (p *xpathParser) expect(kind xpathTokenKind, value string) error {
+	if token := p.next(); !token.is(kind, value) {
+		return unexpectedToken(token, "\""+value+"\"")
+	}
+
+	return nil
+}
+
+func unexpectedToken(token xpathToken, expected string) error {
+	if token.kind == tokenEOF {
+		return errors.New("unexpected end of expression, expected " + expected)
+	}
+
+	return errors.New("unexpected token \"" + token.value + "\", expected " + expected)
+}
+
+func (p *xpathParser) parseBinary(operators []string, parseOperand func() (xpathExpr, error)) (xpathExpr, error) {
+	left, err := parseOperand()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		token := p.peek()
+
+		if token.kind != tokenOperator || !containsString(operators, token.value) {
+			return left, nil
+		}
+
+		p.next()
+		right, err := parseOperand()
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &binaryExpr{operator: token.value, left: left, right: right}
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *xpathParser) parseOrExpr() (xpathExpr, error) {
+	return p.parseBinary([]string{"or"}, p.parseAndExpr)
+}
+
+func (p *xpathParser) parseAndExpr() (xpathExpr, error) {
+	return p.parseBinary([]string{"and"}, p.parseEqualityExpr)
+}
+
+func (p *xpathParser) parseEqualityExpr() (xpathExpr, error) {
+	return p.parseBinary([]string{"=", "!="}, p.parseRelationalExpr)
+}
+
+func (p *xpathParser) parseRelationalExpr() (xpathExpr, error) {
+	return p.parseBinary([]string{"<", "<=", ">", ">="}, p.parseAdditiveExpr)
+}
+
+func (p *xpathParser) parseAdditiveExpr() (xpathExpr, error) {
+	return p.parseBinary([]string{"+", "-"}, p.parseMultiplicativeExpr)
+}
+
+func (p *xpathParser) parseMultiplicativeExpr() (xpathExpr, error) {
+	return p.parseBinary([]string{"*", "div", "mod"}, p.parseUnaryExpr)
+}
+
+func (p *xpathParser) parseUnaryExpr() (xpathExpr, error) {
+	if p.peek().is(tokenOperator, "-") {
+		p.next()
+		operand, err := p.parseUnaryExpr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		return &negateExpr{operand: operand}, nil
+	}
+
+	return p.parseUnionExpr()
+}
+
+func (p *xpathParser) parseUnionExpr() (xpathExpr, error) {
+	left, err := p.parsePathExpr()
+
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().is(tokenOperator, "|") {
+		p.next()
+		right, err := p.parsePathExpr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		left = &unionExpr{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+func (p *xpathParser) parsePathExpr() (xpathExpr, error) {
+	token := p.peek()
+
+	switch {
+	case token.kind == tokenLiteral, token.kind == tokenNumber, token.kind == tokenVariable,
+		token.kind == tokenFunctionName, token.is(tokenSymbol, "("):
+		filter, err := p.parseFilterExpr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		token = p.peek()
+
+		if !token.is(tokenOperator, "/") && !token.is(tokenOperator, "//") {
+			return filter, nil
+		}
+
+		path := &pathExpr{filter: filter}
+
+		if err = p.parseRelativeLocationPath(path); err != nil {
+			return nil, err
+		}
+
+		return path, nil
+	}
+
+	return p.parseLocationPath()
+}
+
+func (p *xpathParser) parseFilterExpr() (xpathExpr, error) {
+	primary, err := p.parsePrimaryExpr()
+
+	if err != nil {
+		return nil, err
+	}
+
+	predicates, err := p.parsePredicates()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(predicates) == 0 {
+		return primary, nil
+	}
+
+	return &filterExpr{primary: primary, predicates: predicates}, nil
+}
+
+func (p *xpathParser) parsePrimaryExpr() (xpathExpr, error) {
+	token := p.next()
+
+	switch token.kind {
+	case tokenLiteral:
+		return &literalExpr{value: token.value}, nil
+	case tokenNumber:
+		value, err := strconv.ParseFloat(token.value, 64)
+
+		if err != nil {
+			return nil, errors.New("invalid number \"" + token.value + "\"")
+		}
+
+		return &numberExpr{value: value}, nil
+	case tokenVariable:
+		return nil, errors.New("variable reference \"$" + token.value + "\" is not supported")
+	case tokenFunctionName:
+		return p.parseFunctionCall(token.value)
+	}
+
+	if token.is(tokenSymbol, "(") {
+		expr, err := p.parseOrExpr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err = p.expect(tokenSymbol, ")"); err != nil {
+			return nil, err
+		}
+
+		return expr, nil
+	}
+
+	return nil, unexpectedToken(token, "expression")
+}
+
+func (p *xpathParser) parseFunctionCall(name string) (xpathExpr, error) {
+	function, found := xpathFunctions[name]
+
+	if !found {
+		return nil, errors.New("unknown function \"" + name + "()\"")
+	}
+
+	if err := p.expect(tokenSymbol, "("); err != nil {
+		return nil, err
+	}
+
+	var arguments []xpathExpr
+
+	if !p.peek().is(tokenSymbol, ")") {
+		for {
+			argument, err := p.parseOrExpr()
+
+			if err != nil {
+				return nil, err
+			}
+
+			arguments = append(arguments, argument)
+
+			if !p.peek().is(tokenSymbol, ",") {
+				break
+			}
+
+			p.next()
+		}
+	}
+
+	if err := p.expect(tokenSymbol, ")"); err != nil {
+		return nil, err
+	}
+
+	if len(arguments) < function.minArgs || function.maxArgs > -1 && len(arguments) > function.maxArgs {
+		return nil, errors.New("invalid number of arguments for function \"" + name + "()\": " + strconv.Itoa(len(arguments)))
+	}
+
+	return &functionExpr{name: name, function: function, arguments: arguments}, nil
+}
+
+func (p *xpathParser) parseLocationPath() (xpathExpr, error) {
+	path := &pathExpr{}
+	token := p.peek()
+
+	if token.is(tokenOperator, "/") {
+		path.absolute = true
+		p.next()
+
+		// A lone "/" selects the document node
+		if !p.startsStep() {
+			return path, nil
+		}
+
+		return path, p.parseSteps(path)
+	}
+
+	if token.is(tokenOperator, "//") {
+		path.absolute = true
+		return path, p.parseRelativeLocationPath(path)
+	}
+
+	return path, p.parseSteps(path)
+}
+
+// parseRelativeLocationPath parses a "/" or "//" separator followed by steps.
+func (p *xpathParser) parseRelativeLocationPath(path *pathExpr) error {
+	if p.next().value == "//" {
+		path.steps = append(path.steps, descendantOrSelfStep())
+	}
+
+	return p.parseSteps(path)
+}
+
+func (p *xpathParser) startsStep() bool {
+	token := p.peek()
+
+	switch token.kind {
+	case tokenNameTest, tokenNodeType, tokenAxisName:
+		return true
+	case tokenSymbol:
+		return token.value == "@" || token.value == "." || token.value == ".."
+	}
+
+	return false
+}
+
+func (p *xpathParser) parseSteps(path *pathExpr) error {
+	for {
+		step, err := p.parseStep()
+
+		if err != nil {
+			return err
+		}
+
+		path.steps = append(path.steps, step)
+		token := p.peek()
+
+		switch {
+		case token.is(tokenOperator, "/"):
+			p.next()
+		case token.is(tokenOperator, "//"):
+			p.next()
+			path.steps = append(path.steps, descendantOrSelfStep())
+		default:
+			return nil
+		}
+	}
+}
+
+func descendantOrSelfStep() *xpathStep {
+	return &xpathStep{axis: xpathAxis.descendantOrSelf, test: xpathNodeTest{kind: nodeTestAnyNode}}
+}
+
+//gocyclo:ignore
+func (p *xpathParser) parseStep() (*xpathStep, error) {
+	token := p.peek()
+
+	if token.is(tokenSymbol, ".") {
+		p.next()
+		return &xpathStep{axis: xpathAxis.self, test: xpathNodeTest{kind: nodeTestAnyNode}}, nil
+	}
+
+	if token.is(tokenSymbol, "..") {
+		p.next()
+		return &xpathStep{axis: xpathAxis.parent, test: xpathNodeTest{kind: nodeTestAnyNode}}, nil
+	}
+
+	step := &xpathStep{axis: xpathAxis.child}
+
+	if token.is(tokenSymbol, "@") {
+		p.next()
+		step.axis = xpathAxis.attribute
+	} else if token.kind == tokenAxisName {
+		p.next()
+		axis, found := xpathAxisNames[token.value]
+
+		if !found {
+			return nil, errors.New("unknown axis \"" + token.value + "\"")
+		}
+
+		step.axis = axis
+
+		if err := p.expect(tokenSymbol, "::"); err != nil {
+			return nil, err
+		}
+	}
+
+	token = p.next()
+
+	switch token.kind {
+	case tokenNameTest:
+		step.test.kind = nodeTestName
+
+		if index := strings.IndexByte(token.value, ':'); index > -1 {
+			step.test.prefix = token.value[:index]
+			step.test.local = token.value[index+1:]
+		} else {
+			step.test.local = token.value
+		}
+	case tokenNodeType:
+		if err := p.expect(tokenSymbol, "("); err != nil {
+			return nil, err
+		}
+
+		switch token.value {
+		case "node":
+			step.test.kind = nodeTestAnyNode
+		case "text":
+			step.test.kind = nodeTestText
+		case "comment":
+			step.test.kind = nodeTestComment
+		case "processing-instruction":
+			step.test.kind = nodeTestProcessingInstruction
+
+			if p.peek().kind == tokenLiteral {
+				step.test.local = p.next().value
+			}
+		}
+
+		if err := p.expect(tokenSymbol, ")"); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, unexpectedToken(token, "node test")
+	}
+
+	predicates, err := p.parsePredicates()
+
+	if err != nil {
+		return nil, err
+	}
+
+	step.predicates = predicates
+
+	return step, nil
+}
+
+func (p *xpathParser) parsePredicates() ([]xpathExpr, error) {
+	var predicates []xpathExpr
+
+	for p.peek().is(tokenSymbol, "[") {
+		p.next()
+		predicate, err := p.parseOrExpr()
+
+		if err != nil {
+			return nil, err
+		}
+
+		if err = p.expect(tokenSymbol, "]"); err != nil {
+			return nil, err
+		}
+
+		predicates = append(predicates, predicate)
+	}
+
+	return predicates, nil
+}
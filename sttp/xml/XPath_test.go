@@ -0,0 +1,338 @@
+//******************************************************************************************************
+//  XPath_test.go - Gbtc
+//
+//  Copyright © 2026, Grid Protection Alliance.  All Rights Reserved.
+//
+//  Licensed to the Grid Protection Alliance (GPA) under one or more contributor license agreements. See
+//  the NOTICE file distributed with this work for additional information regarding copyright ownership.
+//  The GPA licenses this file to you under the MIT License (MIT), the "License"; you may not use this
+//  file except in compliance with the License. You may obtain a copy of the License at:
+//
+//      http://opensource.org/licenses/MIT
+//
+//  Unless agreed to in writing, the subject software distributed under the License is distributed on an
+//  "AS-IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. Refer to the
+//  License for the specific language governing permissions and limitations.
+//
+//  Code Modification History:
+//  ----------------------------------------------------------------------------------------------------
+//  10/16/2026 - agent
+//       Generated original version of source code.
+//
+//******************************************************************************************************
+
+package xml
+
+import (
+	"math"
+	"testing"
+)
+
+const testXPathXml = `<?xml version="1.0"?>
+<library xmlns:ext="urn:example:ext">
+  <book id="b1" xml:lang="en">
+    <title>Go &amp; XML</title>
+    <price>29.95</price>
+    <author>Ann</author>
+    <author>Bob</author>
+  </book>
+  <book id="b2" ext:edition="2">
+    <title>Streaming  Data
+    Systems</title>
+    <price>45</price>
+    <author>Cal</author>
+  </book>
+  <book id="b3">
+    <title>Phasors</title>
+    <price>12.5</price>
+    <section><section><title>Nested</title></section></section>
+  </book>
+  <ext:note>Mixed <b>bold</b> text</ext:note>
+</library>`
+
+func loadTestXPathDocument(t *testing.T) *XmlDocument {
+	var xpathDoc XmlDocument
+
+	if err := xpathDoc.LoadXml([]byte(testXPathXml)); err != nil {
+		t.Fatalf("Failed to load test XML: %s", err.Error())
+	}
+
+	return &xpathDoc
+}
+
+func nodeValues(nodes []*XmlNode) []string {
+	values := make([]string, len(nodes))
+
+	for i, node := range nodes {
+		values[i] = node.InnerText()
+	}
+
+	return values
+}
+
+func TestXPathNodeSets(t *testing.T) {
+	xpathDoc := loadTestXPathDocument(t)
+
+	tests := []struct {
+		xpath    string
+		expected []string
+	}{
+		{"book/title", []string{"Go & XML", "Streaming  Data\n    Systems", "Phasors"}},
+		{"/library/book[2]/author", []string{"Cal"}},
+		{"//author", []string{"Ann", "Bob", "Cal"}},
+		{"//book[author='Bob']/@id", []string{"b1"}},
+		{"//book[last()]/@id", []string{"b3"}},
+		{"//book[position() > 1 and price < 20]/@id", []string{"b3"}},
+		{"//book[price > 40 or @id='b1']/@id", []string{"b1", "b2"}},
+		{"//book[count(author) = 2]/title", []string{"Go & XML"}},
+		{"//book[contains(title, 'XML')]/@id", []string{"b1"}},
+		{"//book[not(author)]/@id", []string{"b3"}},
+		{"//title[../@id='b3'] | //book[1]/title", []string{"Go & XML", "Phasors"}},
+		{"//section//title", []string{"Nested"}},
+		{"//title[ancestor::section]/ancestor::book/@id", []string{"b3"}},
+		{"book[1]/author[1]/following-sibling::*", []string{"Bob"}},
+		{"book[3]/preceding-sibling::book[1]/@id", []string{"b2"}},
+		{"(//author)[last()]", []string{"Cal"}},
+		{"//book[@ext:edition]/@id", []string{"b2"}},
+		{"//book/@*[local-name()='edition']", []string{"2"}},
+		{"ext:note/text()", []string{"Mixed  text"}},
+		{"//price[. = 45]/parent::node()/@id", []string{"b2"}},
+		{"book[2]/author/following::title", []string{"Phasors", "Nested"}},
+		{"book[2]/preceding::author", []string{"Ann", "Bob"}},
+		{"//book[@xml:lang][1]/self::book/@id", []string{"b1"}},
+		{"//book[lang('EN')]/@id", []string{"b1"}},
+		{"//*[name()='ext:note']/b", []string{"bold"}},
+		{"descendant::book[2]/descendant-or-self::*/title", []string{"Streaming  Data\n    Systems"}},
+	}
+
+	for _, test := range tests {
+		expression, err := CompileXPath(test.xpath)
+
+		if err != nil {
+			t.Fatalf("TestXPathNodeSets: %s", err.Error())
+		}
+
+		nodes, err := expression.Select(&xpathDoc.Root)
+
+		if err != nil {
+			t.Fatalf("TestXPathNodeSets: \"%s\": %s", test.xpath, err.Error())
+		}
+
+		values := nodeValues(nodes)
+
+		if len(values) != len(test.expected) {
+			t.Fatalf("TestXPathNodeSets: \"%s\": expected %q, received: %q", test.xpath, test.expected, values)
+		}
+
+		for i := range values {
+			if values[i] != test.expected[i] {
+				t.Fatalf("TestXPathNodeSets: \"%s\": expected %q, received: %q", test.xpath, test.expected, values)
+			}
+		}
+	}
+}
+
+func TestXPathScalars(t *testing.T) {
+	xpathDoc := loadTestXPathDocument(t)
+
+	tests := []struct {
+		xpath    string
+		expected interface{}
+	}{
+		{"count(//book)", 3.0},
+		{"count(/)", 1.0},
+		{"sum(//price)", 87.45},
+		{"//book[1]/price * 2", 59.9},
+		{"-//book[3]/price + 10 div 4", -10.0},
+		{"7 mod 3", 1.0},
+		{"-7 mod 3", -1.0},
+		{"floor(2.5) + ceiling(2.5) + round(2.5) + round(-2.5)", 6.0},
+		{"string-length(//book[1]/title)", 8.0},
+		{"number('abc') = number('abc')", false},
+		{"1 div 0 > 1000000", true},
+		{"string(//book[1]/price)", "29.95"},
+		{"string(1 div 0)", "Infinity"},
+		{"string(0.5 * 3)", "1.5"},
+		{"string(100 div 4)", "25"},
+		{"concat(//book[1]/@id, '-', //book[2]/@id)", "b1-b2"},
+		{"normalize-space(//book[2]/title)", "Streaming Data Systems"},
+		{"translate('bar', 'abc', 'ABC')", "BAr"},
+		{"translate('--aaa--', 'a-', 'A')", "AAA"},
+		{"substring('12345', 1.5, 2.6)", "234"},
+		{"substring('12345', 0, 3)", "12"},
+		{"substring('12345', 0 div 0, 3)", ""},
+		{"substring-before('1999/04/01', '/')", "1999"},
+		{"substring-after('1999/04/01', '/')", "04/01"},
+		{"starts-with(//book[3]/title, 'Pha')", true},
+		{"local-name(//ext:note)", "note"},
+		{"namespace-uri(//ext:note)", "urn:example:ext"},
+		{"name(//book[2]/@ext:edition)", "ext:edition"},
+		{"//book/price > 40", true},
+		{"//book/price = 12.5", true},
+		{"//book/price != 12.5", true},
+		{"//author = 'Cal'", true},
+		{"//author = //book[2]/author", true},
+		{"not(//missing)", true},
+		{"boolean(//book[4])", false},
+		{"true() and not(false())", true},
+		{"'10' < '9'", false},
+		{"string(//book[1]/@xml:lang) = 'en'", true},
+		{"count(id('b1'))", 0.0},
+		{"count(//book[1]/@*)", 2.0},
+		{"count(//book[1]/namespace::*)", 0.0},
+		{"count(//comment() | //processing-instruction())", 0.0},
+	}
+
+	for _, test := range tests {
+		result, err := xpathDoc.Evaluate(test.xpath)
+
+		if err != nil {
+			t.Fatalf("TestXPathScalars: \"%s\": %s", test.xpath, err.Error())
+		}
+
+		if expected, ok := test.expected.(float64); ok {
+			number, ok := result.(float64)
+
+			if !ok || math.Abs(number-expected) > 1e-9 {
+				t.Fatalf("TestXPathScalars: \"%s\": expected %v, received: %v", test.xpath, test.expected, result)
+			}
+
+			continue
+		}
+
+		if result != test.expected {
+			t.Fatalf("TestXPathScalars: \"%s\": expected %v, received: %v", test.xpath, test.expected, result)
+		}
+	}
+}
+
+func TestXPathConversions(t *testing.T) {
+	xpathDoc := loadTestXPathDocument(t)
+	expression, _ := CompileXPath("//book[2]/price")
+
+	if value, _ := expression.EvaluateNumber(&xpathDoc.Root); value != 45 {
+		t.Fatalf("TestXPathConversions: unexpected number value: %v", value)
+	}
+
+	if value, _ := expression.EvaluateString(&xpathDoc.Root); value != "45" {
+		t.Fatalf("TestXPathConversions: unexpected string value: %v", value)
+	}
+
+	if value, _ := expression.EvaluateBoolean(&xpathDoc.Root); !value {
+		t.Fatal("TestXPathConversions: expected true boolean value")
+	}
+
+	if _, err := expression.Select(nil); err == nil {
+		t.Fatal("TestXPathConversions: expected error for nil context node")
+	}
+
+	expression, _ = CompileXPath("count(//book)")
+
+	if _, err := expression.Select(&xpathDoc.Root); err == nil {
+		t.Fatal("TestXPathConversions: expected error selecting nodes from number result")
+	}
+}
+
+func TestXPathNodeTypes(t *testing.T) {
+	xpathDoc := loadTestXPathDocument(t)
+
+	attribute := xpathDoc.SelectSingleNode("book[2]/@ext:edition")
+
+	if attribute == nil || attribute.NodeType() != XmlNodeType.Attribute || attribute.Name != "edition" || attribute.Namespace != "urn:example:ext" {
+		t.Fatal("TestXPathNodeTypes: unexpected attribute node")
+	}
+
+	if attribute.Parent == nil || attribute.Parent.Attributes["id"] != "b2" {
+		t.Fatal("TestXPathNodeTypes: unexpected attribute parent")
+	}
+
+	text := xpathDoc.SelectSingleNode("book[1]/title/text()")
+
+	if text == nil || text.NodeType() != XmlNodeType.Text || text.InnerText() != "Go & XML" {
+		t.Fatal("TestXPathNodeTypes: unexpected text node")
+	}
+
+	document := xpathDoc.SelectSingleNode("/")
+
+	if document == nil || document.NodeType() != XmlNodeType.Document {
+		t.Fatal("TestXPathNodeTypes: unexpected document node")
+	}
+
+	if xpathDoc.SelectSingleNode("/library") != &xpathDoc.Root {
+		t.Fatal("TestXPathNodeTypes: expected root element from absolute path")
+	}
+
+	if xpathDoc.Root.NodeType() != XmlNodeType.Element {
+		t.Fatal("TestXPathNodeTypes: expected element node type for root")
+	}
+}
+
+func TestXPathSyntaxErrors(t *testing.T) {
+	xpathDoc := loadTestXPathDocument(t)
+
+	invalid := []string{
+		"",
+		"//",
+		"book[",
+		"book[1",
+		"count(",
+		"unknown()",
+		"count()",
+		"concat('a')",
+		"'unterminated",
+		"book/!",
+		"$variable",
+		"child::",
+		"bad-axis::book",
+		"book title",
+		"1 +",
+	}
+
+	for _, xpath := range invalid {
+		if _, err := CompileXPath(xpath); err == nil {
+			t.Fatalf("TestXPathSyntaxErrors: expected error for \"%s\"", xpath)
+		}
+
+		if nodes := xpathDoc.SelectNodes(xpath); nodes == nil || len(nodes) != 0 {
+			t.Fatalf("TestXPathSyntaxErrors: expected empty node-set for \"%s\"", xpath)
+		}
+	}
+
+	if _, err := xpathDoc.Evaluate("count(1)"); err == nil {
+		t.Fatal("TestXPathSyntaxErrors: expected error for count() of a number")
+	}
+}
+
+func TestXPathSampleMetadata(t *testing.T) {
+	if count, _ := doc.Evaluate("count(//MeasurementDetail)"); count != 130.0 {
+		t.Fatalf("TestXPathSampleMetadata: expected 130 MeasurementDetail records, received: %v", count)
+	}
+
+	signalID, err := doc.Evaluate("string(//MeasurementDetail[SignalAcronym='FREQ']/SignalID)")
+
+	if err != nil || signalID != "93673c68-d59d-4926-b7e9-e7678f9f66b4" {
+		t.Fatalf("TestXPathSampleMetadata: unexpected FREQ signal ID: %v", signalID)
+	}
+
+	tables := doc.SelectNodes("/DataSet/xs:schema/xs:element/xs:complexType/xs:choice/xs:element/@name")
+
+	if len(tables) != 4 || tables[0].InnerText() != "DeviceDetail" || tables[3].InnerText() != "SchemaVersion" {
+		t.Fatalf("TestXPathSampleMetadata: unexpected table names: %q", nodeValues(tables))
+	}
+
+	guidFields := doc.SelectNodes("//xs:element[@ext:DataType='System.Guid']")
+
+	if len(guidFields) != 3 {
+		t.Fatalf("TestXPathSampleMetadata: expected 3 Guid fields, received: %d", len(guidFields))
+	}
+
+	phasors := doc.SelectNodes("PhasorDetail[Type='I' and SourceIndex >= 3]/Label")
+
+	if len(phasors) != 3 || phasors[0].InnerText() != "Cordova" {
+		t.Fatalf("TestXPathSampleMetadata: unexpected current phasor labels: %q", nodeValues(phasors))
+	}
+
+	if version, _ := doc.Evaluate("number(SchemaVersion/VersionNumber) + 1"); version != 10.0 {
+		t.Fatalf("TestXPathSampleMetadata: unexpected schema version: %v", version)
+	}
+}
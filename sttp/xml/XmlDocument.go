@@ -27,7 +27,6 @@ import (
 	"bytes"
 	"encoding/xml"
 	"os"
-	"strings"
 )
 
 // XmlDocument represents XML data as a tree of XmlNode instances.
@@ -102,13 +101,32 @@ func (xd *XmlDocument) LoadXml(data []byte) error {
 		return err
 	}
 
+	xd.initRoot()
+
+	return nil
+}
+
+// LoadXmlElement loads the XmlDocument from the element beginning with the given start element
+// read from decoder. This allows a single element, and its child nodes, to be loaded from a larger
+// XML stream without decoding the entire stream into a tree.
+func (xd *XmlDocument) LoadXmlElement(decoder *xml.Decoder, start xml.StartElement) error {
+	err := decoder.DecodeElement(&xd.Root, &start)
+
+	if err != nil {
+		return err
+	}
+
+	xd.initRoot()
+
+	return nil
+}
+
+func (xd *XmlDocument) initRoot() {
 	xd.Root.Name = xd.Root.XMLName.Local
 	xd.Root.Namespace = xd.Root.XMLName.Space
 	xd.Root.Owner = xd
 
 	xd.traverse(xd.Root.ChildNodes, &xd.Root)
-
-	return nil
 }
 
 // LoadXmlFromFile loads the XmlDocument from the specified file name containing XML data.
@@ -127,13 +145,21 @@ func (xd *XmlDocument) MaxDepth() int {
 	return xd.maxLevel + 1
 }
 
-// SelectNodes finds all nodes matching xpath expression starting at XmlDocument root.
-// Predicates currently only support "=" matching.
+// SelectNodes finds all nodes matching the XPath 1.0 xpath expression evaluated with the XmlDocument
+// root element as the context node, i.e., relative paths start at the root element and absolute paths
+// start at the document node. See XmlNode.SelectNodes.
 func (xd *XmlDocument) SelectNodes(xpath string) []*XmlNode {
+	return xd.Root.SelectNodes(xpath)
+}
 
-	if strings.HasPrefix(xpath, "//") && strings.HasPrefix(xpath[2:], xd.Root.Name+"/") {
-		return xd.Root.SelectNodes(xpath[3+len(xd.Root.Name):])
-	}
+// SelectSingleNode finds the first node, in document order, matching the XPath 1.0 xpath expression
+// evaluated with the XmlDocument root element as the context node, or nil if there is no match.
+func (xd *XmlDocument) SelectSingleNode(xpath string) *XmlNode {
+	return xd.Root.SelectSingleNode(xpath)
+}
 
-	return xd.Root.SelectNodes(xpath)
+// Evaluate evaluates the XPath 1.0 xpath expression with the XmlDocument root element as the
+// context node. See XPathExpression.Evaluate for the possible result types.
+func (xd *XmlDocument) Evaluate(xpath string) (interface{}, error) {
+	return xd.Root.Evaluate(xpath)
 }
@@ -240,3 +240,19 @@ func TestReverseEnumeration(t *testing.T) {
 		t.Fatalf("SampleMetadata.xml expected to have 138 root child nodes")
 	}
 }
+
+func TestInnerText(t *testing.T) {
+	var textDoc XmlDocument
+
+	if err := textDoc.LoadXml([]byte("<Root><Text>A &amp; B &lt;C&gt; &#x9;<![CDATA[<D>]]></Text><Plain> E </Plain></Root>")); err != nil {
+		t.Fatalf("Failed to load XML: %s", err.Error())
+	}
+
+	if text := textDoc.Root.Item["Text"].InnerText(); text != "A & B <C> \t<D>" {
+		t.Fatalf("Unexpected decoded inner text: %q", text)
+	}
+
+	if text := textDoc.Root.Item["Plain"].InnerText(); text != " E " {
+		t.Fatalf("Unexpected plain inner text: %q", text)
+	}
+}
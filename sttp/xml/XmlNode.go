@@ -24,10 +24,33 @@
 package xml
 
 import (
+	"bytes"
 	"encoding/xml"
+	"io"
 	"strings"
 )
 
+// XmlNodeTypeEnum defines the type of the XmlNodeType enumeration.
+type XmlNodeTypeEnum int
+
+// XmlNodeType is an enumeration of the possible types of an XmlNode. Nodes parsed into an
+// XmlDocument tree are always elements, other node types are produced by XPath evaluation.
+var XmlNodeType = struct {
+	// Element defines an XML element node.
+	Element XmlNodeTypeEnum
+	// Attribute defines an XML attribute node, the attribute value is the node value.
+	Attribute XmlNodeTypeEnum
+	// Text defines an XML text node, the text content of an element.
+	Text XmlNodeTypeEnum
+	// Document defines the XML document root node, i.e., the parent of the root element.
+	Document XmlNodeTypeEnum
+}{
+	Element:   0,
+	Attribute: 1,
+	Text:      2,
+	Document:  3,
+}
+
 // XmlNode represents a single node in an XmlDocument tree.
 type XmlNode struct {
 	// XMLName gets the Go encoding xml.Name of this node.
@@ -58,6 +81,13 @@ type XmlNode struct {
 	Level int `xml:"-"`
 	// Owner is the XmlDocument to which this current node belongs.
 	Owner *XmlDocument `xml:"-"`
+
+	nodeType XmlNodeTypeEnum
+}
+
+// NodeType gets the XmlNodeType of this node.
+func (xn *XmlNode) NodeType() XmlNodeTypeEnum {
+	return xn.nodeType
 }
 
 // Path gets the full path of this node within its XmlDocument tree.
@@ -86,6 +116,36 @@ func (xn *XmlNode) Value() string {
 	return string(xn.InnerXml)
 }
 
+// InnerText gets the text content of this node and its child nodes with any character
+// references, predefined entities and CDATA sections decoded. If the content cannot be
+// decoded, the raw InnerXml is returned.
+func (xn *XmlNode) InnerText() string {
+	if !bytes.ContainsAny(xn.InnerXml, "&<\r") {
+		return string(xn.InnerXml)
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(xn.InnerXml))
+	var text strings.Builder
+
+	for {
+		token, err := decoder.Token()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return xn.Value()
+		}
+
+		if charData, ok := token.(xml.CharData); ok {
+			text.Write(charData)
+		}
+	}
+
+	return text.String()
+}
+
 // Prefix looks up namespace prefix for this node.
 func (xn *XmlNode) Prefix() string {
 	namespace := xn.Namespace
@@ -134,8 +194,7 @@ func (xn *XmlNode) GetChildNodes() []*XmlNode {
 	return childNodes
 }
 
-// SelectNodes finds all nodes matching xpath expression for each input node.
-// Predicates currently only support "=" matching.
+// SelectNodes finds all nodes matching xpath expression for each input node. See XmlNode.SelectNodes.
 func SelectNodes(nodes []*XmlNode, xpath string) []*XmlNode {
 	results := make([]*XmlNode, 0)
 
@@ -146,128 +205,45 @@ func SelectNodes(nodes []*XmlNode, xpath string) []*XmlNode {
 	return results
 }
 
-// SelectNodes finds all nodes matching xpath expression.
-// Predicates currently only support "=" matching.
-//gocyclo:ignore
+// SelectNodes finds all nodes matching the XPath 1.0 xpath expression evaluated with this node
+// as the context node. An empty slice is returned if the expression is invalid or does not
+// evaluate to a node-set. Use CompileXPath for access to parsing errors and other result types.
 func (xn *XmlNode) SelectNodes(xpath string) []*XmlNode {
-	// This is a simple XPath implementation, this can be expanded
-	// as needed, perhaps using github.com/antchfx/xpath
-	nodes := xn.GetChildNodes()
-	exprs := strings.Split(xpath, "/")
-	var results []*XmlNode
-
-	for i := 0; i < len(exprs); i++ {
-		expr := exprs[i]
-		results = make([]*XmlNode, 0)
-
-		for _, node := range nodes {
-			// Look for predicate expression
-			lbp := strings.IndexRune(expr, '[')
-			rbp := strings.IndexRune(expr, ']')
-
-			if lbp > -1 && rbp > -1 && rbp > lbp && len(expr) > 2 {
-				name := expr[:lbp]
-				predicate := expr[lbp+1 : rbp]
-
-				if predicate[0] == '@' {
-					if isMatch(node.Name, name) && node.attributeMatch(predicate[1:]) {
-						results = append(results, node)
-					}
-				} else {
-					if isMatch(node.Name, name) && node.childValueMatch(predicate) {
-						results = append(results, node)
-					}
-				}
-			} else if isMatch(node.Name, expr) {
-				results = append(results, node)
-			}
-		}
-
-		if len(results) == 0 {
-			break
-		}
+	expression, err := CompileXPath(xpath)
 
-		if i < len(exprs)-1 {
-			nodes = make([]*XmlNode, 0, len(results))
-
-			// Check all macthing children of next level
-			for _, result := range results {
-				nodes = append(nodes, result.GetChildNodes()...)
-			}
-		}
+	if err != nil {
+		return make([]*XmlNode, 0)
 	}
 
-	return results
-}
-
-func isMatch(value, name string) bool {
-	return value == name || name == "*"
-}
-
-func (xn *XmlNode) attributeMatch(expr string) bool {
-	parts := strings.Split(expr, "=")
+	nodes, err := expression.Select(xn)
 
-	for i := 0; i < len(parts); i++ {
-		parts[i] = strings.TrimSpace(parts[i])
+	if err != nil || nodes == nil {
+		return make([]*XmlNode, 0)
 	}
 
-	if parts[0] == "*" {
-		if len(parts) == 1 {
-			return true
-		}
-
-		// Check if any attribute value matches
-		for _, value := range xn.Attributes {
-			if value == removeQuotes(parts[1]) {
-				return true
-			}
-		}
-	} else {
-		// Check if named attribute value matches
-		if value, found := xn.Attributes[parts[0]]; found {
-			if len(parts) == 2 {
-				return value == removeQuotes(parts[1])
-			}
-
-			return true
-		}
-	}
-
-	return false
-}
-
-func (xn *XmlNode) childValueMatch(expr string) bool {
-	for _, node := range xn.GetChildNodes() {
-		if node.valueMatch(expr) {
-			return true
-		}
-	}
-
-	return false
+	return nodes
 }
 
-func (xn *XmlNode) valueMatch(expr string) bool {
-	parts := strings.Split(expr, "=")
+// SelectSingleNode finds the first node, in document order, matching the XPath 1.0 xpath
+// expression evaluated with this node as the context node, or nil if there is no match.
+func (xn *XmlNode) SelectSingleNode(xpath string) *XmlNode {
+	nodes := xn.SelectNodes(xpath)
 
-	for i := 0; i < len(parts); i++ {
-		parts[i] = strings.TrimSpace(parts[i])
+	if len(nodes) == 0 {
+		return nil
 	}
 
-	if parts[0] == xn.Name || parts[0] == "*" {
-		if len(parts) == 2 {
-			return xn.Value() == removeQuotes(parts[1])
-		}
-
-		return true
-	}
-
-	return false
+	return nodes[0]
 }
 
-func removeQuotes(value string) string {
-	if strings.HasPrefix(value, "'") && strings.HasSuffix(value, "'") && len(value) > 2 {
-		value = value[1 : len(value)-1]
+// Evaluate evaluates the XPath 1.0 xpath expression with this node as the context node.
+// See XPathExpression.Evaluate for the possible result types.
+func (xn *XmlNode) Evaluate(xpath string) (interface{}, error) {
+	expression, err := CompileXPath(xpath)
+
+	if err != nil {
+		return nil, err
 	}
 
-	return value
+	return expression.Evaluate(xn)
 }